@@ -2,11 +2,16 @@ package main
 
 import (
 	"backend/internal/application/usecases"
+	"backend/internal/domain"
 	"backend/internal/infrastructure/config"
 	"backend/internal/infrastructure/http/handlers"
 	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/infrastructure/jobs"
+	"backend/internal/infrastructure/metadata"
+	"backend/internal/infrastructure/orcid"
 	"backend/internal/infrastructure/persistence/postgres"
 	"backend/internal/infrastructure/security"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/utils"
 	"context"
 	"log"
@@ -45,6 +50,18 @@ func main() {
 	// ---- Initilization of Store ----
 	store := postgres.NewStore(pool)
 
+	// ---- Initilization of Token Encryption ----
+	orcidTokenCipher, err := security.NewTokenCipher(cfg.OrcidTokenKey)
+	if err != nil {
+		log.Fatalf("Error initializing ORCID token encryption: %v", err)
+	}
+
+	// ---- Initilization of Trusted Proxies ----
+	trustedProxies, err := utils.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		log.Fatalf("Error parsing trusted proxies: %v", err)
+	}
+
 	// ---- Initialization of Repositories ----
 	userRepo := postgres.NewPGUserRepository(store)
 	userSessionRepo := postgres.NewPGUserSessionRepository(store)
@@ -61,8 +78,42 @@ func main() {
 	employeePIERepo := postgres.NewPgEmployeeParticipationInEventRepository(store)
 	employeeResearchActivityRepo := postgres.NewPgEmployeeResearchActivityRepository(store)
 	employeeMRARepo := postgres.NewPgEmployeeMainResearchAreaRepository(store)
+	employeeOrcidRepo := postgres.NewPgEmployeeOrcidRepository(store, orcidTokenCipher)
+	publicationRepo := postgres.NewPgPublicationRepository(store)
+	publicationCitationRepo := postgres.NewPgPublicationCitationRepository(store)
+	translationGroupRepo := postgres.NewPgTranslationGroupRepository(store)
+	languageRepo := postgres.NewPgLanguageRepository(store)
+	degreeLevelRepo := postgres.NewPgDegreeLevelRepository(store)
+	specialityRepo := postgres.NewPgSpecialityRepository(store)
+	researchFieldRepo := postgres.NewPgResearchFieldRepository(store)
+	keywordRepo := postgres.NewPgKeywordRepository(store)
+	expertRepo := postgres.NewPgExpertRepository(store)
+	employeeSimilarityRepo := postgres.NewPgEmployeeSimilarityRepository(store)
+	collaborationRepo := postgres.NewPgCollaborationRepository(store)
+	employeeCompletenessRepo := postgres.NewPgEmployeeCompletenessRepository(store)
+	employeeVisibilityRepo := postgres.NewPgEmployeeVisibilityRepository(store)
+	consentRepo := postgres.NewPgConsentRepository(store)
+	workplaceMappingRepo := postgres.NewPgWorkplaceMappingRepository(store)
 
 	institutionRepo := postgres.NewPgInstitutionRepository(store)
+	institutionDetailsRepo := postgres.NewPGInstitutionDetailsRepository(store)
+	orgUnitRepo := postgres.NewPgOrgUnitRepository(store)
+	institutionSocialRepo := postgres.NewPgInstitutionSocialRepository(store)
+
+	// ---- Initialization of External Services ----
+	publicationMetadataResolver := metadata.NewCachedResolver(
+		metadata.NewCrossrefResolver(cfg.CrossrefBaseURL, cfg.CrossrefMailto, time.Duration(cfg.CrossrefTimeout)*time.Second),
+		time.Duration(cfg.DOICacheTTL)*time.Minute,
+	)
+	orcidClient := orcid.NewClient(orcid.Config{
+		BaseURL:      cfg.OrcidBaseURL,
+		APIBaseURL:   cfg.OrcidAPIBaseURL,
+		ClientID:     cfg.OrcidClientID,
+		ClientSecret: cfg.OrcidClientSecret,
+		RedirectURI:  cfg.OrcidRedirectURI,
+		Scope:        cfg.OrcidScope,
+		Timeout:      time.Duration(cfg.OrcidTimeout) * time.Second,
+	})
 
 	// ---- Initilization of Security Components
 	tokenManager := security.NewTokenManager(
@@ -72,27 +123,54 @@ func main() {
 		time.Duration(cfg.JWTRefreshExpiryHours)*7*24*60*60*time.Second,
 	)
 	validator := validator.New()
+	if err := languages.RegisterValidation(validator); err != nil {
+		log.Fatalf("Error registering language validation: %v", err)
+	}
 
 	// ---- Initialization of Use Cases ----
+	languageUC := usecases.NewLanguageUsecase(languageRepo)
+	if err := languageUC.Reload(ctx); err != nil {
+		log.Fatalf("Error loading language registry: %v", err)
+	}
+	if !languages.IsEnabled(cfg.OrcidImportLanguage) {
+		log.Fatalf("ORCID_IMPORT_LANGUAGE(%s) is not an enabled language", cfg.OrcidImportLanguage)
+	}
+	log.Printf("Language registry loaded: %v", languages.Codes())
+
 	authUC := usecases.NewAuthUsecase(userRepo, userSessionRepo, employeeRepo, store, tokenManager, validator)
-	employeeUC := usecases.NewEmployeeUsecase(employeeRepo, store, validator)
+	employeeUC := usecases.NewEmployeeUsecase(employeeRepo, store, validator, cfg.PublicBaseURL, cfg.LanguageFallbackChain)
 	employeeDetailsUC := usecases.NewEmployeeDetailsUsecase(employeeDetailsRepo, store, validator)
-	employeeDegreeUC := usecases.NewEmployeeDegreeUsecase(employeeDegreeRepo, validator)
-	employeeWorkExperienceUC := usecases.NewEmployeeWorkExperienceUsecase(employeeWorkExperienceRepo, validator)
-	employeePublicationUC := usecases.NewEmployeePublicationUsecase(employeePublicationRepo, validator)
-	employeeScientificAwardUC := usecases.NewEmployeeScientificAwardUsecase(employeeScientificAwardRepo, validator)
-	employeePatentUC := usecases.NewEmployeePatentUsecase(employeePatentRepo, validator)
-	employeePIPCUC := usecases.NewEmployeeParticipationInProfessionalCommunityUsecase(employeePIPCRepo, validator)
+	employeeDegreeUC := usecases.NewEmployeeDegreeUsecase(employeeDegreeRepo, degreeLevelRepo, specialityRepo, validator, cfg.LanguageFallbackChain)
+	employeeWorkExperienceUC := usecases.NewEmployeeWorkExperienceUsecase(employeeWorkExperienceRepo, institutionDetailsRepo, orgUnitRepo, validator, cfg.LanguageFallbackChain)
+	employeePublicationUC := usecases.NewEmployeePublicationUsecase(employeeRepo, employeePublicationRepo, publicationCitationRepo, publicationMetadataResolver, store, validator, cfg.LanguageFallbackChain)
+	employeeScientificAwardUC := usecases.NewEmployeeScientificAwardUsecase(employeeScientificAwardRepo, validator, cfg.LanguageFallbackChain)
+	employeePatentUC := usecases.NewEmployeePatentUsecase(employeePatentRepo, validator, cfg.LanguageFallbackChain)
+	employeePIPCUC := usecases.NewEmployeeParticipationInProfessionalCommunityUsecase(employeePIPCRepo, validator, cfg.LanguageFallbackChain)
 	employeeSocialUC := usecases.NewEmployeeSocialUsecase(employeeSocialRepo, validator)
-	employeeRefresherUC := usecases.NewEmployeeRefresherCourseUsecase(employeeRefresherRepo, validator)
-	employeeParticipationInEventUC := usecases.NewEmployeeParticipationInEventUsecase(employeePIERepo, validator)
-	employeeResearchActivityUC := usecases.NewEmployeeResearchActivityUsecase(employeeResearchActivityRepo, validator)
-	employeeMRAUC := usecases.NewEmployeeMainResearchAreaUsecase(employeeMRARepo, store, validator)
+	employeeRefresherUC := usecases.NewEmployeeRefresherCourseUsecase(employeeRefresherRepo, validator, cfg.LanguageFallbackChain)
+	employeeParticipationInEventUC := usecases.NewEmployeeParticipationInEventUsecase(employeePIERepo, validator, cfg.LanguageFallbackChain)
+	employeeResearchActivityUC := usecases.NewEmployeeResearchActivityUsecase(employeeResearchActivityRepo, validator, cfg.LanguageFallbackChain)
+	employeeMRAUC := usecases.NewEmployeeMainResearchAreaUsecase(employeeMRARepo, researchFieldRepo, store, validator, cfg.LanguageFallbackChain)
+	publicationUC := usecases.NewPublicationUsecase(publicationRepo, employeeRepo, store, validator)
+	employeeOrcidUC := usecases.NewEmployeeOrcidUsecase(employeeRepo, employeeOrcidRepo, orcidClient, orcidTokenCipher, store, validator, cfg.OrcidImportLanguage)
 	reportUC := usecases.NewReportUsecase(store, validator)
+	translationGroupUC := usecases.NewTranslationGroupUsecase(employeeRepo, institutionRepo, translationGroupRepo)
+	workplaceMappingUC := usecases.NewWorkplaceMappingUsecase(workplaceMappingRepo, store, validator)
+	degreeLevelUC := usecases.NewDegreeLevelUsecase(degreeLevelRepo)
+	specialityUC := usecases.NewSpecialityUsecase(specialityRepo, store, validator)
+	researchFieldUC := usecases.NewResearchFieldUsecase(researchFieldRepo, store, validator)
+	keywordUC := usecases.NewKeywordUsecase(keywordRepo, store, validator)
+	expertUC := usecases.NewExpertUsecase(expertRepo, degreeLevelRepo, employeeRepo, institutionRepo, employeeVisibilityRepo, cfg.LanguageFallbackChain)
+	employeeSimilarityUC := usecases.NewEmployeeSimilarityUsecase(employeeRepo, institutionRepo, employeeVisibilityRepo, employeeSimilarityRepo, store, cfg.LanguageFallbackChain)
+	collaborationUC := usecases.NewCollaborationUsecase(employeeRepo, institutionRepo, employeeVisibilityRepo, collaborationRepo, cfg.LanguageFallbackChain)
+	employeeCompletenessUC := usecases.NewEmployeeCompletenessUsecase(employeeRepo, institutionRepo, employeeCompletenessRepo)
+	employeeVisibilityUC := usecases.NewEmployeeVisibilityUsecase(employeeRepo, institutionRepo, employeeVisibilityRepo, store, validator)
+	consentUC := usecases.NewConsentUsecase(consentRepo, store, validator, cfg.LanguageFallbackChain)
 
-	institutionUC := usecases.NewInstitutionUsecase(institutionRepo, validator, store)
+	orgUnitUC := usecases.NewOrgUnitUsecase(orgUnitRepo, institutionRepo, store, validator)
+	institutionUC := usecases.NewInstitutionUsecase(institutionRepo, institutionDetailsRepo, institutionSocialRepo, validator, store, cfg.PublicBaseURL, cfg.LanguageFallbackChain)
 	// ---- Initialization of HTTP Handlers ----
-	authHandlers := handlers.NewAuthHandler(authUC, cfg.CookieDomain, cfg.CookieSecure)
+	authHandlers := handlers.NewAuthHandler(authUC, cfg.CookieDomain, cfg.CookieSecure, trustedProxies)
 	employeeHandlers := handlers.NewEmployeeHandler(employeeUC)
 	employeeDetailsHandler := handlers.NewEmployeeDetailsHandler(employeeDetailsUC)
 	employeeDegreeHandler := handlers.NewEmployeeDegreeHandler(employeeDegreeUC)
@@ -106,15 +184,44 @@ func main() {
 	employeePIEHandler := handlers.NewEmployeeParticipationInEventHandler(employeeParticipationInEventUC)
 	employeeResearchActivityHandler := handlers.NewEmployeeResearchActivityHandler(employeeResearchActivityUC)
 	employeeMRAHandler := handlers.NewEmployeeMainResearchAreaHandler(employeeMRAUC)
+	employeeOrcidHandler := handlers.NewEmployeeOrcidHandler(employeeOrcidUC)
+	publicationHandler := handlers.NewPublicationHandler(publicationUC)
 	reportHandler := handlers.NewReportHandler(reportUC)
+	translationGroupHandler := handlers.NewTranslationGroupHandler(translationGroupUC)
+	languageHandler := handlers.NewLanguageHandler(languageUC)
+	workplaceMappingHandler := handlers.NewWorkplaceMappingHandler(workplaceMappingUC)
+	degreeLevelHandler := handlers.NewDegreeLevelHandler(degreeLevelUC)
+	specialityHandler := handlers.NewSpecialityHandler(specialityUC)
+	researchFieldHandler := handlers.NewResearchFieldHandler(researchFieldUC)
+	keywordHandler := handlers.NewKeywordHandler(keywordUC)
+	expertHandler := handlers.NewExpertHandler(expertUC)
+	employeeSimilarityHandler := handlers.NewEmployeeSimilarityHandler(employeeSimilarityUC)
+	collaborationHandler := handlers.NewCollaborationHandler(collaborationUC)
+	employeeCompletenessHandler := handlers.NewEmployeeCompletenessHandler(employeeCompletenessUC)
+	employeeVisibilityHandler := handlers.NewEmployeeVisibilityHandler(employeeVisibilityUC)
+	consentHandler := handlers.NewConsentHandler(consentUC, trustedProxies)
 
 	institutionHandler := handlers.NewInstitutionHandler(institutionUC)
+	orgUnitHandler := handlers.NewOrgUnitHandler(orgUnitUC)
 	// --- Initilization of Routes
 	authMiddleware := middleware.CreateAuthMiddleware(tokenManager, utils.RespondWithError)
+	adminMiddleware := middleware.CreateRoleMiddleware(utils.RespondWithError, domain.UserRoleAdmin)
+	optionalAuthMiddleware := middleware.CreateOptionalAuthMiddleware(tokenManager)
+	// sectionMiddleware serves a section of a profile only to the viewers the employee shares it with
+	sectionMiddleware := func(section string, next http.HandlerFunc) http.HandlerFunc {
+		return optionalAuthMiddleware(employeeVisibilityHandler.RequireSection(section, next))
+	}
 	mainMux := http.NewServeMux()
 	mainMux.HandleFunc("GET /ping", func(w http.ResponseWriter, r *http.Request) {
 		utils.RespondWithJSON(w, r, http.StatusOK, map[string]string{"ping": "pong"})
 	})
+	mainMux.HandleFunc("GET /languages", languageHandler.GetEnabled)
+	mainMux.HandleFunc("GET /degree-levels", degreeLevelHandler.GetAll)
+	mainMux.HandleFunc("GET /specialities", specialityHandler.GetChildren)
+	mainMux.HandleFunc("GET /research-fields", researchFieldHandler.GetAll)
+	mainMux.HandleFunc("GET /research-fields/stats", researchFieldHandler.GetStats)
+	mainMux.HandleFunc("GET /keywords", keywordHandler.Search)
+	mainMux.HandleFunc("GET /consent/terms", consentHandler.GetCurrent)
 
 	// Auth Routes
 	authMux := http.NewServeMux()
@@ -123,85 +230,125 @@ func main() {
 	authMux.HandleFunc("POST /refresh-token", authHandlers.RefreshToken)
 	authMux.HandleFunc("POST /logout", authHandlers.Logout)
 	authMux.HandleFunc("GET /me", authMiddleware(authHandlers.Me))
+	authMux.HandleFunc("PUT /me/preferred-language", authMiddleware(authHandlers.UpdatePreferredLanguage))
+	authMux.HandleFunc("GET /consent", authMiddleware(consentHandler.GetStatus))
+	authMux.HandleFunc("POST /consent", authMiddleware(consentHandler.Accept))
 	mainMux.Handle("/auth/", http.StripPrefix("/auth", authMux))
 
 	//Employee Handlers
 	employeeMux := http.NewServeMux()
 
-	employeeMux.HandleFunc("GET /{uid}", employeeHandlers.GetByUID)
+	employeeMux.HandleFunc("GET /{uid}", optionalAuthMiddleware(employeeHandlers.GetByUID))
 	employeeMux.HandleFunc("PUT /profile-picture/{uid}", employeeHandlers.UpdateProfilePicture)
 	employeeMux.HandleFunc("GET /profile-picture/{uid}", employeeHandlers.GetProfilePicture)
 	employeeMux.HandleFunc("GET /personnel", employeeHandlers.GetPersonnelPaginated)
 	employeeMux.HandleFunc("GET /personnel/count", employeeHandlers.GetPersonnelCountPaginated)
+	employeeMux.HandleFunc("GET /experts", optionalAuthMiddleware(expertHandler.Search))
 	employeeMux.HandleFunc("GET /personnel/list-of-highest-academic-degrees", employeeHandlers.ListUniqueHighestAcademicDegrees)
 	employeeMux.HandleFunc("GET /personnel/list-specialities", employeeHandlers.ListUniqueSpecialities)
 	employeeMux.HandleFunc("GET /personnel/list-workplaces", employeeWorkExperienceHandler.ListUniqueOngoingWorkplaces)
 
 	// ---- employee/detials
 	employeeMux.HandleFunc("PUT /details", authMiddleware(employeeDetailsHandler.Update))
-	employeeMux.HandleFunc("GET /details/{employeeID}", employeeDetailsHandler.GetByEmployeeID)
+	employeeMux.HandleFunc("GET /details/{employeeID}", sectionMiddleware(domain.VisibilitySectionDetails, employeeDetailsHandler.GetByEmployeeID))
+	employeeMux.HandleFunc("DELETE /details/{id}", authMiddleware(employeeDetailsHandler.Delete))
 	// ---- employee/degree
-	employeeMux.HandleFunc("GET /degree/{employeeID}", employeeDegreeHandler.GetByEmployeeIDAndLanguageCode)
+	employeeMux.HandleFunc("GET /degree/{employeeID}", sectionMiddleware(domain.VisibilitySectionDegrees, employeeDegreeHandler.GetByEmployeeIDAndLanguageCode))
 	employeeMux.HandleFunc("PUT /degree", authMiddleware(employeeDegreeHandler.Update))
 	employeeMux.HandleFunc("POST /degree", authMiddleware(employeeDegreeHandler.Create))
 	// ---- employee/work-experience
 	employeeMux.HandleFunc("DELETE /degree/{id}", authMiddleware(employeeDegreeHandler.Delete))
-	employeeMux.HandleFunc("GET /work-experience/{employeeID}", employeeWorkExperienceHandler.GetByEmployeeIDAndLanguageCode)
+	employeeMux.HandleFunc("GET /work-experience/{employeeID}", sectionMiddleware(domain.VisibilitySectionWorkExperiences, employeeWorkExperienceHandler.GetByEmployeeIDAndLanguageCode))
 	employeeMux.HandleFunc("POST /work-experience", authMiddleware(employeeWorkExperienceHandler.Create))
 	employeeMux.HandleFunc("PUT /work-experience", authMiddleware(employeeWorkExperienceHandler.Update))
 	employeeMux.HandleFunc("DELETE /work-experience/{id}", authMiddleware(employeeWorkExperienceHandler.Delete))
+	// ---- employee/visibility
+	employeeMux.HandleFunc("GET /visibility", authMiddleware(employeeVisibilityHandler.Get))
+	employeeMux.HandleFunc("PUT /visibility", authMiddleware(employeeVisibilityHandler.Update))
+	// ---- employee/orcid
+	employeeMux.HandleFunc("GET /orcid", authMiddleware(employeeOrcidHandler.Get))
+	employeeMux.HandleFunc("POST /orcid/authorize", authMiddleware(employeeOrcidHandler.StartLinking))
+	employeeMux.HandleFunc("POST /orcid/link", authMiddleware(employeeOrcidHandler.CompleteLinking))
+	employeeMux.HandleFunc("POST /orcid/sync", authMiddleware(employeeOrcidHandler.Sync))
+	employeeMux.HandleFunc("PUT /orcid", authMiddleware(employeeOrcidHandler.Update))
+	employeeMux.HandleFunc("DELETE /orcid", authMiddleware(employeeOrcidHandler.Unlink))
 	// ---- employee/publication
-	employeeMux.HandleFunc("GET /publication/{employeeID}", employeePublicationHandler.GetByEmployeeIDAndLanguageCode)
+	employeeMux.HandleFunc("GET /publication/resolve", authMiddleware(employeePublicationHandler.ResolveDOI))
+	employeeMux.HandleFunc("GET /publication/{employeeID}", sectionMiddleware(domain.VisibilitySectionPublications, employeePublicationHandler.GetByEmployeeIDAndLanguageCode))
+	employeeMux.HandleFunc("GET /publication/{employeeID}/export", sectionMiddleware(domain.VisibilitySectionPublications, employeePublicationHandler.Export))
+	employeeMux.HandleFunc("POST /publication/import/preview/{employeeID}", authMiddleware(employeePublicationHandler.PreviewImport))
+	employeeMux.HandleFunc("POST /publication/import", authMiddleware(employeePublicationHandler.Import))
 	employeeMux.HandleFunc("POST /publication", authMiddleware(employeePublicationHandler.Create))
 	employeeMux.HandleFunc("PUT /publication", authMiddleware(employeePublicationHandler.Update))
 	employeeMux.HandleFunc("DELETE /publication/{id}", authMiddleware(employeePublicationHandler.Delete))
+	employeeMux.HandleFunc("PUT /publication/citations", authMiddleware(employeePublicationHandler.UpdateCitations))
+	employeeMux.HandleFunc("POST /publication/citations/import/{employeeID}", authMiddleware(employeePublicationHandler.ImportCitations))
+	// ---- employee/shared-publication
+	employeeMux.HandleFunc("GET /shared-publication/suggestions", authMiddleware(publicationHandler.ListClaimSuggestions))
+	employeeMux.HandleFunc("GET /shared-publication/{id}", publicationHandler.GetByID)
+	employeeMux.HandleFunc("POST /shared-publication/claim", authMiddleware(publicationHandler.Claim))
+	employeeMux.HandleFunc("DELETE /shared-publication/{id}/claim", authMiddleware(publicationHandler.Unclaim))
 	// ---- employee/scientific-award
-	employeeMux.HandleFunc("GET /scientific-award/{employeeID}", employeeScientificAwardHandler.GetByEmployeeIDAndLanguageCode)
+	employeeMux.HandleFunc("GET /scientific-award/{employeeID}", sectionMiddleware(domain.VisibilitySectionScientificAwards, employeeScientificAwardHandler.GetByEmployeeIDAndLanguageCode))
 	employeeMux.HandleFunc("POST /scientific-award", authMiddleware(employeeScientificAwardHandler.Create))
 	employeeMux.HandleFunc("PUT /scientific-award", authMiddleware(employeeScientificAwardHandler.Update))
 	employeeMux.HandleFunc("DELETE /scientific-award/{id}", authMiddleware(employeeScientificAwardHandler.Delete))
 	// --- employee/patent
-	employeeMux.HandleFunc("GET /patent/{employeeID}", employeePatentHandler.GetByEmployeeIDAndLanguageCode)
+	employeeMux.HandleFunc("GET /patent/{employeeID}", sectionMiddleware(domain.VisibilitySectionPatents, employeePatentHandler.GetByEmployeeIDAndLanguageCode))
 	employeeMux.HandleFunc("POST /patent", authMiddleware(employeePatentHandler.Create))
 	employeeMux.HandleFunc("PUT /patent", authMiddleware(employeePatentHandler.Update))
 	employeeMux.HandleFunc("DELETE /patent/{id}", authMiddleware(employeePatentHandler.Delete))
 	// --- employee/pipc
-	employeeMux.HandleFunc("GET /pipc/{employeeID}", employeePIPCHandler.GetByEmployeeIDAndLanguageCode)
+	employeeMux.HandleFunc("GET /pipc/{employeeID}", sectionMiddleware(domain.VisibilitySectionProfessionalCommunities, employeePIPCHandler.GetByEmployeeIDAndLanguageCode))
 	employeeMux.HandleFunc("POST /pipc", authMiddleware(employeePIPCHandler.Create))
 	employeeMux.HandleFunc("PUT /pipc", authMiddleware(employeePIPCHandler.Update))
 	employeeMux.HandleFunc("DELETE /pipc/{id}", authMiddleware(employeePIPCHandler.Delete))
 	// --- employee/social
-	employeeMux.HandleFunc("GET /social/{employeeID}", employeeSocialHandler.GetByEmployeeID)
+	employeeMux.HandleFunc("GET /social/{employeeID}", sectionMiddleware(domain.VisibilitySectionSocials, employeeSocialHandler.GetByEmployeeID))
 	employeeMux.HandleFunc("POST /social", authMiddleware(employeeSocialHandler.Create))
 	employeeMux.HandleFunc("PUT /social", authMiddleware(employeeSocialHandler.Update))
 	employeeMux.HandleFunc("DELETE /social/{id}", authMiddleware(employeeSocialHandler.Delete))
 	// --- employee/refresher-course
-	employeeMux.HandleFunc("GET /refresher-course/{employeeID}", employeeRefresherHandler.GetByEmployeeIDAndLanguageCode)
+	employeeMux.HandleFunc("GET /refresher-course/{employeeID}", sectionMiddleware(domain.VisibilitySectionRefresherCourses, employeeRefresherHandler.GetByEmployeeIDAndLanguageCode))
 	employeeMux.HandleFunc("POST /refresher-course", authMiddleware(employeeRefresherHandler.Create))
 	employeeMux.HandleFunc("PUT /refresher-course", authMiddleware(employeeRefresherHandler.Update))
 	employeeMux.HandleFunc("DELETE /refresher-course/{id}", authMiddleware(employeeRefresherHandler.Delete))
 	// --- employee/pie
-	employeeMux.HandleFunc("GET /pie/{employeeID}", employeePIEHandler.GetByEmployeeIDAndLanguageCode)
+	employeeMux.HandleFunc("GET /pie/{employeeID}", sectionMiddleware(domain.VisibilitySectionEvents, employeePIEHandler.GetByEmployeeIDAndLanguageCode))
 	employeeMux.HandleFunc("POST /pie", authMiddleware(employeePIEHandler.Create))
 	employeeMux.HandleFunc("PUT /pie", authMiddleware(employeePIEHandler.Update))
 	employeeMux.HandleFunc("DELETE /pie/{id}", authMiddleware(employeePIEHandler.Delete))
 	// --- employee/research-activity
-	employeeMux.HandleFunc("GET /research-activity/{employeeID}", employeeResearchActivityHandler.GetByEmployeeIDAndLanguageCode)
+	employeeMux.HandleFunc("GET /research-activity/{employeeID}", sectionMiddleware(domain.VisibilitySectionResearchActivities, employeeResearchActivityHandler.GetByEmployeeIDAndLanguageCode))
 	employeeMux.HandleFunc("POST /research-activity", authMiddleware(employeeResearchActivityHandler.Create))
 	employeeMux.HandleFunc("PUT /research-activity", authMiddleware(employeeResearchActivityHandler.Update))
 	employeeMux.HandleFunc("DELETE /research-activity/{id}", authMiddleware(employeeResearchActivityHandler.Delete))
 	// --- employee/mra
-	employeeMux.HandleFunc("GET /mra/{employeeID}", employeeMRAHandler.GetByEmployeeIDAndLanguageCode)
+	employeeMux.HandleFunc("GET /mra/{employeeID}", sectionMiddleware(domain.VisibilitySectionMainResearchAreas, employeeMRAHandler.GetByEmployeeIDAndLanguageCode))
 	employeeMux.HandleFunc("POST /mra", authMiddleware(employeeMRAHandler.Create))
 	employeeMux.HandleFunc("PUT /mra", authMiddleware(employeeMRAHandler.Update))
 	employeeMux.HandleFunc("DELETE /mra/{id}", authMiddleware(employeeMRAHandler.Delete))
 
+	employeeMux.HandleFunc("GET /translations/missing/{employeeID}", authMiddleware(translationGroupHandler.GetMissingByEmployeeID))
+
 	mainMux.Handle("/employee/", http.StripPrefix("/employee", employeeMux))
+	// registered on the main mux because "/{uid}/cv" would conflict with "/publication/{employeeID}" and alike in employeeMux
+	mainMux.HandleFunc("GET /employee/{uid}/cv", optionalAuthMiddleware(employeeHandlers.GenerateCV))
+	mainMux.HandleFunc("GET /employee/{uid}/similar", optionalAuthMiddleware(employeeSimilarityHandler.GetSimilar))
+	mainMux.HandleFunc("GET /employee/{uid}/network", optionalAuthMiddleware(collaborationHandler.GetEgoNetwork))
+	mainMux.HandleFunc("GET /employee/{uid}/completeness", authMiddleware(employeeCompletenessHandler.GetByUniqueID))
 
 	//Institution handlers
 	institutionMux := http.NewServeMux()
 	institutionMux.HandleFunc("GET /all", institutionHandler.GetAllInstitutions)
 	institutionMux.HandleFunc("GET /names", institutionHandler.GetAllInstitutionName)
+	institutionMux.HandleFunc("GET /{id}", institutionHandler.GetByID)
+	institutionMux.HandleFunc("GET /translations/missing/{institutionID}", authMiddleware(translationGroupHandler.GetMissingByInstitutionID))
+	institutionMux.HandleFunc("GET /{id}/units", orgUnitHandler.GetTree)
+	institutionMux.HandleFunc("GET /{id}/network", optionalAuthMiddleware(collaborationHandler.GetInstitutionNetwork))
+	institutionMux.HandleFunc("POST /{id}/units", authMiddleware(orgUnitHandler.Create))
+	institutionMux.HandleFunc("PUT /{id}/units/{unitID}", authMiddleware(orgUnitHandler.Update))
+	institutionMux.HandleFunc("DELETE /{id}/units/{unitID}", authMiddleware(orgUnitHandler.Delete))
 
 	mainMux.Handle("/institution/", http.StripPrefix("/institution", institutionMux))
 
@@ -211,12 +358,26 @@ func main() {
 
 	mainMux.Handle("/report/", http.StripPrefix("/report", reportMux))
 
+	//Admin handlers
+	adminMux := http.NewServeMux()
+	adminMux.HandleFunc("GET /workplaces/clusters", authMiddleware(adminMiddleware(workplaceMappingHandler.GetClusters)))
+	adminMux.HandleFunc("GET /workplaces/mappings", authMiddleware(adminMiddleware(workplaceMappingHandler.ListBatches)))
+	adminMux.HandleFunc("POST /workplaces/mappings", authMiddleware(adminMiddleware(workplaceMappingHandler.Apply)))
+	adminMux.HandleFunc("POST /workplaces/mappings/{id}/undo", authMiddleware(adminMiddleware(workplaceMappingHandler.Undo)))
+	adminMux.HandleFunc("PUT /research-fields/{code}", authMiddleware(adminMiddleware(researchFieldHandler.Upsert)))
+	adminMux.HandleFunc("POST /keywords/merge", authMiddleware(adminMiddleware(keywordHandler.Merge)))
+	adminMux.HandleFunc("GET /institutions/{id}/completeness", authMiddleware(adminMiddleware(employeeCompletenessHandler.GetLeastCompleteByInstitutionID)))
+	adminMux.HandleFunc("POST /consent-documents", authMiddleware(adminMiddleware(consentHandler.Publish)))
+	adminMux.HandleFunc("GET /consents/export", authMiddleware(adminMiddleware(consentHandler.Export)))
+
+	mainMux.Handle("/admin/", http.StripPrefix("/admin", adminMux))
+
 	// ---- Server initialization ----
 	mainMiddlewareStack := middleware.CreateMiddlewareStack(
 		middleware.PanicRecoveryMiddleware(utils.RespondWithError),
 		middleware.CORSMiddleware(middleware.DefaultCORSConfig),
 		middleware.RequestIDMiddleware,
-		middleware.LanguageMiddleware,
+		middleware.CreateLanguageMiddleware(tokenManager),
 	)
 	srv := &http.Server{
 		Addr:         "localhost:" + cfg.Port,
@@ -226,6 +387,19 @@ func main() {
 		IdleTimeout:  time.Duration(cfg.IdleTimeout) * time.Second,
 	}
 
+	// ---- Background jobs ----
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	jobs.RunPeriodically(jobsCtx, "language-registry-reload", time.Duration(cfg.LanguageReloadInterval)*time.Minute, languageUC.Reload)
+
+	orcidSyncInterval := time.Duration(cfg.OrcidSyncInterval) * time.Minute
+	jobs.RunPeriodically(jobsCtx, "orcid-sync", min(orcidSyncInterval, time.Hour), func(ctx context.Context) error {
+		return employeeOrcidUC.SyncDue(ctx, orcidSyncInterval)
+	})
+
+	jobs.RunPeriodically(jobsCtx, "employee-similarity-refresh", time.Duration(cfg.SimilarityRefreshInterval)*time.Minute, employeeSimilarityUC.Refresh)
+
 	go func() {
 		log.Printf("Server starting on %s", srv.Addr)
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -240,6 +414,7 @@ func main() {
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopJobs()
 
 	ctx, cancel = context.WithTimeout(context.Background(), time.Duration(cfg.ShutdownTimeout)*time.Second)
 	defer cancel()
@@ -0,0 +1,83 @@
+// import-specialities loads the national speciality classifier from a CSV or XLSX file into the database.
+// The file has a header row with the columns code, parent_code and name_<language>(e.g. name_tg, name_ru, name_en),
+// parent_code is left empty for top level groups. Nodes already imported are updated, nodes missing from the file are kept.
+//
+//	go run ./cmd/import-specialities -file specialities.xlsx
+package main
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/config"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/shared/classifier"
+	"backend/internal/shared/languages"
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func main() {
+	filePath := flag.String("file", "", "path to the classifier file(.csv or .xlsx)")
+	flag.Parse()
+	if *filePath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.LoadConfig(".")
+	if err != nil {
+		log.Fatalf("Error initializing config: %v", err)
+	}
+
+	rows, err := classifier.ReadFile(*filePath)
+	if err != nil {
+		log.Fatalf("Error reading classifier file: %v", err)
+	}
+
+	req := &dtos.ImportSpecialitiesRequest{}
+	for _, row := range rows {
+		if row.Code == "" {
+			log.Fatalf("Error reading classifier file: line %d has no code", row.Line)
+		}
+
+		req.Specialities = append(req.Specialities, &dtos.ImportSpecialityRequest{
+			Code:       row.Code,
+			ParentCode: row.ParentCode,
+			Names:      row.Names,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	pool, err := postgres.NewPostgresDB(ctx, cfg)
+	if err != nil {
+		log.Fatalf("Error connecting to database: %v", err)
+	}
+	defer pool.Close()
+
+	store := postgres.NewStore(pool)
+
+	validator := validator.New()
+	if err := languages.RegisterValidation(validator); err != nil {
+		log.Fatalf("Error registering language validation: %v", err)
+	}
+
+	languageUC := usecases.NewLanguageUsecase(postgres.NewPgLanguageRepository(store))
+	if err := languageUC.Reload(ctx); err != nil {
+		log.Fatalf("Error loading language registry: %v", err)
+	}
+
+	specialityUC := usecases.NewSpecialityUsecase(postgres.NewPgSpecialityRepository(store), store, validator)
+	resp, err := specialityUC.Import(ctx, req)
+	if err != nil {
+		log.Fatalf("Error importing specialities: %v", err)
+	}
+
+	log.Printf("Specialities imported from %s: %d created, %d updated", *filePath, resp.Created, resp.Updated)
+}
@@ -6,6 +6,13 @@ type RegisterRequest struct {
 	Gender   string `json:"gender" validate:"required"`
 	Email    string `json:"email" validate:"required"`
 	Password string `json:"password" validate:"required"`
+	// PreferredLanguage is optional, requests fall back to Accept-Language while it is empty
+	PreferredLanguage string `json:"preferredLanguage" validate:"omitempty,language"`
+	// AcceptedTermsVersion is the version of the terms of publishing personal data shown to the user,
+	// it has to be the latest one once terms are published
+	AcceptedTermsVersion int32 `json:"acceptedTermsVersion" validate:"omitempty,min=1"`
+	// IPAddress is set by the handler and recorded with the consent
+	IPAddress string `json:"-"`
 }
 
 type AuthRequest struct {
@@ -21,6 +28,11 @@ type RefreshTokenRequest struct {
 	RefreshToken string `json:"refreshToken" validate:"required"`
 }
 
+// Empty PreferredLanguage clears the preference
+type UpdatePreferredLanguageRequest struct {
+	PreferredLanguage string `json:"preferredLanguage" validate:"omitempty,language"`
+}
+
 // ---- RESPONSE DTOs ----
 type AuthResponse struct {
 	AccessToken  string `json:"accessToken"`
@@ -31,5 +43,16 @@ type AuthResponse struct {
 }
 
 type MeResponse struct {
-	UniqueID string `json:"uniqueID"`
+	UniqueID          string `json:"uniqueID"`
+	PreferredLanguage string `json:"preferredLanguage"`
+	UserRole          string `json:"userRole"`
+	// ConsentRequired is set while the user has not accepted the latest terms of publishing personal data
+	ConsentRequired bool `json:"consentRequired"`
+}
+
+// AccessToken is reissued so that it carries the new preference
+type PreferredLanguageResponse struct {
+	PreferredLanguage string `json:"preferredLanguage"`
+	AccessToken       string `json:"accessToken"`
+	TokenType         string `json:"tokenType,omitempty"`
 }
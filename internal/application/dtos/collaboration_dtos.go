@@ -0,0 +1,31 @@
+package dtos
+
+// ---- RESPONSE DTOs ----
+
+// CollaborationGraphResponse is an undirected graph of employees, nodes are identified by the unique ID of the employee
+type CollaborationGraphResponse struct {
+	Nodes []*CollaborationNodeResponse `json:"nodes"`
+	Edges []*CollaborationEdgeResponse `json:"edges"`
+	// Truncated tells that only the strongest collaborators were kept
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// CollaborationNodeResponse - IsEgo marks the employee an ego network is built around,
+// IsExternal the collaborators from outside of the institution of an institution network
+type CollaborationNodeResponse struct {
+	ID                   string `json:"id"`
+	Label                string `json:"label"`
+	CurrentWorkplace     string `json:"currentWorkplace"`
+	CurrentInstitutionID int64  `json:"currentInstitutionID,omitempty"`
+	IsEgo                bool   `json:"isEgo,omitempty"`
+	IsExternal           bool   `json:"isExternal,omitempty"`
+	Degree               int    `json:"degree"`
+}
+
+// CollaborationEdgeResponse - Types counts the shared items by kind (publication, project, event, community), Weight is their sum
+type CollaborationEdgeResponse struct {
+	Source string           `json:"source"`
+	Target string           `json:"target"`
+	Weight int32            `json:"weight"`
+	Types  map[string]int32 `json:"types"`
+}
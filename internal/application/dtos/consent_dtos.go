@@ -0,0 +1,51 @@
+package dtos
+
+import "time"
+
+// ---- REQUEST DTOs ----
+
+// AcceptConsentRequest - Version has to be the latest published version of the terms
+type AcceptConsentRequest struct {
+	Version int32 `json:"version" validate:"required,min=1"`
+	// IPAddress is set by the handler and recorded with the consent
+	IPAddress string `json:"-"`
+}
+
+// PublishConsentDocumentsRequest publishes a new version of the terms with its text in every given language
+type PublishConsentDocumentsRequest struct {
+	Documents []*ConsentDocumentRequest `json:"documents" validate:"required,min=1,dive"`
+}
+
+type ConsentDocumentRequest struct {
+	LanguageCode string `json:"languageCode" validate:"required,language"`
+	Title        string `json:"title" validate:"required,max=255"`
+	Body         string `json:"body" validate:"required"`
+}
+
+// ---- RESPONSE DTOs ----
+
+type ConsentDocumentResponse struct {
+	Version      int32     `json:"version"`
+	LanguageCode string    `json:"languageCode"`
+	Title        string    `json:"title"`
+	Body         string    `json:"body"`
+	PublishedAt  time.Time `json:"publishedAt"`
+}
+
+// ConsentStatusResponse - versions are zero while there are none
+type ConsentStatusResponse struct {
+	CurrentVersion  int32 `json:"currentVersion"`
+	AcceptedVersion int32 `json:"acceptedVersion"`
+	ConsentRequired bool  `json:"consentRequired"`
+}
+
+type UserConsentResponse struct {
+	ID               int64     `json:"id"`
+	UserID           int64     `json:"userID"`
+	Email            string    `json:"email"`
+	EmployeeUniqueID string    `json:"employeeUniqueID,omitempty"`
+	DocumentVersion  int32     `json:"documentVersion"`
+	LanguageCode     string    `json:"languageCode"`
+	IPAddress        string    `json:"ipAddress"`
+	AcceptedAt       time.Time `json:"acceptedAt"`
+}
@@ -0,0 +1,17 @@
+package dtos
+
+// ---- RESPONSE DTOs ----
+
+type DegreeLevelResponse struct {
+	Code string `json:"code"`
+	Rank int32  `json:"rank"`
+	// Name is the name in the requested language, Names holds it in every language by language code
+	Name  string            `json:"name"`
+	Names map[string]string `json:"names"`
+}
+
+type HighestAcademicDegreeResponse struct {
+	// Code is the value to filter personnel by
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,27 @@
+package dtos
+
+// ---- RESPONSE DTOs ----
+
+// EmployeeCompletenessResponse - completeness of a profile in one language, Score is out of 100,
+// NotListedReasons explain why the employee is missing from the personnel listing in that language
+type EmployeeCompletenessResponse struct {
+	UID              string                        `json:"uid"`
+	Fullname         string                        `json:"fullname,omitempty"`
+	LanguageCode     string                        `json:"languageCode"`
+	Score            int                           `json:"score"`
+	Listed           bool                          `json:"listed"`
+	Missing          []*CompletenessItemResponse   `json:"missing"`
+	NotListedReasons []*CompletenessReasonResponse `json:"notListedReasons"`
+}
+
+// CompletenessItemResponse - Weight is the number of points the item adds to the score once filled
+type CompletenessItemResponse struct {
+	Item    string `json:"item"`
+	Weight  int    `json:"weight"`
+	Message string `json:"message"`
+}
+
+type CompletenessReasonResponse struct {
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
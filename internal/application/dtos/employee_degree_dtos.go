@@ -6,10 +6,12 @@ import "time"
 
 type CreateEmployeeDegreeRequest struct {
 	EmployeeID         int64     `json:"employeeID" validate:"required,min=1"`
-	LanguageCode       string    `json:"-" validate:"required,len=2"`
-	DegreeLevel        string    `json:"degreeLevel" validate:"required"`
+	LanguageCode       string    `json:"-" validate:"required,language"`
+	TranslationGroupID string    `json:"translationGroupId" validate:"omitempty,uuid"`
+	DegreeLevelCode    string    `json:"degreeLevelCode" validate:"required"`
 	UniversityName     string    `json:"universityName" validate:"required"`
 	Speciality         string    `json:"speciality" validate:"required"`
+	SpecialityCode     string    `json:"specialityCode" validate:"omitempty"`
 	DateStart          time.Time `json:"dateStart" validate:"required"`
 	DateEnd            time.Time `json:"dateEnd" validate:"required"`
 	GivenBy            string    `json:"givenBy" validate:"required"`
@@ -18,9 +20,10 @@ type CreateEmployeeDegreeRequest struct {
 
 type UpdateEmployeeDegreeRequest struct {
 	ID                 int64      `json:"id" validate:"required,min=1"`
-	DegreeLevel        *string    `json:"degreeLevel" validate:"omitempty"`
+	DegreeLevelCode    *string    `json:"degreeLevelCode" validate:"omitempty"`
 	UniversityName     *string    `json:"universityName" validate:"omitempty"`
 	Speciality         *string    `json:"speciality" validate:"omitempty"`
+	SpecialityCode     *string    `json:"specialityCode" validate:"omitempty"`
 	DateStart          *time.Time `json:"dateStart" validate:"omitempty"`
 	DateEnd            *time.Time `json:"dateEnd" validate:"omitempty"`
 	GivenBy            *string    `json:"givenBy" validate:"omitempty"`
@@ -31,9 +34,13 @@ type UpdateEmployeeDegreeRequest struct {
 
 type EmployeeDegreeResponse struct {
 	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	LanguageCode       string    `json:"languageCode"`
+	DegreeLevelCode    string    `json:"degreeLevelCode"`
 	DegreeLevel        string    `json:"degreeLevel"`
 	UniversityName     string    `json:"universityName"`
 	Speciality         string    `json:"speciality"`
+	SpecialityCode     string    `json:"specialityCode,omitempty"`
 	DateStart          time.Time `json:"dateStart"`
 	DateEnd            time.Time `json:"dateEnd"`
 	GivenBy            string    `json:"givenBy"`
@@ -5,21 +5,25 @@ import "time"
 // ---- REQUEST DTOS ----
 
 type CreateEmployeeDetailsRequest struct {
-	LanguageCode         string `json:"languageCode" validate:"required,len=2"`
-	Surname              string `json:"surname" validate:"required"`
-	Name                 string `json:"name" validate:"required"`
-	Middlename           string `json:"middlename" validate:"required"`
-	IsEmployeeDetailsNew bool   `json:"isNewEmployeeDetails" validate:"required"`
+	LanguageCode         string     `json:"languageCode" validate:"required,language"`
+	Surname              string     `json:"surname" validate:"required"`
+	Name                 string     `json:"name" validate:"required"`
+	Middlename           string     `json:"middlename" validate:"required"`
+	IsEmployeeDetailsNew bool       `json:"isNewEmployeeDetails" validate:"required"`
+	EffectiveFrom        *time.Time `json:"effectiveFrom" validate:"omitempty"`
+	EffectiveTo          *time.Time `json:"effectiveTo" validate:"omitempty"`
 }
 
 type UpdateEmployeeDetailsRequest struct {
-	ID                   int64   `json:"id" validate:"min=0"`
-	EmployeeID           int64   `json:"employeeID" validate:"required,min=1"`
-	LanguageCode         string  `json:"languageCode" validate:"required,len=2"`
-	Surname              *string `json:"surname"`
-	Name                 *string `json:"name"`
-	Middlename           *string `json:"middlename"`
-	IsEmployeeDetailsNew *bool   `json:"isNewEmployeeDetails"`
+	ID                   int64      `json:"id" validate:"min=0"`
+	EmployeeID           int64      `json:"employeeID" validate:"required,min=1"`
+	LanguageCode         string     `json:"languageCode" validate:"required,language"`
+	Surname              *string    `json:"surname"`
+	Name                 *string    `json:"name"`
+	Middlename           *string    `json:"middlename"`
+	IsEmployeeDetailsNew *bool      `json:"isNewEmployeeDetails"`
+	EffectiveFrom        *time.Time `json:"effectiveFrom" validate:"omitempty"`
+	EffectiveTo          *time.Time `json:"effectiveTo" validate:"omitempty"`
 }
 
 type UpdateFullEmployeeData struct {
@@ -35,6 +39,8 @@ type EmployeeDetailsResponse struct {
 	Name                 string    `json:"name"`
 	Middlename           string    `json:"middlename"`
 	IsEmployeeDetailsNew bool      `json:"isNewEmployeeDetails"`
+	EffectiveFrom        time.Time `json:"effectiveFrom"`
+	EffectiveTo          time.Time `json:"effectiveTo"`
 	CreatedAt            time.Time `json:"createdAt"`
 	UpdatedAt            time.Time `json:"updatedAt"`
 }
@@ -19,10 +19,18 @@ type PersonnelPaginatedQueryParameters struct {
 	UID            string
 	AcademicDegree string
 	Speciality     string
+	SpecialityCode string
+	Keyword        string
 	Name           string
 	Surname        string
 	Middlename     string
 	Workplace      string
+	InstitutionID  int64
+	OrgUnitID      int64
+	MinHIndex      int32
+	MinI10Index    int32
+	MinCitations   int32
+	SortBy         string
 	Limit          int64
 	Page           int64
 }
@@ -33,9 +41,18 @@ type EmployeeResponse struct {
 	ID        int64     `json:"id"`
 	UniqueID  string    `json:"uniqueID"`
 	Gender    string    `json:"gender"`
+	ORCID     string    `json:"orcid,omitempty"`
+	TIN       string    `json:"tin,omitempty"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 
+	HIndex         int32 `json:"hIndex"`
+	I10Index       int32 `json:"i10Index"`
+	TotalCitations int32 `json:"totalCitations"`
+
+	CurrentInstitutionID int64 `json:"currentInstitutionID,omitempty"`
+	CurrentOrgUnitID     int64 `json:"currentOrgUnitID,omitempty"`
+
 	Details                                []*EmployeeDetailsResponse                              `json:"details,omitempty"`
 	Degrees                                []*EmployeeDegreeResponse                               `json:"degrees,omitempty"`
 	WorkExperiences                        []*EmployeeWorkExperienceResponse                       `json:"workExperiences,omitempty"`
@@ -56,6 +73,12 @@ type PersonnelProfileData struct {
 	HighestAcademicDegree string                   `json:"highestAcademicDegree"`
 	Speciality            string                   `json:"speciality"`
 	CurrentWorkplace      string                   `json:"currentWorkplace"`
+	CurrentInstitutionID  int64                    `json:"currentInstitutionID,omitempty"`
+	CurrentOrgUnitID      int64                    `json:"currentOrgUnitID,omitempty"`
 	WorkExperience        int64                    `json:"workExperience"`
+	PublicationCount      int64                    `json:"publicationCount"`
+	HIndex                int32                    `json:"hIndex"`
+	I10Index              int32                    `json:"i10Index"`
+	TotalCitations        int32                    `json:"totalCitations"`
 	Socials               []EmployeeSocialResponse `json:"socials"`
 }
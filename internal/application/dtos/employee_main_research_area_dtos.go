@@ -5,22 +5,25 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateEmployeeMainResearchAreaRequest struct {
-	EmployeeID   int64                                `json:"employeeID" validate:"required,min=1"`
-	LanguageCode string                               `json:"languageCode" validate:"required,len=2"`
-	Area         string                               `json:"area" validate:"required"`
-	Discipline   string                               `json:"discipline" validate:"required"`
-	KeyTopics    []*CreateResearchAreaKeyTopicRequest `json:"keyTopics" validate:"required,dive"`
+	EmployeeID         int64                                `json:"employeeID" validate:"required,min=1"`
+	LanguageCode       string                               `json:"languageCode" validate:"required,language"`
+	TranslationGroupID string                               `json:"translationGroupId" validate:"omitempty,uuid"`
+	Area               string                               `json:"area" validate:"required"`
+	Discipline         string                               `json:"discipline" validate:"required"`
+	ResearchFieldCode  string                               `json:"researchFieldCode" validate:"omitempty,max=31"`
+	KeyTopics          []*CreateResearchAreaKeyTopicRequest `json:"keyTopics" validate:"required,dive"`
 }
 
 type UpdateEmployeeMainResearchAreaRequest struct {
-	ID         int64                                `json:"id" validate:"required,min=1"`
-	Discipline *string                              `json:"discipline" validate:"omitempty"`
-	Area       *string                              `json:"area" validate:"omitempty"`
-	KeyTopics  []*UpdateResearchAreaKeyTopicRequest `json:"keyTopics" required:"omitempty,dive"`
+	ID                int64                                `json:"id" validate:"required,min=1"`
+	Discipline        *string                              `json:"discipline" validate:"omitempty"`
+	Area              *string                              `json:"area" validate:"omitempty"`
+	ResearchFieldCode *string                              `json:"researchFieldCode" validate:"omitempty,max=31"`
+	KeyTopics         []*UpdateResearchAreaKeyTopicRequest `json:"keyTopics" required:"omitempty,dive"`
 }
 
 type CreateResearchAreaKeyTopicRequest struct {
-	LanguageCode  string `json:"languageCode" validate:"required,len=2"`
+	LanguageCode  string `json:"languageCode" validate:"required,language"`
 	KeyTopicTitle string `json:"keyTopicTitle"`
 }
 
@@ -32,17 +35,22 @@ type UpdateResearchAreaKeyTopicRequest struct {
 // ---- RESPONSE DTOs ----
 
 type EmployeeMainResearchAreaResponse struct {
-	ID         int64                           `json:"id"`
-	Discipline string                          `json:"discipline"`
-	Area       string                          `json:"area"`
-	KeyTopics  []*ResearchAreaKeyTopicResponse `json:"keyTopics,omitempty"`
-	CreatedAt  time.Time                       `json:"createdAt"`
-	UpdatedAt  time.Time                       `json:"updatedAt"`
+	ID                 int64                           `json:"id"`
+	TranslationGroupID string                          `json:"translationGroupId"`
+	LanguageCode       string                          `json:"languageCode"`
+	Discipline         string                          `json:"discipline"`
+	Area               string                          `json:"area"`
+	ResearchFieldCode  string                          `json:"researchFieldCode,omitempty"`
+	KeyTopics          []*ResearchAreaKeyTopicResponse `json:"keyTopics,omitempty"`
+	CreatedAt          time.Time                       `json:"createdAt"`
+	UpdatedAt          time.Time                       `json:"updatedAt"`
 }
 
 type ResearchAreaKeyTopicResponse struct {
 	ID            int64     `json:"id"`
+	LanguageCode  string    `json:"languageCode,omitempty"`
 	KeyTopicTitle string    `json:"keyTopicTitle"`
+	KeywordID     int64     `json:"keywordId,omitempty"`
 	CreatedAt     time.Time `json:"createdAt"`
 	UpdatedAt     time.Time `json:"updatedAt"`
 }
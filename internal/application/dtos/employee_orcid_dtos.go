@@ -0,0 +1,31 @@
+package dtos
+
+import "time"
+
+// ---- REQUEST DTOS ----
+type LinkEmployeeOrcidRequest struct {
+	State string `json:"state" validate:"required"`
+	Code  string `json:"code" validate:"required"`
+}
+
+type UpdateEmployeeOrcidRequest struct {
+	ORCID string `json:"orcid" validate:"required"`
+}
+
+// ---- RESPONSE DTOS ----
+type EmployeeOrcidAuthorizationResponse struct {
+	AuthorizationURL string `json:"authorizationURL"`
+	State            string `json:"state"`
+}
+
+type EmployeeOrcidAccountResponse struct {
+	ORCID        string     `json:"orcid"`
+	Linked       bool       `json:"linked"`
+	LastSyncedAt *time.Time `json:"lastSyncedAt,omitempty"`
+}
+
+type EmployeeOrcidSyncResponse struct {
+	ImportedPublications    int `json:"importedPublications"`
+	ImportedWorkExperiences int `json:"importedWorkExperiences"`
+	SkippedPublications     int `json:"skippedPublications"`
+}
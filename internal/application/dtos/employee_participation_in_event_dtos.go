@@ -5,24 +5,27 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateEmployeeParticipationInEventRequest struct {
-	EmployeeID                     int64     `json:"employeeID" validate:"required,min=1"`
-	LanguageCode                   string    `json:"-" validate:"required,len=2"`
-	EventTitle                     string    `json:"eventTitle" validate:"required"`
-	EventDate                      time.Time `json:"eventDate" validate:"required"`
+	EmployeeID         int64     `json:"employeeID" validate:"required,min=1"`
+	LanguageCode       string    `json:"-" validate:"required,language"`
+	TranslationGroupID string    `json:"translationGroupId" validate:"omitempty,uuid"`
+	EventTitle         string    `json:"eventTitle" validate:"required"`
+	EventDate          time.Time `json:"eventDate" validate:"required"`
 }
 
 type UpdateEmployeeParticipationInEventRequest struct {
-	ID                             int64      `json:"id" validate:"required,min=1"`
-	EventTitle                     *string    `json:"eventTitle" validate:"omitempty"`
-	EventDate                      *time.Time `json:"eventDate" validate:"omitempty"`
+	ID         int64      `json:"id" validate:"required,min=1"`
+	EventTitle *string    `json:"eventTitle" validate:"omitempty"`
+	EventDate  *time.Time `json:"eventDate" validate:"omitempty"`
 }
 
 // ---- RESPONSE DTOs ----
 
 type EmployeeParticipationInEventResponse struct {
-	ID                             int64     `json:"id"`
-	EventTitle                     string    `json:"eventTitle"`
-	EventDate                      time.Time `json:"eventDate"`
-	CreatedAt                      time.Time `json:"createdAt"`
-	UpdatedAt                      time.Time `json:"updatedAt"`
+	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	LanguageCode       string    `json:"languageCode"`
+	EventTitle         string    `json:"eventTitle"`
+	EventDate          time.Time `json:"eventDate"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -6,7 +6,8 @@ import "time"
 
 type CreateEmployeeParticipationInProfessionalCommunityRequest struct {
 	EmployeeID                  int64  `json:"employeeID" validate:"required,min=1"`
-	LanguageCode                string `json:"-" validate:"required,len=2"`
+	LanguageCode                string `json:"-" validate:"required,language"`
+	TranslationGroupID          string `json:"translationGroupId" validate:"omitempty,uuid"`
 	ProfessionalCommunityTitle  string `json:"professionalCommunityTitle" validate:"required"`
 	RoleInProfessionalCommunity string `json:"roleInProfessionalCommunity" validate:"required"`
 }
@@ -21,6 +22,8 @@ type UpdateEmployeeParticipationInProfessionalCommunityRequest struct {
 
 type EmployeeParticipationInProfessionalCommunityResponse struct {
 	ID                          int64     `json:"id"`
+	TranslationGroupID          string    `json:"translationGroupId"`
+	LanguageCode                string    `json:"languageCode"`
 	ProfessionalCommunityTitle  string    `json:"professionalCommunityTitle"`
 	RoleInProfessionalCommunity string    `json:"roleInProfessionalCommunity"`
 	CreatedAt                   time.Time `json:"createdAt"`
@@ -5,10 +5,11 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateEmployeePatentRequest struct {
-	EmployeeID   int64  `json:"employeeID" validate:"required,min=1"`
-	LanguageCode string `json:"-" validate:"required,len=2"`
-	PatentTitle  string `json:"patentTitle" validate:"required"`
-	Description  string `json:"description" validate:"required"`
+	EmployeeID         int64  `json:"employeeID" validate:"required,min=1"`
+	LanguageCode       string `json:"-" validate:"required,language"`
+	TranslationGroupID string `json:"translationGroupId" validate:"omitempty,uuid"`
+	PatentTitle        string `json:"patentTitle" validate:"required"`
+	Description        string `json:"description" validate:"required"`
 }
 
 type UpdateEmployeePatentRequest struct {
@@ -20,9 +21,11 @@ type UpdateEmployeePatentRequest struct {
 // ---- RESPONSE DTOs ----
 
 type EmployeePatentResponse struct {
-	ID          int64     `json:"id"`
-	PatentTitle string    `json:"patentTitle"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	LanguageCode       string    `json:"languageCode"`
+	PatentTitle        string    `json:"patentTitle"`
+	Description        string    `json:"description"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -5,24 +5,98 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateEmployeePublicationRequest struct {
-	EmployeeID        int64  `json:"employeeID" validate:"required,min=1"`
-	LanguageCode      string `json:"-" validate:"required,len=2"`
-	PublicationTitle  string `json:"publicationTitle" validate:"required"`
-	LinkToPublication string `json:"linkToPublication" validate:"required"`
+	EmployeeID         int64    `json:"employeeID" validate:"required,min=1"`
+	LanguageCode       string   `json:"-" validate:"required,language"`
+	TranslationGroupID string   `json:"translationGroupId" validate:"omitempty,uuid"`
+	PublicationTitle   string   `json:"publicationTitle" validate:"required_without=DOI"`
+	LinkToPublication  string   `json:"linkToPublication" validate:"required_without=DOI"`
+	Authors            []string `json:"authors" validate:"omitempty"`
+	PublicationYear    int32    `json:"publicationYear" validate:"omitempty,min=1000,max=9999"`
+	Venue              string   `json:"venue" validate:"omitempty"`
+	DOI                string   `json:"doi" validate:"omitempty"`
+	PublicationType    string   `json:"publicationType" validate:"omitempty"`
 }
 
 type UpdateEmployeePublicationRequest struct {
-	ID                int64   `json:"id" validate:"required,min=1"`
-	PublicationTitle  *string `json:"publicationTitle" validate:"omitempty"`
-	LinkToPublication *string `json:"linkToPublication" validate:"omitempty"`
+	ID                int64     `json:"id" validate:"required,min=1"`
+	PublicationTitle  *string   `json:"publicationTitle" validate:"omitempty"`
+	LinkToPublication *string   `json:"linkToPublication" validate:"omitempty"`
+	Authors           *[]string `json:"authors" validate:"omitempty"`
+	PublicationYear   *int32    `json:"publicationYear" validate:"omitempty,eq=0|min=1000,max=9999"`
+	Venue             *string   `json:"venue" validate:"omitempty"`
+	DOI               *string   `json:"doi" validate:"omitempty"`
+	PublicationType   *string   `json:"publicationType" validate:"omitempty"`
+}
+
+type UpdateEmployeePublicationCitationsRequest struct {
+	ID            int64  `json:"id" validate:"required,min=1"`
+	CitationCount *int32 `json:"citationCount" validate:"required,min=0"`
+}
+
+type ImportEmployeePublicationsRequest struct {
+	EmployeeID   int64                                    `json:"employeeID" validate:"required,min=1"`
+	LanguageCode string                                   `json:"-" validate:"required,language"`
+	Entries      []*ImportEmployeePublicationEntryRequest `json:"entries" validate:"required,min=1,dive"`
+}
+
+type ImportEmployeePublicationEntryRequest struct {
+	PublicationTitle  string   `json:"publicationTitle" validate:"required"`
+	LinkToPublication string   `json:"linkToPublication" validate:"omitempty"`
+	Authors           []string `json:"authors" validate:"omitempty"`
+	PublicationYear   int32    `json:"publicationYear" validate:"omitempty,min=1000,max=9999"`
+	Venue             string   `json:"venue" validate:"omitempty"`
+	DOI               string   `json:"doi" validate:"omitempty"`
+	PublicationType   string   `json:"publicationType" validate:"omitempty"`
 }
 
 // ---- RESPONSE DTOs ----
 
 type EmployeePublicationResponse struct {
-	ID                int64     `json:"id"`
-	PublicationTitle  string    `json:"publicationTitle"`
-	LinkToPublication string    `json:"linkToPublication"`
-	CreatedAt         time.Time `json:"createdAt"`
-	UpdatedAt         time.Time `json:"updatedAt"`
+	ID                 int64                        `json:"id"`
+	TranslationGroupID string                       `json:"translationGroupId"`
+	LanguageCode       string                       `json:"languageCode"`
+	PublicationTitle   string                       `json:"publicationTitle"`
+	LinkToPublication  string                       `json:"linkToPublication"`
+	Authors            []string                     `json:"authors"`
+	PublicationYear    int32                        `json:"publicationYear,omitempty"`
+	Venue              string                       `json:"venue,omitempty"`
+	DOI                string                       `json:"doi,omitempty"`
+	PublicationType    string                       `json:"publicationType,omitempty"`
+	Source             string                       `json:"source"`
+	PublicationID      int64                        `json:"publicationID,omitempty"`
+	Citations          *PublicationCitationResponse `json:"citations,omitempty"`
+	CreatedAt          time.Time                    `json:"createdAt"`
+	UpdatedAt          time.Time                    `json:"updatedAt"`
+}
+
+type PublicationMetadataResponse struct {
+	DOI               string   `json:"doi"`
+	PublicationTitle  string   `json:"publicationTitle"`
+	LinkToPublication string   `json:"linkToPublication"`
+	Authors           []string `json:"authors"`
+	PublicationYear   int32    `json:"publicationYear,omitempty"`
+	Venue             string   `json:"venue,omitempty"`
+	PublicationType   string   `json:"publicationType,omitempty"`
+}
+
+type EmployeePublicationImportPreviewResponse struct {
+	Format  string                                   `json:"format"`
+	Entries []*EmployeePublicationImportEntryPreview `json:"entries"`
+}
+
+type EmployeePublicationImportEntryPreview struct {
+	ImportEmployeePublicationEntryRequest
+	IsDuplicate         bool  `json:"isDuplicate"`
+	DuplicateOfID       int64 `json:"duplicateOfID,omitempty"`
+	IsDuplicateInUpload bool  `json:"isDuplicateInUpload"`
+}
+
+type EmployeePublicationImportResponse struct {
+	Created []*EmployeePublicationResponse `json:"created"`
+	Skipped int                            `json:"skipped"`
+}
+
+type EmployeePublicationCitationImportResponse struct {
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped"`
 }
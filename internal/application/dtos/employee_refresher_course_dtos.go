@@ -5,11 +5,12 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateEmployeeRefresherCourseRequest struct {
-	EmployeeID   int64     `json:"employeeID" validate:"required,min=1"`
-	LanguageCode string    `json:"-" validate:"required,len=2"`
-	CourseTitle  string    `json:"courseTitle" validate:"required"`
-	DateStart    time.Time `json:"dateStart" validate:"required"`
-	DateEnd      time.Time `json:"dateEnd" validate:"required"`
+	EmployeeID         int64     `json:"employeeID" validate:"required,min=1"`
+	LanguageCode       string    `json:"-" validate:"required,language"`
+	TranslationGroupID string    `json:"translationGroupId" validate:"omitempty,uuid"`
+	CourseTitle        string    `json:"courseTitle" validate:"required"`
+	DateStart          time.Time `json:"dateStart" validate:"required"`
+	DateEnd            time.Time `json:"dateEnd" validate:"required"`
 }
 
 type UpdateEmployeeRefresherCourseRequest struct {
@@ -22,10 +23,12 @@ type UpdateEmployeeRefresherCourseRequest struct {
 // ---- RESPONSE DTOs ----
 
 type EmployeeRefresherCourseResponse struct {
-	ID          int64     `json:"id"`
-	CourseTitle string    `json:"courseTitle"`
-	DateStart   time.Time `json:"dateStart"`
-	DateEnd     time.Time `json:"dateEnd"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	LanguageCode       string    `json:"languageCode"`
+	CourseTitle        string    `json:"courseTitle"`
+	DateStart          time.Time `json:"dateStart"`
+	DateEnd            time.Time `json:"dateEnd"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -6,7 +6,8 @@ import "time"
 
 type CreateEmployeeResearchActivityRequest struct {
 	EmployeeID            int64  `json:"employeeID" validate:"required,min=1"`
-	LanguageCode          string `json:"-" validate:"required,len=2"`
+	LanguageCode          string `json:"-" validate:"required,language"`
+	TranslationGroupID    string `json:"translationGroupId" validate:"omitempty,uuid"`
 	ResearchActivityTitle string `json:"researchActivityTitle" validate:"required"`
 	EmployeeRole          string `json:"employeeRole" validate:"required"`
 }
@@ -21,6 +22,8 @@ type UpdateEmployeeResearchActivityRequest struct {
 
 type EmployeeResearchActivityResponse struct {
 	ID                    int64     `json:"id"`
+	TranslationGroupID    string    `json:"translationGroupId"`
+	LanguageCode          string    `json:"languageCode"`
 	ResearchActivityTitle string    `json:"researchActivityTitle"`
 	EmployeeRole          string    `json:"employeeRole"`
 	CreatedAt             time.Time `json:"createdAt"`
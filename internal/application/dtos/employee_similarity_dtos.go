@@ -0,0 +1,20 @@
+package dtos
+
+import "time"
+
+// ---- RESPONSE DTOs ----
+
+// SimilarEmployeeResponse - Shared* count what both researchers have in common, ComputedAt is the time of the last recomputation
+type SimilarEmployeeResponse struct {
+	UID                   string    `json:"uid"`
+	Fullname              string    `json:"fullname"`
+	HighestAcademicDegree string    `json:"highestAcademicDegree"`
+	CurrentWorkplace      string    `json:"currentWorkplace"`
+	CurrentInstitutionID  int64     `json:"currentInstitutionID,omitempty"`
+	Score                 float64   `json:"score"`
+	SharedResearchAreas   int32     `json:"sharedResearchAreas"`
+	SharedKeywords        int32     `json:"sharedKeywords"`
+	SharedEvents          int32     `json:"sharedEvents"`
+	SharedInstitutions    int32     `json:"sharedInstitutions"`
+	ComputedAt            time.Time `json:"computedAt"`
+}
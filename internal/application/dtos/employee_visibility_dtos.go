@@ -0,0 +1,22 @@
+package dtos
+
+// ---- REQUEST DTOs ----
+
+// UpdateEmployeeVisibilityRequest - Target is a section or field of the profile, settings of other targets are left as they are
+type UpdateEmployeeVisibilityRequest struct {
+	Settings []*EmployeeVisibilitySettingRequest `json:"settings" validate:"required,min=1,dive"`
+}
+
+type EmployeeVisibilitySettingRequest struct {
+	Target string `json:"target" validate:"required"`
+	Level  string `json:"level" validate:"required,oneof=public authenticated institution admin private"`
+}
+
+// ---- RESPONSE DTOs ----
+
+// EmployeeVisibilitySettingResponse - Level is the one chosen by the employee or DefaultLevel when there is none
+type EmployeeVisibilitySettingResponse struct {
+	Target       string `json:"target"`
+	Level        string `json:"level"`
+	DefaultLevel string `json:"defaultLevel"`
+}
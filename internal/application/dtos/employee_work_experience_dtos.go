@@ -5,36 +5,47 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateEmployeeWorkExperienceRequest struct {
-	EmployeeID   int64     `json:"employeeID" validate:"required,min=1"`
-	LanguageCode string    `json:"-" validate:"required,len=2"`
-	Workplace    string    `json:"workplace" validate:"required"`
-	Description  string    `json:"description" validate:"required"`
-	JobTitle     string    `json:"jobTitle" validate:"required"`
-	DateStart    time.Time `json:"dateStart" validate:"required"`
-	DateEnd      time.Time `json:"dateEnd" validate:"omitempty"`
-	Ongoing      bool      `json:"ongoing"`
+	EmployeeID         int64     `json:"employeeID" validate:"required,min=1"`
+	LanguageCode       string    `json:"-" validate:"required,language"`
+	TranslationGroupID string    `json:"translationGroupId" validate:"omitempty,uuid"`
+	InstitutionID      int64     `json:"institutionID" validate:"omitempty,min=1"`
+	OrgUnitID          int64     `json:"orgUnitID" validate:"omitempty,min=1"`
+	Workplace          string    `json:"workplace" validate:"required_without_all=InstitutionID OrgUnitID"`
+	Description        string    `json:"description" validate:"required"`
+	JobTitle           string    `json:"jobTitle" validate:"required"`
+	DateStart          time.Time `json:"dateStart" validate:"required"`
+	DateEnd            time.Time `json:"dateEnd" validate:"omitempty"`
+	Ongoing            bool      `json:"ongoing"`
 }
 
 type UpdateEmployeeWorkExperienceRequest struct {
-	ID          int64      `json:"id" validate:"required,min=1"`
-	JobTitle    *string    `json:"jobTitle" validate:"omitempty"`
-	Workplace   *string    `json:"workplace" validate:"omitempty"`
-	Description *string    `json:"description" validate:"omitempty"`
-	DateStart   *time.Time `json:"dateStart" validate:"omitempty"`
-	DateEnd     *time.Time `json:"dateEnd" validate:"omitempty"`
-	Ongoing     bool       `json:"ongoing"`
+	ID       int64   `json:"id" validate:"required,min=1"`
+	JobTitle *string `json:"jobTitle" validate:"omitempty"`
+	// InstitutionID links the work experience to an institution and OrgUnitID to a unit of it, zero removes the link
+	InstitutionID *int64     `json:"institutionID" validate:"omitempty,min=0"`
+	OrgUnitID     *int64     `json:"orgUnitID" validate:"omitempty,min=0"`
+	Workplace     *string    `json:"workplace" validate:"omitempty"`
+	Description   *string    `json:"description" validate:"omitempty"`
+	DateStart     *time.Time `json:"dateStart" validate:"omitempty"`
+	DateEnd       *time.Time `json:"dateEnd" validate:"omitempty"`
+	Ongoing       bool       `json:"ongoing"`
 }
 
 // ---- RESPONSE DTOs ----
 
 type EmployeeWorkExperienceResponse struct {
-	ID          int64     `json:"id"`
-	Workplace   string    `json:"workplace"`
-	Description string    `json:"description"`
-	JobTitle    string    `json:"jobTitle"`
-	DateStart   time.Time `json:"dateStart"`
-	DateEnd     time.Time `json:"dateEnd"`
-	Ongoing     bool      `json:"ongoing"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	LanguageCode       string    `json:"languageCode"`
+	InstitutionID      int64     `json:"institutionID,omitempty"`
+	OrgUnitID          int64     `json:"orgUnitID,omitempty"`
+	Workplace          string    `json:"workplace"`
+	Description        string    `json:"description"`
+	JobTitle           string    `json:"jobTitle"`
+	DateStart          time.Time `json:"dateStart"`
+	DateEnd            time.Time `json:"dateEnd"`
+	Ongoing            bool      `json:"ongoing"`
+	Source             string    `json:"source"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -0,0 +1,40 @@
+package dtos
+
+// ---- REQUEST DTOs ----
+
+// ExpertSearchQueryParameters - Weights overrides the default weight of a profile section,
+// ProfileLanguageCode limits the search to profiles and items in that language
+type ExpertSearchQueryParameters struct {
+	Query               string
+	ProfileLanguageCode string
+	InstitutionID       int64
+	DegreeLevelCode     string
+	Weights             map[string]float64
+	Limit               int64
+}
+
+// ---- RESPONSE DTOs ----
+
+type ExpertResponse struct {
+	UID                   string                   `json:"uid"`
+	Fullname              string                   `json:"fullname"`
+	HighestAcademicDegree string                   `json:"highestAcademicDegree"`
+	CurrentWorkplace      string                   `json:"currentWorkplace"`
+	CurrentInstitutionID  int64                    `json:"currentInstitutionID,omitempty"`
+	Score                 float64                  `json:"score"`
+	MatchedTerms          []string                 `json:"matchedTerms"`
+	Explanations          []*ExpertSectionResponse `json:"explanations"`
+}
+
+// ExpertSectionResponse explains the part of the score earned by one section of the profile
+type ExpertSectionResponse struct {
+	Section string                `json:"section"`
+	Weight  float64               `json:"weight"`
+	Score   float64               `json:"score"`
+	Items   []*ExpertItemResponse `json:"items"`
+}
+
+type ExpertItemResponse struct {
+	Title        string   `json:"title"`
+	MatchedTerms []string `json:"matchedTerms"`
+}
@@ -5,10 +5,11 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateInstitutionAccreditationRequest struct {
-	InstitutionID     int64  `json:"institutionID" validate:"required,min=1"`
-	LanguageCode      string `json:"-" validate:"required,len=2"`
-	AccreditationType string `json:"accreditationType" validate:"required"`
-	GivenBy           string `json:"givenBy" validate:"required"`
+	InstitutionID      int64  `json:"institutionID" validate:"required,min=1"`
+	LanguageCode       string `json:"-" validate:"required,language"`
+	TranslationGroupID string `json:"translationGroupId" validate:"omitempty,uuid"`
+	AccreditationType  string `json:"accreditationType" validate:"required"`
+	GivenBy            string `json:"givenBy" validate:"required"`
 }
 
 type UpdateInstitutionAccreditationRequest struct {
@@ -20,9 +21,11 @@ type UpdateInstitutionAccreditationRequest struct {
 // ---- RESPONSE DTOs ----
 
 type InstitutionAccreditationResponse struct {
-	ID                int64     `json:"id"`
-	AccreditationType string    `json:"accreditationType"`
-	GivenBy           string    `json:"givenBy"`
-	CreatedAt         time.Time `json:"createdAt"`
-	UpdatedAt         time.Time `json:"updatedAt"`
+	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	LanguageCode       string    `json:"languageCode"`
+	AccreditationType  string    `json:"accreditationType"`
+	GivenBy            string    `json:"givenBy"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -5,14 +5,15 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateInstitutionAchievementRequest struct {
-	InstitutionID    int64     `json:"institutionID" validate:"required,min=1"`
-	LanguageCode     string    `json:"-" validate:"required,len=2"`
-	AchievementTitle string    `json:"achievementTitle" validate:"required"`
-	AchievementType  string    `json:"achievementType" validate:"required"`
-	DateReceived     time.Time `json:"dateReceived" validate:"required"`
-	GivenBy          string    `json:"givenBy" validate:"required"`
-	Description      string    `json:"description" validate:"required"`
-	LinkToFile       string    `json:"linkToFile" validate:"required"`
+	InstitutionID      int64     `json:"institutionID" validate:"required,min=1"`
+	LanguageCode       string    `json:"-" validate:"required,language"`
+	TranslationGroupID string    `json:"translationGroupId" validate:"omitempty,uuid"`
+	AchievementTitle   string    `json:"achievementTitle" validate:"required"`
+	AchievementType    string    `json:"achievementType" validate:"required"`
+	DateReceived       time.Time `json:"dateReceived" validate:"required"`
+	GivenBy            string    `json:"givenBy" validate:"required"`
+	Description        string    `json:"description" validate:"required"`
+	LinkToFile         string    `json:"linkToFile" validate:"required"`
 }
 
 type UpdateInstitutionAchievementRequest struct {
@@ -28,13 +29,15 @@ type UpdateInstitutionAchievementRequest struct {
 // ---- RESPONSE DTOs ----
 
 type InstitutionAchievementResponse struct {
-	ID               int64     `json:"id"`
-	AchievementTitle string    `json:"achievementTitle"`
-	AchievementType  string    `json:"achievementType"`
-	DateReceived     time.Time `json:"dateReceived"`
-	GivenBy          string    `json:"givenBy"`
-	Description      string    `json:"description"`
-	LinkToFile       string    `json:"linkToFile"`
-	CreatedAt        time.Time `json:"createdAt"`
-	UpdatedAt        time.Time `json:"updatedAt"`
+	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	LanguageCode       string    `json:"languageCode"`
+	AchievementTitle   string    `json:"achievementTitle"`
+	AchievementType    string    `json:"achievementType"`
+	DateReceived       time.Time `json:"dateReceived"`
+	GivenBy            string    `json:"givenBy"`
+	Description        string    `json:"description"`
+	LinkToFile         string    `json:"linkToFile"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -5,16 +5,17 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateInstitutionConferenceRequest struct {
-	InstitutionID    int64     `json:"institutionID" validate:"required,min=1"`
-	LanguageCode     string    `json:"-" validate:"required,len=2"`
-	ConferenceTitle  string    `json:"conferenceTitle" validate:"required"`
-	Link             string    `json:"link" validate:"required"`
-	LinkToRINC       string    `json:"linkToRINC" validate:"required"`
-	DateOfConference time.Time `json:"dateOfConference" validate:"required"`
+	InstitutionID      int64     `json:"institutionID" validate:"required,min=1"`
+	LanguageCode       string    `json:"-" validate:"required,language"`
+	TranslationGroupID string    `json:"translationGroupId" validate:"omitempty,uuid"`
+	ConferenceTitle    string    `json:"conferenceTitle" validate:"required"`
+	Link               string    `json:"link" validate:"required"`
+	LinkToRINC         string    `json:"linkToRINC" validate:"required"`
+	DateOfConference   time.Time `json:"dateOfConference" validate:"required"`
 }
 
 type UpdateInstitutionConferenceRequest struct {
-	ID               int64     `json:"id" validate:"required,min=1"`
+	ID               int64      `json:"id" validate:"required,min=1"`
 	ConferenceTitle  *string    `json:"conferenceTitle" validate:"omitempty"`
 	Link             *string    `json:"link" validate:"omitempty"`
 	LinkToRINC       *string    `json:"linkToRINC" validate:"omitempty"`
@@ -24,11 +25,13 @@ type UpdateInstitutionConferenceRequest struct {
 // ---- RESPONSE DTOs ----
 
 type InstitutionConferenceResponse struct {
-	ID               int64     `json:"id"`
-	ConferenceTitle  string    `json:"conferenceTitle"`
-	Link             string    `json:"link"`
-	LinkToRINC       string    `json:"linkToRINC"`
-	DateOfConference time.Time `json:"dateOfConference"`
-	CreatedAt        time.Time `json:"createdAt"`
-	UpdatedAt        time.Time `json:"updatedAt"`
+	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	LanguageCode       string    `json:"languageCode"`
+	ConferenceTitle    string    `json:"conferenceTitle"`
+	Link               string    `json:"link"`
+	LinkToRINC         string    `json:"linkToRINC"`
+	DateOfConference   time.Time `json:"dateOfConference"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -5,7 +5,7 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateInstitutionDetailsRequest struct {
-	LanguageCode     string  `json:"languageCode" validate:"required,len=2"`
+	LanguageCode     string  `json:"languageCode" validate:"required,language"`
 	InstitutionTitle string  `json:"institutionTitle" validate:"required"`
 	InstitutionType  string  `json:"institutionType" validate:"required"`
 	LegalStatus      string  `json:"legalStatus" validate:"required"`
@@ -17,7 +17,7 @@ type CreateInstitutionDetailsRequest struct {
 
 type UpdateInstitutionDetailsRequest struct {
 	ID               int64   `json:"id" validate:"required,min=1"`
-	LanguageCode     string  `json:"languageCode" validate:"required,len=2"`
+	LanguageCode     string  `json:"languageCode" validate:"required,language"`
 	InstitutionTitle *string `json:"institutionTitle,omitempty"`
 	InstitutionType  *string `json:"institutionType,omitempty"`
 	LegalStatus      *string `json:"legalStatus,omitempty"`
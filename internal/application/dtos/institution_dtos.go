@@ -45,6 +45,7 @@ type InstitutionResponse struct {
 type AllInstitutionResponse struct {
 	InstitutitonTitleShort string `json:"institutionTitleShort"`
 	InstitutitonTitleLong  string `json:"institutionTitleLong"`
+	LanguageCode           string `json:"languageCode"`
 	MailIndex              string `json:"mainIndex"`
 	City                   string `json:"city"`
 	Address                string `json:"address"`
@@ -5,14 +5,15 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateInstitutionLicenceRequest struct {
-	InstitutionID int64     `json:"institutionID" validate:"required,min=1"`
-	LanguageCode  string    `json:"-" validate:"required,len=2"`
-	LicenceTitle  string    `json:"licenceTitle" validate:"required"`
-	LicenceType   string    `json:"licenceType" validate:"required"`
-	GivenBy       string    `json:"givenBy" validate:"required"`
-	LinkToFile    string    `json:"linkToFole" validate:"required"`
-	DateStart     time.Time `json:"dateStart" validate:"required"`
-	DateEnd       time.Time `json:"dateEnd" validate:"required"`
+	InstitutionID      int64     `json:"institutionID" validate:"required,min=1"`
+	LanguageCode       string    `json:"-" validate:"required,language"`
+	TranslationGroupID string    `json:"translationGroupId" validate:"omitempty,uuid"`
+	LicenceTitle       string    `json:"licenceTitle" validate:"required"`
+	LicenceType        string    `json:"licenceType" validate:"required"`
+	GivenBy            string    `json:"givenBy" validate:"required"`
+	LinkToFile         string    `json:"linkToFole" validate:"required"`
+	DateStart          time.Time `json:"dateStart" validate:"required"`
+	DateEnd            time.Time `json:"dateEnd" validate:"required"`
 }
 
 type UpdateInstitutionLicenceRequest struct {
@@ -28,13 +29,15 @@ type UpdateInstitutionLicenceRequest struct {
 // ---- RESPONSE DTOs ----
 
 type InstitutionLicenceResponse struct {
-	ID            int64     `json:"id"`
-	LicenceTitle  string    `json:"licenceTitle"`
-	LicenceType   string    `json:"licenceType"`
-	GivenBy       string    `json:"givenBy"`
-	LinkToFile    string    `json:"linkToFole"`
-	DateStart     time.Time `json:"dateStart"`
-	DateEnd       time.Time `json:"dateEnd"`
-	CreatedAt     time.Time `json:"createdAt"`
-	UpdatedAt     time.Time `json:"updatedAt"`
+	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	LanguageCode       string    `json:"languageCode"`
+	LicenceTitle       string    `json:"licenceTitle"`
+	LicenceType        string    `json:"licenceType"`
+	GivenBy            string    `json:"givenBy"`
+	LinkToFile         string    `json:"linkToFole"`
+	DateStart          time.Time `json:"dateStart"`
+	DateEnd            time.Time `json:"dateEnd"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -5,11 +5,12 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateInstitutionMagazineRequest struct {
-	InstitutionID int64  `json:"institutionID" validate:"required,min=1"`
-	LanguageCode  string `json:"-" validate:"required,len=2"`
-	MagazineName  string `json:"magazineName" validate:"required"`
-	Link          string `json:"link" validate:"required"`
-	LinkToRINC    string `json:"linkToRINC" validate:"required"`
+	InstitutionID      int64  `json:"institutionID" validate:"required,min=1"`
+	LanguageCode       string `json:"-" validate:"required,language"`
+	TranslationGroupID string `json:"translationGroupId" validate:"omitempty,uuid"`
+	MagazineName       string `json:"magazineName" validate:"required"`
+	Link               string `json:"link" validate:"required"`
+	LinkToRINC         string `json:"linkToRINC" validate:"required"`
 }
 
 type UpdateInstitutionMagazineRequest struct {
@@ -22,10 +23,12 @@ type UpdateInstitutionMagazineRequest struct {
 // ---- RESPONSE DTOs ----
 
 type InstitutionMagazineResponse struct {
-	ID           int64     `json:"id"`
-	MagazineName string    `json:"magazineName"`
-	Link         string    `json:"link"`
-	LinkToRINC   string    `json:"linkToRINC"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	LanguageCode       string    `json:"languageCode"`
+	MagazineName       string    `json:"magazineName"`
+	Link               string    `json:"link"`
+	LinkToRINC         string    `json:"linkToRINC"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
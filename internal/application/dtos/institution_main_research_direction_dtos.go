@@ -6,10 +6,12 @@ import "time"
 
 type CreateInstitutionMainResearchDirectionRequest struct {
 	InstitutionID          int64  `json:"institutionID" validate:"required,min=1"`
-	LanguageCode           string `json:"-" validate:"required,len=2"`
+	LanguageCode           string `json:"-" validate:"required,language"`
+	TranslationGroupID     string `json:"translationGroupId" validate:"omitempty,uuid"`
 	ResearchDirectionTitle string `json:"researchDirectionTitle" validate:"required"`
 	Discipline             string `json:"discipline" validate:"required"`
 	AreaOfResearch         string `json:"areaOfResearch" validate:"required"`
+	ResearchFieldCode      string `json:"researchFieldCode" validate:"omitempty,max=31"`
 }
 
 type UpdateInstitutionMainResearchDirectionRequest struct {
@@ -17,15 +19,19 @@ type UpdateInstitutionMainResearchDirectionRequest struct {
 	ResearchDirectionTitle *string `json:"researchDirectionTitle" validate:"omitempty"`
 	Discipline             *string `json:"discipline" validate:"omitempty"`
 	AreaOfResearch         *string `json:"areaOfResearch" validate:"omitempty"`
+	ResearchFieldCode      *string `json:"researchFieldCode" validate:"omitempty,max=31"`
 }
 
 // ---- RESPONSE DTOs ----
 
 type InstitutionMainResearchDirectionResponse struct {
 	ID                     int64     `json:"id"`
+	TranslationGroupID     string    `json:"translationGroupId"`
+	LanguageCode           string    `json:"languageCode"`
 	ResearchDirectionTitle string    `json:"researchDirectionTitle"`
 	Discipline             string    `json:"discipline"`
 	AreaOfResearch         string    `json:"areaOfResearch"`
+	ResearchFieldCode      string    `json:"researchFieldCode,omitempty"`
 	CreatedAt              time.Time `json:"createdAt"`
 	UpdatedAt              time.Time `json:"updatedAt"`
 }
@@ -5,13 +5,14 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateInstitutionPartnershipRequest struct {
-	InstitutionID  int64     `json:"institutionID" validate:"required,min=1"`
-	LanguageCode   string    `json:"-" validate:"required,len=2"`
-	PartnerName    string    `json:"partnerName" validate:"required"`
-	PartnerType    string    `json:"partnerType" validate:"required"`
-	Goal           string    `json:"goal" validate:"required"`
-	LinkToPartner  string    `json:"linkToPartner" validate:"required"`
-	DateOfContract time.Time `json:"dateOfContract" validate:"required"`
+	InstitutionID      int64     `json:"institutionID" validate:"required,min=1"`
+	LanguageCode       string    `json:"-" validate:"required,language"`
+	TranslationGroupID string    `json:"translationGroupId" validate:"omitempty,uuid"`
+	PartnerName        string    `json:"partnerName" validate:"required"`
+	PartnerType        string    `json:"partnerType" validate:"required"`
+	Goal               string    `json:"goal" validate:"required"`
+	LinkToPartner      string    `json:"linkToPartner" validate:"required"`
+	DateOfContract     time.Time `json:"dateOfContract" validate:"required"`
 }
 
 type UpdateInstitutionPartnershipRequest struct {
@@ -26,12 +27,14 @@ type UpdateInstitutionPartnershipRequest struct {
 // ---- RESPONSE DTOs ----
 
 type InstitutionPartnershipResponse struct {
-	ID             int64     `json:"id"`
-	PartnerName    string    `json:"partnerName"`
-	PartnerType    string    `json:"partnerType"`
-	Goal           string    `json:"goal"`
-	LinkToPartner  string    `json:"linkToPartner"`
-	DateOfContract time.Time `json:"dateOfContract"`
-	CreatedAt      time.Time `json:"createdAt"`
-	UpdatedAt      time.Time `json:"updatedAt"`
+	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	LanguageCode       string    `json:"languageCode"`
+	PartnerName        string    `json:"partnerName"`
+	PartnerType        string    `json:"partnerType"`
+	Goal               string    `json:"goal"`
+	LinkToPartner      string    `json:"linkToPartner"`
+	DateOfContract     time.Time `json:"dateOfContract"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -5,23 +5,25 @@ import "time"
 // ---- REQUEST DTOs ----
 
 type CreateInstitutionProjectRequest struct {
-	InstitutionID   int64                                     `json:"institutionID" validate:"required,min=1"`
-	LanguageCode    string                                    `json:"-" validate:"required,len=2"`
-	ProjectType     string                                    `json:"projectType" validate:"required"`
-	ProjectTitle    string                                    `json:"projectTitle" validate:"required"`
-	DateStart       time.Time                                 `json:"dateStart" validate:"required"`
-	DateEnd         time.Time                                 `json:"dateEnd" validate:"required"`
-	Fund            float64                                   `json:"fund" validate:"required"`
-	InstitutionRole string                                    `json:"institutionRole" validate:"required"`
-	Coordinator     string                                    `json:"coordinator" validate:"required"`
-	Partners        []*CreateInstitutionProjectPartnerRequest `json:"partner" validate:"omitempty,dive"`
+	InstitutionID      int64                                     `json:"institutionID" validate:"required,min=1"`
+	LanguageCode       string                                    `json:"-" validate:"required,language"`
+	TranslationGroupID string                                    `json:"translationGroupId" validate:"omitempty,uuid"`
+	ProjectType        string                                    `json:"projectType" validate:"required"`
+	ProjectTitle       string                                    `json:"projectTitle" validate:"required"`
+	DateStart          time.Time                                 `json:"dateStart" validate:"required"`
+	DateEnd            time.Time                                 `json:"dateEnd" validate:"required"`
+	Fund               float64                                   `json:"fund" validate:"required"`
+	InstitutionRole    string                                    `json:"institutionRole" validate:"required"`
+	Coordinator        string                                    `json:"coordinator" validate:"required"`
+	Partners           []*CreateInstitutionProjectPartnerRequest `json:"partner" validate:"omitempty,dive"`
 }
 
 type CreateInstitutionProjectPartnerRequest struct {
-	LanguageCode  string `json:"languageCode" validate:"required,len=2"`
-	PartnerType   string `json:"partnerType" validate:"required"`
-	PartnerName   string `json:"partnerName" validate:"required"`
-	LinkToPartner string `json:"linkToPartner" validate:"required"`
+	LanguageCode       string `json:"languageCode" validate:"required,language"`
+	TranslationGroupID string `json:"translationGroupId" validate:"omitempty,uuid"`
+	PartnerType        string `json:"partnerType" validate:"required"`
+	PartnerName        string `json:"partnerName" validate:"required"`
+	LinkToPartner      string `json:"linkToPartner" validate:"required"`
 }
 
 type UpdateInstitutionProjectRequest struct {
@@ -46,25 +48,27 @@ type UpdateInstitutionProjectPartnerRequest struct {
 // ---- RESPONSE DTOs ----
 
 type InstitutionProjectResponse struct {
-	ID              int64                               `json:"id"`
-	LanguageCode    string                              `json:"-"`
-	ProjectType     string                              `json:"projectType"`
-	ProjectTitle    string                              `json:"projectTitle"`
-	DateStart       time.Time                           `json:"dateStart"`
-	DateEnd         time.Time                           `json:"dateEnd"`
-	Fund            float64                             `json:"fund"`
-	InstitutionRole string                              `json:"institutionRole"`
-	Coordinator     string                              `json:"coordinator"`
-	Partners        []*InstitutionProjectPartnerResponse `json:"partners,omitempty"`
-	CreatedAt       time.Time                           `json:"createdAt"`
-	UpdatedAt       time.Time                           `json:"updatedAt"`
+	ID                 int64                                `json:"id"`
+	TranslationGroupID string                               `json:"translationGroupId"`
+	LanguageCode       string                               `json:"languageCode"`
+	ProjectType        string                               `json:"projectType"`
+	ProjectTitle       string                               `json:"projectTitle"`
+	DateStart          time.Time                            `json:"dateStart"`
+	DateEnd            time.Time                            `json:"dateEnd"`
+	Fund               float64                              `json:"fund"`
+	InstitutionRole    string                               `json:"institutionRole"`
+	Coordinator        string                               `json:"coordinator"`
+	Partners           []*InstitutionProjectPartnerResponse `json:"partners,omitempty"`
+	CreatedAt          time.Time                            `json:"createdAt"`
+	UpdatedAt          time.Time                            `json:"updatedAt"`
 }
 
 type InstitutionProjectPartnerResponse struct {
-	ID            int64    `json:"id"`
-	PartnerType   string    `json:"partnerType"`
-	PartnerName   string    `json:"partnerName"`
-	LinkToPartner string    `json:"linkToPartner"`
-	CreatedAt     time.Time `json:"createdAt"`
-	UpdatedAt     time.Time `json:"updatedAt"`
+	ID                 int64     `json:"id"`
+	TranslationGroupID string    `json:"translationGroupId"`
+	PartnerType        string    `json:"partnerType"`
+	PartnerName        string    `json:"partnerName"`
+	LinkToPartner      string    `json:"linkToPartner"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -6,7 +6,8 @@ import "time"
 
 type CreateInstitutionRankingRequest struct {
 	InstitutionID           int64     `json:"institutionID" validate:"required,min=1"`
-	LanguageCode            string    `json:"-" validate:"required,len=2"`
+	LanguageCode            string    `json:"-" validate:"required,language"`
+	TranslationGroupID      string    `json:"translationGroupId" validate:"omitempty,uuid"`
 	RankingTitle            string    `json:"rankingTitle" validate:"required"`
 	RankingType             string    `json:"rankingType" validate:"required"`
 	DateReceived            time.Time `json:"dateReceived" validate:"required"`
@@ -31,6 +32,8 @@ type UpdateInstitutionRankingRequest struct {
 
 type InstitutionRankingResponse struct {
 	ID                      int64     `json:"id"`
+	TranslationGroupID      string    `json:"translationGroupId"`
+	LanguageCode            string    `json:"languageCode"`
 	RankingTitle            string    `json:"rankingTitle"`
 	RankingType             string    `json:"rankingType"`
 	DateReceived            time.Time `json:"dateReceived"`
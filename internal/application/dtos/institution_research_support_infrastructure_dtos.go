@@ -6,7 +6,8 @@ import "time"
 
 type CreateInstitutionResearchSupportInfrastructureRequest struct {
 	InstitutionID                      int64  `json:"institutionID" validate:"required,min=1"`
-	LanguageCode                       string `json:"-" validate:"required,len=2"`
+	LanguageCode                       string `json:"-" validate:"required,language"`
+	TranslationGroupID                 string `json:"translationGroupId" validate:"omitempty,uuid"`
 	ResearchSupportInfrastructureTitle string `json:"researchSupportInfrastructureTitle" validate:"required"`
 	ResearchSupportInfrastructureType  string `json:"researchSupportInfrastructureType" validate:"required"`
 	TINOfLegalEntity                   string `json:"tinOfLegalEntity" validate:"required"`
@@ -23,6 +24,8 @@ type UpdateInstitutionResearchSupportInfrastructureRequest struct {
 
 type InstitutionResearchSupportInfrastructureResponse struct {
 	ID                                 int64     `json:"id"`
+	TranslationGroupID                 string    `json:"translationGroupId"`
+	LanguageCode                       string    `json:"languageCode"`
 	ResearchSupportInfrastructureTitle string    `json:"researchSupportInfrastructureTitle"`
 	ResearchSupportInfrastructureType  string    `json:"researchSupportInfrastructureType"`
 	TINOfLegalEntity                   string    `json:"tinOfLegalEntity"`
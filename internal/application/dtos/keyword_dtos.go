@@ -0,0 +1,20 @@
+package dtos
+
+// ---- REQUEST DTOs ----
+
+// MergeKeywordsRequest folds the source keywords into the target, their labels are kept as synonyms of the target
+type MergeKeywordsRequest struct {
+	TargetID  int64   `json:"targetId" validate:"required,min=1"`
+	SourceIDs []int64 `json:"sourceIds" validate:"required,min=1,dive,min=1"`
+}
+
+// ---- RESPONSE DTOs ----
+
+// Label is in the requested language or its fallback, Labels holds the preferred label of every language
+type KeywordResponse struct {
+	ID            int64               `json:"id"`
+	Label         string              `json:"label"`
+	Labels        map[string]string   `json:"labels"`
+	Synonyms      map[string][]string `json:"synonyms,omitempty"`
+	EmployeeCount int64               `json:"employeeCount"`
+}
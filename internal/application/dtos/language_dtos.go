@@ -0,0 +1,10 @@
+package dtos
+
+// ---- RESPONSE DTOs ----
+
+type LanguageResponse struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	NativeName string `json:"nativeName"`
+	IsDefault  bool   `json:"isDefault"`
+}
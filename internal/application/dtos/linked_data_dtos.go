@@ -0,0 +1,73 @@
+package dtos
+
+// --- Response DTOs ---
+// Linked data representations of profiles in schema.org vocabulary, served as JSON-LD (application/ld+json)
+
+const SchemaOrgContext = "https://schema.org"
+
+// PersonJSONLD represents an employee as schema.org/Person
+type PersonJSONLD struct {
+	Context        string                `json:"@context"`
+	Type           string                `json:"@type"`
+	ID             string                `json:"@id,omitempty"`
+	URL            string                `json:"url,omitempty"`
+	Name           string                `json:"name"`
+	GivenName      string                `json:"givenName,omitempty"`
+	FamilyName     string                `json:"familyName,omitempty"`
+	AdditionalName string                `json:"additionalName,omitempty"`
+	AlternateName  []string              `json:"alternateName,omitempty"`
+	JobTitle       []string              `json:"jobTitle,omitempty"`
+	Identifier     []PropertyValueJSONLD `json:"identifier,omitempty"`
+	SameAs         []string              `json:"sameAs,omitempty"`
+	Affiliation    []OrganizationJSONLD  `json:"affiliation,omitempty"`
+	AlumniOf       []OrganizationJSONLD  `json:"alumniOf,omitempty"`
+	Award          []string              `json:"award,omitempty"`
+	HasCredential  []CredentialJSONLD    `json:"hasCredential,omitempty"`
+}
+
+// CollegeOrUniversityJSONLD represents an institution as schema.org/CollegeOrUniversity
+type CollegeOrUniversityJSONLD struct {
+	Context       string               `json:"@context"`
+	Type          string               `json:"@type"`
+	ID            string               `json:"@id,omitempty"`
+	URL           string               `json:"url,omitempty"`
+	Name          string               `json:"name,omitempty"`
+	AlternateName string               `json:"alternateName,omitempty"`
+	Description   string               `json:"description,omitempty"`
+	FoundingDate  string               `json:"foundingDate,omitempty"`
+	Email         string               `json:"email,omitempty"`
+	Telephone     string               `json:"telephone,omitempty"`
+	FaxNumber     string               `json:"faxNumber,omitempty"`
+	Address       *PostalAddressJSONLD `json:"address,omitempty"`
+	SameAs        []string             `json:"sameAs,omitempty"`
+}
+
+// OrganizationJSONLD is a named organization referenced from a profile, type is Organization or CollegeOrUniversity
+type OrganizationJSONLD struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+// CredentialJSONLD represents an academic degree as schema.org/EducationalOccupationalCredential
+type CredentialJSONLD struct {
+	Type               string              `json:"@type"`
+	Name               string              `json:"name"`
+	CredentialCategory string              `json:"credentialCategory"`
+	EducationalLevel   string              `json:"educationalLevel,omitempty"`
+	RecognizedBy       *OrganizationJSONLD `json:"recognizedBy,omitempty"`
+	DateCreated        string              `json:"dateCreated,omitempty"`
+}
+
+// PropertyValueJSONLD represents an external identifier such as ORCID iD
+type PropertyValueJSONLD struct {
+	Type       string `json:"@type"`
+	PropertyID string `json:"propertyID"`
+	Value      string `json:"value"`
+}
+
+type PostalAddressJSONLD struct {
+	Type            string `json:"@type"`
+	StreetAddress   string `json:"streetAddress,omitempty"`
+	AddressLocality string `json:"addressLocality,omitempty"`
+	PostalCode      string `json:"postalCode,omitempty"`
+}
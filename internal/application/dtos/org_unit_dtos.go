@@ -0,0 +1,40 @@
+package dtos
+
+import "time"
+
+// ---- REQUEST DTOs ----
+
+// Names are keyed by language code, ParentID is zero for top level units
+type CreateOrgUnitRequest struct {
+	InstitutionID int64             `json:"-" validate:"required,min=1"`
+	ParentID      int64             `json:"parentID" validate:"omitempty,min=1"`
+	UnitType      string            `json:"unitType" validate:"required,oneof=faculty department laboratory institute center division other"`
+	SortOrder     int32             `json:"sortOrder"`
+	Names         map[string]string `json:"names" validate:"required,min=1,dive,keys,language,endkeys,required,max=511"`
+}
+
+// ParentID set to zero moves the unit to the top level, Names when given replace all names of the unit
+type UpdateOrgUnitRequest struct {
+	ID            int64             `json:"-" validate:"required,min=1"`
+	InstitutionID int64             `json:"-" validate:"required,min=1"`
+	ParentID      *int64            `json:"parentID" validate:"omitempty,min=0"`
+	UnitType      *string           `json:"unitType" validate:"omitempty,oneof=faculty department laboratory institute center division other"`
+	SortOrder     *int32            `json:"sortOrder"`
+	Names         map[string]string `json:"names" validate:"omitempty,min=1,dive,keys,language,endkeys,required,max=511"`
+}
+
+// ---- RESPONSE DTOs ----
+
+// Name is in the requested language or its fallback, Names holds every translation
+type OrgUnitResponse struct {
+	ID            int64              `json:"id"`
+	InstitutionID int64              `json:"institutionID"`
+	ParentID      int64              `json:"parentID,omitempty"`
+	UnitType      string             `json:"unitType"`
+	Name          string             `json:"name"`
+	Names         map[string]string  `json:"names"`
+	SortOrder     int32              `json:"sortOrder"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	UpdatedAt     time.Time          `json:"updatedAt"`
+	Children      []*OrgUnitResponse `json:"children,omitempty"`
+}
@@ -0,0 +1,39 @@
+package dtos
+
+import "time"
+
+// ---- REQUEST DTOS ----
+type ClaimPublicationRequest struct {
+	PublicationID int64 `json:"publicationID" validate:"required"`
+	// Position of the author in the author list, when omitted the author is matched by surname
+	// or the employee is appended to the end of the list
+	AuthorPosition int32  `json:"authorPosition" validate:"omitempty,min=1"`
+	LanguageCode   string `json:"-"`
+}
+
+// ---- RESPONSE DTOS ----
+type PublicationResponse struct {
+	ID                int64                        `json:"id"`
+	PublicationTitle  string                       `json:"publicationTitle"`
+	LinkToPublication string                       `json:"linkToPublication"`
+	PublicationYear   int32                        `json:"publicationYear,omitempty"`
+	Venue             string                       `json:"venue,omitempty"`
+	DOI               string                       `json:"doi,omitempty"`
+	PublicationType   string                       `json:"publicationType,omitempty"`
+	Authors           []*PublicationAuthorResponse `json:"authors"`
+	CreatedAt         time.Time                    `json:"createdAt"`
+	UpdatedAt         time.Time                    `json:"updatedAt"`
+}
+
+type PublicationAuthorResponse struct {
+	Position    int32  `json:"position"`
+	AuthorName  string `json:"authorName"`
+	IsClaimed   bool   `json:"isClaimed"`
+	EmployeeUID string `json:"employeeUID,omitempty"`
+}
+
+type PublicationCitationResponse struct {
+	CitationCount int32     `json:"citationCount"`
+	Source        string    `json:"source"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
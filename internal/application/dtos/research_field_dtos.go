@@ -0,0 +1,33 @@
+package dtos
+
+// ---- REQUEST DTOs ----
+
+// UpsertResearchFieldRequest adds or renames a node of the taxonomy, its level follows from the parent:
+// a node without parent is a domain, the child of a domain is a field and the child of a field a subfield
+type UpsertResearchFieldRequest struct {
+	Code       string            `json:"-" validate:"required,max=31"`
+	ParentCode string            `json:"parentCode" validate:"omitempty,max=31,nefield=Code"`
+	SortOrder  int32             `json:"sortOrder" validate:"min=0"`
+	Names      map[string]string `json:"names" validate:"required,min=1,dive,keys,language,endkeys,required,max=511"`
+}
+
+// ---- RESPONSE DTOs ----
+
+// Name is in the requested language or its fallback, Names holds every translation
+type ResearchFieldResponse struct {
+	Code       string            `json:"code"`
+	ParentCode string            `json:"parentCode,omitempty"`
+	Level      string            `json:"level"`
+	SortOrder  int32             `json:"sortOrder"`
+	Name       string            `json:"name"`
+	Names      map[string]string `json:"names"`
+}
+
+type ResearchFieldStatsResponse struct {
+	Code             string `json:"code"`
+	ParentCode       string `json:"parentCode,omitempty"`
+	Level            string `json:"level"`
+	Name             string `json:"name"`
+	ResearcherCount  int64  `json:"researcherCount"`
+	InstitutionCount int64  `json:"institutionCount"`
+}
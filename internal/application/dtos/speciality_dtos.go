@@ -0,0 +1,30 @@
+package dtos
+
+// ---- REQUEST DTOs ----
+
+// ImportSpecialitiesRequest is the content of a classifier file, parents are either in the file or already imported
+type ImportSpecialitiesRequest struct {
+	Specialities []*ImportSpecialityRequest `json:"specialities" validate:"required,min=1,dive"`
+}
+
+type ImportSpecialityRequest struct {
+	Code       string            `json:"code" validate:"required,max=63"`
+	ParentCode string            `json:"parentCode" validate:"omitempty,max=63,nefield=Code"`
+	Names      map[string]string `json:"names" validate:"required,min=1,dive,keys,language,endkeys,required,max=511"`
+}
+
+// ---- RESPONSE DTOs ----
+
+// Name is in the requested language or its fallback, Names holds every translation
+type SpecialityResponse struct {
+	Code        string            `json:"code"`
+	ParentCode  string            `json:"parentCode,omitempty"`
+	Name        string            `json:"name"`
+	Names       map[string]string `json:"names"`
+	HasChildren bool              `json:"hasChildren"`
+}
+
+type ImportSpecialitiesResponse struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+}
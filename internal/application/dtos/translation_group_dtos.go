@@ -0,0 +1,14 @@
+package dtos
+
+// ---- RESPONSE DTOs ----
+
+// MissingTranslationResponse is an entry that is not available in some of the supported languages,
+// RecordID and Title refer to one of its existing versions
+type MissingTranslationResponse struct {
+	Resource               string   `json:"resource"`
+	TranslationGroupID     string   `json:"translationGroupId"`
+	RecordID               int64    `json:"recordId"`
+	Title                  string   `json:"title"`
+	AvailableLanguageCodes []string `json:"availableLanguageCodes"`
+	MissingLanguageCodes   []string `json:"missingLanguageCodes"`
+}
@@ -0,0 +1,74 @@
+package dtos
+
+import "time"
+
+// ---- REQUEST DTOs ----
+
+type WorkplaceClustersQueryParameters struct {
+	// Threshold is the trigram similarity from 0 to 1 above which spellings are put into the same cluster
+	Threshold float64 `validate:"gt=0,lte=1"`
+	// UnlinkedOnly leaves out clusters whose every work experience is already linked to an institution
+	UnlinkedOnly bool
+}
+
+// DryRun only lists the changes the mapping would make
+type ApplyWorkplaceMappingRequest struct {
+	InstitutionID int64    `json:"institutionID" validate:"required,min=1"`
+	Workplaces    []string `json:"workplaces" validate:"required,min=1,dive,required"`
+	Overwrite     bool     `json:"overwrite"`
+	DryRun        bool     `json:"dryRun"`
+}
+
+// ---- RESPONSE DTOs ----
+
+type WorkplaceVariantResponse struct {
+	Workplace       string  `json:"workplace"`
+	LanguageCode    string  `json:"languageCode"`
+	ExperienceCount int64   `json:"experienceCount"`
+	LinkedCount     int64   `json:"linkedCount"`
+	InstitutionIDs  []int64 `json:"institutionIDs"`
+}
+
+type WorkplaceClusterResponse struct {
+	Key                    string                      `json:"key"`
+	Variants               []*WorkplaceVariantResponse `json:"variants"`
+	ExperienceCount        int64                       `json:"experienceCount"`
+	LinkedCount            int64                       `json:"linkedCount"`
+	SuggestedInstitutionID int64                       `json:"suggestedInstitutionID,omitempty"`
+	SuggestionScore        float64                     `json:"suggestionScore,omitempty"`
+}
+
+type WorkplaceMappingChangeResponse struct {
+	WorkExperienceID int64  `json:"workExperienceID"`
+	EmployeeID       int64  `json:"employeeID"`
+	LanguageCode     string `json:"languageCode"`
+	Workplace        string `json:"workplace"`
+	OldInstitutionID int64  `json:"oldInstitutionID,omitempty"`
+	NewInstitutionID int64  `json:"newInstitutionID"`
+}
+
+type WorkplaceMappingBatchResponse struct {
+	ID            int64      `json:"id"`
+	InstitutionID int64      `json:"institutionID,omitempty"`
+	Workplaces    []string   `json:"workplaces"`
+	Overwrite     bool       `json:"overwrite"`
+	CreatedBy     int64      `json:"createdBy,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UndoneBy      int64      `json:"undoneBy,omitempty"`
+	UndoneAt      *time.Time `json:"undoneAt,omitempty"`
+	ChangeCount   int64      `json:"changeCount"`
+}
+
+// Batch is absent for dry runs and when there is nothing to change
+type WorkplaceMappingResponse struct {
+	DryRun  bool                              `json:"dryRun"`
+	Batch   *WorkplaceMappingBatchResponse    `json:"batch,omitempty"`
+	Changes []*WorkplaceMappingChangeResponse `json:"changes"`
+}
+
+// SkippedCount are work experiences relinked after the batch, they keep their current institution
+type UndoWorkplaceMappingResponse struct {
+	Batch         *WorkplaceMappingBatchResponse `json:"batch"`
+	RestoredCount int64                          `json:"restoredCount"`
+	SkippedCount  int64                          `json:"skippedCount"`
+}
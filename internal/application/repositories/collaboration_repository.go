@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type CollaborationRepository interface {
+	//GetLinks - retrives the links of the employees, to collaborators among them or to anyone when includeExternal is set,
+	//titles shared by more than maxTokenEmployees employees link nobody
+	GetLinks(ctx context.Context, employeeIDs []int64, includeExternal bool, maxTokenEmployees int32) ([]*domain.CollaborationLink, error)
+
+	//GetNodesByIDs - retrives the employees named in the first available language of langCodes
+	GetNodesByIDs(ctx context.Context, ids []int64, langCodes []string) ([]*domain.CollaborationNode, error)
+
+	//GetEmployeeIDsByInstitutionID - retrives the IDs of the employees currently working in the institution
+	GetEmployeeIDsByInstitutionID(ctx context.Context, institutionID int64) ([]int64, error)
+}
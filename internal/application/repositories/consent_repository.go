@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type ConsentRepository interface {
+	//CreateDocument - inserts the text of a version of the terms in one language
+	CreateDocument(ctx context.Context, document *domain.ConsentDocument) (*domain.ConsentDocument, error)
+
+	//GetLatestVersion - retrives the latest published version of the terms, zero while none are published
+	GetLatestVersion(ctx context.Context) (int32, error)
+
+	//GetDocumentsByVersion - retrives the texts of a version of the terms in every language it is published in
+	GetDocumentsByVersion(ctx context.Context, version int32) ([]*domain.ConsentDocument, error)
+
+	//CreateUserConsent - records the acceptance of a version of the terms, accepting it again keeps the first record
+	CreateUserConsent(ctx context.Context, consent *domain.UserConsent) error
+
+	//GetLatestAcceptedVersion - retrives the latest version of the terms accepted by the user, zero when none is
+	GetLatestAcceptedVersion(ctx context.Context, userID int64) (int32, error)
+
+	//HasLatestConsent - retrives whether the user accepted the latest version of the terms
+	HasLatestConsent(ctx context.Context, userID int64) (bool, error)
+
+	//GetAllUserConsents - retrives every recorded acceptance, oldest first
+	GetAllUserConsents(ctx context.Context) ([]*domain.UserConsent, error)
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type DegreeLevelRepository interface {
+	//GetAll - retrives every entry of domain.DegreeLevel with its names ordered by rank
+	GetAll(ctx context.Context) ([]*domain.DegreeLevel, error)
+
+	//Exists - reports whether a degree level with the given code exists
+	Exists(ctx context.Context, code string) (bool, error)
+}
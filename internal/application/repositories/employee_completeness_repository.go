@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type EmployeeCompletenessRepository interface {
+	//GetByUniqueID - retrives the completeness counts of the employee in every enabled language
+	GetByUniqueID(ctx context.Context, uniqueID string) ([]*domain.EmployeeCompletenessCounts, error)
+
+	//GetByInstitutionID - retrives the completeness counts of the employees currently working in the institution in langCode
+	GetByInstitutionID(ctx context.Context, institutionID int64, langCode string) ([]*domain.EmployeeCompletenessCounts, error)
+}
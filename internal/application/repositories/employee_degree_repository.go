@@ -9,7 +9,7 @@ type EmployeeDegreeRepository interface {
   //Create - insterts employee degree data into DB
 	Create(ctx context.Context, employeeDegree *domain.EmployeeDegree) (*domain.EmployeeDegree, error)
 
-  //Update - modifies existing employee in DB
+  //Update - modifies existing employee in DB, an empty SpecialityCode unlinks the degree from the classifier
   Update(ctx context.Context, employeeDegree *domain.EmployeeDegree) (*domain.EmployeeDegree, error)
 
   //Delete - deletes employee degree data from DB by ID
@@ -20,4 +20,8 @@ type EmployeeDegreeRepository interface {
 
   //GetByEmployeeIDAndLanguageCode - retrives a single employee degree data by EmployeeID and specified language code
   GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeDegree, error)
+
+  //GetByEmployeeIDAndLanguageCodes - retrives entries of domain.EmployeeDegree from DB by EmployeeID, one per translation group
+  //in the first of langCodes the entry is available in
+  GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeDegree, error)
 }
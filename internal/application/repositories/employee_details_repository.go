@@ -3,6 +3,7 @@ package repositories
 import (
 	"backend/internal/domain"
 	"context"
+	"time"
 )
 
 type EmployeeDetailsRepository interface {
@@ -23,4 +24,8 @@ type EmployeeDetailsRepository interface {
 
 	//GetCurrentDetailsByEmployeeIDAndLanguageCode - retrives a single employee details by employeeID and language code where is_new_employee_details = true (current employee information)
 	GetCurrentDetailsByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) (*domain.EmployeeDetails, error)
+
+	//ArchiveCurrentDetails - turns the current employee details of the language, except the one with exceptID, into a previous name
+	//ending at effectiveTo (today when zero) unless its end is already known
+	ArchiveCurrentDetails(ctx context.Context, employeeID int64, langCode string, exceptID int64, effectiveTo time.Time) error
 }
@@ -35,6 +35,10 @@ type EmployeeMainResearchArea interface {
 	//GetByEmployeeIDAndLanguageCode - retrives employee main research area entries from DB by EmployeeID and specified language code.
 	GetMRAByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeMainResearchArea, error)
 
+	//GetMRAByEmployeeIDAndLanguageCodes - retrives entries of domain.EmployeeMainResearchArea from DB by EmployeeID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetMRAByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeMainResearchArea, error)
+
 	//GetRAKTByMRAIDAndLanguageCode - retrives main research area key topic entries from DB by EmployeeMainResearchAreaID and specified language code.
 	GetRAKTByMRAIDAndLanguageCode(ctx context.Context, employeeMRAID int64) ([]*domain.ResearchAreaKeyTopic, error)
 }
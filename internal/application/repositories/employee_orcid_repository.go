@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+	"time"
+)
+
+type EmployeeOrcidRepository interface {
+	//CreateOAuthState - stores the state parameter of ORCID authorization request issued for the employee
+	CreateOAuthState(ctx context.Context, state string, employeeID int64) error
+
+	//ConsumeOAuthState - removes the state parameter and returns the employee it was issued for and its creation time
+	ConsumeOAuthState(ctx context.Context, state string) (int64, time.Time, error)
+
+	//DeleteExpiredOAuthStates - removes state parameters created before the given time
+	DeleteExpiredOAuthStates(ctx context.Context, createdBefore time.Time) error
+
+	//UpsertAccount - inserts or replaces the linked ORCID account of the employee, tokens are stored encrypted
+	UpsertAccount(ctx context.Context, account *domain.EmployeeOrcidAccount) (*domain.EmployeeOrcidAccount, error)
+
+	//GetAccountByEmployeeID - retrives the linked ORCID account of the employee without its tokens
+	GetAccountByEmployeeID(ctx context.Context, employeeID int64) (*domain.EmployeeOrcidAccount, error)
+
+	//GetAccessToken - retrives the decrypted access token of the linked ORCID account of the employee
+	GetAccessToken(ctx context.Context, employeeID int64) (string, error)
+
+	//DeleteAccountByEmployeeID - removes the linked ORCID account of the employee
+	DeleteAccountByEmployeeID(ctx context.Context, employeeID int64) error
+
+	//ListAccountsDueForSync - retrives linked accounts without their tokens which were never synced or synced before the given time
+	ListAccountsDueForSync(ctx context.Context, syncedBefore time.Time, limit int32) ([]*domain.EmployeeOrcidAccount, error)
+
+	//MarkAccountSynced - sets last synchronization time of the account to now
+	MarkAccountSynced(ctx context.Context, employeeID int64) error
+}
+
+type OrcidClient interface {
+	//AuthorizationURL - builds ORCID authorization page URL the user is redirected to
+	AuthorizationURL(state string) string
+
+	//ExchangeCode - exchanges authorization code returned by ORCID for access token and the authenticated ORCID iD
+	ExchangeCode(ctx context.Context, code string) (*domain.EmployeeOrcidAccount, error)
+
+	//GetWorks - retrives works of the ORCID record as publications, ExternalID holds ORCID put-code
+	GetWorks(ctx context.Context, account *domain.EmployeeOrcidAccount) ([]*domain.EmployeePublication, error)
+
+	//GetEmployments - retrives employments of the ORCID record as work experiences, ExternalID holds ORCID put-code
+	GetEmployments(ctx context.Context, account *domain.EmployeeOrcidAccount) ([]*domain.EmployeeWorkExperience, error)
+}
@@ -20,4 +20,8 @@ type EmployeeParticipationInEventRepository interface {
 
   //GetByEmployeeIDAndLanguageCode - retrives a single domain.EmployeeParticipationInEvent from DB by EmployeeID and specified language code
   GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeParticipationInEvent, error)
+
+  //GetByEmployeeIDAndLanguageCodes - retrives entries of domain.EmployeeParticipationInEvent from DB by EmployeeID, one per translation group
+  //in the first of langCodes the entry is available in
+  GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeParticipationInEvent, error)
 }
@@ -25,4 +25,8 @@ type EmployeeParticipationInProfessionalCommunityRepository interface {
 
 	//GetByEmployeeIDAndLanguageCode - retrives an entry of domain.EmployeeParticipationInProfessionalCommunity from DB by EmployeeID and specified language code
 	GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeParticipationInProfessionalCommunity, error)
+
+	//GetByEmployeeIDAndLanguageCodes - retrives entries of domain.EmployeeParticipationInProfessionalCommunity from DB by EmployeeID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeParticipationInProfessionalCommunity, error)
 }
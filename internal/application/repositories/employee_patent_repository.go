@@ -20,4 +20,8 @@ type EmployeePatentRepository interface {
 
   //GetByEmployeeIDAndLanguageCode - retrives an entry of domain.EmployeePatent from DB By EmployeeID and specified langauge code
   GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeePatent, error)
+
+  //GetByEmployeeIDAndLanguageCodes - retrives entries of domain.EmployeePatent from DB by EmployeeID, one per translation group
+  //in the first of langCodes the entry is available in
+  GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeePatent, error)
 }
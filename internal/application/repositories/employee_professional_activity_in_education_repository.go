@@ -21,5 +21,15 @@ type EmployeeWorkExperienceRepository interface {
 	//GetByEmploployeeIDAndLanguageCode - retrives an entry of domain.EmployeeWorkExperience from DB by EmployeeID and specified language code
 	GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeWorkExperience, error)
 
+	//GetByEmployeeIDAndLanguageCodes - retrives entries of domain.EmployeeWorkExperience from DB by EmployeeID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeWorkExperience, error)
+
 	ListUniqueOngoingWorkplaces(ctx context.Context, langCode string) ([]string, error)
+
+	//UpsertImported - inserts or refreshes an entry of domain.EmployeeWorkExperience imported from an external source, matched by Source and ExternalID
+	UpsertImported(ctx context.Context, employeeWorkExperience *domain.EmployeeWorkExperience) (*domain.EmployeeWorkExperience, error)
+
+	//DeleteStaleImported - removes entries imported from the source whose external IDs are not in keepExternalIDs
+	DeleteStaleImported(ctx context.Context, employeeID int64, langCode string, source string, keepExternalIDs []string) error
 }
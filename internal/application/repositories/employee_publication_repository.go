@@ -9,15 +9,36 @@ type EmployeePublicationRepository interface {
 	//Create - inserts an entry of domain.EmployeePublication into DB
 	Create(ctx context.Context, employeePublication *domain.EmployeePublication) (*domain.EmployeePublication, error)
 
-	//Update - modifes an entry of domain.EmployeePublication in DB
+	//Update - modifes an entry of domain.EmployeePublication in DB, empty optional bibliographic fields are cleared
 	Update(ctx context.Context, employeePublication *domain.EmployeePublication) (*domain.EmployeePublication, error)
 
-	//Delete - removes an entry of domain.EmployeePublication from DB
-	Delete(ctx context.Context, id int64) error
+	//Delete - removes an entry of domain.EmployeePublication together with its translations from DB.
+	//Returns IDs of shared publications the removed entries were linked to
+	Delete(ctx context.Context, id int64) ([]int64, error)
 
 	//GetByID - retrives an entry of domain.EmployeePublication from DB
 	GetByID(ctx context.Context, id int64) (*domain.EmployeePublication, error)
 
 	//GetByEmployeeIDAndLanguageCode - retrives an entry of domain.EmployeePublication from DB by EmployeeID and specified language code
 	GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeePublication, error)
+
+	//GetByEmployeeIDAndLanguageCodes - retrives entries of domain.EmployeePublication from DB by EmployeeID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeePublication, error)
+
+	//UpsertImported - inserts or refreshes an entry of domain.EmployeePublication imported from an external source, matched by Source and ExternalID
+	UpsertImported(ctx context.Context, employeePublication *domain.EmployeePublication) (*domain.EmployeePublication, error)
+
+	//DeleteStaleImported - removes entries imported from the source whose external IDs are not in keepExternalIDs.
+	//Returns shared publication IDs the removed entries were linked to
+	DeleteStaleImported(ctx context.Context, employeeID int64, langCode string, source string, keepExternalIDs []string) ([]int64, error)
+
+	//SetPublicationID - links an entry of domain.EmployeePublication to the shared publication
+	SetPublicationID(ctx context.Context, id int64, publicationID int64) error
+
+	//CountByPublicationID - counts entries of the employee (in all languages) linked to the shared publication
+	CountByPublicationID(ctx context.Context, employeeID int64, publicationID int64) (int64, error)
+
+	//DeleteByPublicationID - removes entries of the employee (in all languages) linked to the shared publication
+	DeleteByPublicationID(ctx context.Context, employeeID int64, publicationID int64) error
 }
@@ -20,4 +20,8 @@ type EmployeeRefresherCourseRepository interface {
 
   //GetByEmployeeIDAndLanguageCode - retrives an entry of domain.EmployeeRefresherCourseReposiotry from DB by EmployeeID and specified langauge code
   GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeRefresherCourse, error)
+
+  //GetByEmployeeIDAndLanguageCodes - retrives entries of domain.EmployeeRefresherCourse from DB by EmployeeID, one per translation group
+  //in the first of langCodes the entry is available in
+  GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeRefresherCourse, error)
 } 
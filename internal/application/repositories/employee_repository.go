@@ -13,8 +13,14 @@ type GetPersonnelPaginatedQueryResult struct {
 	Name                  string `json:"name"`
 	Middlename            string `json:"middlename"`
 	Currentworkplace      string `json:"currentworkplace"`
+	CurrentInstitutionID  int64  `json:"current_institution_id"`
+	CurrentOrgUnitID      int64  `json:"current_org_unit_id"`
 	Highestacademicdegree string `json:"highestacademicdegree"`
 	Speciality            string `json:"speciality"`
+	PublicationCount      int64  `json:"publication_count"`
+	HIndex                int32  `json:"h_index"`
+	I10Index              int32  `json:"i10_index"`
+	TotalCitations        int32  `json:"total_citations"`
 }
 
 type EmployeeRepository interface {
@@ -41,9 +47,12 @@ type EmployeeRepository interface {
 	//CountPersonnel - count total number of personnel (by unique employee_id) from db that satisfy the filter paramenter
 	CountPersonnel(ctx context.Context, filter *dtos.PersonnelPaginatedQueryParameters) (int64, error)
 
-	// ListUniqueHighestAcademicDegrees - returns all unique non-empty highest academic degree values.
-	ListUniqueHighestAcademicDegrees(ctx context.Context) ([]string, error)
+	// ListUniqueHighestAcademicDegrees - returns all unique non-empty highest academic degree values named in langCode.
+	ListUniqueHighestAcademicDegrees(ctx context.Context, langCode string) ([]*domain.HighestAcademicDegree, error)
 
 	// ListUniqueSpecialities - returns all unique non-empty speciality values.
 	ListUniqueSpecialities(ctx context.Context) ([]string, error)
+
+	//UpdateORCID - sets ORCID iD of the employee, empty string removes it
+	UpdateORCID(ctx context.Context, employeeID int64, orcid string) error
 }
@@ -20,4 +20,8 @@ type EmployeeScientificAwardRepository interface {
 
 	//GetByEmployeeIDAndLanguageCode - retrives an entry of domain.EmployeeScientificAward from DB by EmployeeID and specified language code
 	GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeScientificAward, error)
+
+	//GetByEmployeeIDAndLanguageCodes - retrives entries of domain.EmployeeScientificAward from DB by EmployeeID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeScientificAward, error)
 }
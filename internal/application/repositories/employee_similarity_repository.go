@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type EmployeeSimilarityRepository interface {
+	//Refresh - recomputes the similarities of every employee keeping at most maxPerEmployee for each, returns the number stored
+	Refresh(ctx context.Context, weights domain.EmployeeSimilarityWeights, maxPerEmployee int32) (int64, error)
+
+	//GetByUniqueID - retrives the employees most similar to the employee, named in the first available language of langCodes
+	GetByUniqueID(ctx context.Context, uniqueID string, langCodes []string, limit int32) ([]*domain.EmployeeSimilarity, error)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type EmployeeVisibilityRepository interface {
+	//GetByEmployeeID - retrives the visibility levels chosen by the employee, sections and fields without one are left out
+	GetByEmployeeID(ctx context.Context, employeeID int64) (*domain.EmployeeVisibility, error)
+
+	//GetByEmployeeIDs - retrives the visibility levels and the current institution of every existing employee of employeeIDs keyed by employee ID
+	GetByEmployeeIDs(ctx context.Context, employeeIDs []int64) (map[int64]*domain.EmployeeVisibility, error)
+
+	//Upsert - stores the visibility level chosen by the employee for a section or field
+	Upsert(ctx context.Context, employeeID int64, target string, level string) error
+}
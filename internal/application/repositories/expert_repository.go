@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type ExpertRepository interface {
+	//SearchMatches - retrives the profile items containing any of the filter terms, ordered by employee and section
+	SearchMatches(ctx context.Context, filter *domain.ExpertSearchFilter) ([]*domain.ExpertMatch, error)
+
+	//GetProfilesByIDs - retrives the names and current positions of the employees in the first available language of langCodes
+	GetProfilesByIDs(ctx context.Context, ids []int64, langCodes []string) ([]*domain.ExpertProfile, error)
+}
@@ -21,4 +21,8 @@ type InstitutionAccreditationRepository interface {
 	//GetByInstitutionIDAndLanguageCode - retrives an entry of domian.InstitutionAccrediation
 	//by institutionID and specified language code
 	GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionAccreditation, error)
+
+	//GetByInstitutionIDAndLanguageCodes - retrives entries of domain.InstitutionAccreditation from DB by InstitutionID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionAccreditation, error)
 }
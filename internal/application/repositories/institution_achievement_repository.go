@@ -20,4 +20,8 @@ type InstitutionAchievementRepository interface {
 
   //GetByInstitutionIDAndLanguageCode - retrives an entry of domain.InstitutionAchievement from DB by ID and specified language code 
   GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionAchievement, error)
+
+  //GetByInstitutionIDAndLanguageCodes - retrives entries of domain.InstitutionAchievement from DB by InstitutionID, one per translation group
+  //in the first of langCodes the entry is available in
+  GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionAchievement, error)
 }
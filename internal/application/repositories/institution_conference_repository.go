@@ -20,4 +20,8 @@ type InstitutionConferenceRepository interface {
 
   //GetByInstitutionIDAndLanguageCode - retrives an entry of domain.InstitutionConference from DB by Institution ID and specified language code
   GetByInstitutionIDAndLanguageCode(ctx context.Context, id int64, langCode string) ([]*domain.InstitutionConference, error)
+
+  //GetByInstitutionIDAndLanguageCodes - retrives entries of domain.InstitutionConference from DB by InstitutionID, one per translation group
+  //in the first of langCodes the entry is available in
+  GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionConference, error)
 }
@@ -18,4 +18,8 @@ type InstitutionDetailsRepository interface {
 
 	//GetByInstitutionIDAndLanguageCode - retrives entries institution_details(*domain.InstitutionDetails) by ID and specified langauge code.
 	GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) (*domain.InstitutionDetails, error)
+
+	//GetByInstitutionIDAndLanguageCodes - retrives institution_details(*domain.InstitutionDetails) by ID
+	//in the first of langCodes the institution has details in
+	GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) (*domain.InstitutionDetails, error)
 }
@@ -20,4 +20,8 @@ type InstitutionLicenceRepository interface {
 
   //GetByInstitutionIDAndLanguageCode - retrives an entry of domain.InstitutionLicence from DB by InstititonID and specified language code.
   GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionLicence, error)
+
+  //GetByInstitutionIDAndLanguageCodes - retrives entries of domain.InstitutionLicence from DB by InstitutionID, one per translation group
+  //in the first of langCodes the entry is available in
+  GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionLicence, error)
 }
@@ -20,4 +20,8 @@ type InstitutionMagazineRepository interface {
 
 	//GetByInstitutionIDAndLanguageCode - retrives an entry of domain.InstitutionMagazine from DB by ID and specified langauge code.
 	GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionMagazine, error)
+
+	//GetByInstitutionIDAndLanguageCodes - retrives entries of domain.InstitutionMagazine from DB by InstitutionID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionMagazine, error)
 }
@@ -20,4 +20,8 @@ type InstitutionMainResearchDirectionRepository interface {
 
 	//GetByInstituionIDAndLanguageCode - retrives an entry of domain.InstitutionMainResearchDirection from DB by institutionID and specified langauge code.
 	GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionMainResearchDirection, error)
+
+	//GetByInstitutionIDAndLanguageCodes - retrives entries of domain.InstitutionMainResearchDirection from DB by InstitutionID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionMainResearchDirection, error)
 }
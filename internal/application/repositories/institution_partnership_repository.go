@@ -20,4 +20,8 @@ type InstitutionPartnershipRepository interface {
 
 	//GetByInstitutionIDAndLanguageCode - retrives an entry of domain.InstitutionPartnership from DB by InstituionID and specified language code
 	GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionPartnership, error)
+
+	//GetByInstitutionIDAndLanguageCodes - retrives entries of domain.InstitutionPartnership from DB by InstitutionID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionPartnership, error)
 }
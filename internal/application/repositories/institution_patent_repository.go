@@ -20,4 +20,8 @@ type InstitutionPatentRepository interface {
 
 	//GetByInstitutionIDAndLanguageCode - retrives an entry of domain.InstitutionPatent from DB by InstitutionID with specified language code
 	GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionPatent, error)
+
+	//GetByInstitutionIDAndLanguageCodes - retrives entries of domain.InstitutionPatent from DB by InstitutionID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionPatent, error)
 }
@@ -20,4 +20,8 @@ type InstitutionProjectRepository interface {
 
   //GetByInstitutionIDAndLanguageCode - retrives an entry of domain.InstitutionProject from DB by InstitutionID and specified language code 
   GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionProject, error)
+
+  //GetByInstitutionIDAndLanguageCodes - retrives entries of domain.InstitutionProject from DB by InstitutionID, one per translation group
+  //in the first of langCodes the entry is available in
+  GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionProject, error)
 }
@@ -20,4 +20,8 @@ type InstitutionRankingRepository interface {
 
 	//GetByInstitutionIDAndLanguageCode - retrives an entry of domain.InstitutionRanking from DB by InstitutionID with specific language
 	GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionRanking, error)
+
+	//GetByInstitutionIDAndLanguageCodes - retrives entries of domain.InstitutionRanking from DB by InstitutionID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionRanking, error)
 }
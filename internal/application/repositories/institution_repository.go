@@ -19,4 +19,7 @@ type InstitutionRepository interface {
 	GetByID(ctx context.Context, id int64, langCode string) (*domain.Institution, error)
 
 	GetAllInstitutions(ctx context.Context) ([]*domain.Institution, error)
+
+	//IsAdmin - reports whether the user manages the structure of the institution.
+	IsAdmin(ctx context.Context, institutionID int64, userID int64) (bool, error)
 }
@@ -20,4 +20,8 @@ type InstitutionResearchSupportInfrastructureRepository interface {
 
 	//GetByInstitutionIDAndLanguageCode - retrives an entry of domain.InstitutionResearchSupportInfrastructure from DB by InstitutionID with specified language code
 	GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionResearchSupportInfrastructure, error)
+
+	//GetByInstitutionIDAndLanguageCodes - retrives entries of domain.InstitutionResearchSupportInfrastructure from DB by InstitutionID, one per translation group
+	//in the first of langCodes the entry is available in
+	GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionResearchSupportInfrastructure, error)
 }
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type KeywordRepository interface {
+	//FindOrCreate - retrives the ID of the keyword with the label in the given language,
+	//a new keyword with the label preferred is inserted when there is none
+	FindOrCreate(ctx context.Context, langCode string, label string) (int64, error)
+
+	//GetByIDs - retrives the keywords with their labels and the number of employees using them, ordered by ID
+	GetByIDs(ctx context.Context, ids []int64) ([]*domain.Keyword, error)
+
+	//SearchByPrefix - retrives at most limit keywords with a label starting with prefix in any language, most used first
+	SearchByPrefix(ctx context.Context, prefix string, limit int32) ([]*domain.Keyword, error)
+
+	//Merge - moves the labels and key topics of the sources to the target and deletes the sources
+	Merge(ctx context.Context, targetID int64, sourceIDs []int64) error
+}
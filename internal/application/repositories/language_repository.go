@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type LanguageRepository interface {
+	//GetAll - retrives every entry of the language registry, enabled or not, ordered by sort order
+	GetAll(ctx context.Context) ([]*domain.Language, error)
+}
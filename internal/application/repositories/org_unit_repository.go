@@ -0,0 +1,26 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type OrgUnitRepository interface {
+	//Create - inserts an entry of domain.OrgUnit with its names into DB.
+	Create(ctx context.Context, orgUnit *domain.OrgUnit) (*domain.OrgUnit, error)
+
+	//Update - modifies an entry of domain.OrgUnit in DB, names in languages absent from orgUnit.Names are removed.
+	Update(ctx context.Context, orgUnit *domain.OrgUnit) (*domain.OrgUnit, error)
+
+	//Delete - removes an entry of domain.OrgUnit from DB, units having sub-units can not be removed.
+	Delete(ctx context.Context, id int64) error
+
+	//GetByID - retrives an entry of domain.OrgUnit with its names by ID.
+	GetByID(ctx context.Context, id int64) (*domain.OrgUnit, error)
+
+	//GetByInstitutionID - retrives all units of the institution with their names, Children are left empty.
+	GetByInstitutionID(ctx context.Context, institutionID int64) ([]*domain.OrgUnit, error)
+
+	//IsInSubtree - reports whether the unit is the root unit or lies anywhere below it.
+	IsInSubtree(ctx context.Context, rootID int64, id int64) (bool, error)
+}
@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type PublicationCitationRepository interface {
+	//Upsert - sets the citation count of the shared publication
+	Upsert(ctx context.Context, citation *domain.PublicationCitation) (*domain.PublicationCitation, error)
+
+	//GetByEmployeeID - retrives citation counts of all publications linked to the employee
+	GetByEmployeeID(ctx context.Context, employeeID int64) ([]*domain.PublicationCitation, error)
+
+	//ListImportCandidates - retrives publications (ID and DOI only) of the employee that can be looked up in a DOI registry
+	ListImportCandidates(ctx context.Context, employeeID int64) ([]*domain.Publication, error)
+
+	//GetEmployeeMetrics - retrives h-index, i10-index and total citations of the employee.
+	//Zero metrics are returned when nothing was computed for the employee yet
+	GetEmployeeMetrics(ctx context.Context, employeeID int64) (*domain.EmployeeCitationMetrics, error)
+
+	//RefreshEmployeeMetrics - recomputes the metrics of the employee
+	RefreshEmployeeMetrics(ctx context.Context, employeeID int64) error
+
+	//RefreshMetricsByPublicationID - recomputes the metrics of every employee linked to the publication
+	RefreshMetricsByPublicationID(ctx context.Context, publicationID int64) error
+}
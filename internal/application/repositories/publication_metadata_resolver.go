@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type PublicationMetadataResolver interface {
+	//ResolveDOI - retrives bibliographic metadata of the publication with given DOI from an external registry
+	ResolveDOI(ctx context.Context, doi string) (*domain.PublicationMetadata, error)
+}
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type PublicationRepository interface {
+	//Create - inserts a shared publication together with its author list
+	Create(ctx context.Context, publication *domain.Publication) (*domain.Publication, error)
+
+	//GetByID - retrives a shared publication with its author list
+	GetByID(ctx context.Context, id int64) (*domain.Publication, error)
+
+	//FindByDOIOrTitle - retrives a shared publication (without authors) by DOI, or by normalized title when DOI is empty.
+	//Returns nil if there is no such publication
+	FindByDOIOrTitle(ctx context.Context, doi string, title string) (*domain.Publication, error)
+
+	//ListClaimSuggestions - retrives publications with an unclaimed author whose name matches the employee
+	ListClaimSuggestions(ctx context.Context, employeeID int64, limit int32) ([]*domain.Publication, error)
+
+	//GetAuthorByEmployeeID - retrives the author entry claimed by the employee, nil if the employee is not among authors
+	GetAuthorByEmployeeID(ctx context.Context, publicationID int64, employeeID int64) (*domain.PublicationAuthor, error)
+
+	//FindMatchingUnclaimedAuthor - retrives the first unclaimed author whose name matches the employee, nil if there is none
+	FindMatchingUnclaimedAuthor(ctx context.Context, publicationID int64, employeeID int64) (*domain.PublicationAuthor, error)
+
+	//AddAuthor - appends an author to the end of the author list
+	AddAuthor(ctx context.Context, author *domain.PublicationAuthor) (*domain.PublicationAuthor, error)
+
+	//ClaimAuthor - assigns the unclaimed author entry to the employee, returns false if it was already claimed
+	ClaimAuthor(ctx context.Context, authorID int64, employeeID int64) (bool, error)
+
+	//UnclaimAuthor - releases the author entry claimed by the employee
+	UnclaimAuthor(ctx context.Context, publicationID int64, employeeID int64) error
+}
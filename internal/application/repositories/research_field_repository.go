@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type ResearchFieldRepository interface {
+	//GetAll - retrives every node of the taxonomy with its names, ordered by sort order within the level
+	GetAll(ctx context.Context) ([]*domain.ResearchField, error)
+
+	//GetByCode - retrives the node with the given code, names are left empty
+	GetByCode(ctx context.Context, code string) (*domain.ResearchField, error)
+
+	//Exists - reports whether the node with the given code exists
+	Exists(ctx context.Context, code string) (bool, error)
+
+	//Upsert - inserts or modifies the node with its names, names in languages absent from researchField.Names are kept.
+	//Reports whether the node was inserted
+	Upsert(ctx context.Context, researchField *domain.ResearchField) (bool, error)
+
+	//GetStats - retrives the counts of the nodes, narrowed to the given level and parent when they are not empty
+	GetStats(ctx context.Context, level string, parentCode string) ([]*domain.ResearchFieldStats, error)
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type SpecialityRepository interface {
+	//GetAll - retrives every node of the classifier ordered by code, names are left empty
+	GetAll(ctx context.Context) ([]*domain.Speciality, error)
+
+	//GetByParentCode - retrives the children of the node with their names, top level nodes when parentCode is empty
+	GetByParentCode(ctx context.Context, parentCode string) ([]*domain.Speciality, error)
+
+	//Exists - reports whether the node with the given code exists
+	Exists(ctx context.Context, code string) (bool, error)
+
+	//Upsert - inserts or modifies the node with its names, names in languages absent from speciality.Names are kept.
+	//Reports whether the node was inserted
+	Upsert(ctx context.Context, speciality *domain.Speciality) (bool, error)
+}
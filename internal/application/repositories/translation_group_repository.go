@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type TranslationGroupRepository interface {
+	//GetByEmployeeID - retrives translation groups of all language-coded entries of the employee
+	GetByEmployeeID(ctx context.Context, employeeID int64) ([]*domain.TranslationGroup, error)
+
+	//GetByInstitutionID - retrives translation groups of all language-coded entries of the institution
+	GetByInstitutionID(ctx context.Context, institutionID int64) ([]*domain.TranslationGroup, error)
+}
@@ -9,4 +9,6 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user *domain.User) (*domain.User, error)
   GetByID(ctx context.Context, id int64) (*domain.User, error)
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+	//UpdatePreferredLanguage - stores language the user prefers content in, empty langCode clears the preference
+	UpdatePreferredLanguage(ctx context.Context, id int64, langCode string) error
 }
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"backend/internal/domain"
+	"context"
+)
+
+type WorkplaceMappingRepository interface {
+	//ListWorkplaceVariants - retrives distinct spellings of workplaces of work experiences per language.
+	ListWorkplaceVariants(ctx context.Context) ([]*domain.WorkplaceVariant, error)
+
+	//ListInstitutionTitles - retrives short and long titles of every institution in every language.
+	ListInstitutionTitles(ctx context.Context) ([]*domain.InstitutionDetails, error)
+
+	//GetCandidates - retrives changes linking work experiences spelled as one of workplaces to the institution would make.
+	GetCandidates(ctx context.Context, workplaces []string, institutionID int64, overwrite bool) ([]*domain.WorkplaceMappingChange, error)
+
+	//Apply - records the batch with its changes into the undo log and links the work experiences to the institution.
+	Apply(ctx context.Context, batch *domain.WorkplaceMappingBatch, changes []*domain.WorkplaceMappingChange) (*domain.WorkplaceMappingBatch, error)
+
+	//GetBatchByIDForUpdate - retrives a batch of the undo log by ID and locks it until the end of the transaction.
+	GetBatchByIDForUpdate(ctx context.Context, id int64) (*domain.WorkplaceMappingBatch, error)
+
+	//Undo - restores institutions of work experiences changed by the batch, returns the number of restored work experiences.
+	Undo(ctx context.Context, id int64, undoneBy int64) (int64, error)
+
+	//ListBatches - retrives batches of the undo log, latest first.
+	ListBatches(ctx context.Context, page int64, limit int64) ([]*domain.WorkplaceMappingBatch, error)
+}
@@ -9,8 +9,10 @@ import (
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/infrastructure/security"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
@@ -27,6 +29,7 @@ type AuthUsecase interface {
 	Logout(ctx context.Context, refreshToken string) error
 	GetRefreshTokenDuration() time.Duration
 	Me(ctx context.Context) (*dtos.MeResponse, error)
+	UpdatePreferredLanguage(ctx context.Context, req *dtos.UpdatePreferredLanguageRequest) (*dtos.PreferredLanguageResponse, error)
 }
 
 type authUsecase struct {
@@ -56,6 +59,7 @@ func NewAuthUsecase(
 	}
 }
 
+// clientErrorMessages are keyed by message and language code, languages missing here get messages of the default language
 var clientErrorMessages = map[string]map[string]string{
 	"registerSameEmail": {
 		"en": "User with the same email '%s' already exists.",
@@ -67,6 +71,16 @@ var clientErrorMessages = map[string]map[string]string{
 		"ru": "Неверные учётные данные",
 		"tg": "Маълумоти воридшавӣ нодуруст аст",
 	},
+	"termsNotAccepted": {
+		"en": "The terms of publishing personal data must be accepted to register.",
+		"ru": "Для регистрации необходимо принять условия публикации персональных данных.",
+		"tg": "Барои бақайдгирӣ шартҳои нашри маълумоти шахсиро қабул кардан лозим аст.",
+	},
+	"termsOutdated": {
+		"en": "The terms of publishing personal data have changed, please accept the latest version.",
+		"ru": "Условия публикации персональных данных изменились, примите последнюю версию.",
+		"tg": "Шартҳои нашри маълумоти шахсӣ тағйир ёфтанд, лутфан версияи охиринро қабул кунед.",
+	},
 }
 
 func (uc *authUsecase) GetRefreshTokenDuration() time.Duration {
@@ -74,7 +88,7 @@ func (uc *authUsecase) GetRefreshTokenDuration() time.Duration {
 }
 
 func (uc *authUsecase) generateAndStoreTokens(ctx context.Context, user *domain.User) (*dtos.AuthResponse, error) {
-	accessToken, _, err := uc.tokenManager.GenerateAccessToken(user.ID)
+	accessToken, _, err := uc.tokenManager.GenerateAccessToken(user.ID, user.PreferredLanguage, user.Role)
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to generate access token: %w", err))
 	}
@@ -122,6 +136,7 @@ func (uc *authUsecase) generateAndStoreTokens(ctx context.Context, user *domain.
 		RefreshToken: refreshToken,
 		TokenType:    "Bearer",
 		UID:          uid,
+		UserRole:     user.Role,
 	}, nil
 }
 
@@ -181,7 +196,15 @@ func (uc *authUsecase) Me(ctx context.Context) (*dtos.MeResponse, error) {
 			return err
 		}
 
+		status, err := consentStatus(ctx, postgres.NewPgConsentRepositoryWithQuery(q), user.ID)
+		if err != nil {
+			return err
+		}
+
 		result.UniqueID = employee.UniqueID
+		result.PreferredLanguage = user.PreferredLanguage
+		result.UserRole = user.Role
+		result.ConsentRequired = status.ConsentRequired
 
 		return nil
 	})
@@ -192,6 +215,34 @@ func (uc *authUsecase) Me(ctx context.Context) (*dtos.MeResponse, error) {
 	return result, nil
 }
 
+func (uc *authUsecase) UpdatePreferredLanguage(ctx context.Context, req *dtos.UpdatePreferredLanguageRequest) (*dtos.PreferredLanguageResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update preferred language: %w", err))
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, custom_errors.Unauthorized(fmt.Errorf("user is not signed in"))
+	}
+
+	if err := uc.userRepo.UpdatePreferredLanguage(ctx, userID, req.PreferredLanguage); err != nil {
+		return nil, err
+	}
+
+	// the role is carried over from the current access token, it is refreshed from the DB on sign in and token refresh
+	role, _ := middleware.GetUserRoleFromContext(ctx)
+	accessToken, _, err := uc.tokenManager.GenerateAccessToken(userID, req.PreferredLanguage, role)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to generate access token: %w", err))
+	}
+
+	return &dtos.PreferredLanguageResponse{
+		PreferredLanguage: req.PreferredLanguage,
+		AccessToken:       accessToken,
+		TokenType:         "Bearer",
+	}, nil
+}
+
 func (uc *authUsecase) Register(ctx context.Context, req *dtos.RegisterRequest) error {
 	if err := uc.validator.Struct(req); err != nil {
 		return custom_errors.BadRequest(fmt.Errorf("invalid registration input: %w", err))
@@ -200,6 +251,17 @@ func (uc *authUsecase) Register(ctx context.Context, req *dtos.RegisterRequest)
 	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
 		txUserRepo := postgres.NewPgUserRepositoryWithQueries(q)
 		txEmployeeRepo := postgres.NewPgEmployeeRepositoryWithQuery(q)
+		txConsentRepo := postgres.NewPgConsentRepositoryWithQuery(q)
+
+		// the latest terms have to be accepted along with the registration, unless none are published yet
+		termsVersion, err := txConsentRepo.GetLatestVersion(ctx)
+		if err != nil {
+			return err
+		}
+		if termsVersion > 0 && req.AcceptedTermsVersion != termsVersion {
+			lang := middleware.GetLanguageFromContext(ctx)
+			return custom_errors.BadRequest(errors.New(languages.Localize(clientErrorMessages["termsNotAccepted"], lang)))
+		}
 
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 		if err != nil {
@@ -207,14 +269,15 @@ func (uc *authUsecase) Register(ctx context.Context, req *dtos.RegisterRequest)
 		}
 
 		user, err := txUserRepo.CreateUser(ctx, &domain.User{
-			Email:        req.Email,
-			PasswordHash: string(hashedPassword),
+			Email:             req.Email,
+			PasswordHash:      string(hashedPassword),
+			PreferredLanguage: req.PreferredLanguage,
 		})
 		if err != nil && !custom_errors.IsUniqueConstraintError(err) {
 			return err
 		} else if custom_errors.IsUniqueConstraintError(err) {
 			lang := middleware.GetLanguageFromContext(ctx)
-			return custom_errors.BadRequest(fmt.Errorf(clientErrorMessages["registerSameEmail"][lang], req.Email))
+			return custom_errors.BadRequest(fmt.Errorf(languages.Localize(clientErrorMessages["registerSameEmail"], lang), req.Email))
 		}
 
 		isUniqueExists := true
@@ -237,6 +300,18 @@ func (uc *authUsecase) Register(ctx context.Context, req *dtos.RegisterRequest)
 			}
 		}
 
+		if termsVersion > 0 {
+			err = txConsentRepo.CreateUserConsent(ctx, &domain.UserConsent{
+				UserID:          user.ID,
+				DocumentVersion: termsVersion,
+				LanguageCode:    middleware.GetLanguageFromContext(ctx),
+				IPAddress:       req.IPAddress,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -255,7 +330,7 @@ func (uc *authUsecase) Login(ctx context.Context, req *dtos.AuthRequest) (*dtos.
 	if err != nil {
 		if custom_errors.IsNotFound(err) {
 			lang := middleware.GetLanguageFromContext(ctx)
-			return nil, custom_errors.BadRequest(fmt.Errorf(clientErrorMessages["invalidCredentials"][lang]))
+			return nil, custom_errors.BadRequest(errors.New(languages.Localize(clientErrorMessages["invalidCredentials"], lang)))
 		}
 
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive user by email(%s): %w", req.Email, err))
@@ -263,7 +338,7 @@ func (uc *authUsecase) Login(ctx context.Context, req *dtos.AuthRequest) (*dtos.
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
 		lang := middleware.GetLanguageFromContext(ctx)
-		return nil, custom_errors.BadRequest(fmt.Errorf(clientErrorMessages["invalidCredentials"][lang]))
+		return nil, custom_errors.BadRequest(errors.New(languages.Localize(clientErrorMessages["invalidCredentials"], lang)))
 	}
 
 	return uc.generateAndStoreTokens(ctx, user)
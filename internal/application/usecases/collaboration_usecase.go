@@ -0,0 +1,327 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/graph"
+	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// collaborationMaxTokenEmployees - a title shared by more employees (a whole association, a congress) links nobody
+	collaborationMaxTokenEmployees = 50
+	// collaborationMaxEgoCollaborators keeps the strongest collaborators of an ego network
+	collaborationMaxEgoCollaborators = 100
+	// collaborationMaxInstitutionNodes bounds an institution network, its best connected employees are kept first
+	collaborationMaxInstitutionNodes = 500
+)
+
+type CollaborationUsecase interface {
+	GetEgoNetwork(ctx context.Context, uniqueID string, langCode string) (*dtos.CollaborationGraphResponse, error)
+	GetInstitutionNetwork(ctx context.Context, institutionID int64, includeExternal bool, langCode string) (*dtos.CollaborationGraphResponse, error)
+	RenderGEXF(collaborationGraph *dtos.CollaborationGraphResponse, description string) ([]byte, error)
+}
+
+type collaborationUsecase struct {
+	employeeRepo           repositories.EmployeeRepository
+	institutionRepo        repositories.InstitutionRepository
+	employeeVisibilityRepo repositories.EmployeeVisibilityRepository
+	collaborationRepo      repositories.CollaborationRepository
+	languageFallback       []string
+}
+
+func NewCollaborationUsecase(
+	employeeRepo repositories.EmployeeRepository,
+	institutionRepo repositories.InstitutionRepository,
+	employeeVisibilityRepo repositories.EmployeeVisibilityRepository,
+	collaborationRepo repositories.CollaborationRepository,
+	languageFallback []string,
+) CollaborationUsecase {
+	return &collaborationUsecase{
+		employeeRepo:           employeeRepo,
+		institutionRepo:        institutionRepo,
+		employeeVisibilityRepo: employeeVisibilityRepo,
+		collaborationRepo:      collaborationRepo,
+		languageFallback:       languageFallback,
+	}
+}
+
+// collaborationVisibilitySections are the sections of the profile the shared items of each kind are taken from
+var collaborationVisibilitySections = map[string]string{
+	domain.CollaborationKindPublication: domain.VisibilitySectionPublications,
+	domain.CollaborationKindProject:     domain.VisibilitySectionResearchActivities,
+	domain.CollaborationKindEvent:       domain.VisibilitySectionEvents,
+	domain.CollaborationKindCommunity:   domain.VisibilitySectionProfessionalCommunities,
+}
+
+// GetEgoNetwork returns the employee, their direct collaborators and the collaborations among all of them
+func (uc *collaborationUsecase) GetEgoNetwork(ctx context.Context, uniqueID string, langCode string) (*dtos.CollaborationGraphResponse, error) {
+	if uniqueID == "" {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - uniqueID(%s) to retrive collaboration network", uniqueID))
+	}
+
+	employee, err := uc.employeeRepo.GetByUniqueID(ctx, uniqueID)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, custom_errors.NotFound(fmt.Errorf("employee with given unique id(%s) does not exist", uniqueID))
+		}
+
+		return nil, err
+	}
+
+	egoLinks, err := uc.collaborationRepo.GetLinks(ctx, []int64{employee.ID}, true, collaborationMaxTokenEmployees)
+	if err != nil {
+		return nil, err
+	}
+
+	linkedIDs := []int64{employee.ID}
+	for _, link := range egoLinks {
+		linkedIDs = append(linkedIDs, link.CollaboratorID)
+	}
+
+	visibilities, err := employeeVisibilitiesFor(ctx, linkedIDs, uc.employeeRepo, uc.institutionRepo, uc.employeeVisibilityRepo)
+	if err != nil {
+		return nil, err
+	}
+	egoLinks = visibleLinks(egoLinks, visibilities)
+
+	collaboratorIDs := []int64{}
+	strength := map[int64]int32{}
+	for _, link := range egoLinks {
+		if _, ok := strength[link.CollaboratorID]; !ok {
+			collaboratorIDs = append(collaboratorIDs, link.CollaboratorID)
+		}
+		strength[link.CollaboratorID] += link.Weight
+	}
+
+	truncated := len(collaboratorIDs) > collaborationMaxEgoCollaborators
+	nodeIDs := append([]int64{employee.ID}, strongestIDs(collaboratorIDs, strength, collaborationMaxEgoCollaborators)...)
+
+	links := egoLinks
+	if len(nodeIDs) > 1 {
+		links, err = uc.collaborationRepo.GetLinks(ctx, nodeIDs, false, collaborationMaxTokenEmployees)
+		if err != nil {
+			return nil, err
+		}
+		links = visibleLinks(links, visibilities)
+	}
+
+	resp, err := uc.buildGraph(ctx, nodeIDs, links, visibilities, langCode, func(node *dtos.CollaborationNodeResponse, employeeID int64) {
+		node.IsEgo = employeeID == employee.ID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Truncated = truncated
+	return resp, nil
+}
+
+// GetInstitutionNetwork returns the current employees of the institution with the collaborations among them,
+// includeExternal adds their collaborators from other institutions
+func (uc *collaborationUsecase) GetInstitutionNetwork(ctx context.Context, institutionID int64, includeExternal bool, langCode string) (*dtos.CollaborationGraphResponse, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive collaboration network", institutionID))
+	}
+
+	employeeIDs, err := uc.collaborationRepo.GetEmployeeIDsByInstitutionID(ctx, institutionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(employeeIDs) == 0 {
+		return &dtos.CollaborationGraphResponse{
+			Nodes: []*dtos.CollaborationNodeResponse{},
+			Edges: []*dtos.CollaborationEdgeResponse{},
+		}, nil
+	}
+
+	links, err := uc.collaborationRepo.GetLinks(ctx, employeeIDs, includeExternal, collaborationMaxTokenEmployees)
+	if err != nil {
+		return nil, err
+	}
+
+	linkedIDs := append([]int64{}, employeeIDs...)
+	for _, link := range links {
+		linkedIDs = append(linkedIDs, link.CollaboratorID)
+	}
+
+	visibilities, err := employeeVisibilitiesFor(ctx, linkedIDs, uc.employeeRepo, uc.institutionRepo, uc.employeeVisibilityRepo)
+	if err != nil {
+		return nil, err
+	}
+	links = visibleLinks(links, visibilities)
+
+	internalIDs := make(map[int64]bool, len(employeeIDs))
+	for _, employeeID := range employeeIDs {
+		internalIDs[employeeID] = true
+	}
+	externalIDs := []int64{}
+	strength := map[int64]int32{}
+	for _, link := range links {
+		if !internalIDs[link.CollaboratorID] {
+			if _, ok := strength[link.CollaboratorID]; !ok {
+				externalIDs = append(externalIDs, link.CollaboratorID)
+			}
+			strength[link.CollaboratorID] += link.Weight
+		}
+		strength[link.EmployeeID] += link.Weight
+	}
+
+	// the employees of the institution are kept before their external collaborators
+	truncated := len(employeeIDs)+len(externalIDs) > collaborationMaxInstitutionNodes
+	nodeIDs := strongestIDs(employeeIDs, strength, collaborationMaxInstitutionNodes)
+	nodeIDs = append(nodeIDs, strongestIDs(externalIDs, strength, collaborationMaxInstitutionNodes-len(nodeIDs))...)
+
+	resp, err := uc.buildGraph(ctx, nodeIDs, links, visibilities, langCode, func(node *dtos.CollaborationNodeResponse, employeeID int64) {
+		node.IsExternal = !internalIDs[employeeID]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Truncated = truncated
+	return resp, nil
+}
+
+// RenderGEXF writes the network as a GEXF document for Gephi
+func (uc *collaborationUsecase) RenderGEXF(collaborationGraph *dtos.CollaborationGraphResponse, description string) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := graph.WriteGEXF(&buffer, mappers.MapCollaborationGraphResponseToGraph(collaborationGraph, description)); err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to render collaboration network as gexf: %w", err))
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// buildGraph names the nodes and folds the links into undirected edges, a pair linked from both sides is counted once.
+// Names and workplaces are shown only to the viewers the employee shares them with, mark flags the nodes the kind of network cares about
+func (uc *collaborationUsecase) buildGraph(
+	ctx context.Context,
+	nodeIDs []int64,
+	links []*domain.CollaborationLink,
+	visibilities map[int64]*domain.EmployeeVisibility,
+	langCode string,
+	mark func(node *dtos.CollaborationNodeResponse, employeeID int64),
+) (*dtos.CollaborationGraphResponse, error) {
+	nodes, err := uc.collaborationRepo.GetNodesByIDs(ctx, nodeIDs, utils.LanguageFallback(langCode, uc.languageFallback))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dtos.CollaborationGraphResponse{
+		Nodes: make([]*dtos.CollaborationNodeResponse, len(nodes)),
+		Edges: []*dtos.CollaborationEdgeResponse{},
+	}
+
+	nodesByID := make(map[int64]*dtos.CollaborationNodeResponse, len(nodes))
+	for index, node := range nodes {
+		visibility := visibilities[node.EmployeeID]
+		if visibility == nil || !visibility.Allows(domain.VisibilitySectionDetails) {
+			node.Surname, node.Name, node.Middlename = "", "", ""
+		}
+		if visibility == nil || !visibility.Allows(domain.VisibilitySectionWorkExperiences) {
+			node.CurrentWorkplace = ""
+		}
+
+		label := strings.TrimSpace(strings.Join([]string{node.Surname, node.Name, node.Middlename}, " "))
+		if label == "" {
+			label = node.UniqueID
+		}
+
+		resp.Nodes[index] = &dtos.CollaborationNodeResponse{
+			ID:                   node.UniqueID,
+			Label:                label,
+			CurrentWorkplace:     node.CurrentWorkplace,
+			CurrentInstitutionID: node.CurrentInstitutionID,
+		}
+		mark(resp.Nodes[index], node.EmployeeID)
+		nodesByID[node.EmployeeID] = resp.Nodes[index]
+	}
+
+	type pair struct{ first, second int64 }
+	edgesByPair := map[pair]*dtos.CollaborationEdgeResponse{}
+	pairs := []pair{}
+	for _, link := range links {
+		key := pair{min(link.EmployeeID, link.CollaboratorID), max(link.EmployeeID, link.CollaboratorID)}
+		source, target := nodesByID[key.first], nodesByID[key.second]
+		if source == nil || target == nil {
+			continue
+		}
+
+		edge, ok := edgesByPair[key]
+		if !ok {
+			edge = &dtos.CollaborationEdgeResponse{
+				Source: source.ID,
+				Target: target.ID,
+				Types:  map[string]int32{},
+			}
+			edgesByPair[key] = edge
+			pairs = append(pairs, key)
+
+			source.Degree++
+			target.Degree++
+		}
+
+		if _, counted := edge.Types[link.Kind]; !counted {
+			edge.Types[link.Kind] = link.Weight
+			edge.Weight += link.Weight
+		}
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].first != pairs[j].first {
+			return pairs[i].first < pairs[j].first
+		}
+
+		return pairs[i].second < pairs[j].second
+	})
+
+	for _, key := range pairs {
+		resp.Edges = append(resp.Edges, edgesByPair[key])
+	}
+
+	return resp, nil
+}
+
+// visibleLinks keeps the links made of items both employees share with the viewer
+func visibleLinks(links []*domain.CollaborationLink, visibilities map[int64]*domain.EmployeeVisibility) []*domain.CollaborationLink {
+	visible := make([]*domain.CollaborationLink, 0, len(links))
+	for _, link := range links {
+		section := collaborationVisibilitySections[link.Kind]
+		employee, collaborator := visibilities[link.EmployeeID], visibilities[link.CollaboratorID]
+		if employee == nil || collaborator == nil || !employee.Allows(section) || !collaborator.Allows(section) {
+			continue
+		}
+
+		visible = append(visible, link)
+	}
+
+	return visible
+}
+
+// strongestIDs returns at most limit of the ids, the ones with the greatest strength first
+func strongestIDs(ids []int64, strength map[int64]int32, limit int) []int64 {
+	sorted := append([]int64{}, ids...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if strength[sorted[i]] != strength[sorted[j]] {
+			return strength[sorted[i]] > strength[sorted[j]]
+		}
+
+		return sorted[i] < sorted[j]
+	})
+
+	if len(sorted) > limit {
+		sorted = sorted[:max(limit, 0)]
+	}
+
+	return sorted
+}
@@ -0,0 +1,243 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type ConsentUsecase interface {
+	GetCurrent(ctx context.Context, langCode string) (*dtos.ConsentDocumentResponse, error)
+	GetStatus(ctx context.Context) (*dtos.ConsentStatusResponse, error)
+	Accept(ctx context.Context, req *dtos.AcceptConsentRequest) (*dtos.ConsentStatusResponse, error)
+	Publish(ctx context.Context, req *dtos.PublishConsentDocumentsRequest) ([]*dtos.ConsentDocumentResponse, error)
+	GetAllRecords(ctx context.Context) ([]*dtos.UserConsentResponse, error)
+	RenderCSV(records []*dtos.UserConsentResponse) ([]byte, error)
+}
+
+type consentUsecase struct {
+	consentRepo      repositories.ConsentRepository
+	store            *postgres.Store
+	validator        *validator.Validate
+	languageFallback []string
+}
+
+func NewConsentUsecase(
+	consentRepo repositories.ConsentRepository,
+	store *postgres.Store,
+	validator *validator.Validate,
+	languageFallback []string,
+) ConsentUsecase {
+	return &consentUsecase{
+		consentRepo:      consentRepo,
+		store:            store,
+		validator:        validator,
+		languageFallback: languageFallback,
+	}
+}
+
+// GetCurrent returns the latest version of the terms in langCode or its fallback
+func (uc *consentUsecase) GetCurrent(ctx context.Context, langCode string) (*dtos.ConsentDocumentResponse, error) {
+	version, err := uc.consentRepo.GetLatestVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if version == 0 {
+		return nil, custom_errors.NotFound(fmt.Errorf("no terms of publishing personal data are published"))
+	}
+
+	documents, err := uc.consentRepo.GetDocumentsByVersion(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fallbackLangCode := range utils.LanguageFallback(langCode, uc.languageFallback) {
+		for _, document := range documents {
+			if document.LanguageCode == fallbackLangCode {
+				return mappers.MapConsentDocumentDomainToResponseDTO(document), nil
+			}
+		}
+	}
+
+	// a version published only in disabled languages is still served
+	return mappers.MapConsentDocumentDomainToResponseDTO(documents[0]), nil
+}
+
+// GetStatus tells the signed in user whether the latest terms have to be accepted
+func (uc *consentUsecase) GetStatus(ctx context.Context) (*dtos.ConsentStatusResponse, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, custom_errors.Unauthorized(fmt.Errorf("user is not signed in"))
+	}
+
+	return consentStatus(ctx, uc.consentRepo, userID)
+}
+
+// Accept records the acceptance of the latest terms by the signed in user in the language of the request
+func (uc *consentUsecase) Accept(ctx context.Context, req *dtos.AcceptConsentRequest) (*dtos.ConsentStatusResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid request body: %w", err))
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, custom_errors.Unauthorized(fmt.Errorf("user is not signed in"))
+	}
+
+	var status *dtos.ConsentStatusResponse
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txConsentRepo := postgres.NewPgConsentRepositoryWithQuery(q)
+
+		version, err := txConsentRepo.GetLatestVersion(ctx)
+		if err != nil {
+			return err
+		}
+
+		if version == 0 || req.Version != version {
+			lang := middleware.GetLanguageFromContext(ctx)
+			return custom_errors.BadRequest(errors.New(languages.Localize(clientErrorMessages["termsOutdated"], lang)))
+		}
+
+		err = txConsentRepo.CreateUserConsent(ctx, &domain.UserConsent{
+			UserID:          userID,
+			DocumentVersion: version,
+			LanguageCode:    middleware.GetLanguageFromContext(ctx),
+			IPAddress:       req.IPAddress,
+		})
+		if err != nil {
+			return err
+		}
+
+		status, err = consentStatus(ctx, txConsentRepo, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// Publish stores the documents as the next version of the terms, every user has to accept it again
+func (uc *consentUsecase) Publish(ctx context.Context, req *dtos.PublishConsentDocumentsRequest) ([]*dtos.ConsentDocumentResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid request body: %w", err))
+	}
+
+	seenLangCodes := make(map[string]bool, len(req.Documents))
+	for _, document := range req.Documents {
+		if seenLangCodes[document.LanguageCode] {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - language code(%s) is given more than once", document.LanguageCode))
+		}
+		seenLangCodes[document.LanguageCode] = true
+	}
+
+	var resp []*dtos.ConsentDocumentResponse
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txConsentRepo := postgres.NewPgConsentRepositoryWithQuery(q)
+
+		version, err := txConsentRepo.GetLatestVersion(ctx)
+		if err != nil {
+			return err
+		}
+
+		resp = make([]*dtos.ConsentDocumentResponse, len(req.Documents))
+		for index, document := range req.Documents {
+			documentResult, err := txConsentRepo.CreateDocument(ctx, &domain.ConsentDocument{
+				Version:      version + 1,
+				LanguageCode: document.LanguageCode,
+				Title:        document.Title,
+				Body:         document.Body,
+			})
+			if err != nil {
+				return err
+			}
+
+			resp[index] = mappers.MapConsentDocumentDomainToResponseDTO(documentResult)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetAllRecords returns every recorded acceptance of the terms, oldest first
+func (uc *consentUsecase) GetAllRecords(ctx context.Context) ([]*dtos.UserConsentResponse, error) {
+	consents, err := uc.consentRepo.GetAllUserConsents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*dtos.UserConsentResponse, len(consents))
+	for index, consent := range consents {
+		resp[index] = mappers.MapUserConsentDomainToResponseDTO(consent)
+	}
+
+	return resp, nil
+}
+
+// RenderCSV writes the records with a header row, times are in RFC 3339
+func (uc *consentUsecase) RenderCSV(records []*dtos.UserConsentResponse) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	rows := [][]string{{"id", "user_id", "email", "employee_unique_id", "document_version", "language_code", "ip_address", "accepted_at"}}
+	for _, record := range records {
+		rows = append(rows, []string{
+			strconv.FormatInt(record.ID, 10),
+			strconv.FormatInt(record.UserID, 10),
+			record.Email,
+			record.EmployeeUniqueID,
+			strconv.FormatInt(int64(record.DocumentVersion), 10),
+			record.LanguageCode,
+			record.IPAddress,
+			record.AcceptedAt.Format(time.RFC3339),
+		})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to write consent records as CSV: %w", err))
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// consentStatus compares the latest terms with the latest version the user accepted
+func consentStatus(ctx context.Context, consentRepo repositories.ConsentRepository, userID int64) (*dtos.ConsentStatusResponse, error) {
+	currentVersion, err := consentRepo.GetLatestVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptedVersion, err := consentRepo.GetLatestAcceptedVersion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.ConsentStatusResponse{
+		CurrentVersion:  currentVersion,
+		AcceptedVersion: acceptedVersion,
+		ConsentRequired: acceptedVersion < currentVersion,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/shared/mappers"
+	"context"
+)
+
+type DegreeLevelUsecase interface {
+	GetAll(ctx context.Context, langCode string) ([]*dtos.DegreeLevelResponse, error)
+}
+
+type degreeLevelUsecase struct {
+	degreeLevelRepo repositories.DegreeLevelRepository
+}
+
+func NewDegreeLevelUsecase(degreeLevelRepo repositories.DegreeLevelRepository) DegreeLevelUsecase {
+	return &degreeLevelUsecase{
+		degreeLevelRepo: degreeLevelRepo,
+	}
+}
+
+// GetAll lists the degree levels ordered by rank, named in langCode
+func (uc *degreeLevelUsecase) GetAll(ctx context.Context, langCode string) ([]*dtos.DegreeLevelResponse, error) {
+	degreeLevels, err := uc.degreeLevelRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*dtos.DegreeLevelResponse, len(degreeLevels))
+	for index, degreeLevel := range degreeLevels {
+		resp[index] = mappers.MapDegreeLevelDomainToResponseDTO(degreeLevel, langCode)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,336 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"context"
+	"fmt"
+	"sort"
+)
+
+type EmployeeCompletenessUsecase interface {
+	GetByUniqueID(ctx context.Context, uniqueID string, langCode string) ([]*dtos.EmployeeCompletenessResponse, error)
+	GetLeastCompleteByInstitutionID(ctx context.Context, institutionID int64, profileLangCode string, limit int64, langCode string) ([]*dtos.EmployeeCompletenessResponse, error)
+}
+
+type employeeCompletenessUsecase struct {
+	employeeRepo             repositories.EmployeeRepository
+	institutionRepo          repositories.InstitutionRepository
+	employeeCompletenessRepo repositories.EmployeeCompletenessRepository
+}
+
+func NewEmployeeCompletenessUsecase(
+	employeeRepo repositories.EmployeeRepository,
+	institutionRepo repositories.InstitutionRepository,
+	employeeCompletenessRepo repositories.EmployeeCompletenessRepository,
+) EmployeeCompletenessUsecase {
+	return &employeeCompletenessUsecase{
+		employeeRepo:             employeeRepo,
+		institutionRepo:          institutionRepo,
+		employeeCompletenessRepo: employeeCompletenessRepo,
+	}
+}
+
+const (
+	leastCompleteProfilesDefaultLimit = 20
+	leastCompleteProfilesMaxLimit     = 200
+)
+
+// completenessCheck is an item of the checklist, the weights of all checks add up to 100
+type completenessCheck struct {
+	item   string
+	weight int
+	filled func(c *domain.EmployeeCompletenessCounts) bool
+}
+
+var completenessChecklist = []completenessCheck{
+	{domain.CompletenessItemDetails, 15, func(c *domain.EmployeeCompletenessCounts) bool { return c.HasDetails }},
+	{domain.CompletenessItemDegree, 10, func(c *domain.EmployeeCompletenessCounts) bool { return c.DegreeCount > 0 }},
+	{domain.CompletenessItemCurrentWork, 10, func(c *domain.EmployeeCompletenessCounts) bool { return c.OngoingWorkExperienceCount > 0 }},
+	{domain.CompletenessItemSocial, 10, func(c *domain.EmployeeCompletenessCounts) bool { return c.SocialCount > 0 }},
+	{domain.CompletenessItemMainResearchArea, 10, func(c *domain.EmployeeCompletenessCounts) bool { return c.MainResearchAreaCount > 0 }},
+	{domain.CompletenessItemKeyTopic, 5, func(c *domain.EmployeeCompletenessCounts) bool { return c.KeyTopicCount > 0 }},
+	{domain.CompletenessItemPublication, 10, func(c *domain.EmployeeCompletenessCounts) bool { return c.PublicationCount > 0 }},
+	{domain.CompletenessItemResearchActivity, 5, func(c *domain.EmployeeCompletenessCounts) bool { return c.ResearchActivityCount > 0 }},
+	{domain.CompletenessItemOrcid, 8, func(c *domain.EmployeeCompletenessCounts) bool { return c.HasOrcid }},
+	{domain.CompletenessItemScientificAward, 3, func(c *domain.EmployeeCompletenessCounts) bool { return c.ScientificAwardCount > 0 }},
+	{domain.CompletenessItemPatent, 3, func(c *domain.EmployeeCompletenessCounts) bool { return c.PatentCount > 0 }},
+	{domain.CompletenessItemProfessionalCommunity, 3, func(c *domain.EmployeeCompletenessCounts) bool { return c.ProfessionalCommunityCount > 0 }},
+	{domain.CompletenessItemRefresherCourse, 3, func(c *domain.EmployeeCompletenessCounts) bool { return c.RefresherCourseCount > 0 }},
+	{domain.CompletenessItemEvent, 3, func(c *domain.EmployeeCompletenessCounts) bool { return c.EventCount > 0 }},
+	{domain.CompletenessItemGender, 2, func(c *domain.EmployeeCompletenessCounts) bool { return c.HasGender }},
+}
+
+// listingRequirement mirrors a condition of the personnel listing query, keep both in sync
+type listingRequirement struct {
+	reason string
+	met    func(c *domain.EmployeeCompletenessCounts) bool
+}
+
+var listingRequirements = []listingRequirement{
+	{domain.ListingReasonNoDetails, func(c *domain.EmployeeCompletenessCounts) bool { return c.HasDetails }},
+	{domain.ListingReasonNoSocials, func(c *domain.EmployeeCompletenessCounts) bool { return c.SocialCount > 0 }},
+	{domain.ListingReasonNoHighestAcademicDegree, func(c *domain.EmployeeCompletenessCounts) bool { return c.HasHighestAcademicDegree }},
+	{domain.ListingReasonNoSpeciality, func(c *domain.EmployeeCompletenessCounts) bool { return c.HasSpeciality }},
+	{domain.ListingReasonNoCurrentWorkplace, func(c *domain.EmployeeCompletenessCounts) bool { return c.HasCurrentWorkplace }},
+	{domain.ListingReasonNoConsent, func(c *domain.EmployeeCompletenessCounts) bool { return c.HasConsent }},
+}
+
+// completenessMessages are keyed by checklist item or listing reason and language code,
+// languages missing here get the messages picked by languages.Localize
+var completenessMessages = map[string]map[string]string{
+	domain.CompletenessItemDetails: {
+		"en": "Fill in your name and personal details in this language.",
+		"ru": "Заполните имя и личные данные на этом языке.",
+		"tg": "Ном ва маълумоти шахсиро бо ҳамин забон пур кунед.",
+	},
+	domain.CompletenessItemDegree: {
+		"en": "Add at least one academic degree.",
+		"ru": "Добавьте хотя бы одну учёную степень.",
+		"tg": "Ақаллан як дараҷаи илмӣ илова кунед.",
+	},
+	domain.CompletenessItemCurrentWork: {
+		"en": "Add your current place of work.",
+		"ru": "Добавьте текущее место работы.",
+		"tg": "Ҷои кори ҳозираро илова кунед.",
+	},
+	domain.CompletenessItemSocial: {
+		"en": "Add your contacts and social profiles.",
+		"ru": "Добавьте контакты и профили в социальных сетях.",
+		"tg": "Тамосҳо ва профилҳои иҷтимоиро илова кунед.",
+	},
+	domain.CompletenessItemMainResearchArea: {
+		"en": "Describe your main research area.",
+		"ru": "Опишите основное направление исследований.",
+		"tg": "Самти асосии тадқиқотро тавсиф кунед.",
+	},
+	domain.CompletenessItemKeyTopic: {
+		"en": "Add key topics to your research areas.",
+		"ru": "Добавьте ключевые темы к направлениям исследований.",
+		"tg": "Ба самтҳои тадқиқот мавзӯъҳои калидӣ илова кунед.",
+	},
+	domain.CompletenessItemPublication: {
+		"en": "Add your publications.",
+		"ru": "Добавьте публикации.",
+		"tg": "Интишоротро илова кунед.",
+	},
+	domain.CompletenessItemResearchActivity: {
+		"en": "Add your research activities.",
+		"ru": "Добавьте научную деятельность.",
+		"tg": "Фаъолияти илмиро илова кунед.",
+	},
+	domain.CompletenessItemOrcid: {
+		"en": "Link your ORCID iD.",
+		"ru": "Привяжите ORCID iD.",
+		"tg": "ORCID iD-ро пайваст кунед.",
+	},
+	domain.CompletenessItemScientificAward: {
+		"en": "Add your scientific awards.",
+		"ru": "Добавьте научные награды.",
+		"tg": "Мукофотҳои илмиро илова кунед.",
+	},
+	domain.CompletenessItemPatent: {
+		"en": "Add your patents.",
+		"ru": "Добавьте патенты.",
+		"tg": "Патентҳоро илова кунед.",
+	},
+	domain.CompletenessItemProfessionalCommunity: {
+		"en": "Add the professional communities you take part in.",
+		"ru": "Добавьте профессиональные сообщества, в которых вы участвуете.",
+		"tg": "Ҷамъиятҳои касбие, ки дар онҳо иштирок мекунед, илова кунед.",
+	},
+	domain.CompletenessItemRefresherCourse: {
+		"en": "Add your refresher courses.",
+		"ru": "Добавьте курсы повышения квалификации.",
+		"tg": "Курсҳои такмили ихтисосро илова кунед.",
+	},
+	domain.CompletenessItemEvent: {
+		"en": "Add the events you took part in.",
+		"ru": "Добавьте мероприятия, в которых вы участвовали.",
+		"tg": "Чорабиниҳое, ки дар онҳо иштирок кардаед, илова кунед.",
+	},
+	domain.CompletenessItemGender: {
+		"en": "Specify your gender.",
+		"ru": "Укажите пол.",
+		"tg": "Ҷинсро нишон диҳед.",
+	},
+	domain.ListingReasonNoDetails: {
+		"en": "Profiles are listed only in languages they have personal details in.",
+		"ru": "Профиль показывается только на языках, на которых заполнены личные данные.",
+		"tg": "Профил танҳо бо забонҳое нишон дода мешавад, ки маълумоти шахсӣ бо онҳо пур шудааст.",
+	},
+	domain.ListingReasonNoSocials: {
+		"en": "Profiles without contacts or social profiles are not listed.",
+		"ru": "Профили без контактов и социальных сетей не показываются.",
+		"tg": "Профилҳои бе тамос ва шабакаҳои иҷтимоӣ нишон дода намешаванд.",
+	},
+	domain.ListingReasonNoHighestAcademicDegree: {
+		"en": "Profiles without an academic degree are not listed.",
+		"ru": "Профили без учёной степени не показываются.",
+		"tg": "Профилҳои бе дараҷаи илмӣ нишон дода намешаванд.",
+	},
+	domain.ListingReasonNoSpeciality: {
+		"en": "Profiles without a speciality of the degree are not listed.",
+		"ru": "Профили без специальности по степени не показываются.",
+		"tg": "Профилҳои бе ихтисоси дараҷа нишон дода намешаванд.",
+	},
+	domain.ListingReasonNoCurrentWorkplace: {
+		"en": "Profiles without an ongoing place of work are not listed.",
+		"ru": "Профили без текущего места работы не показываются.",
+		"tg": "Профилҳои бе ҷои кори ҳозира нишон дода намешаванд.",
+	},
+	domain.ListingReasonNoConsent: {
+		"en": "Profiles are listed only after the latest terms of publishing personal data are accepted.",
+		"ru": "Профиль показывается только после принятия последней версии условий публикации персональных данных.",
+		"tg": "Профил танҳо пас аз қабули нусхаи охирини шартҳои нашри маълумоти шахсӣ нишон дода мешавад.",
+	},
+}
+
+// GetByUniqueID returns the completeness of the employee in every enabled language to the employee or an admin,
+// messages are written in langCode
+func (uc *employeeCompletenessUsecase) GetByUniqueID(ctx context.Context, uniqueID string, langCode string) ([]*dtos.EmployeeCompletenessResponse, error) {
+	if uniqueID == "" {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - uniqueID(%s) to retrive profile completeness", uniqueID))
+	}
+
+	employee, err := uc.employeeRepo.GetByUniqueID(ctx, uniqueID)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, custom_errors.NotFound(fmt.Errorf("employee with given unique id(%s) does not exist", uniqueID))
+		}
+
+		return nil, err
+	}
+
+	if err := uc.authorize(ctx, employee); err != nil {
+		return nil, err
+	}
+
+	counts, err := uc.employeeCompletenessRepo.GetByUniqueID(ctx, uniqueID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*dtos.EmployeeCompletenessResponse, len(counts))
+	for index, c := range counts {
+		resp[index] = evaluateCompleteness(c, langCode)
+	}
+
+	return resp, nil
+}
+
+// GetLeastCompleteByInstitutionID returns the least complete profiles in profileLangCode of the current employees of the institution,
+// lowest score first
+func (uc *employeeCompletenessUsecase) GetLeastCompleteByInstitutionID(ctx context.Context, institutionID int64, profileLangCode string, limit int64, langCode string) ([]*dtos.EmployeeCompletenessResponse, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive profile completeness", institutionID))
+	}
+
+	if !languages.IsEnabled(profileLangCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - language code(%s) is not supported", profileLangCode))
+	}
+
+	if limit < 0 || limit > leastCompleteProfilesMaxLimit {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - limit(%d) of profiles, expected at most %d", limit, leastCompleteProfilesMaxLimit))
+	}
+
+	if limit == 0 {
+		limit = leastCompleteProfilesDefaultLimit
+	}
+
+	counts, err := uc.employeeCompletenessRepo.GetByInstitutionID(ctx, institutionID, profileLangCode)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*dtos.EmployeeCompletenessResponse, 0, len(counts))
+	for _, c := range counts {
+		completeness := evaluateCompleteness(c, langCode)
+		if c.HasDetails {
+			completeness.Fullname = fmt.Sprintf("%s %s", c.Surname, c.Name)
+			if c.Middlename != "" {
+				completeness.Fullname += " " + c.Middlename
+			}
+		}
+		resp = append(resp, completeness)
+	}
+
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].Score < resp[j].Score
+	})
+
+	if int64(len(resp)) > limit {
+		resp = resp[:limit]
+	}
+
+	return resp, nil
+}
+
+// authorize lets the employee, a global admin or an admin of the institution the employee works in see the completeness
+func (uc *employeeCompletenessUsecase) authorize(ctx context.Context, employee *domain.Employee) error {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return custom_errors.Unauthorized(fmt.Errorf("user is not authenticated"))
+	}
+
+	if employee.UserID == userID {
+		return nil
+	}
+
+	if role, _ := middleware.GetUserRoleFromContext(ctx); role == domain.UserRoleAdmin {
+		return nil
+	}
+
+	if employee.CurrentInstitutionID != 0 {
+		isAdmin, err := uc.institutionRepo.IsAdmin(ctx, employee.CurrentInstitutionID, userID)
+		if err != nil {
+			return err
+		}
+		if isAdmin {
+			return nil
+		}
+	}
+
+	return custom_errors.Forbidden(fmt.Errorf("user(%d) is not allowed to see profile completeness of employee(%d)", userID, employee.ID))
+}
+
+func evaluateCompleteness(c *domain.EmployeeCompletenessCounts, langCode string) *dtos.EmployeeCompletenessResponse {
+	completeness := &dtos.EmployeeCompletenessResponse{
+		UID:              c.UniqueID,
+		LanguageCode:     c.LanguageCode,
+		Listed:           true,
+		Missing:          []*dtos.CompletenessItemResponse{},
+		NotListedReasons: []*dtos.CompletenessReasonResponse{},
+	}
+
+	for _, check := range completenessChecklist {
+		if check.filled(c) {
+			completeness.Score += check.weight
+			continue
+		}
+
+		completeness.Missing = append(completeness.Missing, &dtos.CompletenessItemResponse{
+			Item:    check.item,
+			Weight:  check.weight,
+			Message: languages.Localize(completenessMessages[check.item], langCode),
+		})
+	}
+
+	for _, requirement := range listingRequirements {
+		if requirement.met(c) {
+			continue
+		}
+
+		completeness.Listed = false
+		completeness.NotListedReasons = append(completeness.NotListedReasons, &dtos.CompletenessReasonResponse{
+			Reason:  requirement.reason,
+			Message: languages.Localize(completenessMessages[requirement.reason], langCode),
+		})
+	}
+
+	return completeness
+}
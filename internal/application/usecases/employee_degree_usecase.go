@@ -5,7 +5,9 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -21,16 +23,25 @@ type EmployeeDegreeUsecase interface {
 
 type employeeDegreeUsecase struct {
 	employeeDegreeRepo repositories.EmployeeDegreeRepository
+	degreeLevelRepo    repositories.DegreeLevelRepository
+	specialityRepo     repositories.SpecialityRepository
 	validator          *validator.Validate
+	languageFallback   []string
 }
 
 func NewEmployeeDegreeUsecase(
 	employeeDegreeRepo repositories.EmployeeDegreeRepository,
+	degreeLevelRepo repositories.DegreeLevelRepository,
+	specialityRepo repositories.SpecialityRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) EmployeeDegreeUsecase {
 	return &employeeDegreeUsecase{
 		employeeDegreeRepo: employeeDegreeRepo,
+		degreeLevelRepo:    degreeLevelRepo,
+		specialityRepo:     specialityRepo,
 		validator:          validator,
+		languageFallback:   languageFallback,
 	}
 }
 
@@ -39,12 +50,24 @@ func (uc *employeeDegreeUsecase) Create(ctx context.Context, req *dtos.CreateEmp
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to create employee degree: %w", err))
 	}
 
+	if err := uc.checkDegreeLevel(ctx, req.DegreeLevelCode); err != nil {
+		return nil, err
+	}
+
+	if req.SpecialityCode != "" {
+		if err := uc.checkSpeciality(ctx, req.SpecialityCode); err != nil {
+			return nil, err
+		}
+	}
+
 	employeeDegree := &domain.EmployeeDegree{
 		EmployeeID:         req.EmployeeID,
 		LanguageCode:       req.LanguageCode,
-		DegreeLevel:        req.DegreeLevel,
+		TranslationGroupID: req.TranslationGroupID,
+		DegreeLevelCode:    req.DegreeLevelCode,
 		UniversityName:     req.UniversityName,
 		Speciality:         req.Speciality,
+		SpecialityCode:     req.SpecialityCode,
 		DateStart:          req.DateStart,
 		DateEnd:            req.DateEnd,
 		GivenBy:            req.GivenBy,
@@ -56,6 +79,12 @@ func (uc *employeeDegreeUsecase) Create(ctx context.Context, req *dtos.CreateEmp
 		return nil, err
 	}
 
+	// re-read to respond with the name of the degree level
+	createdEmployeeDegree, err = uc.employeeDegreeRepo.GetByID(ctx, createdEmployeeDegree.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := mappers.MapEmployeeDegreeDomainToResponseDTO(createdEmployeeDegree)
 	return resp, nil
 }
@@ -73,14 +102,34 @@ func (uc *employeeDegreeUsecase) Update(ctx context.Context, req *dtos.UpdateEmp
 		employeeDegree.UniversityName = *req.UniversityName
 	}
 
-	if req.DegreeLevel != nil {
-		employeeDegree.DegreeLevel = *req.DegreeLevel
+	if req.DegreeLevelCode != nil {
+		if err := uc.checkDegreeLevel(ctx, *req.DegreeLevelCode); err != nil {
+			return nil, err
+		}
+
+		employeeDegree.DegreeLevelCode = *req.DegreeLevelCode
 	}
 
 	if req.Speciality != nil {
 		employeeDegree.Speciality = *req.Speciality
 	}
 
+	// the speciality code is written as given, so a missing one keeps the stored code and an empty one unlinks it
+	if req.SpecialityCode == nil {
+		storedEmployeeDegree, err := uc.employeeDegreeRepo.GetByID(ctx, req.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		employeeDegree.SpecialityCode = storedEmployeeDegree.SpecialityCode
+	} else if *req.SpecialityCode != "" {
+		if err := uc.checkSpeciality(ctx, *req.SpecialityCode); err != nil {
+			return nil, err
+		}
+
+		employeeDegree.SpecialityCode = *req.SpecialityCode
+	}
+
 	if req.DateStart != nil {
 		employeeDegree.DateStart = *req.DateStart
 	}
@@ -102,6 +151,11 @@ func (uc *employeeDegreeUsecase) Update(ctx context.Context, req *dtos.UpdateEmp
 		return nil, err
 	}
 
+	updatedEmployeeDegree, err = uc.employeeDegreeRepo.GetByID(ctx, updatedEmployeeDegree.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := mappers.MapEmployeeDegreeDomainToResponseDTO(updatedEmployeeDegree)
 	return resp, nil
 }
@@ -123,11 +177,11 @@ func (uc *employeeDegreeUsecase) GetByEmployeeIDAndLanguageCode(ctx context.Cont
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive employee degree", employeeID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive employee degree", langCode))
 	}
 
-	employeeDegrees, err := uc.employeeDegreeRepo.GetByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+	employeeDegrees, err := uc.employeeDegreeRepo.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -139,3 +193,31 @@ func (uc *employeeDegreeUsecase) GetByEmployeeIDAndLanguageCode(ctx context.Cont
 
 	return resp, nil
 }
+
+// checkDegreeLevel rejects codes absent from the degree level vocabulary
+func (uc *employeeDegreeUsecase) checkDegreeLevel(ctx context.Context, code string) error {
+	exists, err := uc.degreeLevelRepo.Exists(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return custom_errors.BadRequest(fmt.Errorf("invalid input - DegreeLevelCode(%s) is not a known degree level", code))
+	}
+
+	return nil
+}
+
+// checkSpeciality rejects codes absent from the speciality classifier
+func (uc *employeeDegreeUsecase) checkSpeciality(ctx context.Context, code string) error {
+	exists, err := uc.specialityRepo.Exists(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return custom_errors.BadRequest(fmt.Errorf("invalid input - SpecialityCode(%s) is not in the speciality classifier", code))
+	}
+
+	return nil
+}
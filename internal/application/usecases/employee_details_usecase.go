@@ -8,9 +8,10 @@ import (
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
 	"backend/internal/shared/mappers"
-	"backend/internal/shared/utils"
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -18,6 +19,7 @@ import (
 type EmployeeDetailsUsecase interface {
 	GetByEmployeeID(ctx context.Context, req int64) ([]*dtos.EmployeeDetailsResponse, error)
 	Update(ctx context.Context, req []dtos.UpdateEmployeeDetailsRequest) ([]*dtos.EmployeeDetailsResponse, error)
+	Delete(ctx context.Context, id int64) error
 }
 
 type employeeDetailsUsecase struct {
@@ -56,17 +58,53 @@ func (uc *employeeDetailsUsecase) GetByEmployeeID(ctx context.Context, req int64
 	return resp, nil
 }
 
+// Update saves names of the employee. Entries left out of the request are kept as previous names,
+// marking an entry as current turns the former current name of its language into a previous one.
 func (uc *employeeDetailsUsecase) Update(ctx context.Context, req []dtos.UpdateEmployeeDetailsRequest) ([]*dtos.EmployeeDetailsResponse, error) {
-	for _, details := range req {
+	if len(req) == 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update employee details: no details given"))
+	}
+
+	currentByLanguage := map[string]int{}
+	for index, details := range req {
 		if err := uc.validator.Struct(details); err != nil {
 			return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update employee details: %w", err))
 		}
+
+		if details.EmployeeID != req[0].EmployeeID {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update employee details: details of several employees given"))
+		}
+
+		if details.EffectiveFrom != nil && details.EffectiveTo != nil && details.EffectiveTo.Before(*details.EffectiveFrom) {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update employee details: effectiveTo is before effectiveFrom"))
+		}
+
+		if details.IsEmployeeDetailsNew != nil && *details.IsEmployeeDetailsNew {
+			if _, ok := currentByLanguage[details.LanguageCode]; ok {
+				return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update employee details: several current names given in language(%s)", details.LanguageCode))
+			}
+			if details.EffectiveTo != nil {
+				return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update employee details: current name can not have effectiveTo"))
+			}
+
+			currentByLanguage[details.LanguageCode] = index
+		}
 	}
 
 	resp := []*dtos.EmployeeDetailsResponse{}
 	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
 		txEmployeeDetailsRepo := postgres.NewPGEmployeeDetailsRepositoryWithQueries(q)
 
+		oldDetails, err := txEmployeeDetailsRepo.GetByEmployeeID(ctx, req[0].EmployeeID)
+		if err != nil && !custom_errors.IsNotFound(err) {
+			return err
+		}
+
+		oldDetailsByID := make(map[int64]*domain.EmployeeDetails, len(oldDetails))
+		for _, details := range oldDetails {
+			oldDetailsByID[details.ID] = details
+		}
+
 		newDetails := make([]*domain.EmployeeDetails, len(req))
 		for index, details := range req {
 			newDetails[index] = &domain.EmployeeDetails{
@@ -75,6 +113,20 @@ func (uc *employeeDetailsUsecase) Update(ctx context.Context, req []dtos.UpdateE
 				LanguageCode: details.LanguageCode,
 			}
 
+			if details.ID != 0 {
+				oldDetails, ok := oldDetailsByID[details.ID]
+				if !ok {
+					return custom_errors.BadRequest(fmt.Errorf("invalid input to update employee details: details(%d) do not belong to employee(%d)", details.ID, details.EmployeeID))
+				}
+
+				// a name in another language is a separate entry, the language of an existing one is not changed
+				if oldDetails.LanguageCode != details.LanguageCode {
+					return custom_errors.BadRequest(fmt.Errorf("invalid input to update employee details: language of details(%d) can not be changed", details.ID))
+				}
+
+				*newDetails[index] = *oldDetails
+			}
+
 			if details.Name != nil {
 				newDetails[index].Name = *details.Name
 			}
@@ -87,39 +139,57 @@ func (uc *employeeDetailsUsecase) Update(ctx context.Context, req []dtos.UpdateE
 				newDetails[index].Middlename = *details.Middlename
 			}
 
-			if details.IsEmployeeDetailsNew != nil {
+			if details.EffectiveFrom != nil {
+				newDetails[index].EffectiveFrom = *details.EffectiveFrom
+			}
+
+			if details.EffectiveTo != nil {
+				newDetails[index].EffectiveTo = *details.EffectiveTo
+			}
+
+			currentIndex, hasCurrent := currentByLanguage[newDetails[index].LanguageCode]
+			switch {
+			case details.IsEmployeeDetailsNew != nil:
 				newDetails[index].IsEmployeeDetailsNew = *details.IsEmployeeDetailsNew
+			case hasCurrent && currentIndex != index:
+				newDetails[index].IsEmployeeDetailsNew = false
 			}
-		}
 
-		oldDetails, err := txEmployeeDetailsRepo.GetByEmployeeID(ctx, newDetails[0].EmployeeID)
-		if err != nil && !custom_errors.IsNotFound(err) {
-			return err
+			// a previous name made current again is in use from now on
+			if newDetails[index].IsEmployeeDetailsNew {
+				newDetails[index].EffectiveTo = time.Time{}
+			}
 		}
 
-		updatedDetails, newDetails, removeDetails := utils.CompareSlices(oldDetails, newDetails)
-		for _, details := range updatedDetails {
-			updatedDetailsResult, err := txEmployeeDetailsRepo.Update(ctx, details)
-			if err != nil {
+		// former current names are archived first, so that a single current name per language is kept at every step
+		for langCode, index := range currentByLanguage {
+			if err := txEmployeeDetailsRepo.ArchiveCurrentDetails(ctx, req[index].EmployeeID, langCode, newDetails[index].ID, newDetails[index].EffectiveFrom); err != nil {
 				return err
 			}
-
-			resp = append(resp, mappers.MapEmployeeDetailsDomainIntoResponseDTO(updatedDetailsResult))
 		}
 
+		slices.SortStableFunc(newDetails, func(a, b *domain.EmployeeDetails) int {
+			if a.IsEmployeeDetailsNew == b.IsEmployeeDetailsNew {
+				return 0
+			}
+			if b.IsEmployeeDetailsNew {
+				return -1
+			}
+			return 1
+		})
+
 		for _, details := range newDetails {
-			createdDetailsResult, err := txEmployeeDetailsRepo.Create(ctx, details)
+			var savedDetails *domain.EmployeeDetails
+			if details.IsNew() {
+				savedDetails, err = txEmployeeDetailsRepo.Create(ctx, details)
+			} else {
+				savedDetails, err = txEmployeeDetailsRepo.Update(ctx, details)
+			}
 			if err != nil {
 				return err
 			}
 
-			resp = append(resp, mappers.MapEmployeeDetailsDomainIntoResponseDTO(createdDetailsResult))
-		}
-
-		for _, details := range removeDetails {
-			if err := txEmployeeDetailsRepo.Delete(ctx, details.ID); err != nil {
-				return err
-			}
+			resp = append(resp, mappers.MapEmployeeDetailsDomainIntoResponseDTO(savedDetails))
 		}
 
 		return nil
@@ -130,3 +200,21 @@ func (uc *employeeDetailsUsecase) Update(ctx context.Context, req []dtos.UpdateE
 
 	return resp, nil
 }
+
+// Delete removes a previous name of the employee, the current name can only be replaced
+func (uc *employeeDetailsUsecase) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return custom_errors.BadRequest(fmt.Errorf("invalid input - ID(%d) to delete employee details", id))
+	}
+
+	details, err := uc.employeeDetailsRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if details.IsEmployeeDetailsNew {
+		return custom_errors.BadRequest(fmt.Errorf("current name of the employee can not be deleted, mark another name as current first"))
+	}
+
+	return uc.employeeDetailsRepo.Delete(ctx, id)
+}
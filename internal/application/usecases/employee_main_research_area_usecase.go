@@ -7,10 +7,12 @@ import (
 	"backend/internal/infrastructure/persistence/postgres"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/mappers"
 	"backend/internal/shared/utils"
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -23,20 +25,26 @@ type EmployeeMainResearchAreaUsecase interface {
 }
 
 type employeeMainResearchAreaUsecase struct {
-	store           *postgres.Store
-	employeeMRARepo repositories.EmployeeMainResearchArea
-	validator       *validator.Validate
+	store             *postgres.Store
+	employeeMRARepo   repositories.EmployeeMainResearchArea
+	researchFieldRepo repositories.ResearchFieldRepository
+	validator         *validator.Validate
+	languageFallback  []string
 }
 
 func NewEmployeeMainResearchAreaUsecase(
 	employeeMRARepo repositories.EmployeeMainResearchArea,
+	researchFieldRepo repositories.ResearchFieldRepository,
 	store *postgres.Store,
 	validator *validator.Validate,
+	languageFallback []string,
 ) EmployeeMainResearchAreaUsecase {
 	return &employeeMainResearchAreaUsecase{
-		employeeMRARepo: employeeMRARepo,
-		store:           store,
-		validator:       validator,
+		employeeMRARepo:   employeeMRARepo,
+		researchFieldRepo: researchFieldRepo,
+		store:             store,
+		validator:         validator,
+		languageFallback:  languageFallback,
 	}
 }
 
@@ -45,26 +53,43 @@ func (uc *employeeMainResearchAreaUsecase) Create(ctx context.Context, req *dtos
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to create employee main research area: %w", err))
 	}
 
+	if req.ResearchFieldCode != "" {
+		if err := checkResearchField(ctx, uc.researchFieldRepo, req.ResearchFieldCode); err != nil {
+			return nil, err
+		}
+	}
+
 	var employeeMRA *domain.EmployeeMainResearchArea
 	var err error
 	err = uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
 		txEmployeeMainResearchAreaRepo := postgres.NewPgEmployeeMainResearchAreaRepositoryWithQueries(q)
 
 		employeeMRA, err = txEmployeeMainResearchAreaRepo.CreateMRA(ctx, &domain.EmployeeMainResearchArea{
-			EmployeeID:   req.EmployeeID,
-			LanguageCode: req.LanguageCode,
-			Discipline:   req.Discipline,
-			Area:         req.Area,
+			EmployeeID:         req.EmployeeID,
+			LanguageCode:       req.LanguageCode,
+			TranslationGroupID: req.TranslationGroupID,
+			Discipline:         req.Discipline,
+			Area:               req.Area,
+			ResearchFieldCode:  req.ResearchFieldCode,
 		})
 		if err != nil {
 			return err
 		}
 
+		txKeywordRepo := postgres.NewPgKeywordRepositoryWithQuery(q)
+
 		mainResearchAreaKT := make([]*domain.ResearchAreaKeyTopic, len(req.KeyTopics))
 		for index, rakt := range req.KeyTopics {
+			keywordID, err := findKeyword(ctx, txKeywordRepo, rakt.LanguageCode, rakt.KeyTopicTitle)
+			if err != nil {
+				return err
+			}
+
 			mainResearchAreaKT[index], err = txEmployeeMainResearchAreaRepo.CreateRAKT(ctx, &domain.ResearchAreaKeyTopic{
 				EmployeeMainResearchAreaID: employeeMRA.ID,
+				LanguageCode:               rakt.LanguageCode,
 				KeyTopicTitle:              rakt.KeyTopicTitle,
+				KeywordID:                  keywordID,
 			})
 			if err != nil {
 				return err
@@ -89,11 +114,19 @@ func (uc *employeeMainResearchAreaUsecase) Update(ctx context.Context, req *dtos
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update employee main research area: %w", err))
 	}
 
+	if req.ResearchFieldCode != nil {
+		if err := checkResearchField(ctx, uc.researchFieldRepo, *req.ResearchFieldCode); err != nil {
+			return nil, err
+		}
+	}
+
 	var employeeMRA *domain.EmployeeMainResearchArea
 	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
 		txEmployeeMainResearchAreaRepo := postgres.NewPgEmployeeMainResearchAreaRepositoryWithQueries(q)
 
-		_, err := txEmployeeMainResearchAreaRepo.GetMRAByID(ctx, req.ID)
+		txKeywordRepo := postgres.NewPgKeywordRepositoryWithQuery(q)
+
+		oldEmployeeMRA, err := txEmployeeMainResearchAreaRepo.GetMRAByID(ctx, req.ID)
 		if err != nil && !custom_errors.IsNotFound(err) {
 			return err
 		}
@@ -110,6 +143,10 @@ func (uc *employeeMainResearchAreaUsecase) Update(ctx context.Context, req *dtos
 			employeeMRA.Area = *req.Area
 		}
 
+		if req.ResearchFieldCode != nil {
+			employeeMRA.ResearchFieldCode = *req.ResearchFieldCode
+		}
+
 		employeeMRA, err = txEmployeeMainResearchAreaRepo.UpdateMRA(ctx, employeeMRA)
 		if err != nil {
 			return err
@@ -132,6 +169,24 @@ func (uc *employeeMainResearchAreaUsecase) Update(ctx context.Context, req *dtos
 			return err
 		}
 
+		// key topics keep the language they were created in, new ones take the language of the area
+		oldRaktLanguageCodes := make(map[int64]string, len(oldRakt))
+		for _, rakt := range oldRakt {
+			oldRaktLanguageCodes[rakt.ID] = rakt.LanguageCode
+		}
+
+		for _, rakt := range reqRakt {
+			rakt.LanguageCode = oldRaktLanguageCodes[rakt.ID]
+			if rakt.LanguageCode == "" && oldEmployeeMRA != nil {
+				rakt.LanguageCode = oldEmployeeMRA.LanguageCode
+			}
+
+			rakt.KeywordID, err = findKeyword(ctx, txKeywordRepo, rakt.LanguageCode, rakt.KeyTopicTitle)
+			if err != nil {
+				return err
+			}
+		}
+
 		updatedRAKTs, newRAKTs, removeRAKTs := utils.CompareSlices(oldRakt, reqRakt)
 		for _, rakt := range updatedRAKTs {
 			updatedRAKT, err := txEmployeeMainResearchAreaRepo.UpdateRAKT(ctx, rakt)
@@ -198,7 +253,7 @@ func (uc *employeeMainResearchAreaUsecase) GetByEmployeeIDAndLanguageCode(ctx co
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive employee main research area", employeeID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive employee main research area", langCode))
 	}
 
@@ -207,7 +262,7 @@ func (uc *employeeMainResearchAreaUsecase) GetByEmployeeIDAndLanguageCode(ctx co
 	err = uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
 		txEmployeeMainResearchAreaRepo := postgres.NewPgEmployeeMainResearchAreaRepositoryWithQueries(q)
 
-		employeeMRAs, err = txEmployeeMainResearchAreaRepo.GetMRAByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+		employeeMRAs, err = txEmployeeMainResearchAreaRepo.GetMRAByEmployeeIDAndLanguageCodes(ctx, employeeID, utils.LanguageFallback(langCode, uc.languageFallback))
 		if err != nil {
 			return err
 		}
@@ -231,3 +286,12 @@ func (uc *employeeMainResearchAreaUsecase) GetByEmployeeIDAndLanguageCode(ctx co
 
 	return resp, nil
 }
+
+// findKeyword links a key topic title to the keyword index, blank titles are left unlinked
+func findKeyword(ctx context.Context, keywordRepo repositories.KeywordRepository, langCode string, title string) (int64, error) {
+	if strings.TrimSpace(title) == "" || langCode == "" {
+		return 0, nil
+	}
+
+	return keywordRepo.FindOrCreate(ctx, langCode, title)
+}
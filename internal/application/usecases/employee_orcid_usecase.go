@@ -0,0 +1,383 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/infrastructure/security"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// orcidStateLifetime is how long the user has to complete authorization on ORCID side
+const orcidStateLifetime = 15 * time.Minute
+
+// orcidSyncBatchSize limits the amount of accounts synced by a single run of the background job
+const orcidSyncBatchSize = 50
+
+type EmployeeOrcidUsecase interface {
+	Get(ctx context.Context) (*dtos.EmployeeOrcidAccountResponse, error)
+	StartLinking(ctx context.Context) (*dtos.EmployeeOrcidAuthorizationResponse, error)
+	CompleteLinking(ctx context.Context, req *dtos.LinkEmployeeOrcidRequest) (*dtos.EmployeeOrcidAccountResponse, error)
+	UpdateORCID(ctx context.Context, req *dtos.UpdateEmployeeOrcidRequest) (*dtos.EmployeeOrcidAccountResponse, error)
+	Unlink(ctx context.Context) error
+	Sync(ctx context.Context) (*dtos.EmployeeOrcidSyncResponse, error)
+	SyncDue(ctx context.Context, interval time.Duration) error
+}
+
+type employeeOrcidUsecase struct {
+	employeeRepo       repositories.EmployeeRepository
+	employeeOrcidRepo  repositories.EmployeeOrcidRepository
+	orcidClient        repositories.OrcidClient
+	tokenCipher        *security.TokenCipher
+	store              *postgres.Store
+	validator          *validator.Validate
+	importLanguageCode string
+}
+
+func NewEmployeeOrcidUsecase(
+	employeeRepo repositories.EmployeeRepository,
+	employeeOrcidRepo repositories.EmployeeOrcidRepository,
+	orcidClient repositories.OrcidClient,
+	tokenCipher *security.TokenCipher,
+	store *postgres.Store,
+	validator *validator.Validate,
+	importLanguageCode string,
+) EmployeeOrcidUsecase {
+	return &employeeOrcidUsecase{
+		employeeRepo:       employeeRepo,
+		employeeOrcidRepo:  employeeOrcidRepo,
+		orcidClient:        orcidClient,
+		tokenCipher:        tokenCipher,
+		store:              store,
+		validator:          validator,
+		importLanguageCode: importLanguageCode,
+	}
+}
+
+func (uc *employeeOrcidUsecase) Get(ctx context.Context) (*dtos.EmployeeOrcidAccountResponse, error) {
+	employee, err := uc.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.accountResponse(ctx, employee.ID, employee.ORCID)
+}
+
+// StartLinking issues a state parameter and returns ORCID authorization page the user has to be redirected to
+func (uc *employeeOrcidUsecase) StartLinking(ctx context.Context) (*dtos.EmployeeOrcidAuthorizationResponse, error) {
+	employee, err := uc.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stateBytes := make([]byte, 16)
+	if _, err := rand.Read(stateBytes); err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to generate orcid authorization state: %w", err))
+	}
+	state := hex.EncodeToString(stateBytes)
+
+	if err := uc.employeeOrcidRepo.CreateOAuthState(ctx, state, employee.ID); err != nil {
+		return nil, err
+	}
+
+	return &dtos.EmployeeOrcidAuthorizationResponse{
+		AuthorizationURL: uc.orcidClient.AuthorizationURL(state),
+		State:            state,
+	}, nil
+}
+
+// CompleteLinking exchanges the code returned by ORCID for an access token,
+// stores the authenticated ORCID iD on the employee and runs the first synchronization
+func (uc *employeeOrcidUsecase) CompleteLinking(ctx context.Context, req *dtos.LinkEmployeeOrcidRequest) (*dtos.EmployeeOrcidAccountResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to link orcid account: %w", err))
+	}
+
+	employee, err := uc.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stateEmployeeID, stateCreatedAt, err := uc.employeeOrcidRepo.ConsumeOAuthState(ctx, req.State)
+	if err != nil {
+		return nil, err
+	}
+
+	if stateEmployeeID != employee.ID || time.Since(stateCreatedAt) > orcidStateLifetime {
+		return nil, custom_errors.BadRequest(fmt.Errorf("orcid authorization state is invalid or expired"))
+	}
+
+	account, err := uc.orcidClient.ExchangeCode(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	account.ORCID = utils.NormalizeORCID(account.ORCID)
+	if !utils.IsValidORCID(account.ORCID) {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("orcid returned invalid iD(%s)", account.ORCID))
+	}
+	account.EmployeeID = employee.ID
+
+	var linkedAccount *domain.EmployeeOrcidAccount
+	err = uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txEmployeeRepo := postgres.NewPgEmployeeRepositoryWithQuery(q)
+		txEmployeeOrcidRepo := postgres.NewPgEmployeeOrcidRepositoryWithQuery(q, uc.tokenCipher)
+
+		if err := txEmployeeRepo.UpdateORCID(ctx, employee.ID, account.ORCID); err != nil {
+			return err
+		}
+
+		linkedAccount, err = txEmployeeOrcidRepo.UpsertAccount(ctx, account)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the account stays linked even if the record could not be imported right away,
+	// the background job retries it later
+	if _, err := uc.syncAccount(ctx, linkedAccount); err != nil {
+		log.Printf("WARNING: failed initial synchronization of orcid account(%s) of employee(%d): %v", linkedAccount.ORCID, employee.ID, err)
+	}
+
+	return uc.accountResponse(ctx, employee.ID, account.ORCID)
+}
+
+// UpdateORCID sets the ORCID iD manually without authorization on ORCID side.
+// Records are not imported for such iD since there is no access token.
+func (uc *employeeOrcidUsecase) UpdateORCID(ctx context.Context, req *dtos.UpdateEmployeeOrcidRequest) (*dtos.EmployeeOrcidAccountResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update orcid: %w", err))
+	}
+
+	orcid := utils.NormalizeORCID(req.ORCID)
+	if !utils.IsValidORCID(orcid) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - ORCID(%s) is not a valid ORCID iD", req.ORCID))
+	}
+
+	employee, err := uc.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := uc.employeeOrcidRepo.GetAccountByEmployeeID(ctx, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account != nil && account.ORCID != orcid {
+		return nil, custom_errors.BadRequest(fmt.Errorf("employee is linked to ORCID iD(%s), unlink it before setting another one", account.ORCID))
+	}
+
+	if err := uc.employeeRepo.UpdateORCID(ctx, employee.ID, orcid); err != nil {
+		return nil, err
+	}
+
+	return uc.accountResponse(ctx, employee.ID, orcid)
+}
+
+// Unlink removes the ORCID iD and the access token, imported records are kept
+func (uc *employeeOrcidUsecase) Unlink(ctx context.Context) error {
+	employee, err := uc.currentEmployee(ctx)
+	if err != nil {
+		return err
+	}
+
+	return uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txEmployeeRepo := postgres.NewPgEmployeeRepositoryWithQuery(q)
+		txEmployeeOrcidRepo := postgres.NewPgEmployeeOrcidRepositoryWithQuery(q, uc.tokenCipher)
+
+		if err := txEmployeeOrcidRepo.DeleteAccountByEmployeeID(ctx, employee.ID); err != nil {
+			return err
+		}
+
+		return txEmployeeRepo.UpdateORCID(ctx, employee.ID, "")
+	})
+}
+
+func (uc *employeeOrcidUsecase) Sync(ctx context.Context) (*dtos.EmployeeOrcidSyncResponse, error) {
+	employee, err := uc.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := uc.employeeOrcidRepo.GetAccountByEmployeeID(ctx, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("employee(%d) has no linked orcid account", employee.ID))
+	}
+
+	return uc.syncAccount(ctx, account)
+}
+
+// SyncDue synchronizes accounts which were not synced during the interval,
+// failures are logged so that one broken account does not block the others
+func (uc *employeeOrcidUsecase) SyncDue(ctx context.Context, interval time.Duration) error {
+	if err := uc.employeeOrcidRepo.DeleteExpiredOAuthStates(ctx, time.Now().Add(-orcidStateLifetime)); err != nil {
+		log.Printf("WARNING: failed to delete expired orcid authorization states: %v", err)
+	}
+
+	accounts, err := uc.employeeOrcidRepo.ListAccountsDueForSync(ctx, time.Now().Add(-interval), orcidSyncBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, account := range accounts {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if _, err := uc.syncAccount(ctx, account); err != nil {
+			log.Printf("WARNING: failed to synchronize orcid account(%s) of employee(%d): %v", account.ORCID, account.EmployeeID, err)
+		}
+	}
+
+	return nil
+}
+
+// syncAccount imports works and employments of the ORCID record.
+// Works already entered manually (same DOI or title) are skipped,
+// previously imported records missing from the record are removed.
+func (uc *employeeOrcidUsecase) syncAccount(ctx context.Context, account *domain.EmployeeOrcidAccount) (*dtos.EmployeeOrcidSyncResponse, error) {
+	if !account.ExpiresAt.IsZero() && account.ExpiresAt.Before(time.Now()) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("orcid access token of %s has expired, the account has to be linked again", account.ORCID))
+	}
+
+	// accounts are read without their tokens, the access token is loaded only to call ORCID
+	if account.AccessToken == "" {
+		accessToken, err := uc.employeeOrcidRepo.GetAccessToken(ctx, account.EmployeeID)
+		if err != nil {
+			return nil, err
+		}
+		account.AccessToken = accessToken
+	}
+
+	works, err := uc.orcidClient.GetWorks(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	employments, err := uc.orcidClient.GetEmployments(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dtos.EmployeeOrcidSyncResponse{}
+	err = uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txEmployeePublicationRepo := postgres.NewPgEmployeePublicationRepositoryWithQuery(q)
+		txEmployeeWorkExperienceRepo := postgres.NewPgEmployeeWorkExperienceRepositoryWithQuery(q)
+		txEmployeeOrcidRepo := postgres.NewPgEmployeeOrcidRepositoryWithQuery(q, uc.tokenCipher)
+
+		existingPublications, err := txEmployeePublicationRepo.GetByEmployeeIDAndLanguageCode(ctx, account.EmployeeID, uc.importLanguageCode)
+		if err != nil {
+			return err
+		}
+
+		duplicateIndex := newPublicationDuplicateIndex()
+		for _, publication := range existingPublications {
+			if publication.Source != domain.SourceORCID {
+				duplicateIndex.add(publication.ID, publication.DOI, publication.PublicationTitle)
+			}
+		}
+
+		importedPublicationIDs := []string{}
+		for _, work := range works {
+			if _, found := duplicateIndex.find(work.DOI, work.PublicationTitle); found {
+				resp.SkippedPublications++
+				continue
+			}
+
+			work.EmployeeID = account.EmployeeID
+			work.LanguageCode = uc.importLanguageCode
+			importedWork, err := txEmployeePublicationRepo.UpsertImported(ctx, work)
+			if err != nil {
+				return err
+			}
+
+			if err := linkEmployeePublication(ctx, q, importedWork); err != nil {
+				return err
+			}
+
+			importedPublicationIDs = append(importedPublicationIDs, work.ExternalID)
+			resp.ImportedPublications++
+		}
+
+		removedFromPublicationIDs, err := txEmployeePublicationRepo.DeleteStaleImported(ctx, account.EmployeeID, uc.importLanguageCode, domain.SourceORCID, importedPublicationIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, publicationID := range removedFromPublicationIDs {
+			if err := releasePublicationAuthorIfUnused(ctx, q, publicationID, account.EmployeeID); err != nil {
+				return err
+			}
+		}
+
+		importedWorkExperienceIDs := []string{}
+		for _, employment := range employments {
+			employment.EmployeeID = account.EmployeeID
+			employment.LanguageCode = uc.importLanguageCode
+			if _, err := txEmployeeWorkExperienceRepo.UpsertImported(ctx, employment); err != nil {
+				return err
+			}
+
+			importedWorkExperienceIDs = append(importedWorkExperienceIDs, employment.ExternalID)
+			resp.ImportedWorkExperiences++
+		}
+
+		if err := txEmployeeWorkExperienceRepo.DeleteStaleImported(ctx, account.EmployeeID, uc.importLanguageCode, domain.SourceORCID, importedWorkExperienceIDs); err != nil {
+			return err
+		}
+
+		return txEmployeeOrcidRepo.MarkAccountSynced(ctx, account.EmployeeID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (uc *employeeOrcidUsecase) currentEmployee(ctx context.Context) (*domain.Employee, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, custom_errors.Unauthorized(fmt.Errorf("user is not authenticated"))
+	}
+
+	return uc.employeeRepo.GetByUserID(ctx, userID)
+}
+
+func (uc *employeeOrcidUsecase) accountResponse(ctx context.Context, employeeID int64, orcid string) (*dtos.EmployeeOrcidAccountResponse, error) {
+	account, err := uc.employeeOrcidRepo.GetAccountByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dtos.EmployeeOrcidAccountResponse{
+		ORCID: orcid,
+	}
+	if account != nil {
+		resp.Linked = true
+		if !account.LastSyncedAt.IsZero() {
+			lastSyncedAt := account.LastSyncedAt
+			resp.LastSyncedAt = &lastSyncedAt
+		}
+	}
+
+	return resp, nil
+}
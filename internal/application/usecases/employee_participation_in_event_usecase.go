@@ -5,7 +5,9 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -22,15 +24,18 @@ type EmployeeParticipationInEventUsecase interface {
 type employeeParticipationInEventUsecase struct {
 	employeeParticipationInEventRepo repositories.EmployeeParticipationInEventRepository
 	validator                        *validator.Validate
+	languageFallback                 []string
 }
 
 func NewEmployeeParticipationInEventUsecase(
 	employeeParticipationInEventRepo repositories.EmployeeParticipationInEventRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) EmployeeParticipationInEventUsecase {
 	return &employeeParticipationInEventUsecase{
 		employeeParticipationInEventRepo: employeeParticipationInEventRepo,
 		validator:                        validator,
+		languageFallback:                 languageFallback,
 	}
 }
 
@@ -40,10 +45,11 @@ func (uc *employeeParticipationInEventUsecase) Create(ctx context.Context, req *
 	}
 
 	employeeParticipationInEvent := &domain.EmployeeParticipationInEvent{
-		EmployeeID:                     req.EmployeeID,
-		LanguageCode:                   req.LanguageCode,
-		EventTitle:                     req.EventTitle,
-		EventDate:                      req.EventDate,
+		EmployeeID:         req.EmployeeID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		EventTitle:         req.EventTitle,
+		EventDate:          req.EventDate,
 	}
 
 	createdEmployeeParticipationInEvent, err := uc.employeeParticipationInEventRepo.Create(ctx, employeeParticipationInEvent)
@@ -98,11 +104,11 @@ func (uc *employeeParticipationInEventUsecase) GetByEmployeeIDAndLanguageCode(ct
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive employee participation in event", employeeID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive employee participation in event", langCode))
 	}
 
-	employeeParticipationInEvents, err := uc.employeeParticipationInEventRepo.GetByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+	employeeParticipationInEvents, err := uc.employeeParticipationInEventRepo.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -114,5 +120,3 @@ func (uc *employeeParticipationInEventUsecase) GetByEmployeeIDAndLanguageCode(ct
 
 	return resp, nil
 }
-
-
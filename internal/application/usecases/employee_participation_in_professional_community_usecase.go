@@ -5,7 +5,9 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -22,15 +24,18 @@ type EmployeeParticipationInProfessionalCommunityUsecase interface {
 type employeeParticipationInProfessionalCommunityUsecase struct {
 	employeeParticipationInProfessionalCommunityRepo repositories.EmployeeParticipationInProfessionalCommunityRepository
 	validator                                        *validator.Validate
+	languageFallback                                 []string
 }
 
 func NewEmployeeParticipationInProfessionalCommunityUsecase(
 	employeeParticipationInProfessionalCommunityRepo repositories.EmployeeParticipationInProfessionalCommunityRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) EmployeeParticipationInProfessionalCommunityUsecase {
 	return &employeeParticipationInProfessionalCommunityUsecase{
 		employeeParticipationInProfessionalCommunityRepo: employeeParticipationInProfessionalCommunityRepo,
-		validator: validator,
+		validator:        validator,
+		languageFallback: languageFallback,
 	}
 }
 
@@ -42,6 +47,7 @@ func (uc *employeeParticipationInProfessionalCommunityUsecase) Create(ctx contex
 	employeeParticipationInProfessionalCommunity := &domain.EmployeeParticipationInProfessionalCommunity{
 		EmployeeID:                  req.EmployeeID,
 		LanguageCode:                req.LanguageCode,
+		TranslationGroupID:          req.TranslationGroupID,
 		ProfessionalCommunityTitle:  req.ProfessionalCommunityTitle,
 		RoleInProfessionalCommunity: req.RoleInProfessionalCommunity,
 	}
@@ -98,11 +104,11 @@ func (uc *employeeParticipationInProfessionalCommunityUsecase) GetByEmployeeIDAn
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive employee participation in professional community", employeeID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive employee participation in professional community", langCode))
 	}
 
-	employeeParticipationInProfessionalCommunitys, err := uc.employeeParticipationInProfessionalCommunityRepo.GetByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+	employeeParticipationInProfessionalCommunitys, err := uc.employeeParticipationInProfessionalCommunityRepo.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -114,5 +120,3 @@ func (uc *employeeParticipationInProfessionalCommunityUsecase) GetByEmployeeIDAn
 
 	return resp, nil
 }
-
-
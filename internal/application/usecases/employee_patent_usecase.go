@@ -5,7 +5,9 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -22,15 +24,18 @@ type EmployeePatentUsecase interface {
 type employeePatentUsecase struct {
 	employeePatentRepo repositories.EmployeePatentRepository
 	validator          *validator.Validate
+	languageFallback   []string
 }
 
 func NewEmployeePatentUsecase(
 	employeePatentRepo repositories.EmployeePatentRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) EmployeePatentUsecase {
 	return &employeePatentUsecase{
 		employeePatentRepo: employeePatentRepo,
 		validator:          validator,
+		languageFallback:   languageFallback,
 	}
 }
 
@@ -40,10 +45,11 @@ func (uc *employeePatentUsecase) Create(ctx context.Context, req *dtos.CreateEmp
 	}
 
 	employeePatent := &domain.EmployeePatent{
-		EmployeeID:   req.EmployeeID,
-		LanguageCode: req.LanguageCode,
-		PatentTitle:  req.PatentTitle,
-		Description:  req.Description,
+		EmployeeID:         req.EmployeeID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		PatentTitle:        req.PatentTitle,
+		Description:        req.Description,
 	}
 
 	createdEmployeePatent, err := uc.employeePatentRepo.Create(ctx, employeePatent)
@@ -98,11 +104,11 @@ func (uc *employeePatentUsecase) GetByEmployeeIDAndLanguageCode(ctx context.Cont
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive employee patent", employeeID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive employee patent", langCode))
 	}
 
-	employeePatents, err := uc.employeePatentRepo.GetByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+	employeePatents, err := uc.employeePatentRepo.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -4,9 +4,18 @@ import (
 	"backend/internal/application/dtos"
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/bibliography"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
+	"bytes"
 	"fmt"
+	"log"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"golang.org/x/net/context"
@@ -17,20 +26,46 @@ type EmployeePublicationUsecase interface {
 	Update(ctx context.Context, req *dtos.UpdateEmployeePublicationRequest) (*dtos.EmployeePublicationResponse, error)
 	Delete(ctx context.Context, id int64) error
 	GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*dtos.EmployeePublicationResponse, error)
+	PreviewImport(ctx context.Context, employeeID int64, langCode string, filename string, content []byte) (*dtos.EmployeePublicationImportPreviewResponse, error)
+	Import(ctx context.Context, req *dtos.ImportEmployeePublicationsRequest) (*dtos.EmployeePublicationImportResponse, error)
+	Export(ctx context.Context, employeeID int64, langCode string, format string) ([]byte, error)
+	ResolveDOI(ctx context.Context, doi string) (*dtos.PublicationMetadataResponse, error)
+	UpdateCitations(ctx context.Context, req *dtos.UpdateEmployeePublicationCitationsRequest) (*dtos.EmployeePublicationResponse, error)
+	ImportCitations(ctx context.Context, employeeID int64) (*dtos.EmployeePublicationCitationImportResponse, error)
 }
 
+const (
+	publicationCitationSourceManual   = "manual"
+	publicationCitationSourceCrossref = "crossref"
+)
+
 type employeePublicationUsecase struct {
-	employeePublicationRepo repositories.EmployeePublicationRepository
-	validator               *validator.Validate
+	employeeRepo                repositories.EmployeeRepository
+	employeePublicationRepo     repositories.EmployeePublicationRepository
+	publicationCitationRepo     repositories.PublicationCitationRepository
+	publicationMetadataResolver repositories.PublicationMetadataResolver
+	store                       *postgres.Store
+	validator                   *validator.Validate
+	languageFallback            []string
 }
 
 func NewEmployeePublicationUsecase(
+	employeeRepo repositories.EmployeeRepository,
 	employeePublicationRepo repositories.EmployeePublicationRepository,
+	publicationCitationRepo repositories.PublicationCitationRepository,
+	publicationMetadataResolver repositories.PublicationMetadataResolver,
+	store *postgres.Store,
 	validator *validator.Validate,
+	languageFallback []string,
 ) EmployeePublicationUsecase {
 	return &employeePublicationUsecase{
-		employeePublicationRepo: employeePublicationRepo,
-		validator:               validator,
+		employeeRepo:                employeeRepo,
+		employeePublicationRepo:     employeePublicationRepo,
+		publicationCitationRepo:     publicationCitationRepo,
+		publicationMetadataResolver: publicationMetadataResolver,
+		store:                       store,
+		validator:                   validator,
+		languageFallback:            languageFallback,
 	}
 }
 
@@ -40,13 +75,44 @@ func (uc *employeePublicationUsecase) Create(ctx context.Context, req *dtos.Crea
 	}
 
 	employeePublication := &domain.EmployeePublication{
-		EmployeeID:        req.EmployeeID,
-		LanguageCode:      req.LanguageCode,
-		PublicationTitle:  req.PublicationTitle,
-		LinkToPublication: req.LinkToPublication,
+		EmployeeID:         req.EmployeeID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		PublicationTitle:   req.PublicationTitle,
+		LinkToPublication:  req.LinkToPublication,
+		Authors:            req.Authors,
+		PublicationYear:    req.PublicationYear,
+		Venue:              req.Venue,
+		DOI:                bibliography.NormalizeDOI(req.DOI),
+		PublicationType:    req.PublicationType,
+	}
+
+	// fields left empty by the user are pre-filled from the DOI registry
+	if employeePublication.DOI != "" {
+		publicationMetadata, err := uc.publicationMetadataResolver.ResolveDOI(ctx, employeePublication.DOI)
+		if err != nil {
+			if employeePublication.PublicationTitle == "" || employeePublication.LinkToPublication == "" {
+				return nil, err
+			}
+
+			log.Printf("WARNING: failed to resolve DOI(%s) while creating employee publication: %v", employeePublication.DOI, err)
+		} else {
+			fillEmployeePublicationFromMetadata(employeePublication, publicationMetadata)
+		}
 	}
 
-	createdEmployeePublication, err := uc.employeePublicationRepo.Create(ctx, employeePublication)
+	var createdEmployeePublication *domain.EmployeePublication
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txEmployeePublicationRepo := postgres.NewPgEmployeePublicationRepositoryWithQuery(q)
+
+		var err error
+		createdEmployeePublication, err = txEmployeePublicationRepo.Create(ctx, employeePublication)
+		if err != nil {
+			return err
+		}
+
+		return linkEmployeePublication(ctx, q, createdEmployeePublication)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -60,19 +126,56 @@ func (uc *employeePublicationUsecase) Update(ctx context.Context, req *dtos.Upda
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update employee publication: %w", err))
 	}
 
-	employeePublication := &domain.EmployeePublication{
-		ID: req.ID,
-	}
+	var updatedEmployeePublication *domain.EmployeePublication
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txEmployeePublicationRepo := postgres.NewPgEmployeePublicationRepositoryWithQuery(q)
 
-	if req.PublicationTitle != nil {
-		employeePublication.PublicationTitle = *req.PublicationTitle
-	}
+		// fields missing from the request keep their stored values, empty optional fields clear them
+		employeePublication, err := txEmployeePublicationRepo.GetByID(ctx, req.ID)
+		if err != nil {
+			return err
+		}
 
-	if req.LinkToPublication != nil {
-		employeePublication.LinkToPublication = *req.LinkToPublication
-	}
+		if req.PublicationTitle != nil {
+			employeePublication.PublicationTitle = *req.PublicationTitle
+		}
 
-	updatedEmployeePublication, err := uc.employeePublicationRepo.Update(ctx, employeePublication)
+		if req.LinkToPublication != nil {
+			employeePublication.LinkToPublication = *req.LinkToPublication
+		}
+
+		if req.Authors != nil {
+			employeePublication.Authors = *req.Authors
+		}
+
+		if req.PublicationYear != nil {
+			employeePublication.PublicationYear = *req.PublicationYear
+		}
+
+		if req.Venue != nil {
+			employeePublication.Venue = *req.Venue
+		}
+
+		if req.DOI != nil {
+			employeePublication.DOI = bibliography.NormalizeDOI(*req.DOI)
+		}
+
+		if req.PublicationType != nil {
+			employeePublication.PublicationType = *req.PublicationType
+		}
+
+		if _, err := txEmployeePublicationRepo.Update(ctx, employeePublication); err != nil {
+			return err
+		}
+
+		// title or DOI may have changed, so the entry is linked again using its stored state
+		updatedEmployeePublication, err = txEmployeePublicationRepo.GetByID(ctx, employeePublication.ID)
+		if err != nil {
+			return err
+		}
+
+		return linkEmployeePublication(ctx, q, updatedEmployeePublication)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +189,27 @@ func (uc *employeePublicationUsecase) Delete(ctx context.Context, id int64) erro
 		return custom_errors.BadRequest(fmt.Errorf("invalid input to delete employee publication"))
 	}
 
-	return uc.employeePublicationRepo.Delete(ctx, id)
+	return uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txEmployeePublicationRepo := postgres.NewPgEmployeePublicationRepositoryWithQuery(q)
+
+		employeePublication, err := txEmployeePublicationRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		publicationIDs, err := txEmployeePublicationRepo.Delete(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, publicationID := range publicationIDs {
+			if err := releasePublicationAuthorIfUnused(ctx, q, publicationID, employeePublication.EmployeeID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
 
 func (uc *employeePublicationUsecase) GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*dtos.EmployeePublicationResponse, error) {
@@ -94,11 +217,11 @@ func (uc *employeePublicationUsecase) GetByEmployeeIDAndLanguageCode(ctx context
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive employee publication", employeeID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive employee publication", langCode))
 	}
 
-	employeePublications, err := uc.employeePublicationRepo.GetByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+	employeePublications, err := uc.employeePublicationRepo.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -111,4 +234,373 @@ func (uc *employeePublicationUsecase) GetByEmployeeIDAndLanguageCode(ctx context
 	return resp, nil
 }
 
+// PreviewImport parses an uploaded BibTeX or RIS file without saving anything
+// and marks entries that already exist in the employee's publication list
+func (uc *employeePublicationUsecase) PreviewImport(ctx context.Context, employeeID int64, langCode string, filename string, content []byte) (*dtos.EmployeePublicationImportPreviewResponse, error) {
+	if employeeID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to preview employee publication import", employeeID))
+	}
+
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to preview employee publication import", langCode))
+	}
+
+	format := bibliography.DetectFormat(filename, content)
+	var entries []bibliography.Entry
+	var err error
+	switch format {
+	case bibliography.FormatBibTeX:
+		entries, err = bibliography.ParseBibTeX(bytes.NewReader(content))
+	case bibliography.FormatRIS:
+		entries, err = bibliography.ParseRIS(bytes.NewReader(content))
+	default:
+		return nil, custom_errors.BadRequest(fmt.Errorf("unsupported file format to import employee publications - only BibTeX and RIS are supported"))
+	}
+	if err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("failed to parse uploaded %s file: %w", format, err))
+	}
+
+	existingPublications, err := uc.employeePublicationRepo.GetByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+	if err != nil {
+		return nil, err
+	}
+
+	existingIndex := newPublicationDuplicateIndex()
+	for _, publication := range existingPublications {
+		existingIndex.add(publication.ID, publication.DOI, publication.PublicationTitle)
+	}
+
+	uploadIndex := newPublicationDuplicateIndex()
+	resp := &dtos.EmployeePublicationImportPreviewResponse{
+		Format:  format,
+		Entries: make([]*dtos.EmployeePublicationImportEntryPreview, len(entries)),
+	}
+	for index, entry := range entries {
+		preview := &dtos.EmployeePublicationImportEntryPreview{
+			ImportEmployeePublicationEntryRequest: *mappers.MapBibliographyEntryToImportEntryRequest(entry),
+		}
+
+		if duplicateOfID, found := existingIndex.find(entry.DOI, entry.Title); found {
+			preview.IsDuplicate = true
+			preview.DuplicateOfID = duplicateOfID
+		}
+
+		if _, found := uploadIndex.find(entry.DOI, entry.Title); found {
+			preview.IsDuplicateInUpload = true
+		}
+		uploadIndex.add(int64(index), entry.DOI, entry.Title)
+
+		resp.Entries[index] = preview
+	}
+
+	return resp, nil
+}
+
+// Import saves the entries confirmed by the user after the preview.
+// Entries that already exist (same DOI or same normalized title) are skipped.
+func (uc *employeePublicationUsecase) Import(ctx context.Context, req *dtos.ImportEmployeePublicationsRequest) (*dtos.EmployeePublicationImportResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to import employee publications: %w", err))
+	}
+
+	resp := &dtos.EmployeePublicationImportResponse{
+		Created: []*dtos.EmployeePublicationResponse{},
+	}
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txEmployeePublicationRepo := postgres.NewPgEmployeePublicationRepositoryWithQuery(q)
+
+		existingPublications, err := txEmployeePublicationRepo.GetByEmployeeIDAndLanguageCode(ctx, req.EmployeeID, req.LanguageCode)
+		if err != nil {
+			return err
+		}
+
+		duplicateIndex := newPublicationDuplicateIndex()
+		for _, publication := range existingPublications {
+			duplicateIndex.add(publication.ID, publication.DOI, publication.PublicationTitle)
+		}
+
+		for _, entry := range req.Entries {
+			doi := bibliography.NormalizeDOI(entry.DOI)
+			if _, found := duplicateIndex.find(doi, entry.PublicationTitle); found {
+				resp.Skipped++
+				continue
+			}
+
+			createdPublication, err := txEmployeePublicationRepo.Create(ctx, &domain.EmployeePublication{
+				EmployeeID:        req.EmployeeID,
+				LanguageCode:      req.LanguageCode,
+				PublicationTitle:  entry.PublicationTitle,
+				LinkToPublication: entry.LinkToPublication,
+				Authors:           entry.Authors,
+				PublicationYear:   entry.PublicationYear,
+				Venue:             entry.Venue,
+				DOI:               doi,
+				PublicationType:   entry.PublicationType,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := linkEmployeePublication(ctx, q, createdPublication); err != nil {
+				return err
+			}
+
+			duplicateIndex.add(createdPublication.ID, createdPublication.DOI, createdPublication.PublicationTitle)
+			resp.Created = append(resp.Created, mappers.MapEmployeePublicationDomainToResponseDTO(createdPublication))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Export serializes publications of the employee in the requested language into bibtex, ris or csl-json
+func (uc *employeePublicationUsecase) Export(ctx context.Context, employeeID int64, langCode string, format string) ([]byte, error) {
+	if employeeID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to export employee publications", employeeID))
+	}
+
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to export employee publications", langCode))
+	}
+
+	if format != bibliography.FormatBibTeX && format != bibliography.FormatRIS && format != bibliography.FormatCSLJSON {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - Format(%s) to export employee publications", format))
+	}
+
+	employeePublications, err := uc.employeePublicationRepo.GetByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]bibliography.Entry, len(employeePublications))
+	for index, publication := range employeePublications {
+		entries[index] = mappers.MapEmployeePublicationDomainToBibliographyEntry(publication)
+	}
+
+	var buffer bytes.Buffer
+	switch format {
+	case bibliography.FormatBibTeX:
+		err = bibliography.WriteBibTeX(&buffer, entries)
+	case bibliography.FormatRIS:
+		err = bibliography.WriteRIS(&buffer, entries)
+	case bibliography.FormatCSLJSON:
+		err = bibliography.WriteCSLJSON(&buffer, entries)
+	}
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to export employee publications: %w", err))
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (uc *employeePublicationUsecase) ResolveDOI(ctx context.Context, doi string) (*dtos.PublicationMetadataResponse, error) {
+	normalizedDOI := bibliography.NormalizeDOI(doi)
+	if !strings.HasPrefix(normalizedDOI, "10.") || !strings.Contains(normalizedDOI, "/") {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - DOI(%s) to resolve publication metadata", doi))
+	}
+
+	publicationMetadata, err := uc.publicationMetadataResolver.ResolveDOI(ctx, normalizedDOI)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.MapPublicationMetadataDomainToResponseDTO(publicationMetadata), nil
+}
+
+// UpdateCitations sets the citation count typed in by the employee.
+// The count belongs to the shared publication, so it is seen by all of its co-authors.
+func (uc *employeePublicationUsecase) UpdateCitations(ctx context.Context, req *dtos.UpdateEmployeePublicationCitationsRequest) (*dtos.EmployeePublicationResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update employee publication citations: %w", err))
+	}
+
+	var resp *dtos.EmployeePublicationResponse
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txEmployeePublicationRepo := postgres.NewPgEmployeePublicationRepositoryWithQuery(q)
+		txPublicationCitationRepo := postgres.NewPgPublicationCitationRepositoryWithQuery(q)
+
+		employeePublication, err := txEmployeePublicationRepo.GetByID(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.authorizeEmployee(ctx, employeePublication.EmployeeID); err != nil {
+			return err
+		}
+
+		if employeePublication.PublicationID == 0 {
+			return custom_errors.BadRequest(fmt.Errorf("employee publication(%d) is not linked to a shared publication", req.ID))
+		}
+
+		citation, err := txPublicationCitationRepo.Upsert(ctx, &domain.PublicationCitation{
+			PublicationID: employeePublication.PublicationID,
+			CitationCount: *req.CitationCount,
+			Source:        publicationCitationSourceManual,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := txPublicationCitationRepo.RefreshMetricsByPublicationID(ctx, employeePublication.PublicationID); err != nil {
+			return err
+		}
+
+		employeePublication.Citation = citation
+		resp = mappers.MapEmployeePublicationDomainToResponseDTO(employeePublication)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ImportCitations looks up citation counts of the employee's publications having a DOI in the DOI registry.
+// Publications the registry does not know or reports no count for are skipped.
+func (uc *employeePublicationUsecase) ImportCitations(ctx context.Context, employeeID int64) (*dtos.EmployeePublicationCitationImportResponse, error) {
+	if employeeID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to import employee publication citations", employeeID))
+	}
+
+	if err := uc.authorizeEmployee(ctx, employeeID); err != nil {
+		return nil, err
+	}
+
+	publications, err := uc.publicationCitationRepo.ListImportCandidates(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// registry is queried before the transaction is opened, so slow responses do not hold it
+	resp := &dtos.EmployeePublicationCitationImportResponse{}
+	citations := make([]*domain.PublicationCitation, 0, len(publications))
+	for _, publication := range publications {
+		publicationMetadata, err := uc.publicationMetadataResolver.ResolveDOI(ctx, publication.DOI)
+		if err != nil {
+			log.Printf("WARNING: failed to resolve DOI(%s) while importing citations of employee(%d): %v", publication.DOI, employeeID, err)
+			resp.Skipped++
+			continue
+		}
+
+		if publicationMetadata.CitationCount == nil {
+			resp.Skipped++
+			continue
+		}
+
+		citations = append(citations, &domain.PublicationCitation{
+			PublicationID: publication.ID,
+			CitationCount: *publicationMetadata.CitationCount,
+			Source:        publicationCitationSourceCrossref,
+		})
+	}
+
+	err = uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txPublicationCitationRepo := postgres.NewPgPublicationCitationRepositoryWithQuery(q)
+
+		for _, citation := range citations {
+			if _, err := txPublicationCitationRepo.Upsert(ctx, citation); err != nil {
+				return err
+			}
+
+			if err := txPublicationCitationRepo.RefreshMetricsByPublicationID(ctx, citation.PublicationID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Updated = len(citations)
+	return resp, nil
+}
+
+// authorizeEmployee lets the employee and administrators of the application change citation counts,
+// the counts are shared with co-authors and feed into their metrics
+func (uc *employeePublicationUsecase) authorizeEmployee(ctx context.Context, employeeID int64) error {
+	if role, _ := middleware.GetUserRoleFromContext(ctx); role == domain.UserRoleAdmin {
+		return nil
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return custom_errors.Unauthorized(fmt.Errorf("user is not authenticated"))
+	}
+
+	employee, err := uc.employeeRepo.GetByUserID(ctx, userID)
+	if err != nil && !custom_errors.IsNotFound(err) {
+		return err
+	}
+	if employee == nil || employee.ID != employeeID {
+		return custom_errors.Forbidden(fmt.Errorf("user(%d) is not allowed to change citations of employee(%d)", userID, employeeID))
+	}
+
+	return nil
+}
+
+// fillEmployeePublicationFromMetadata sets only those fields which were not provided by the user
+func fillEmployeePublicationFromMetadata(employeePublication *domain.EmployeePublication, publicationMetadata *domain.PublicationMetadata) {
+	if employeePublication.PublicationTitle == "" {
+		employeePublication.PublicationTitle = publicationMetadata.PublicationTitle
+	}
+
+	if employeePublication.LinkToPublication == "" {
+		employeePublication.LinkToPublication = publicationMetadata.LinkToPublication
+	}
+
+	if len(employeePublication.Authors) == 0 {
+		employeePublication.Authors = publicationMetadata.Authors
+	}
+
+	if employeePublication.PublicationYear == 0 {
+		employeePublication.PublicationYear = publicationMetadata.PublicationYear
+	}
+
+	if employeePublication.Venue == "" {
+		employeePublication.Venue = publicationMetadata.Venue
+	}
+
+	if employeePublication.PublicationType == "" {
+		employeePublication.PublicationType = publicationMetadata.PublicationType
+	}
+}
+
+// publicationDuplicateIndex looks up publications by normalized DOI and normalized title
+type publicationDuplicateIndex struct {
+	byDOI   map[string]int64
+	byTitle map[string]int64
+}
 
+func newPublicationDuplicateIndex() *publicationDuplicateIndex {
+	return &publicationDuplicateIndex{
+		byDOI:   map[string]int64{},
+		byTitle: map[string]int64{},
+	}
+}
+
+func (i *publicationDuplicateIndex) add(id int64, doi string, title string) {
+	if normalizedDOI := bibliography.NormalizeDOI(doi); normalizedDOI != "" {
+		i.byDOI[normalizedDOI] = id
+	}
+
+	if normalizedTitle := bibliography.NormalizeTitle(title); normalizedTitle != "" {
+		i.byTitle[normalizedTitle] = id
+	}
+}
+
+func (i *publicationDuplicateIndex) find(doi string, title string) (int64, bool) {
+	if id, ok := i.byDOI[bibliography.NormalizeDOI(doi)]; ok {
+		return id, true
+	}
+
+	id, ok := i.byTitle[bibliography.NormalizeTitle(title)]
+	return id, ok
+}
@@ -5,7 +5,9 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -22,15 +24,18 @@ type EmployeeRefresherCourseUsecase interface {
 type employeeRefresherCourseUsecase struct {
 	employeeRefresherCourseRepo repositories.EmployeeRefresherCourseRepository
 	validator                   *validator.Validate
+	languageFallback            []string
 }
 
 func NewEmployeeRefresherCourseUsecase(
 	employeeRefresherCourseRepo repositories.EmployeeRefresherCourseRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) EmployeeRefresherCourseUsecase {
 	return &employeeRefresherCourseUsecase{
 		employeeRefresherCourseRepo: employeeRefresherCourseRepo,
 		validator:                   validator,
+		languageFallback:            languageFallback,
 	}
 }
 
@@ -40,11 +45,12 @@ func (uc *employeeRefresherCourseUsecase) Create(ctx context.Context, req *dtos.
 	}
 
 	employeeRefresherCourse := &domain.EmployeeRefresherCourse{
-		EmployeeID:   req.EmployeeID,
-		LanguageCode: req.LanguageCode,
-		CourseTitle:  req.CourseTitle,
-		DateStart:    req.DateStart,
-		DateEnd:      req.DateEnd,
+		EmployeeID:         req.EmployeeID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		CourseTitle:        req.CourseTitle,
+		DateStart:          req.DateStart,
+		DateEnd:            req.DateEnd,
 	}
 
 	createdEmployeeRefresherCourse, err := uc.employeeRefresherCourseRepo.Create(ctx, employeeRefresherCourse)
@@ -103,11 +109,11 @@ func (uc *employeeRefresherCourseUsecase) GetByEmployeeIDAndLanguageCode(ctx con
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive employee refresher course", employeeID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive employee refresher course", langCode))
 	}
 
-	employeeRefresherCourses, err := uc.employeeRefresherCourseRepo.GetByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+	employeeRefresherCourses, err := uc.employeeRefresherCourseRepo.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -119,5 +125,3 @@ func (uc *employeeRefresherCourseUsecase) GetByEmployeeIDAndLanguageCode(ctx con
 
 	return resp, nil
 }
-
-
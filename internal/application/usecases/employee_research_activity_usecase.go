@@ -5,7 +5,9 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -22,15 +24,18 @@ type EmployeeResearchActivityUsecase interface {
 type employeeResearchActivityUsecase struct {
 	employeeResearchActivityRepo repositories.EmployeeResearchActivityRepository
 	validator                    *validator.Validate
+	languageFallback             []string
 }
 
 func NewEmployeeResearchActivityUsecase(
 	employeeResearchActivityRepo repositories.EmployeeResearchActivityRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) EmployeeResearchActivityUsecase {
 	return &employeeResearchActivityUsecase{
 		employeeResearchActivityRepo: employeeResearchActivityRepo,
 		validator:                    validator,
+		languageFallback:             languageFallback,
 	}
 }
 
@@ -42,6 +47,7 @@ func (uc *employeeResearchActivityUsecase) Create(ctx context.Context, req *dtos
 	employeeResearchActivity := &domain.EmployeeResearchActivity{
 		EmployeeID:            req.EmployeeID,
 		LanguageCode:          req.LanguageCode,
+		TranslationGroupID:    req.TranslationGroupID,
 		ResearchActivityTitle: req.ResearchActivityTitle,
 		EmployeeRole:          req.EmployeeRole,
 	}
@@ -98,11 +104,11 @@ func (uc *employeeResearchActivityUsecase) GetByEmployeeIDAndLanguageCode(ctx co
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive employee research activity", employeeID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive employee research activity", langCode))
 	}
 
-	employeeResearchActivitys, err := uc.employeeResearchActivityRepo.GetByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+	employeeResearchActivitys, err := uc.employeeResearchActivityRepo.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -114,5 +120,3 @@ func (uc *employeeResearchActivityUsecase) GetByEmployeeIDAndLanguageCode(ctx co
 
 	return resp, nil
 }
-
-
@@ -5,7 +5,9 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -22,15 +24,18 @@ type EmployeeScientificAwardUsecase interface {
 type employeeScientificAwardUsecase struct {
 	employeeScientificAwardRepo repositories.EmployeeScientificAwardRepository
 	validator                   *validator.Validate
+	languageFallback            []string
 }
 
 func NewEmployeeScientificAwardUsecase(
 	employeeScientificAwardRepo repositories.EmployeeScientificAwardRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) EmployeeScientificAwardUsecase {
 	return &employeeScientificAwardUsecase{
 		employeeScientificAwardRepo: employeeScientificAwardRepo,
 		validator:                   validator,
+		languageFallback:            languageFallback,
 	}
 }
 
@@ -40,10 +45,11 @@ func (uc *employeeScientificAwardUsecase) Create(ctx context.Context, req *dtos.
 	}
 
 	employeeScientificAward := &domain.EmployeeScientificAward{
-		EmployeeID:                req.EmployeeID,
-		LanguageCode:              req.LanguageCode,
-		ScientificAwardTitle:      req.ScientificAwardTitle,
-		GivenBy:                   req.GivenBy,
+		EmployeeID:           req.EmployeeID,
+		LanguageCode:         req.LanguageCode,
+		TranslationGroupID:   req.TranslationGroupID,
+		ScientificAwardTitle: req.ScientificAwardTitle,
+		GivenBy:              req.GivenBy,
 	}
 
 	createdEmployeeScientificAward, err := uc.employeeScientificAwardRepo.Create(ctx, employeeScientificAward)
@@ -98,11 +104,11 @@ func (uc *employeeScientificAwardUsecase) GetByEmployeeIDAndLanguageCode(ctx con
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive employee scientific award", employeeID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive employee scientific award", langCode))
 	}
 
-	employeeScientificAwards, err := uc.employeeScientificAwardRepo.GetByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+	employeeScientificAwards, err := uc.employeeScientificAwardRepo.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -114,5 +120,3 @@ func (uc *employeeScientificAwardUsecase) GetByEmployeeIDAndLanguageCode(ctx con
 
 	return resp, nil
 }
-
-
@@ -0,0 +1,145 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
+	"context"
+	"fmt"
+	"log"
+)
+
+type EmployeeSimilarityUsecase interface {
+	GetSimilar(ctx context.Context, uniqueID string, limit int64, langCode string) ([]*dtos.SimilarEmployeeResponse, error)
+	Refresh(ctx context.Context) error
+}
+
+type employeeSimilarityUsecase struct {
+	employeeRepo           repositories.EmployeeRepository
+	institutionRepo        repositories.InstitutionRepository
+	employeeVisibilityRepo repositories.EmployeeVisibilityRepository
+	employeeSimilarityRepo repositories.EmployeeSimilarityRepository
+	store                  *postgres.Store
+	languageFallback       []string
+}
+
+func NewEmployeeSimilarityUsecase(
+	employeeRepo repositories.EmployeeRepository,
+	institutionRepo repositories.InstitutionRepository,
+	employeeVisibilityRepo repositories.EmployeeVisibilityRepository,
+	employeeSimilarityRepo repositories.EmployeeSimilarityRepository,
+	store *postgres.Store,
+	languageFallback []string,
+) EmployeeSimilarityUsecase {
+	return &employeeSimilarityUsecase{
+		employeeRepo:           employeeRepo,
+		institutionRepo:        institutionRepo,
+		employeeVisibilityRepo: employeeVisibilityRepo,
+		employeeSimilarityRepo: employeeSimilarityRepo,
+		store:                  store,
+		languageFallback:       languageFallback,
+	}
+}
+
+// employeeSimilarityWeights favour shared topics over shared events and workplaces
+var employeeSimilarityWeights = domain.EmployeeSimilarityWeights{
+	ResearchArea: 0.3,
+	Keyword:      0.35,
+	Event:        0.15,
+	Institution:  0.2,
+}
+
+const (
+	// similarEmployeesStored is the number of similar researchers kept per employee, it bounds the limit of requests
+	similarEmployeesStored       = 20
+	similarEmployeesDefaultLimit = 5
+)
+
+// GetSimilar reads the researchers similar to the employee from the table filled by Refresh
+func (uc *employeeSimilarityUsecase) GetSimilar(ctx context.Context, uniqueID string, limit int64, langCode string) ([]*dtos.SimilarEmployeeResponse, error) {
+	if uniqueID == "" {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - uniqueID(%s) to retrive similar employees", uniqueID))
+	}
+
+	if limit < 0 || limit > similarEmployeesStored {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - limit(%d) of similar employees, expected at most %d", limit, similarEmployeesStored))
+	}
+
+	if limit == 0 {
+		limit = similarEmployeesDefaultLimit
+	}
+
+	if _, err := uc.employeeRepo.GetByUniqueID(ctx, uniqueID); err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, custom_errors.NotFound(fmt.Errorf("employee with given unique id(%s) does not exist", uniqueID))
+		}
+
+		return nil, err
+	}
+
+	similarities, err := uc.employeeSimilarityRepo.GetByUniqueID(ctx, uniqueID, utils.LanguageFallback(langCode, uc.languageFallback), int32(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	similarIDs := make([]int64, len(similarities))
+	for index, similarity := range similarities {
+		similarIDs[index] = similarity.EmployeeID
+	}
+
+	visibilities, err := employeeVisibilitiesFor(ctx, similarIDs, uc.employeeRepo, uc.institutionRepo, uc.employeeVisibilityRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	// researchers not sharing their details with the viewer are left out, the rest show only what they share
+	resp := make([]*dtos.SimilarEmployeeResponse, 0, len(similarities))
+	for _, similarity := range similarities {
+		visibility := visibilities[similarity.EmployeeID]
+		if visibility == nil || !visibility.Allows(domain.VisibilitySectionDetails) {
+			continue
+		}
+
+		if !visibility.Allows(domain.VisibilitySectionDegrees) {
+			similarity.HighestAcademicDegree = ""
+		}
+		if !visibility.Allows(domain.VisibilitySectionWorkExperiences) {
+			similarity.CurrentWorkplace = ""
+		}
+		if !visibility.Allows(domain.VisibilitySectionMainResearchAreas) {
+			similarity.SharedResearchAreas = 0
+			similarity.SharedKeywords = 0
+		}
+		if !visibility.Allows(domain.VisibilitySectionEvents) {
+			similarity.SharedEvents = 0
+		}
+
+		resp = append(resp, mappers.MapEmployeeSimilarityDomainToResponseDTO(similarity))
+	}
+
+	return resp, nil
+}
+
+// Refresh recomputes the similarities of every employee in one transaction, it is run by a background job
+func (uc *employeeSimilarityUsecase) Refresh(ctx context.Context) error {
+	var count int64
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txEmployeeSimilarityRepo := postgres.NewPgEmployeeSimilarityRepositoryWithQuery(q)
+
+		var err error
+		count, err = txEmployeeSimilarityRepo.Refresh(ctx, employeeSimilarityWeights, similarEmployeesStored)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Employee similarities refreshed, %d pairs stored", count)
+
+	return nil
+}
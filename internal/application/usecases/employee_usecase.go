@@ -3,14 +3,22 @@ package usecases
 import (
 	"backend/internal/application/dtos"
 	"backend/internal/application/repositories"
+	"backend/internal/domain"
 	"backend/internal/infrastructure/http/middleware"
 	"backend/internal/infrastructure/persistence/postgres"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/cv"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/mappers"
 	"backend/internal/shared/utils"
+	"bytes"
 	"context"
 	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -19,27 +27,35 @@ import (
 type EmployeeUsecase interface {
 	Delete(ctx context.Context, id int64) error
 	GetByUniqueID(ctx context.Context, uniqueID string) (*dtos.EmployeeResponse, error)
+	GetLinkedDataByUniqueID(ctx context.Context, uniqueID string) (*dtos.PersonJSONLD, error)
 	GetPersonnelPaginated(ctx context.Context, filter *dtos.PersonnelPaginatedQueryParameters) (*[]dtos.PersonnelProfileData, error)
 	GetPersonnelCountPaginated(ctx context.Context, filter *dtos.PersonnelPaginatedQueryParameters) (*int64, error)
-	ListUniqueHighestAcademicDegrees(ctx context.Context) ([]string, error)
+	ListUniqueHighestAcademicDegrees(ctx context.Context, langCode string) ([]*dtos.HighestAcademicDegreeResponse, error)
 	ListUniqueSpecialities(ctx context.Context) ([]string, error)
+	GenerateCV(ctx context.Context, uniqueID string, format string, templateName string) ([]byte, error)
 }
 
 type employeeUsecase struct {
-	employeeRepo repositories.EmployeeRepository
-	store        *postgres.Store
-	validator    *validator.Validate
+	employeeRepo     repositories.EmployeeRepository
+	store            *postgres.Store
+	validator        *validator.Validate
+	publicBaseURL    string
+	languageFallback []string
 }
 
 func NewEmployeeUsecase(
 	employeeRepo repositories.EmployeeRepository,
 	store *postgres.Store,
 	validator *validator.Validate,
+	publicBaseURL string,
+	languageFallback []string,
 ) EmployeeUsecase {
 	return &employeeUsecase{
-		employeeRepo: employeeRepo,
-		store:        store,
-		validator:    validator,
+		employeeRepo:     employeeRepo,
+		store:            store,
+		validator:        validator,
+		publicBaseURL:    publicBaseURL,
+		languageFallback: languageFallback,
 	}
 }
 
@@ -57,7 +73,7 @@ func (uc *employeeUsecase) GetByUniqueID(ctx context.Context, uniqueID string) (
 	}
 
 	var resp *dtos.EmployeeResponse
-	langCode := middleware.GetLanguageFromContext(ctx)
+	langCodes := utils.LanguageFallback(middleware.GetLanguageFromContext(ctx), uc.languageFallback)
 	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
 		txEmployeeRepo := postgres.NewPgEmployeeRepositoryWithQuery(q)
 		txEmployeeDetailsRepo := postgres.NewPGEmployeeDetailsRepositoryWithQueries(q)
@@ -72,6 +88,10 @@ func (uc *employeeUsecase) GetByUniqueID(ctx context.Context, uniqueID string) (
 		txEmployeeParticipationInEvenRepo := postgres.NewPgEmployeeParticipationInEventRepositoryWithQuery(q)
 		txEmployeeResearchActivityRepo := postgres.NewPgEmployeeResearchActivityRepositoryWithQueries(q)
 		txEmployeeSocialRepo := postgres.NewPgEmployeeSocialRepositoryWithQueries(q)
+		txPublicationCitationRepo := postgres.NewPgPublicationCitationRepositoryWithQuery(q)
+		txInstitutionRepo := postgres.NewPgInstitutionRepositoryWithQueries(q)
+		txEmployeeVisibilityRepo := postgres.NewPgEmployeeVisibilityRepositoryWithQuery(q)
+		txConsentRepo := postgres.NewPgConsentRepositoryWithQuery(q)
 
 		employee, err := txEmployeeRepo.GetByUniqueID(ctx, uniqueID)
 		if err != nil && !custom_errors.IsNotFound(err) {
@@ -79,134 +99,286 @@ func (uc *employeeUsecase) GetByUniqueID(ctx context.Context, uniqueID string) (
 		} else if custom_errors.IsNotFound(err) {
 			return custom_errors.BadRequest(fmt.Errorf("no user with given unique id"))
 		}
-		resp = mappers.MapEmployeeDomainToResponseDTO(employee)
 
-		//Employee Details
-		employeeDetails, err := txEmployeeDetailsRepo.GetByEmployeeID(ctx, employee.ID)
-		if err != nil && !custom_errors.IsNotFound(err) {
+		visibility, err := employeeVisibilityFor(ctx, employee, txEmployeeRepo, txInstitutionRepo, txEmployeeVisibilityRepo)
+		if err != nil {
 			return err
 		}
-		resp.Details = make([]*dtos.EmployeeDetailsResponse, len(employeeDetails))
-		for index, details := range employeeDetails {
-			resp.Details[index] = mappers.MapEmployeeDetailsDomainIntoResponseDTO(details)
+
+		// the employee and admins still see a profile that is not published for lack of consent
+		if visibility.Access != domain.VisibilityPrivate && visibility.Access != domain.VisibilityAdmin {
+			hasConsent, err := txConsentRepo.HasLatestConsent(ctx, employee.UserID)
+			if err != nil {
+				return err
+			}
+			if !hasConsent {
+				return custom_errors.NotFound(fmt.Errorf("profile of employee(%s) is not published until the latest terms are accepted", uniqueID))
+			}
 		}
+		resp = mappers.MapEmployeeDomainToResponseDTO(employee, visibility)
 
-		//Employee Degress
-		employeeDegrees, err := txEmployeeDegreeRepo.GetByEmployeeIDAndLanguageCode(ctx, employee.ID, langCode)
-		if err != nil && !custom_errors.IsNotFound(err) {
-			return err
+		//Employee Details
+		if visibility.Allows(domain.VisibilitySectionDetails) {
+			employeeDetails, err := txEmployeeDetailsRepo.GetByEmployeeID(ctx, employee.ID)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			resp.Details = make([]*dtos.EmployeeDetailsResponse, len(employeeDetails))
+			for index, details := range employeeDetails {
+				resp.Details[index] = mappers.MapEmployeeDetailsDomainIntoResponseDTO(details)
+			}
 		}
-		resp.Degrees = make([]*dtos.EmployeeDegreeResponse, len(employeeDegrees))
-		for index, degree := range employeeDegrees {
-			resp.Degrees[index] = mappers.MapEmployeeDegreeDomainToResponseDTO(degree)
+
+		//Employee Degress
+		if visibility.Allows(domain.VisibilitySectionDegrees) {
+			employeeDegrees, err := txEmployeeDegreeRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			resp.Degrees = make([]*dtos.EmployeeDegreeResponse, len(employeeDegrees))
+			for index, degree := range employeeDegrees {
+				resp.Degrees[index] = mappers.MapEmployeeDegreeDomainToResponseDTO(degree)
+			}
 		}
 
 		//Employee Work Experience
-		employeeWorkExperiences, err := txEmployeeWorkExperienceRepo.GetByEmployeeIDAndLanguageCode(ctx, employee.ID, langCode)
-		if err != nil && !custom_errors.IsNotFound(err) {
-			return err
-		}
-		resp.WorkExperiences = make([]*dtos.EmployeeWorkExperienceResponse, len(employeeWorkExperiences))
-		for index, workExperience := range employeeWorkExperiences {
-			resp.WorkExperiences[index] = mappers.MapEmployeeWorkExperienceDomainToResponseDTO(workExperience)
+		if visibility.Allows(domain.VisibilitySectionWorkExperiences) {
+			employeeWorkExperiences, err := txEmployeeWorkExperienceRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			resp.WorkExperiences = make([]*dtos.EmployeeWorkExperienceResponse, len(employeeWorkExperiences))
+			for index, workExperience := range employeeWorkExperiences {
+				resp.WorkExperiences[index] = mappers.MapEmployeeWorkExperienceDomainToResponseDTO(workExperience)
+			}
 		}
 
 		//Employee Main Research Area
-		employeeMRAs, err := txEmployeeMainResearchAreaRepo.GetMRAByEmployeeIDAndLanguageCode(ctx, employee.ID, langCode)
-		if err != nil && !custom_errors.IsNotFound(err) {
-			return err
-		}
-		for index, mra := range employeeMRAs {
-			rakts, err := txEmployeeMainResearchAreaRepo.GetRAKTByMRAIDAndLanguageCode(ctx, mra.ID)
+		if visibility.Allows(domain.VisibilitySectionMainResearchAreas) {
+			employeeMRAs, err := txEmployeeMainResearchAreaRepo.GetMRAByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
 			if err != nil && !custom_errors.IsNotFound(err) {
 				return err
 			}
+			for index, mra := range employeeMRAs {
+				rakts, err := txEmployeeMainResearchAreaRepo.GetRAKTByMRAIDAndLanguageCode(ctx, mra.ID)
+				if err != nil && !custom_errors.IsNotFound(err) {
+					return err
+				}
 
-			employeeMRAs[index].KeyTopics = rakts
-		}
-		resp.MainResearchAreas = make([]*dtos.EmployeeMainResearchAreaResponse, len(employeeMRAs))
-		for index, mra := range employeeMRAs {
-			resp.MainResearchAreas[index] = mappers.MapEmployeeMainResearchAreaDomainToResponseDTO(mra)
+				employeeMRAs[index].KeyTopics = rakts
+			}
+			resp.MainResearchAreas = make([]*dtos.EmployeeMainResearchAreaResponse, len(employeeMRAs))
+			for index, mra := range employeeMRAs {
+				resp.MainResearchAreas[index] = mappers.MapEmployeeMainResearchAreaDomainToResponseDTO(mra)
+			}
 		}
 
 		//Employee Publications
-		employeePublications, err := txEmployeePublicationRepo.GetByEmployeeIDAndLanguageCode(ctx, employee.ID, langCode)
-		if err != nil && !custom_errors.IsNotFound(err) {
-			return err
+		if visibility.Allows(domain.VisibilitySectionPublications) {
+			employeePublications, err := txEmployeePublicationRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			resp.Publications = make([]*dtos.EmployeePublicationResponse, len(employeePublications))
+			for index, publication := range employeePublications {
+				resp.Publications[index] = mappers.MapEmployeePublicationDomainToResponseDTO(publication)
+			}
 		}
-		resp.Publications = make([]*dtos.EmployeePublicationResponse, len(employeePublications))
-		for index, publication := range employeePublications {
-			resp.Publications[index] = mappers.MapEmployeePublicationDomainToResponseDTO(publication)
+
+		//Employee Citation Metrics
+		if visibility.Allows(domain.VisibilitySectionPublications) {
+			citationMetrics, err := txPublicationCitationRepo.GetEmployeeMetrics(ctx, employee.ID)
+			if err != nil {
+				return err
+			}
+			resp.HIndex = citationMetrics.HIndex
+			resp.I10Index = citationMetrics.I10Index
+			resp.TotalCitations = citationMetrics.TotalCitations
 		}
 
 		//Employee Scientific Awards
-		employeeScientficAwards, err := txEmployeeScientificAwardRepo.GetByEmployeeIDAndLanguageCode(ctx, employee.ID, langCode)
-		if err != nil && !custom_errors.IsNotFound(err) {
-			return err
-		}
-		resp.ScientificAwards = make([]*dtos.EmployeeScientificAwardResponse, len(employeeScientficAwards))
-		for index, award := range employeeScientficAwards {
-			resp.ScientificAwards[index] = mappers.MapEmployeeScientificAwardDomainToResponseDTO(award)
+		if visibility.Allows(domain.VisibilitySectionScientificAwards) {
+			employeeScientficAwards, err := txEmployeeScientificAwardRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			resp.ScientificAwards = make([]*dtos.EmployeeScientificAwardResponse, len(employeeScientficAwards))
+			for index, award := range employeeScientficAwards {
+				resp.ScientificAwards[index] = mappers.MapEmployeeScientificAwardDomainToResponseDTO(award)
+			}
 		}
 
 		//Employee Patents
-		employeePatents, err := txEmployeePatentRepo.GetByEmployeeIDAndLanguageCode(ctx, employee.ID, langCode)
-		if err != nil && !custom_errors.IsNotFound(err) {
-			return err
-		}
-		resp.Patents = make([]*dtos.EmployeePatentResponse, len(employeePatents))
-		for index, patent := range employeePatents {
-			resp.Patents[index] = mappers.MapEmployeePatentDomainToResponseDTO(patent)
+		if visibility.Allows(domain.VisibilitySectionPatents) {
+			employeePatents, err := txEmployeePatentRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			resp.Patents = make([]*dtos.EmployeePatentResponse, len(employeePatents))
+			for index, patent := range employeePatents {
+				resp.Patents[index] = mappers.MapEmployeePatentDomainToResponseDTO(patent)
+			}
 		}
 
 		//Employee Participation In Professional Communities
-		employeePIPCs, err := txEmployeeParticipationInProfessionalCommunityRepo.GetByEmployeeIDAndLanguageCode(ctx, employee.ID, langCode)
-		if err != nil && !custom_errors.IsNotFound(err) {
-			return err
-		}
-		resp.ParticipationInProfessionalCommunities = make([]*dtos.EmployeeParticipationInProfessionalCommunityResponse, len(employeePIPCs))
-		for index, pipc := range employeePIPCs {
-			resp.ParticipationInProfessionalCommunities[index] = mappers.MapEmployeeParticipationInProfessionalCommunityDomainToResponseDTO(pipc)
+		if visibility.Allows(domain.VisibilitySectionProfessionalCommunities) {
+			employeePIPCs, err := txEmployeeParticipationInProfessionalCommunityRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			resp.ParticipationInProfessionalCommunities = make([]*dtos.EmployeeParticipationInProfessionalCommunityResponse, len(employeePIPCs))
+			for index, pipc := range employeePIPCs {
+				resp.ParticipationInProfessionalCommunities[index] = mappers.MapEmployeeParticipationInProfessionalCommunityDomainToResponseDTO(pipc)
+			}
 		}
 
 		//Employee Refresher Courses
-		employeeRCs, err := txEmployeeRefresherCourseRepo.GetByEmployeeIDAndLanguageCode(ctx, employee.ID, langCode)
-		if err != nil && !custom_errors.IsNotFound(err) {
-			return err
-		}
-		resp.RefresherCourses = make([]*dtos.EmployeeRefresherCourseResponse, len(employeeRCs))
-		for index, rc := range employeeRCs {
-			resp.RefresherCourses[index] = mappers.MapEmployeeRefresherCourseDomainToResponseDTO(rc)
+		if visibility.Allows(domain.VisibilitySectionRefresherCourses) {
+			employeeRCs, err := txEmployeeRefresherCourseRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			resp.RefresherCourses = make([]*dtos.EmployeeRefresherCourseResponse, len(employeeRCs))
+			for index, rc := range employeeRCs {
+				resp.RefresherCourses[index] = mappers.MapEmployeeRefresherCourseDomainToResponseDTO(rc)
+			}
 		}
 
 		//Employee Participation In Events
-		employeePIE, err := txEmployeeParticipationInEvenRepo.GetByEmployeeIDAndLanguageCode(ctx, employee.ID, langCode)
-		if err != nil && !custom_errors.IsNotFound(err) {
-			return err
-		}
-		resp.ParticipationInEvents = make([]*dtos.EmployeeParticipationInEventResponse, len(employeePIE))
-		for index, pie := range employeePIE {
-			resp.ParticipationInEvents[index] = mappers.MapEmployeeParticipationInEventDomainToResponseDTO(pie)
+		if visibility.Allows(domain.VisibilitySectionEvents) {
+			employeePIE, err := txEmployeeParticipationInEvenRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			resp.ParticipationInEvents = make([]*dtos.EmployeeParticipationInEventResponse, len(employeePIE))
+			for index, pie := range employeePIE {
+				resp.ParticipationInEvents[index] = mappers.MapEmployeeParticipationInEventDomainToResponseDTO(pie)
+			}
 		}
 
 		//Employee Research Activities
-		employeeRAs, err := txEmployeeResearchActivityRepo.GetByEmployeeIDAndLanguageCode(ctx, employee.ID, langCode)
+		if visibility.Allows(domain.VisibilitySectionResearchActivities) {
+			employeeRAs, err := txEmployeeResearchActivityRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			resp.ResearchActivities = make([]*dtos.EmployeeResearchActivityResponse, len(employeeRAs))
+			for index, ra := range employeeRAs {
+				resp.ResearchActivities[index] = mappers.MapEmployeeResearchActivityDomainToResponseDTO(ra)
+			}
+		}
+
+		//Employee Socials
+		if visibility.Allows(domain.VisibilitySectionSocials) {
+			employeeSocials, err := txEmployeeSocialRepo.GetByEmployeeID(ctx, employee.ID)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			resp.Socials = make([]*dtos.EmployeeSocialResponse, len(employeeSocials))
+			for index, social := range employeeSocials {
+				resp.Socials[index] = mappers.MapEmployeeSocialDomainToResponseDTO(social)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetLinkedDataByUniqueID retrives the public part of the employee profile in the language of the request as schema.org/Person
+func (uc *employeeUsecase) GetLinkedDataByUniqueID(ctx context.Context, uniqueID string) (*dtos.PersonJSONLD, error) {
+	if uniqueID == "" {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - uniqueID(%s) is provided for retrival", uniqueID))
+	}
+
+	var employee *domain.Employee
+	langCode := middleware.GetLanguageFromContext(ctx)
+	langCodes := utils.LanguageFallback(langCode, uc.languageFallback)
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txEmployeeRepo := postgres.NewPgEmployeeRepositoryWithQuery(q)
+		txEmployeeDetailsRepo := postgres.NewPGEmployeeDetailsRepositoryWithQueries(q)
+		txEmployeeDegreeRepo := postgres.NewPgEmployeeDegreeRepositoryWithQuery(q)
+		txEmployeeWorkExperienceRepo := postgres.NewPgEmployeeWorkExperienceRepositoryWithQuery(q)
+		txEmployeeScientificAwardRepo := postgres.NewPgEmployeeScientificAwardRepositoryWithQuery(q)
+		txEmployeeSocialRepo := postgres.NewPgEmployeeSocialRepositoryWithQueries(q)
+		txEmployeeVisibilityRepo := postgres.NewPgEmployeeVisibilityRepositoryWithQuery(q)
+		txConsentRepo := postgres.NewPgConsentRepositoryWithQuery(q)
+
+		var err error
+		employee, err = txEmployeeRepo.GetByUniqueID(ctx, uniqueID)
 		if err != nil && !custom_errors.IsNotFound(err) {
 			return err
+		} else if custom_errors.IsNotFound(err) {
+			return custom_errors.BadRequest(fmt.Errorf("no user with given unique id"))
+		}
+
+		hasConsent, err := txConsentRepo.HasLatestConsent(ctx, employee.UserID)
+		if err != nil {
+			return err
 		}
-		resp.ResearchActivities = make([]*dtos.EmployeeResearchActivityResponse, len(employeeRAs))
-		for index, ra := range employeeRAs {
-			resp.ResearchActivities[index] = mappers.MapEmployeeResearchActivityDomainToResponseDTO(ra)
+		if !hasConsent {
+			return custom_errors.NotFound(fmt.Errorf("profile of employee(%s) is not published until the latest terms are accepted", uniqueID))
 		}
 
-		//Employee Socials
-		employeeSocials, err := txEmployeeSocialRepo.GetByEmployeeID(ctx, employee.ID)
-		if err != nil && !custom_errors.IsNotFound(err) {
+		// linked data is read by crawlers, so only what the employee shares with the public goes into it
+		visibility, err := txEmployeeVisibilityRepo.GetByEmployeeID(ctx, employee.ID)
+		if err != nil {
 			return err
 		}
-		resp.Socials = make([]*dtos.EmployeeSocialResponse, len(employeeSocials))
-		for index, social := range employeeSocials {
-			resp.Socials[index] = mappers.MapEmployeeSocialDomainToResponseDTO(social)
+		visibility.Access = domain.VisibilityPublic
+		if !visibility.Allows(domain.VisibilityFieldORCID) {
+			employee.ORCID = ""
+		}
+
+		if visibility.Allows(domain.VisibilitySectionDetails) {
+			employee.Details, err = txEmployeeDetailsRepo.GetByEmployeeID(ctx, employee.ID)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+		}
+
+		if visibility.Allows(domain.VisibilitySectionDegrees) {
+			degrees, err := txEmployeeDegreeRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			for _, degree := range degrees {
+				employee.Degrees = append(employee.Degrees, *degree)
+			}
+		}
+
+		if visibility.Allows(domain.VisibilitySectionWorkExperiences) {
+			workExperiences, err := txEmployeeWorkExperienceRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			for _, workExperience := range workExperiences {
+				employee.WorkExperiences = append(employee.WorkExperiences, *workExperience)
+			}
+		}
+
+		if visibility.Allows(domain.VisibilitySectionScientificAwards) {
+			scientificAwards, err := txEmployeeScientificAwardRepo.GetByEmployeeIDAndLanguageCodes(ctx, employee.ID, langCodes)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			for _, award := range scientificAwards {
+				employee.ScientificAwards = append(employee.ScientificAwards, *award)
+			}
+		}
+
+		if visibility.Allows(domain.VisibilitySectionSocials) {
+			socials, err := txEmployeeSocialRepo.GetByEmployeeID(ctx, employee.ID)
+			if err != nil && !custom_errors.IsNotFound(err) {
+				return err
+			}
+			for _, social := range socials {
+				employee.Socials = append(employee.Socials, *social)
+			}
 		}
 
 		return nil
@@ -215,15 +387,21 @@ func (uc *employeeUsecase) GetByUniqueID(ctx context.Context, uniqueID string) (
 		return nil, err
 	}
 
-	return resp, nil
+	return mappers.MapEmployeeDomainToPersonJSONLD(employee, langCode, publicProfileURL(uc.publicBaseURL, "employee", uniqueID)), nil
 }
 
 func (uc *employeeUsecase) GetPersonnelPaginated(ctx context.Context, filter *dtos.PersonnelPaginatedQueryParameters) (*[]dtos.PersonnelProfileData, error) {
+	if err := validatePersonnelCitationFilter(filter); err != nil {
+		return nil, err
+	}
+
 	var result []dtos.PersonnelProfileData
 	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
 		txEmployeeRepo := postgres.NewPgEmployeeRepositoryWithQuery(q)
 		txEmployeeWorkExperienceRepo := postgres.NewPgEmployeeWorkExperienceRepositoryWithQuery(q)
 		txEmployeeSocialRepo := postgres.NewPgEmployeeSocialRepositoryWithQueries(q)
+		txInstitutionRepo := postgres.NewPgInstitutionRepositoryWithQueries(q)
+		txEmployeeVisibilityRepo := postgres.NewPgEmployeeVisibilityRepositoryWithQuery(q)
 
 		personnelInitialInfo, err := txEmployeeRepo.GetPersonnelIDsPaginated(ctx, filter)
 		if err != nil {
@@ -237,17 +415,50 @@ func (uc *employeeUsecase) GetPersonnelPaginated(ctx context.Context, filter *dt
 
 		fmt.Println("personnel initial info", personnelInitialInfo)
 
+		employeeIDs := make([]int64, len(personnelInitialInfo))
+		for index, personnel := range personnelInitialInfo {
+			employeeIDs[index] = personnel.EmployeeID
+		}
+
+		visibilities, err := employeeVisibilitiesFor(ctx, employeeIDs, txEmployeeRepo, txInstitutionRepo, txEmployeeVisibilityRepo)
+		if err != nil {
+			return err
+		}
+
 		for index := range personnelInitialInfo {
+			visibility := visibilities[personnelInitialInfo[index].EmployeeID]
+			if visibility == nil {
+				continue
+			}
+
 			//personnel UID
 			currentPersonnel := dtos.PersonnelProfileData{
 				Speciality:            personnelInitialInfo[index].Speciality,
 				HighestAcademicDegree: personnelInitialInfo[index].Highestacademicdegree,
 				CurrentWorkplace:      personnelInitialInfo[index].Currentworkplace,
+				CurrentInstitutionID:  personnelInitialInfo[index].CurrentInstitutionID,
+				CurrentOrgUnitID:      personnelInitialInfo[index].CurrentOrgUnitID,
 				UID:                   personnelInitialInfo[index].UniqueID,
+				PublicationCount:      personnelInitialInfo[index].PublicationCount,
+				HIndex:                personnelInitialInfo[index].HIndex,
+				I10Index:              personnelInitialInfo[index].I10Index,
+				TotalCitations:        personnelInitialInfo[index].TotalCitations,
+			}
+			if visibility.Allows(domain.VisibilitySectionDetails) {
+				currentPersonnel.Fullname = fmt.Sprintf("%s %s", personnelInitialInfo[index].Surname, personnelInitialInfo[index].Name)
+				if personnelInitialInfo[index].Middlename != "" {
+					currentPersonnel.Fullname += " " + personnelInitialInfo[index].Middlename
+				}
 			}
-			currentPersonnel.Fullname = fmt.Sprintf("%s %s", personnelInitialInfo[index].Surname, personnelInitialInfo[index].Name)
-			if personnelInitialInfo[index].Middlename != "" {
-				currentPersonnel.Fullname += " " + personnelInitialInfo[index].Middlename
+
+			// the highest degree and the speciality come from the degrees
+			if !visibility.Allows(domain.VisibilitySectionDegrees) {
+				currentPersonnel.HighestAcademicDegree, currentPersonnel.Speciality = "", ""
+			}
+
+			// bibliometric indicators are computed from the publications
+			if !visibility.Allows(domain.VisibilitySectionPublications) {
+				currentPersonnel.PublicationCount, currentPersonnel.HIndex, currentPersonnel.I10Index, currentPersonnel.TotalCitations = 0, 0, 0, 0
 			}
 
 			// personnel work experience (CurrentWorkplace, WorkExperienceYears, WorkExperienceMonths)
@@ -259,30 +470,36 @@ func (uc *employeeUsecase) GetPersonnelPaginated(ctx context.Context, filter *dt
 				return err
 			}
 
-			var lastDateOfWork time.Time
-			if currentEmployeeWorkExperiences[0].Ongoing {
-				lastDateOfWork = time.Now()
-			} else {
-				lastDateOfWork = currentEmployeeWorkExperiences[0].DateEnd
-			}
-
-			firstDateOfWork := currentEmployeeWorkExperiences[len(currentEmployeeWorkExperiences)-1].DateStart
-			yearDiff, monthDiff := utils.DateDifference(firstDateOfWork, lastDateOfWork)
+			if visibility.Allows(domain.VisibilitySectionWorkExperiences) {
+				var lastDateOfWork time.Time
+				if currentEmployeeWorkExperiences[0].Ongoing {
+					lastDateOfWork = time.Now()
+				} else {
+					lastDateOfWork = currentEmployeeWorkExperiences[0].DateEnd
+				}
 
-			currentPersonnel.WorkExperience = int64(yearDiff) + int64(monthDiff/12)
+				firstDateOfWork := currentEmployeeWorkExperiences[len(currentEmployeeWorkExperiences)-1].DateStart
+				yearDiff, monthDiff := utils.DateDifference(firstDateOfWork, lastDateOfWork)
 
-			// personnel socials
-			currentEmployeeSocials, err := txEmployeeSocialRepo.GetByEmployeeID(ctx, personnelInitialInfo[index].EmployeeID)
-			if err != nil && !custom_errors.IsNotFound(err) {
-				return err
+				currentPersonnel.WorkExperience = int64(yearDiff) + int64(monthDiff/12)
+			} else {
+				currentPersonnel.CurrentWorkplace, currentPersonnel.CurrentInstitutionID, currentPersonnel.CurrentOrgUnitID = "", 0, 0
 			}
 
-			for _, social := range currentEmployeeSocials {
-				if len(currentPersonnel.Socials) == 5 {
-					break
+			// personnel socials
+			if visibility.Allows(domain.VisibilitySectionSocials) {
+				currentEmployeeSocials, err := txEmployeeSocialRepo.GetByEmployeeID(ctx, personnelInitialInfo[index].EmployeeID)
+				if err != nil && !custom_errors.IsNotFound(err) {
+					return err
 				}
 
-				currentPersonnel.Socials = append(currentPersonnel.Socials, *mappers.MapEmployeeSocialDomainToResponseDTO(social))
+				for _, social := range currentEmployeeSocials {
+					if len(currentPersonnel.Socials) == 5 {
+						break
+					}
+
+					currentPersonnel.Socials = append(currentPersonnel.Socials, *mappers.MapEmployeeSocialDomainToResponseDTO(social))
+				}
 			}
 
 			result = append(result, currentPersonnel)
@@ -298,6 +515,10 @@ func (uc *employeeUsecase) GetPersonnelPaginated(ctx context.Context, filter *dt
 }
 
 func (uc *employeeUsecase) GetPersonnelCountPaginated(ctx context.Context, filter *dtos.PersonnelPaginatedQueryParameters) (*int64, error) {
+	if err := validatePersonnelCitationFilter(filter); err != nil {
+		return nil, err
+	}
+
 	total, err := uc.employeeRepo.CountPersonnel(ctx, filter)
 	fmt.Println("GET PERSONNEL COUNT FUNCTION RESULT", total, err)
 	if err != nil {
@@ -308,10 +529,88 @@ func (uc *employeeUsecase) GetPersonnelCountPaginated(ctx context.Context, filte
 	return &total, nil
 }
 
-func (uc *employeeUsecase) ListUniqueHighestAcademicDegrees(ctx context.Context) ([]string, error) {
-	return uc.employeeRepo.ListUniqueHighestAcademicDegrees(ctx)
+// ListUniqueHighestAcademicDegrees lists the degrees to filter personnel by, degree levels are named in langCode
+func (uc *employeeUsecase) ListUniqueHighestAcademicDegrees(ctx context.Context, langCode string) ([]*dtos.HighestAcademicDegreeResponse, error) {
+	degrees, err := uc.employeeRepo.ListUniqueHighestAcademicDegrees(ctx, langCode)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*dtos.HighestAcademicDegreeResponse, len(degrees))
+	for index, degree := range degrees {
+		resp[index] = mappers.MapHighestAcademicDegreeDomainToResponseDTO(degree)
+	}
+
+	return resp, nil
 }
 
 func (uc *employeeUsecase) ListUniqueSpecialities(ctx context.Context) ([]string, error) {
 	return uc.employeeRepo.ListUniqueSpecialities(ctx)
 }
+
+// GenerateCV renders the profile of the employee in the language of the request as PDF or DOCX document
+func (uc *employeeUsecase) GenerateCV(ctx context.Context, uniqueID string, format string, templateName string) ([]byte, error) {
+	langCode := middleware.GetLanguageFromContext(ctx)
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to generate CV", langCode))
+	}
+
+	if format != cv.FormatPDF && format != cv.FormatDOCX {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - Format(%s) to generate CV, expected pdf or docx", format))
+	}
+
+	template, ok := cv.LookupTemplate(templateName)
+	if !ok {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - Template(%s) to generate CV, expected one of %s", templateName, strings.Join(cv.TemplateNames(), ", ")))
+	}
+
+	employee, err := uc.GetByUniqueID(ctx, uniqueID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := mappers.MapEmployeeResponseToCVDocument(employee, langCode)
+
+	var buffer bytes.Buffer
+	switch format {
+	case cv.FormatPDF:
+		executablePath, err := os.Executable()
+		if err != nil {
+			return nil, custom_errors.InternalServerError(fmt.Errorf("Error getting the executable file path: %w", err))
+		}
+		fontDir := filepath.Join(filepath.Dir(executablePath), "/internal/files/fonts")
+
+		err = cv.WritePDF(&buffer, doc, template, fontDir)
+		if err != nil {
+			return nil, custom_errors.InternalServerError(fmt.Errorf("failed to generate PDF CV of employee(%s): %w", uniqueID, err))
+		}
+	case cv.FormatDOCX:
+		if err := cv.WriteDOCX(&buffer, doc, template); err != nil {
+			return nil, custom_errors.InternalServerError(fmt.Errorf("failed to generate DOCX CV of employee(%s): %w", uniqueID, err))
+		}
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func validatePersonnelCitationFilter(filter *dtos.PersonnelPaginatedQueryParameters) error {
+	if filter.MinHIndex < 0 || filter.MinI10Index < 0 || filter.MinCitations < 0 {
+		return custom_errors.BadRequest(fmt.Errorf("invalid input - citation filters of personnel can not be negative"))
+	}
+
+	if filter.SortBy != "" && filter.SortBy != "h_index" && filter.SortBy != "i10_index" && filter.SortBy != "citations" {
+		return custom_errors.BadRequest(fmt.Errorf("invalid input - SortBy(%s) of personnel, expected h_index, i10_index or citations", filter.SortBy))
+	}
+
+	return nil
+}
+
+// publicProfileURL builds the address of a profile page on the frontend,
+// empty result means the base URL is not configured and profiles are left without identifier
+func publicProfileURL(baseURL string, kind string, id string) string {
+	if baseURL == "" {
+		return ""
+	}
+
+	return strings.TrimRight(baseURL, "/") + "/" + kind + "/" + url.PathEscape(id)
+}
@@ -0,0 +1,279 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/mappers"
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type EmployeeVisibilityUsecase interface {
+	Get(ctx context.Context) ([]*dtos.EmployeeVisibilitySettingResponse, error)
+	Update(ctx context.Context, req *dtos.UpdateEmployeeVisibilityRequest) ([]*dtos.EmployeeVisibilitySettingResponse, error)
+	AuthorizeSection(ctx context.Context, employeeID int64, section string) error
+}
+
+type employeeVisibilityUsecase struct {
+	employeeRepo           repositories.EmployeeRepository
+	institutionRepo        repositories.InstitutionRepository
+	employeeVisibilityRepo repositories.EmployeeVisibilityRepository
+	store                  *postgres.Store
+	validator              *validator.Validate
+}
+
+func NewEmployeeVisibilityUsecase(
+	employeeRepo repositories.EmployeeRepository,
+	institutionRepo repositories.InstitutionRepository,
+	employeeVisibilityRepo repositories.EmployeeVisibilityRepository,
+	store *postgres.Store,
+	validator *validator.Validate,
+) EmployeeVisibilityUsecase {
+	return &employeeVisibilityUsecase{
+		employeeRepo:           employeeRepo,
+		institutionRepo:        institutionRepo,
+		employeeVisibilityRepo: employeeVisibilityRepo,
+		store:                  store,
+		validator:              validator,
+	}
+}
+
+// Get returns the visibility of every section and field of the profile of the signed in employee
+func (uc *employeeVisibilityUsecase) Get(ctx context.Context) ([]*dtos.EmployeeVisibilitySettingResponse, error) {
+	employee, err := uc.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility, err := uc.employeeVisibilityRepo.GetByEmployeeID(ctx, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.MapEmployeeVisibilityDomainToResponseDTO(visibility), nil
+}
+
+// Update stores the levels chosen by the signed in employee, all of them or none
+func (uc *employeeVisibilityUsecase) Update(ctx context.Context, req *dtos.UpdateEmployeeVisibilityRequest) ([]*dtos.EmployeeVisibilitySettingResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid request body: %w", err))
+	}
+
+	for _, setting := range req.Settings {
+		if _, ok := domain.DefaultVisibility[setting.Target]; !ok {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - Target(%s) is not a section or field of the profile", setting.Target))
+		}
+	}
+
+	employee, err := uc.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var visibility *domain.EmployeeVisibility
+	err = uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txEmployeeVisibilityRepo := postgres.NewPgEmployeeVisibilityRepositoryWithQuery(q)
+
+		for _, setting := range req.Settings {
+			if err := txEmployeeVisibilityRepo.Upsert(ctx, employee.ID, setting.Target, setting.Level); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		visibility, err = txEmployeeVisibilityRepo.GetByEmployeeID(ctx, employee.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.MapEmployeeVisibilityDomainToResponseDTO(visibility), nil
+}
+
+// AuthorizeSection lets the viewer of the request read the section of the profile only when the employee shares it with them
+func (uc *employeeVisibilityUsecase) AuthorizeSection(ctx context.Context, employeeID int64, section string) error {
+	if employeeID <= 0 {
+		return custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive %s", employeeID, section))
+	}
+
+	employee, err := uc.employeeRepo.GetByID(ctx, employeeID)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return custom_errors.NotFound(fmt.Errorf("employee with given ID(%d) does not exist", employeeID))
+		}
+
+		return err
+	}
+
+	visibility, err := employeeVisibilityFor(ctx, employee, uc.employeeRepo, uc.institutionRepo, uc.employeeVisibilityRepo)
+	if err != nil {
+		return err
+	}
+
+	if !visibility.Allows(section) {
+		return custom_errors.Forbidden(fmt.Errorf("%s of employee(%d) are not shared with the viewer", section, employeeID))
+	}
+
+	return nil
+}
+
+func (uc *employeeVisibilityUsecase) currentEmployee(ctx context.Context) (*domain.Employee, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, custom_errors.Unauthorized(fmt.Errorf("user is not authenticated"))
+	}
+
+	employee, err := uc.employeeRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, custom_errors.NotFound(fmt.Errorf("user(%d) has no employee profile", userID))
+		}
+
+		return nil, err
+	}
+
+	return employee, nil
+}
+
+// employeeVisibilityFor loads the levels chosen by the employee along with the access of the viewer of the request
+func employeeVisibilityFor(
+	ctx context.Context,
+	employee *domain.Employee,
+	employeeRepo repositories.EmployeeRepository,
+	institutionRepo repositories.InstitutionRepository,
+	employeeVisibilityRepo repositories.EmployeeVisibilityRepository,
+) (*domain.EmployeeVisibility, error) {
+	visibility, err := employeeVisibilityRepo.GetByEmployeeID(ctx, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+	visibility.InstitutionID = employee.CurrentInstitutionID
+
+	viewer, err := newVisibilityViewer(ctx, employeeRepo, institutionRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	visibility.Access, err = viewer.accessTo(ctx, visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	return visibility, nil
+}
+
+// employeeVisibilitiesFor loads the levels of several employees along with the access of the viewer of the request to each of them,
+// employees that do not exist are left out
+func employeeVisibilitiesFor(
+	ctx context.Context,
+	employeeIDs []int64,
+	employeeRepo repositories.EmployeeRepository,
+	institutionRepo repositories.InstitutionRepository,
+	employeeVisibilityRepo repositories.EmployeeVisibilityRepository,
+) (map[int64]*domain.EmployeeVisibility, error) {
+	visibilities, err := employeeVisibilityRepo.GetByEmployeeIDs(ctx, employeeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	viewer, err := newVisibilityViewer(ctx, employeeRepo, institutionRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, visibility := range visibilities {
+		visibility.Access, err = viewer.accessTo(ctx, visibility)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return visibilities, nil
+}
+
+// visibilityViewer is the user of the request, the institutions they administer are looked up once each
+type visibilityViewer struct {
+	signedIn        bool
+	userID          int64
+	isAdmin         bool
+	employee        *domain.Employee
+	institutionRepo repositories.InstitutionRepository
+	administers     map[int64]bool
+}
+
+func newVisibilityViewer(
+	ctx context.Context,
+	employeeRepo repositories.EmployeeRepository,
+	institutionRepo repositories.InstitutionRepository,
+) (*visibilityViewer, error) {
+	viewer := &visibilityViewer{
+		institutionRepo: institutionRepo,
+		administers:     map[int64]bool{},
+	}
+
+	viewer.userID, viewer.signedIn = middleware.GetUserIDFromContext(ctx)
+	if !viewer.signedIn {
+		return viewer, nil
+	}
+
+	role, _ := middleware.GetUserRoleFromContext(ctx)
+	viewer.isAdmin = role == domain.UserRoleAdmin
+
+	employee, err := employeeRepo.GetByUserID(ctx, viewer.userID)
+	if err != nil && !custom_errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		viewer.employee = employee
+	}
+
+	return viewer, nil
+}
+
+// accessTo resolves the access of the viewer to the profile: the employee reaches private,
+// administrators of the application and of the current institution of the employee reach admin,
+// employees of the same institution reach institution and any other signed in user reaches authenticated
+func (v *visibilityViewer) accessTo(ctx context.Context, visibility *domain.EmployeeVisibility) (string, error) {
+	if !v.signedIn {
+		return domain.VisibilityPublic, nil
+	}
+
+	if v.employee != nil && v.employee.ID == visibility.EmployeeID {
+		return domain.VisibilityPrivate, nil
+	}
+
+	if v.isAdmin {
+		return domain.VisibilityAdmin, nil
+	}
+
+	if visibility.InstitutionID == 0 {
+		return domain.VisibilityAuthenticated, nil
+	}
+
+	isAdmin, ok := v.administers[visibility.InstitutionID]
+	if !ok {
+		var err error
+		isAdmin, err = v.institutionRepo.IsAdmin(ctx, visibility.InstitutionID, v.userID)
+		if err != nil {
+			return "", err
+		}
+		v.administers[visibility.InstitutionID] = isAdmin
+	}
+	if isAdmin {
+		return domain.VisibilityAdmin, nil
+	}
+
+	if v.employee != nil && v.employee.CurrentInstitutionID == visibility.InstitutionID {
+		return domain.VisibilityInstitution, nil
+	}
+
+	return domain.VisibilityAuthenticated, nil
+}
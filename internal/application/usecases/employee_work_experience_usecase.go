@@ -6,7 +6,9 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/http/middleware"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -23,16 +25,25 @@ type EmployeeWorkExperienceUsecase interface {
 
 type employeeWorkExperienceUsecase struct {
 	employeeWorkExperienceRepo repositories.EmployeeWorkExperienceRepository
+	institutionDetailsRepo     repositories.InstitutionDetailsRepository
+	orgUnitRepo                repositories.OrgUnitRepository
 	validator                  *validator.Validate
+	languageFallback           []string
 }
 
 func NewEmployeeWorkExperienceUsecase(
 	employeeWorkExperienceRepo repositories.EmployeeWorkExperienceRepository,
+	institutionDetailsRepo repositories.InstitutionDetailsRepository,
+	orgUnitRepo repositories.OrgUnitRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) EmployeeWorkExperienceUsecase {
 	return &employeeWorkExperienceUsecase{
 		employeeWorkExperienceRepo: employeeWorkExperienceRepo,
+		institutionDetailsRepo:     institutionDetailsRepo,
+		orgUnitRepo:                orgUnitRepo,
 		validator:                  validator,
+		languageFallback:           languageFallback,
 	}
 }
 
@@ -41,15 +52,38 @@ func (uc *employeeWorkExperienceUsecase) Create(ctx context.Context, req *dtos.C
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to create employee professional activity in education: %w", err))
 	}
 
+	institutionID := req.InstitutionID
+	if req.OrgUnitID != 0 {
+		var err error
+		institutionID, err = uc.orgUnitInstitutionID(ctx, req.InstitutionID, req.OrgUnitID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// a workplace linked to an institution is displayed by the institution title unless named otherwise
+	workplace := req.Workplace
+	if workplace == "" {
+		institutionDetails, err := uc.institutionDetailsRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, req.LanguageCode)
+		if err != nil {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to create employee work experience: workplace is required, institution(%d) has no title in language(%s): %w", institutionID, req.LanguageCode, err))
+		}
+
+		workplace = institutionDetails.InstitutionTitleLong
+	}
+
 	employeeWorkExperience := &domain.EmployeeWorkExperience{
-		EmployeeID:   req.EmployeeID,
-		LanguageCode: req.LanguageCode,
-		Workplace:    req.Workplace,
-		JobTitle:     req.JobTitle,
-		Description:  req.Description,
-		Ongoing:      req.Ongoing,
-		DateStart:    req.DateStart,
-		DateEnd:      req.DateEnd,
+		EmployeeID:         req.EmployeeID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		InstitutionID:      institutionID,
+		OrgUnitID:          req.OrgUnitID,
+		Workplace:          workplace,
+		JobTitle:           req.JobTitle,
+		Description:        req.Description,
+		Ongoing:            req.Ongoing,
+		DateStart:          req.DateStart,
+		DateEnd:            req.DateEnd,
 	}
 
 	createdEmployeeWorkExperience, err := uc.employeeWorkExperienceRepo.Create(ctx, employeeWorkExperience)
@@ -66,9 +100,38 @@ func (uc *employeeWorkExperienceUsecase) Update(ctx context.Context, req *dtos.U
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update employee professional activity in education: %w", err))
 	}
 
+	oldEmployeeWorkExperience, err := uc.employeeWorkExperienceRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if oldEmployeeWorkExperience == nil {
+		return nil, custom_errors.NotFound(fmt.Errorf("employee work experience with given ID(%d) does not exist", req.ID))
+	}
+
 	employeeWorkExperience := &domain.EmployeeWorkExperience{
-		ID:      req.ID,
-		Ongoing: req.Ongoing,
+		ID:            req.ID,
+		InstitutionID: oldEmployeeWorkExperience.InstitutionID,
+		Ongoing:       req.Ongoing,
+	}
+
+	if req.InstitutionID != nil {
+		employeeWorkExperience.InstitutionID = *req.InstitutionID
+	}
+
+	// the unit is kept as long as the institution is, a unit given without institution brings its own
+	if req.OrgUnitID != nil && *req.OrgUnitID != 0 {
+		var requestedInstitutionID int64
+		if req.InstitutionID != nil {
+			requestedInstitutionID = *req.InstitutionID
+		}
+
+		employeeWorkExperience.InstitutionID, err = uc.orgUnitInstitutionID(ctx, requestedInstitutionID, *req.OrgUnitID)
+		if err != nil {
+			return nil, err
+		}
+		employeeWorkExperience.OrgUnitID = *req.OrgUnitID
+	} else if req.OrgUnitID == nil && employeeWorkExperience.InstitutionID == oldEmployeeWorkExperience.InstitutionID {
+		employeeWorkExperience.OrgUnitID = oldEmployeeWorkExperience.OrgUnitID
 	}
 
 	if req.Workplace != nil {
@@ -113,11 +176,11 @@ func (uc *employeeWorkExperienceUsecase) GetByEmployeeIDAndLanguageCode(ctx cont
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive employee professional activity in education", employeeID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive employee professional activity in education", langCode))
 	}
 
-	employeeWorkExperiences, err := uc.employeeWorkExperienceRepo.GetByEmployeeIDAndLanguageCode(ctx, employeeID, langCode)
+	employeeWorkExperiences, err := uc.employeeWorkExperienceRepo.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -134,3 +197,20 @@ func (uc *employeeWorkExperienceUsecase) ListUniqueOngoingWorkplaces(ctx context
 	langCode := middleware.GetLanguageFromContext(ctx)
 	return uc.employeeWorkExperienceRepo.ListUniqueOngoingWorkplaces(ctx, langCode)
 }
+
+// orgUnitInstitutionID returns the institution of the unit, making sure it is the requested one when it is given
+func (uc *employeeWorkExperienceUsecase) orgUnitInstitutionID(ctx context.Context, institutionID int64, orgUnitID int64) (int64, error) {
+	orgUnit, err := uc.orgUnitRepo.GetByID(ctx, orgUnitID)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return 0, custom_errors.BadRequest(fmt.Errorf("organizational unit(%d) of employee work experience does not exist", orgUnitID))
+		}
+		return 0, err
+	}
+
+	if institutionID != 0 && orgUnit.InstitutionID != institutionID {
+		return 0, custom_errors.BadRequest(fmt.Errorf("organizational unit(%d) does not belong to institution(%d)", orgUnitID, institutionID))
+	}
+
+	return orgUnit.InstitutionID, nil
+}
@@ -0,0 +1,381 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type ExpertUsecase interface {
+	Search(ctx context.Context, params *dtos.ExpertSearchQueryParameters, langCode string) ([]*dtos.ExpertResponse, error)
+}
+
+type expertUsecase struct {
+	expertRepo             repositories.ExpertRepository
+	degreeLevelRepo        repositories.DegreeLevelRepository
+	employeeRepo           repositories.EmployeeRepository
+	institutionRepo        repositories.InstitutionRepository
+	employeeVisibilityRepo repositories.EmployeeVisibilityRepository
+	languageFallback       []string
+}
+
+func NewExpertUsecase(
+	expertRepo repositories.ExpertRepository,
+	degreeLevelRepo repositories.DegreeLevelRepository,
+	employeeRepo repositories.EmployeeRepository,
+	institutionRepo repositories.InstitutionRepository,
+	employeeVisibilityRepo repositories.EmployeeVisibilityRepository,
+	languageFallback []string,
+) ExpertUsecase {
+	return &expertUsecase{
+		expertRepo:             expertRepo,
+		degreeLevelRepo:        degreeLevelRepo,
+		employeeRepo:           employeeRepo,
+		institutionRepo:        institutionRepo,
+		employeeVisibilityRepo: employeeVisibilityRepo,
+		languageFallback:       languageFallback,
+	}
+}
+
+// expertVisibilitySections are the sections of the profile the items of each expert section are taken from
+var expertVisibilitySections = map[string]string{
+	domain.ExpertSectionResearchArea: domain.VisibilitySectionMainResearchAreas,
+	domain.ExpertSectionKeyTopic:     domain.VisibilitySectionMainResearchAreas,
+	domain.ExpertSectionPublication:  domain.VisibilitySectionPublications,
+	domain.ExpertSectionPatent:       domain.VisibilitySectionPatents,
+	domain.ExpertSectionProject:      domain.VisibilitySectionResearchActivities,
+	domain.ExpertSectionDegree:       domain.VisibilitySectionDegrees,
+}
+
+// expertSectionWeights are the default weights of profile sections, research interests weigh the most
+var expertSectionWeights = map[string]float64{
+	domain.ExpertSectionResearchArea: 3,
+	domain.ExpertSectionKeyTopic:     3,
+	domain.ExpertSectionPublication:  2,
+	domain.ExpertSectionPatent:       2,
+	domain.ExpertSectionProject:      1.5,
+	domain.ExpertSectionDegree:       1,
+}
+
+const (
+	expertMaxWeight       = 10
+	expertDefaultLimit    = 10
+	expertMaxLimit        = 50
+	expertMaxTerms        = 10
+	expertMinTermLength   = 3
+	expertItemsPerSection = 3
+)
+
+// expertStopWords are frequent words of the supported languages that say nothing about the topic
+var expertStopWords = map[string]bool{
+	"and": true, "the": true, "for": true, "with": true, "from": true,
+	"для": true, "при": true, "как": true, "или": true, "что": true,
+	"барои": true, "дар": true, "ҳам": true, "оид": true,
+}
+
+// expertTerms splits the query into distinct lowercase words, short words and stop words are dropped
+func expertTerms(query string) []string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	seen := map[string]bool{}
+	for _, word := range words {
+		if utf8.RuneCountInString(word) < expertMinTermLength || expertStopWords[word] || seen[word] {
+			continue
+		}
+
+		seen[word] = true
+		terms = append(terms, word)
+		if len(terms) == expertMaxTerms {
+			break
+		}
+	}
+
+	return terms
+}
+
+// expertCandidate accumulates the matched items of one employee, items are keyed by section and item key
+type expertCandidate struct {
+	employeeID   int64
+	items        map[string]map[string]*domain.ExpertMatch
+	matchedTerms map[string]bool
+	score        float64
+	explanations []*dtos.ExpertSectionResponse
+}
+
+// Search ranks employees against the query. An item scores the share of the terms it contains times
+// the weight of its section, the best items of a section count with halving weight and the total is
+// scaled by the share of the terms found anywhere in the profile
+func (uc *expertUsecase) Search(ctx context.Context, params *dtos.ExpertSearchQueryParameters, langCode string) ([]*dtos.ExpertResponse, error) {
+	terms := expertTerms(params.Query)
+	if len(terms) == 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - query(%s) has no words of at least %d letters to search experts", params.Query, expertMinTermLength))
+	}
+
+	if params.ProfileLanguageCode != "" && !languages.IsEnabled(params.ProfileLanguageCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to search experts", params.ProfileLanguageCode))
+	}
+
+	if params.InstitutionID < 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to search experts", params.InstitutionID))
+	}
+
+	if params.Limit < 0 || params.Limit > expertMaxLimit {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - limit(%d) to search experts, expected at most %d", params.Limit, expertMaxLimit))
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = expertDefaultLimit
+	}
+
+	weights := make(map[string]float64, len(expertSectionWeights))
+	for section, weight := range expertSectionWeights {
+		weights[section] = weight
+	}
+	for section, weight := range params.Weights {
+		if _, ok := expertSectionWeights[section]; !ok {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - unknown section(%s) in weights to search experts", section))
+		}
+
+		if weight < 0 || weight > expertMaxWeight || math.IsNaN(weight) {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - weight(%v) of section(%s) to search experts, expected between 0 and %d", weight, section, expertMaxWeight))
+		}
+		weights[section] = weight
+	}
+
+	if params.DegreeLevelCode != "" {
+		exists, err := uc.degreeLevelRepo.Exists(ctx, params.DegreeLevelCode)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - DegreeLevelCode(%s) to search experts", params.DegreeLevelCode))
+		}
+	}
+
+	matches, err := uc.expertRepo.SearchMatches(ctx, &domain.ExpertSearchFilter{
+		Terms:           terms,
+		LanguageCode:    params.ProfileLanguageCode,
+		InstitutionID:   params.InstitutionID,
+		DegreeLevelCode: params.DegreeLevelCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	matchedIDs := []int64{}
+	seenIDs := map[int64]bool{}
+	for _, match := range matches {
+		if !seenIDs[match.EmployeeID] {
+			seenIDs[match.EmployeeID] = true
+			matchedIDs = append(matchedIDs, match.EmployeeID)
+		}
+	}
+
+	visibilities, err := employeeVisibilitiesFor(ctx, matchedIDs, uc.employeeRepo, uc.institutionRepo, uc.employeeVisibilityRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	// a translated item keeps the translation matching the most terms,
+	// experts are found only by the sections they share with the viewer
+	candidatesByID := map[int64]*expertCandidate{}
+	for _, match := range matches {
+		if weights[match.Section] == 0 {
+			continue
+		}
+
+		visibility := visibilities[match.EmployeeID]
+		if visibility == nil || !visibility.Allows(domain.VisibilitySectionDetails) || !visibility.Allows(expertVisibilitySections[match.Section]) {
+			continue
+		}
+
+		if params.DegreeLevelCode != "" && !visibility.Allows(domain.VisibilitySectionDegrees) {
+			continue
+		}
+
+		// the current institution comes from the work experience, its filter must not reveal a hidden employer
+		if params.InstitutionID != 0 && !visibility.Allows(domain.VisibilitySectionWorkExperiences) {
+			continue
+		}
+
+		candidate, ok := candidatesByID[match.EmployeeID]
+		if !ok {
+			candidate = &expertCandidate{
+				employeeID:   match.EmployeeID,
+				items:        map[string]map[string]*domain.ExpertMatch{},
+				matchedTerms: map[string]bool{},
+			}
+			candidatesByID[match.EmployeeID] = candidate
+		}
+
+		if candidate.items[match.Section] == nil {
+			candidate.items[match.Section] = map[string]*domain.ExpertMatch{}
+		}
+
+		if previous, ok := candidate.items[match.Section][match.ItemKey]; !ok || len(match.MatchedTerms) > len(previous.MatchedTerms) {
+			candidate.items[match.Section][match.ItemKey] = match
+		}
+
+		for _, term := range match.MatchedTerms {
+			candidate.matchedTerms[term] = true
+		}
+	}
+
+	candidates := make([]*expertCandidate, 0, len(candidatesByID))
+	for _, candidate := range candidatesByID {
+		scoreExpertCandidate(candidate, weights, len(terms))
+		candidates = append(candidates, candidate)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+
+		return candidates[i].employeeID < candidates[j].employeeID
+	})
+
+	if int64(len(candidates)) > limit {
+		candidates = candidates[:limit]
+	}
+
+	if len(candidates) == 0 {
+		return []*dtos.ExpertResponse{}, nil
+	}
+
+	employeeIDs := make([]int64, len(candidates))
+	for index, candidate := range candidates {
+		employeeIDs[index] = candidate.employeeID
+	}
+
+	profileLangCodes := utils.LanguageFallback(langCode, uc.languageFallback)
+	if params.ProfileLanguageCode != "" {
+		profileLangCodes = []string{params.ProfileLanguageCode}
+	}
+
+	profiles, err := uc.expertRepo.GetProfilesByIDs(ctx, employeeIDs, profileLangCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	profilesByID := make(map[int64]*domain.ExpertProfile, len(profiles))
+	for _, profile := range profiles {
+		profilesByID[profile.EmployeeID] = profile
+	}
+
+	resp := make([]*dtos.ExpertResponse, 0, len(candidates))
+	for _, candidate := range candidates {
+		profile, ok := profilesByID[candidate.employeeID]
+		if !ok {
+			continue
+		}
+
+		fullname := fmt.Sprintf("%s %s", profile.Surname, profile.Name)
+		if profile.Middlename != "" {
+			fullname += " " + profile.Middlename
+		}
+
+		highestAcademicDegree := profile.HighestAcademicDegree
+		if !visibilities[candidate.employeeID].Allows(domain.VisibilitySectionDegrees) {
+			highestAcademicDegree = ""
+		}
+
+		currentWorkplace, currentInstitutionID := profile.CurrentWorkplace, profile.CurrentInstitutionID
+		if !visibilities[candidate.employeeID].Allows(domain.VisibilitySectionWorkExperiences) {
+			currentWorkplace, currentInstitutionID = "", 0
+		}
+
+		matchedTerms := make([]string, 0, len(candidate.matchedTerms))
+		for _, term := range terms {
+			if candidate.matchedTerms[term] {
+				matchedTerms = append(matchedTerms, term)
+			}
+		}
+
+		resp = append(resp, &dtos.ExpertResponse{
+			UID:                   profile.UniqueID,
+			Fullname:              fullname,
+			HighestAcademicDegree: highestAcademicDegree,
+			CurrentWorkplace:      currentWorkplace,
+			CurrentInstitutionID:  currentInstitutionID,
+			Score:                 candidate.score,
+			MatchedTerms:          matchedTerms,
+			Explanations:          candidate.explanations,
+		})
+	}
+
+	return resp, nil
+}
+
+// scoreExpertCandidate computes the score of the candidate and explains it section by section
+func scoreExpertCandidate(candidate *expertCandidate, weights map[string]float64, termCount int) {
+	total := 0.0
+	for section, itemsByKey := range candidate.items {
+		items := make([]*domain.ExpertMatch, 0, len(itemsByKey))
+		for _, item := range itemsByKey {
+			items = append(items, item)
+		}
+
+		sort.Slice(items, func(i, j int) bool {
+			if len(items[i].MatchedTerms) != len(items[j].MatchedTerms) {
+				return len(items[i].MatchedTerms) > len(items[j].MatchedTerms)
+			}
+
+			return items[i].Title < items[j].Title
+		})
+
+		if len(items) > expertItemsPerSection {
+			items = items[:expertItemsPerSection]
+		}
+
+		explanation := &dtos.ExpertSectionResponse{
+			Section: section,
+			Weight:  weights[section],
+			Items:   make([]*dtos.ExpertItemResponse, len(items)),
+		}
+
+		sectionScore := 0.0
+		for index, item := range items {
+			sectionScore += float64(len(item.MatchedTerms)) / float64(termCount) / math.Pow(2, float64(index))
+			explanation.Items[index] = &dtos.ExpertItemResponse{
+				Title:        item.Title,
+				MatchedTerms: item.MatchedTerms,
+			}
+		}
+
+		sectionScore *= weights[section]
+		explanation.Score = roundExpertScore(sectionScore)
+		total += sectionScore
+
+		candidate.explanations = append(candidate.explanations, explanation)
+	}
+
+	sort.Slice(candidate.explanations, func(i, j int) bool {
+		if candidate.explanations[i].Score != candidate.explanations[j].Score {
+			return candidate.explanations[i].Score > candidate.explanations[j].Score
+		}
+
+		return candidate.explanations[i].Section < candidate.explanations[j].Section
+	})
+
+	candidate.score = roundExpertScore(total * float64(len(candidate.matchedTerms)) / float64(termCount))
+}
+
+func roundExpertScore(score float64) float64 {
+	return math.Round(score*1000) / 1000
+}
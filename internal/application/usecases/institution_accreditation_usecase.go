@@ -5,6 +5,8 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -21,15 +23,18 @@ type InstitutionAccreditationUsecase interface {
 type institutionAccreditationUsecase struct {
 	institutionAccreditationRepo repositories.InstitutionAccreditationRepository
 	validator                    *validator.Validate
+	languageFallback             []string
 }
 
 func NewInstitutionAccreditationUsecase(
 	institutionAccreditationRepo repositories.InstitutionAccreditationRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) InstitutionAccreditationUsecase {
 	return &institutionAccreditationUsecase{
 		institutionAccreditationRepo: institutionAccreditationRepo,
 		validator:                    validator,
+		languageFallback:             languageFallback,
 	}
 }
 
@@ -39,10 +44,11 @@ func (uc *institutionAccreditationUsecase) Create(ctx context.Context, req *dtos
 	}
 
 	institutionAccreditation := &domain.InstitutionAccreditation{
-		InstitutionID: req.InstitutionID,
-		LanguageCode:  req.LanguageCode,
-		AccreditationType:          req.AccreditationType,
-		GivenBy:       req.GivenBy,
+		InstitutionID:      req.InstitutionID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		AccreditationType:  req.AccreditationType,
+		GivenBy:            req.GivenBy,
 	}
 
 	createdInstitutionAccreditation, err := uc.institutionAccreditationRepo.Create(ctx, institutionAccreditation)
@@ -97,11 +103,11 @@ func (uc *institutionAccreditationUsecase) GetByEmployeeIDAndLanguageCode(ctx co
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive institution accreditation", institutionID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive institution accreditation", langCode))
 	}
 
-	institutionAccreditations, err := uc.institutionAccreditationRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, langCode)
+	institutionAccreditations, err := uc.institutionAccreditationRepo.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -120,10 +126,12 @@ func MapInstitutionAccreditationDomainToResponseDTO(institutionAccreditation *do
 	}
 
 	return &dtos.InstitutionAccreditationResponse{
-		ID:                institutionAccreditation.ID,
-		AccreditationType: institutionAccreditation.AccreditationType,
-		GivenBy:           institutionAccreditation.GivenBy,
-		CreatedAt:         institutionAccreditation.CreatedAt,
-		UpdatedAt:         institutionAccreditation.UpdatedAt,
+		ID:                 institutionAccreditation.ID,
+		TranslationGroupID: institutionAccreditation.TranslationGroupID,
+		LanguageCode:       institutionAccreditation.LanguageCode,
+		AccreditationType:  institutionAccreditation.AccreditationType,
+		GivenBy:            institutionAccreditation.GivenBy,
+		CreatedAt:          institutionAccreditation.CreatedAt,
+		UpdatedAt:          institutionAccreditation.UpdatedAt,
 	}
 }
@@ -5,6 +5,8 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -21,15 +23,18 @@ type InstitutionAchievementUsecase interface {
 type institutionAchievementUsecase struct {
 	institutionAchievementRepo repositories.InstitutionAchievementRepository
 	validator                  *validator.Validate
+	languageFallback           []string
 }
 
 func NewInstitutionAchievementUsecase(
 	institutionAchievementRepo repositories.InstitutionAchievementRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) InstitutionAchievementUsecase {
 	return &institutionAchievementUsecase{
 		institutionAchievementRepo: institutionAchievementRepo,
 		validator:                  validator,
+		languageFallback:           languageFallback,
 	}
 }
 
@@ -39,14 +44,15 @@ func (uc *institutionAchievementUsecase) Create(ctx context.Context, req *dtos.C
 	}
 
 	institutionAchievement := &domain.InstitutionAchievement{
-		InstitutionID:    req.InstitutionID,
-		LanguageCode:     req.LanguageCode,
-		AchievementType:  req.AchievementType,
-		AchievementTitle: req.AchievementTitle,
-		DateReceived:     req.DateReceived,
-		GivenBy:          req.GivenBy,
-		LinkToFile:       req.LinkToFile,
-		Description:      req.Description,
+		InstitutionID:      req.InstitutionID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		AchievementType:    req.AchievementType,
+		AchievementTitle:   req.AchievementTitle,
+		DateReceived:       req.DateReceived,
+		GivenBy:            req.GivenBy,
+		LinkToFile:         req.LinkToFile,
+		Description:        req.Description,
 	}
 
 	createdInstitutionAchievement, err := uc.institutionAchievementRepo.Create(ctx, institutionAchievement)
@@ -117,11 +123,11 @@ func (uc *institutionAchievementUsecase) GetByEmployeeIDAndLanguageCode(ctx cont
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive institution achievement", institutionID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive institution achievement", langCode))
 	}
 
-	institutionAchievements, err := uc.institutionAchievementRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, langCode)
+	institutionAchievements, err := uc.institutionAchievementRepo.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -140,14 +146,16 @@ func MapInstitutionAchievementDomainToResponseDTO(institutionAchievement *domain
 	}
 
 	return &dtos.InstitutionAchievementResponse{
-		ID:               institutionAchievement.ID,
-		AchievementType:  institutionAchievement.AchievementType,
-		AchievementTitle: institutionAchievement.AchievementType,
-		DateReceived:     institutionAchievement.DateReceived,
-		GivenBy:          institutionAchievement.GivenBy,
-		Description:      institutionAchievement.Description,
-		LinkToFile:       institutionAchievement.LinkToFile,
-		CreatedAt:        institutionAchievement.CreatedAt,
-		UpdatedAt:        institutionAchievement.UpdatedAt,
+		ID:                 institutionAchievement.ID,
+		TranslationGroupID: institutionAchievement.TranslationGroupID,
+		LanguageCode:       institutionAchievement.LanguageCode,
+		AchievementType:    institutionAchievement.AchievementType,
+		AchievementTitle:   institutionAchievement.AchievementType,
+		DateReceived:       institutionAchievement.DateReceived,
+		GivenBy:            institutionAchievement.GivenBy,
+		Description:        institutionAchievement.Description,
+		LinkToFile:         institutionAchievement.LinkToFile,
+		CreatedAt:          institutionAchievement.CreatedAt,
+		UpdatedAt:          institutionAchievement.UpdatedAt,
 	}
 }
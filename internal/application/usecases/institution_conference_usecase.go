@@ -5,6 +5,8 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -21,15 +23,18 @@ type InstitutionConferenceUsecase interface {
 type institutionConferenceUsecase struct {
 	institutionConferenceRepo repositories.InstitutionConferenceRepository
 	validator                 *validator.Validate
+	languageFallback          []string
 }
 
 func NewInstitutionConferenceUsecase(
 	institutionConferenceRepo repositories.InstitutionConferenceRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) InstitutionConferenceUsecase {
 	return &institutionConferenceUsecase{
 		institutionConferenceRepo: institutionConferenceRepo,
 		validator:                 validator,
+		languageFallback:          languageFallback,
 	}
 }
 
@@ -39,12 +44,13 @@ func (uc *institutionConferenceUsecase) Create(ctx context.Context, req *dtos.Cr
 	}
 
 	institutionConference := &domain.InstitutionConference{
-		InstitutionID:    req.InstitutionID,
-		LanguageCode:     req.LanguageCode,
-		ConferenceTitle:  req.ConferenceTitle,
-		Link:             req.Link,
-		LinkToRINC:       req.LinkToRINC,
-		DateOfConference: req.DateOfConference,
+		InstitutionID:      req.InstitutionID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		ConferenceTitle:    req.ConferenceTitle,
+		Link:               req.Link,
+		LinkToRINC:         req.LinkToRINC,
+		DateOfConference:   req.DateOfConference,
 	}
 
 	createdInstitutionConference, err := uc.institutionConferenceRepo.Create(ctx, institutionConference)
@@ -107,11 +113,11 @@ func (uc *institutionConferenceUsecase) GetByEmployeeIDAndLanguageCode(ctx conte
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive institution conference", institutionID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive institution conference", langCode))
 	}
 
-	institutionConferences, err := uc.institutionConferenceRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, langCode)
+	institutionConferences, err := uc.institutionConferenceRepo.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -130,12 +136,14 @@ func MapInstitutionConferenceDomainToResponseDTO(institutionConference *domain.I
 	}
 
 	return &dtos.InstitutionConferenceResponse{
-		ID:               institutionConference.ID,
-		ConferenceTitle:  institutionConference.ConferenceTitle,
-		Link:             institutionConference.Link,
-		LinkToRINC:       institutionConference.LinkToRINC,
-		DateOfConference: institutionConference.DateOfConference,
-		CreatedAt:        institutionConference.CreatedAt,
-		UpdatedAt:        institutionConference.UpdatedAt,
+		ID:                 institutionConference.ID,
+		TranslationGroupID: institutionConference.TranslationGroupID,
+		LanguageCode:       institutionConference.LanguageCode,
+		ConferenceTitle:    institutionConference.ConferenceTitle,
+		Link:               institutionConference.Link,
+		LinkToRINC:         institutionConference.LinkToRINC,
+		DateOfConference:   institutionConference.DateOfConference,
+		CreatedAt:          institutionConference.CreatedAt,
+		UpdatedAt:          institutionConference.UpdatedAt,
 	}
 }
@@ -5,6 +5,8 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -21,15 +23,18 @@ type InstitutionLicenceUsecase interface {
 type institutionLicenceUsecase struct {
 	institutionLicenceRepo repositories.InstitutionLicenceRepository
 	validator              *validator.Validate
+	languageFallback       []string
 }
 
 func NewInstitutionLicenceUsecase(
 	institutionLicenceRepo repositories.InstitutionLicenceRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) InstitutionLicenceUsecase {
 	return &institutionLicenceUsecase{
 		institutionLicenceRepo: institutionLicenceRepo,
 		validator:              validator,
+		languageFallback:       languageFallback,
 	}
 }
 
@@ -39,14 +44,15 @@ func (uc *institutionLicenceUsecase) Create(ctx context.Context, req *dtos.Creat
 	}
 
 	institutionLicence := &domain.InstitutionLicence{
-		InstitutionID: req.InstitutionID,
-		LanguageCode:  req.LanguageCode,
-		LicenceTitle:  req.LicenceTitle,
-		LicenceType:   req.LicenceType,
-		GivenBy:       req.GivenBy,
-		LinkToFile:    req.LinkToFile,
-		DateStart:     req.DateStart,
-		DateEnd:       req.DateEnd,
+		InstitutionID:      req.InstitutionID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		LicenceTitle:       req.LicenceTitle,
+		LicenceType:        req.LicenceType,
+		GivenBy:            req.GivenBy,
+		LinkToFile:         req.LinkToFile,
+		DateStart:          req.DateStart,
+		DateEnd:            req.DateEnd,
 	}
 
 	createdInstitutionLicence, err := uc.institutionLicenceRepo.Create(ctx, institutionLicence)
@@ -117,11 +123,11 @@ func (uc *institutionLicenceUsecase) GetByEmployeeIDAndLanguageCode(ctx context.
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive institution licence", institutionID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive institution licence", langCode))
 	}
 
-	institutionLicences, err := uc.institutionLicenceRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, langCode)
+	institutionLicences, err := uc.institutionLicenceRepo.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -140,14 +146,16 @@ func MapInstitutionLicenceDomainToResponseDTO(institutionLicence *domain.Institu
 	}
 
 	return &dtos.InstitutionLicenceResponse{
-		ID:           institutionLicence.ID,
-		LicenceTitle: institutionLicence.LicenceTitle,
-		LicenceType:  institutionLicence.LicenceType,
-		GivenBy:      institutionLicence.GivenBy,
-		LinkToFile:   institutionLicence.LinkToFile,
-		DateStart:    institutionLicence.DateStart,
-		DateEnd:      institutionLicence.DateEnd,
-		CreatedAt:    institutionLicence.CreatedAt,
-		UpdatedAt:    institutionLicence.UpdatedAt,
+		ID:                 institutionLicence.ID,
+		TranslationGroupID: institutionLicence.TranslationGroupID,
+		LanguageCode:       institutionLicence.LanguageCode,
+		LicenceTitle:       institutionLicence.LicenceTitle,
+		LicenceType:        institutionLicence.LicenceType,
+		GivenBy:            institutionLicence.GivenBy,
+		LinkToFile:         institutionLicence.LinkToFile,
+		DateStart:          institutionLicence.DateStart,
+		DateEnd:            institutionLicence.DateEnd,
+		CreatedAt:          institutionLicence.CreatedAt,
+		UpdatedAt:          institutionLicence.UpdatedAt,
 	}
 }
@@ -5,6 +5,8 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -21,15 +23,18 @@ type InstitutionMagazineUsecase interface {
 type institutionMagazineUsecase struct {
 	institutionMagazineRepo repositories.InstitutionMagazineRepository
 	validator               *validator.Validate
+	languageFallback        []string
 }
 
 func NewInstitutionMagazineUsecase(
 	institutionMagazineRepo repositories.InstitutionMagazineRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) InstitutionMagazineUsecase {
 	return &institutionMagazineUsecase{
 		institutionMagazineRepo: institutionMagazineRepo,
 		validator:               validator,
+		languageFallback:        languageFallback,
 	}
 }
 
@@ -39,11 +44,12 @@ func (uc *institutionMagazineUsecase) Create(ctx context.Context, req *dtos.Crea
 	}
 
 	institutionMagazine := &domain.InstitutionMagazine{
-		InstitutionID: req.InstitutionID,
-		LanguageCode:  req.LanguageCode,
-		MagazineName:  req.MagazineName,
-		Link:          req.Link,
-		LinkToRINC:    req.LinkToRINC,
+		InstitutionID:      req.InstitutionID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		MagazineName:       req.MagazineName,
+		Link:               req.Link,
+		LinkToRINC:         req.LinkToRINC,
 	}
 
 	createdInstitutionMagazine, err := uc.institutionMagazineRepo.Create(ctx, institutionMagazine)
@@ -102,11 +108,11 @@ func (uc *institutionMagazineUsecase) GetByEmployeeIDAndLanguageCode(ctx context
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive institution magazine", institutionID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive institution magazine", langCode))
 	}
 
-	institutionMagazines, err := uc.institutionMagazineRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, langCode)
+	institutionMagazines, err := uc.institutionMagazineRepo.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -125,11 +131,13 @@ func MapInstitutionMagazineDomainToResponseDTO(institutionMagazine *domain.Insti
 	}
 
 	return &dtos.InstitutionMagazineResponse{
-		ID:           institutionMagazine.ID,
-		MagazineName: institutionMagazine.MagazineName,
-		Link:         institutionMagazine.Link,
-		LinkToRINC:   institutionMagazine.LinkToRINC,
-		CreatedAt:    institutionMagazine.CreatedAt,
-		UpdatedAt:    institutionMagazine.UpdatedAt,
+		ID:                 institutionMagazine.ID,
+		TranslationGroupID: institutionMagazine.TranslationGroupID,
+		LanguageCode:       institutionMagazine.LanguageCode,
+		MagazineName:       institutionMagazine.MagazineName,
+		Link:               institutionMagazine.Link,
+		LinkToRINC:         institutionMagazine.LinkToRINC,
+		CreatedAt:          institutionMagazine.CreatedAt,
+		UpdatedAt:          institutionMagazine.UpdatedAt,
 	}
 }
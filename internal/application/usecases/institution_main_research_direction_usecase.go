@@ -5,6 +5,8 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -20,16 +22,22 @@ type InstitutionMainResearchDirectionUsecase interface {
 
 type institutionMainResearchDirectionUsecase struct {
 	institutionMainResearchDirectionRepo repositories.InstitutionMainResearchDirectionRepository
+	researchFieldRepo                    repositories.ResearchFieldRepository
 	validator                            *validator.Validate
+	languageFallback                     []string
 }
 
 func NewInstitutionMainResearchDirectionUsecase(
 	institutionMainResearchDirectionRepo repositories.InstitutionMainResearchDirectionRepository,
+	researchFieldRepo repositories.ResearchFieldRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) InstitutionMainResearchDirectionUsecase {
 	return &institutionMainResearchDirectionUsecase{
 		institutionMainResearchDirectionRepo: institutionMainResearchDirectionRepo,
+		researchFieldRepo:                    researchFieldRepo,
 		validator:                            validator,
+		languageFallback:                     languageFallback,
 	}
 }
 
@@ -38,12 +46,20 @@ func (uc *institutionMainResearchDirectionUsecase) Create(ctx context.Context, r
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to create institution main research direction: %w", err))
 	}
 
+	if req.ResearchFieldCode != "" {
+		if err := checkResearchField(ctx, uc.researchFieldRepo, req.ResearchFieldCode); err != nil {
+			return nil, err
+		}
+	}
+
 	institutionMainResearchDirection := &domain.InstitutionMainResearchDirection{
 		InstitutionID:          req.InstitutionID,
 		LanguageCode:           req.LanguageCode,
+		TranslationGroupID:     req.TranslationGroupID,
 		ResearchDirectionTitle: req.ResearchDirectionTitle,
 		Discipline:             req.Discipline,
 		AreaOfResearch:         req.AreaOfResearch,
+		ResearchFieldCode:      req.ResearchFieldCode,
 	}
 
 	createdInstitutionMainResearchDirection, err := uc.institutionMainResearchDirectionRepo.Create(ctx, institutionMainResearchDirection)
@@ -76,6 +92,14 @@ func (uc *institutionMainResearchDirectionUsecase) Update(ctx context.Context, r
 		institutionMainResearchDirection.AreaOfResearch = *req.AreaOfResearch
 	}
 
+	if req.ResearchFieldCode != nil {
+		if err := checkResearchField(ctx, uc.researchFieldRepo, *req.ResearchFieldCode); err != nil {
+			return nil, err
+		}
+
+		institutionMainResearchDirection.ResearchFieldCode = *req.ResearchFieldCode
+	}
+
 	updatedInstitutionMainResearchDirection, err := uc.institutionMainResearchDirectionRepo.Update(ctx, institutionMainResearchDirection)
 	if err != nil {
 		return nil, err
@@ -102,11 +126,11 @@ func (uc *institutionMainResearchDirectionUsecase) GetByEmployeeIDAndLanguageCod
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive institution main research direction", institutionID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive institution main research direction", langCode))
 	}
 
-	institutionMainResearchDirections, err := uc.institutionMainResearchDirectionRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, langCode)
+	institutionMainResearchDirections, err := uc.institutionMainResearchDirectionRepo.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -126,9 +150,12 @@ func MapInstitutionMainResearchDirectionDomainToResponseDTO(institutionMainResea
 
 	return &dtos.InstitutionMainResearchDirectionResponse{
 		ID:                     institutionMainResearchDirection.ID,
+		TranslationGroupID:     institutionMainResearchDirection.TranslationGroupID,
+		LanguageCode:           institutionMainResearchDirection.LanguageCode,
 		ResearchDirectionTitle: institutionMainResearchDirection.ResearchDirectionTitle,
 		Discipline:             institutionMainResearchDirection.Discipline,
 		AreaOfResearch:         institutionMainResearchDirection.AreaOfResearch,
+		ResearchFieldCode:      institutionMainResearchDirection.ResearchFieldCode,
 		CreatedAt:              institutionMainResearchDirection.CreatedAt,
 		UpdatedAt:              institutionMainResearchDirection.UpdatedAt,
 	}
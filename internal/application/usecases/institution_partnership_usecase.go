@@ -5,6 +5,8 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -21,15 +23,18 @@ type InstitutionPartnershipUsecase interface {
 type institutionPartnershipUsecase struct {
 	institutionPartnershipRepo repositories.InstitutionPartnershipRepository
 	validator                  *validator.Validate
+	languageFallback           []string
 }
 
 func NewInstitutionPartnershipUsecase(
 	institutionPartnershipRepo repositories.InstitutionPartnershipRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) InstitutionPartnershipUsecase {
 	return &institutionPartnershipUsecase{
 		institutionPartnershipRepo: institutionPartnershipRepo,
 		validator:                  validator,
+		languageFallback:           languageFallback,
 	}
 }
 
@@ -39,13 +44,14 @@ func (uc *institutionPartnershipUsecase) Create(ctx context.Context, req *dtos.C
 	}
 
 	institutionPartnership := &domain.InstitutionPartnership{
-		InstitutionID:  req.InstitutionID,
-		LanguageCode:   req.LanguageCode,
-		PartnerName:    req.PartnerName,
-		PartnerType:    req.PartnerType,
-		Goal:           req.Goal,
-		LinkToPartner:  req.LinkToPartner,
-		DateOfContract: req.DateOfContract,
+		InstitutionID:      req.InstitutionID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		PartnerName:        req.PartnerName,
+		PartnerType:        req.PartnerType,
+		Goal:               req.Goal,
+		LinkToPartner:      req.LinkToPartner,
+		DateOfContract:     req.DateOfContract,
 	}
 
 	createdInstitutionPartnership, err := uc.institutionPartnershipRepo.Create(ctx, institutionPartnership)
@@ -108,11 +114,11 @@ func (uc *institutionPartnershipUsecase) GetByEmployeeIDAndLanguageCode(ctx cont
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive institution achievement", institutionID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive institution achievement", langCode))
 	}
 
-	institutionPartnerships, err := uc.institutionPartnershipRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, langCode)
+	institutionPartnerships, err := uc.institutionPartnershipRepo.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -131,13 +137,15 @@ func MapInstitutionPartnershipDomainToResponseDTO(institutionPartnership *domain
 	}
 
 	return &dtos.InstitutionPartnershipResponse{
-		ID:             institutionPartnership.ID,
-		PartnerType:    institutionPartnership.PartnerType,
-		PartnerName:    institutionPartnership.PartnerName,
-		Goal:           institutionPartnership.Goal,
-		LinkToPartner:  institutionPartnership.PartnerName,
-		DateOfContract: institutionPartnership.DateOfContract,
-		CreatedAt:      institutionPartnership.CreatedAt,
-		UpdatedAt:      institutionPartnership.UpdatedAt,
+		ID:                 institutionPartnership.ID,
+		TranslationGroupID: institutionPartnership.TranslationGroupID,
+		LanguageCode:       institutionPartnership.LanguageCode,
+		PartnerType:        institutionPartnership.PartnerType,
+		PartnerName:        institutionPartnership.PartnerName,
+		Goal:               institutionPartnership.Goal,
+		LinkToPartner:      institutionPartnership.PartnerName,
+		DateOfContract:     institutionPartnership.DateOfContract,
+		CreatedAt:          institutionPartnership.CreatedAt,
+		UpdatedAt:          institutionPartnership.UpdatedAt,
 	}
 }
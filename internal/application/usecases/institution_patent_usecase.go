@@ -5,6 +5,8 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -21,15 +23,18 @@ type InstitutionPatentUsecase interface {
 type institutionPatentUsecase struct {
 	institutionPatentRepo repositories.InstitutionPatentRepository
 	validator             *validator.Validate
+	languageFallback      []string
 }
 
 func NewInstitutionPatentUsecase(
 	institutionPatentRepo repositories.InstitutionPatentRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) InstitutionPatentUsecase {
 	return &institutionPatentUsecase{
 		institutionPatentRepo: institutionPatentRepo,
 		validator:             validator,
+		languageFallback:      languageFallback,
 	}
 }
 
@@ -39,13 +44,14 @@ func (uc *institutionPatentUsecase) Create(ctx context.Context, req *dtos.Create
 	}
 
 	institutionPatent := &domain.InstitutionPatent{
-		InstitutionID:    req.InstitutionID,
-		LanguageCode:     req.LanguageCode,
-		PatentTitle:      req.PatentTitle,
-		Discipline:       req.Discipline,
-		ImplementedIn:    req.ImplementedIn,
-		LinkToPatentFile: req.LinkToPartnerFile,
-		Description:      req.Description,
+		InstitutionID:      req.InstitutionID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		PatentTitle:        req.PatentTitle,
+		Discipline:         req.Discipline,
+		ImplementedIn:      req.ImplementedIn,
+		LinkToPatentFile:   req.LinkToPartnerFile,
+		Description:        req.Description,
 	}
 
 	createdInstitutionPatent, err := uc.institutionPatentRepo.Create(ctx, institutionPatent)
@@ -112,11 +118,11 @@ func (uc *institutionPatentUsecase) GetByEmployeeIDAndLanguageCode(ctx context.C
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive institution patent", institutionID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive institution patent", langCode))
 	}
 
-	institutionPatents, err := uc.institutionPatentRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, langCode)
+	institutionPatents, err := uc.institutionPatentRepo.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -135,13 +141,15 @@ func MapInstitutionPatentDomainToResponseDTO(institutionPatent *domain.Instituti
 	}
 
 	return &dtos.InstitutionPatentResponse{
-		ID:                institutionPatent.ID,
-		PatentTitle:       institutionPatent.PatentTitle,
-		Discipline:        institutionPatent.Discipline,
-		ImplementedIn:     institutionPatent.ImplementedIn,
-		LinkToPartnerFile: institutionPatent.LinkToPatentFile,
-		Description:       institutionPatent.Description,
-		CreatedAt:         institutionPatent.CreatedAt,
-		UpdatedAt:         institutionPatent.UpdatedAt,
+		ID:                 institutionPatent.ID,
+		TranslationGroupID: institutionPatent.TranslationGroupID,
+		LanguageCode:       institutionPatent.LanguageCode,
+		PatentTitle:        institutionPatent.PatentTitle,
+		Discipline:         institutionPatent.Discipline,
+		ImplementedIn:      institutionPatent.ImplementedIn,
+		LinkToPartnerFile:  institutionPatent.LinkToPatentFile,
+		Description:        institutionPatent.Description,
+		CreatedAt:          institutionPatent.CreatedAt,
+		UpdatedAt:          institutionPatent.UpdatedAt,
 	}
 }
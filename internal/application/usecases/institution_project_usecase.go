@@ -5,6 +5,8 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -21,15 +23,18 @@ type InstitutionProjectUsecase interface {
 type institutionProjectUsecase struct {
 	institutionProjectRepo repositories.InstitutionProjectRepository
 	validator              *validator.Validate
+	languageFallback       []string
 }
 
 func NewInstitutionProjectUsecase(
 	institutionProjectRepo repositories.InstitutionProjectRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) InstitutionProjectUsecase {
 	return &institutionProjectUsecase{
 		institutionProjectRepo: institutionProjectRepo,
 		validator:              validator,
+		languageFallback:       languageFallback,
 	}
 }
 
@@ -39,24 +44,26 @@ func (uc *institutionProjectUsecase) Create(ctx context.Context, req *dtos.Creat
 	}
 
 	institutionProject := &domain.InstitutionProject{
-		InstitutionID:   req.InstitutionID,
-		LanguageCode:    req.LanguageCode,
-		ProjectType:     req.ProjectType,
-		ProjectTitle:    req.ProjectTitle,
-		DateStart:       req.DateStart,
-		DateEnd:         req.DateEnd,
-		Fund:            req.Fund,
-		InstitutionRole: req.InstitutionRole,
-		Coordinator:     req.Coordinator,
-		Partners:        make([]*domain.InstitutionProjectPartner, len(req.Partners)),
+		InstitutionID:      req.InstitutionID,
+		LanguageCode:       req.LanguageCode,
+		TranslationGroupID: req.TranslationGroupID,
+		ProjectType:        req.ProjectType,
+		ProjectTitle:       req.ProjectTitle,
+		DateStart:          req.DateStart,
+		DateEnd:            req.DateEnd,
+		Fund:               req.Fund,
+		InstitutionRole:    req.InstitutionRole,
+		Coordinator:        req.Coordinator,
+		Partners:           make([]*domain.InstitutionProjectPartner, len(req.Partners)),
 	}
 
 	for index, partner := range req.Partners {
 		institutionProject.Partners[index] = &domain.InstitutionProjectPartner{
-			LanguageCode:  partner.LanguageCode,
-			PartnerType:   partner.PartnerType,
-			PartnerName:   partner.PartnerName,
-			LinkToPartner: partner.LinkToPartner,
+			LanguageCode:       partner.LanguageCode,
+			TranslationGroupID: partner.TranslationGroupID,
+			PartnerType:        partner.PartnerType,
+			PartnerName:        partner.PartnerName,
+			LinkToPartner:      partner.LinkToPartner,
 		}
 	}
 
@@ -151,11 +158,11 @@ func (uc *institutionProjectUsecase) GetByInstitutionIDAndLanguageCode(ctx conte
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive institution project", institutionID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive institution project", langCode))
 	}
 
-	institutionProjects, err := uc.institutionProjectRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, langCode)
+	institutionProjects, err := uc.institutionProjectRepo.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -176,26 +183,29 @@ func MapInstitutionProjectDomainToResponseDTO(institutionProject *domain.Institu
 	partners := make([]*dtos.InstitutionProjectPartnerResponse, len(institutionProject.Partners))
 	for index, partner := range institutionProject.Partners {
 		partners[index] = &dtos.InstitutionProjectPartnerResponse{
-			ID:            partner.ID,
-			PartnerType:   partner.PartnerType,
-			PartnerName:   partner.PartnerName,
-			LinkToPartner: partner.LinkToPartner,
-			CreatedAt:     partner.CreatedAt,
-			UpdatedAt:     partner.UpdatedAt,
+			ID:                 partner.ID,
+			TranslationGroupID: partner.TranslationGroupID,
+			PartnerType:        partner.PartnerType,
+			PartnerName:        partner.PartnerName,
+			LinkToPartner:      partner.LinkToPartner,
+			CreatedAt:          partner.CreatedAt,
+			UpdatedAt:          partner.UpdatedAt,
 		}
 	}
 
 	return &dtos.InstitutionProjectResponse{
-		ID:              institutionProject.ID,
-		ProjectType:     institutionProject.ProjectType,
-		ProjectTitle:    institutionProject.ProjectTitle,
-		DateStart:       institutionProject.DateStart,
-		DateEnd:         institutionProject.DateEnd,
-		Fund:            institutionProject.Fund,
-		InstitutionRole: institutionProject.InstitutionRole,
-		Coordinator:     institutionProject.Coordinator,
-		Partners:        partners,
-		CreatedAt:       institutionProject.CreatedAt,
-		UpdatedAt:       institutionProject.UpdatedAt,
+		ID:                 institutionProject.ID,
+		TranslationGroupID: institutionProject.TranslationGroupID,
+		LanguageCode:       institutionProject.LanguageCode,
+		ProjectType:        institutionProject.ProjectType,
+		ProjectTitle:       institutionProject.ProjectTitle,
+		DateStart:          institutionProject.DateStart,
+		DateEnd:            institutionProject.DateEnd,
+		Fund:               institutionProject.Fund,
+		InstitutionRole:    institutionProject.InstitutionRole,
+		Coordinator:        institutionProject.Coordinator,
+		Partners:           partners,
+		CreatedAt:          institutionProject.CreatedAt,
+		UpdatedAt:          institutionProject.UpdatedAt,
 	}
 }
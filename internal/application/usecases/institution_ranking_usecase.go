@@ -5,6 +5,8 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -21,15 +23,18 @@ type InstitutionRankingUsecase interface {
 type institutionRankingUsecase struct {
 	institutionRankingRepo repositories.InstitutionRankingRepository
 	validator              *validator.Validate
+	languageFallback       []string
 }
 
 func NewInstitutionRankingUsecase(
 	institutionRankingRepo repositories.InstitutionRankingRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) InstitutionRankingUsecase {
 	return &institutionRankingUsecase{
 		institutionRankingRepo: institutionRankingRepo,
 		validator:              validator,
+		languageFallback:       languageFallback,
 	}
 }
 
@@ -41,6 +46,7 @@ func (uc *institutionRankingUsecase) Create(ctx context.Context, req *dtos.Creat
 	institutionRanking := &domain.InstitutionRanking{
 		InstitutionID:       req.InstitutionID,
 		LanguageCode:        req.LanguageCode,
+		TranslationGroupID:  req.TranslationGroupID,
 		RankingTitle:        req.RankingTitle,
 		RankingType:         req.RankingType,
 		DateReceived:        req.DateReceived,
@@ -122,11 +128,11 @@ func (uc *institutionRankingUsecase) GetByEmployeeIDAndLanguageCode(ctx context.
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive institution ranking", institutionID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive institution ranking", langCode))
 	}
 
-	institutionRankings, err := uc.institutionRankingRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, langCode)
+	institutionRankings, err := uc.institutionRankingRepo.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -146,6 +152,8 @@ func MapInstitutionRankingDomainToResponseDTO(institutionRanking *domain.Institu
 
 	return &dtos.InstitutionRankingResponse{
 		ID:                      institutionRanking.ID,
+		TranslationGroupID:      institutionRanking.TranslationGroupID,
+		LanguageCode:            institutionRanking.LanguageCode,
 		RankingTitle:            institutionRanking.RankingTitle,
 		RankingType:             institutionRanking.RankingType,
 		DateReceived:            institutionRanking.DateReceived,
@@ -5,6 +5,8 @@ import (
 	"backend/internal/application/repositories"
 	"backend/internal/domain"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/utils"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -21,15 +23,18 @@ type InstitutionResearchSupportInfrastructureUsecase interface {
 type institutionResearchSupportInfrastructureUsecase struct {
 	institutionResearchSupportInfrastructureRepo repositories.InstitutionResearchSupportInfrastructureRepository
 	validator                                    *validator.Validate
+	languageFallback                             []string
 }
 
 func NewInstitutionResearchSupportInfrastructureUsecase(
 	institutionResearchSupportInfrastructureRepo repositories.InstitutionResearchSupportInfrastructureRepository,
 	validator *validator.Validate,
+	languageFallback []string,
 ) InstitutionResearchSupportInfrastructureUsecase {
 	return &institutionResearchSupportInfrastructureUsecase{
 		institutionResearchSupportInfrastructureRepo: institutionResearchSupportInfrastructureRepo,
-		validator: validator,
+		validator:        validator,
+		languageFallback: languageFallback,
 	}
 }
 
@@ -41,6 +46,7 @@ func (uc *institutionResearchSupportInfrastructureUsecase) Create(ctx context.Co
 	institutionResearchSupportInfrastructure := &domain.InstitutionResearchSupportInfrastructure{
 		InstitutionID:                      req.InstitutionID,
 		LanguageCode:                       req.LanguageCode,
+		TranslationGroupID:                 req.TranslationGroupID,
 		ResearchSupportInfrastructureTitle: req.ResearchSupportInfrastructureTitle,
 		ResearchSupportInfrastructureType:  req.ResearchSupportInfrastructureType,
 		TINOfLegalEntity:                   req.TINOfLegalEntity,
@@ -102,11 +108,11 @@ func (uc *institutionResearchSupportInfrastructureUsecase) GetByEmployeeIDAndLan
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive institution research support infrastructure", institutionID))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
+	if !languages.IsEnabled(langCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive institution research support infrastructure", langCode))
 	}
 
-	institutionResearchSupportInfrastructures, err := uc.institutionResearchSupportInfrastructureRepo.GetByInstitutionIDAndLanguageCode(ctx, institutionID, langCode)
+	institutionResearchSupportInfrastructures, err := uc.institutionResearchSupportInfrastructureRepo.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, utils.LanguageFallback(langCode, uc.languageFallback))
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +132,8 @@ func MapInstitutionResearchSupportInfrastructureDomainToResponseDTO(institutionR
 
 	return &dtos.InstitutionResearchSupportInfrastructureResponse{
 		ID:                                 institutionResearchSupportInfrastructure.ID,
+		TranslationGroupID:                 institutionResearchSupportInfrastructure.TranslationGroupID,
+		LanguageCode:                       institutionResearchSupportInfrastructure.LanguageCode,
 		ResearchSupportInfrastructureType:  institutionResearchSupportInfrastructure.ResearchSupportInfrastructureType,
 		ResearchSupportInfrastructureTitle: institutionResearchSupportInfrastructure.ResearchSupportInfrastructureTitle,
 		TINOfLegalEntity:                   institutionResearchSupportInfrastructure.TINOfLegalEntity,
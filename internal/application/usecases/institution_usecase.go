@@ -9,7 +9,9 @@ import (
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
 	"backend/internal/shared/mappers"
+	"backend/internal/shared/utils"
 	"fmt"
+	"strconv"
 
 	"github.com/go-playground/validator/v10"
 	"golang.org/x/net/context"
@@ -20,25 +22,38 @@ type InstitutionUsecase interface {
 	Update(ctx context.Context, req *dtos.UpdateInstitutionRequest) (*dtos.InstitutionResponse, error)
 	Delete(ctx context.Context, id int64) error
 	GetByID(ctx context.Context, employeeID int64, langCode string) (*dtos.InstitutionResponse, error)
+	GetLinkedDataByID(ctx context.Context, id int64, langCode string) (*dtos.CollegeOrUniversityJSONLD, error)
 	GetAllInstitutions(ctx context.Context) ([]*dtos.AllInstitutionResponse, error)
 	GetAllInstitutionNames(ctx context.Context, langCode string) ([]string, error)
 }
 
 type institutionUsecase struct {
-	institutionRepo repositories.InstitutionRepository
-	validator       *validator.Validate
-	store           *postgres.Store
+	institutionRepo        repositories.InstitutionRepository
+	institutionDetailsRepo repositories.InstitutionDetailsRepository
+	institutionSocialRepo  repositories.InstitutionSocialRepository
+	validator              *validator.Validate
+	store                  *postgres.Store
+	publicBaseURL          string
+	languageFallback       []string
 }
 
 func NewInstitutionUsecase(
 	institutionRepo repositories.InstitutionRepository,
+	institutionDetailsRepo repositories.InstitutionDetailsRepository,
+	institutionSocialRepo repositories.InstitutionSocialRepository,
 	validator *validator.Validate,
 	store *postgres.Store,
+	publicBaseURL string,
+	languageFallback []string,
 ) InstitutionUsecase {
 	return &institutionUsecase{
-		institutionRepo: institutionRepo,
-		validator:       validator,
-		store:           store,
+		institutionRepo:        institutionRepo,
+		institutionDetailsRepo: institutionDetailsRepo,
+		institutionSocialRepo:  institutionSocialRepo,
+		validator:              validator,
+		store:                  store,
+		publicBaseURL:          publicBaseURL,
+		languageFallback:       languageFallback,
 	}
 }
 
@@ -122,6 +137,10 @@ func (uc *institutionUsecase) Delete(ctx context.Context, id int64) error {
 func (uc *institutionUsecase) GetByID(ctx context.Context, id int64, langCode string) (*dtos.InstitutionResponse, error) {
 	institution, err := uc.institutionRepo.GetByID(ctx, id, langCode)
 	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, custom_errors.NotFound(fmt.Errorf("no institution with given ID(%d)", id))
+		}
+
 		return nil, err
 	}
 
@@ -129,6 +148,37 @@ func (uc *institutionUsecase) GetByID(ctx context.Context, id int64, langCode st
 	return resp, nil
 }
 
+// GetLinkedDataByID retrives the institution with its details in the given language as schema.org/CollegeOrUniversity
+func (uc *institutionUsecase) GetLinkedDataByID(ctx context.Context, id int64, langCode string) (*dtos.CollegeOrUniversityJSONLD, error) {
+	if id <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - ID(%d) is provided for retrival of institution", id))
+	}
+
+	institution, err := uc.institutionRepo.GetByID(ctx, id, langCode)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, custom_errors.NotFound(fmt.Errorf("no institution with given ID(%d)", id))
+		}
+
+		return nil, err
+	}
+
+	institution.Details, err = uc.institutionDetailsRepo.GetByInstitutionIDAndLanguageCodes(ctx, id, utils.LanguageFallback(langCode, uc.languageFallback))
+	if err != nil && !custom_errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	socials, err := uc.institutionSocialRepo.GetByInstitutionID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, social := range socials {
+		institution.Socials = append(institution.Socials, *social)
+	}
+
+	return mappers.MapInstitutionDomainToCollegeOrUniversityJSONLD(institution, publicProfileURL(uc.publicBaseURL, "institution", strconv.FormatInt(id, 10))), nil
+}
+
 func (uc *institutionUsecase) GetAllInstitutions(ctx context.Context) ([]*dtos.AllInstitutionResponse, error) {
 	var result []*dtos.AllInstitutionResponse
 	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
@@ -142,9 +192,9 @@ func (uc *institutionUsecase) GetAllInstitutions(ctx context.Context) ([]*dtos.A
 
 		result = make([]*dtos.AllInstitutionResponse, len(institutionsResult))
 
-		langCode := middleware.GetLanguageFromContext(ctx)
+		langCodes := utils.LanguageFallback(middleware.GetLanguageFromContext(ctx), uc.languageFallback)
 		for index, institution := range institutionsResult {
-			institutionDetails, err := txInstitutionDetailsRepo.GetByInstitutionIDAndLanguageCode(ctx, institution.ID, langCode)
+			institutionDetails, err := txInstitutionDetailsRepo.GetByInstitutionIDAndLanguageCodes(ctx, institution.ID, langCodes)
 			if err != nil {
 				if custom_errors.IsNotFound(err) {
 					continue
@@ -156,6 +206,7 @@ func (uc *institutionUsecase) GetAllInstitutions(ctx context.Context) ([]*dtos.A
 			result[index] = &dtos.AllInstitutionResponse{
 				InstitutitonTitleShort: institutionDetails.InstitutionTitleShort,
 				InstitutitonTitleLong:  institutionDetails.InstitutionTitleLong,
+				LanguageCode:           institutionDetails.LanguageCode,
 				MailIndex:              institution.MailIndex,
 				City:                   institutionDetails.City,
 				Address:                institutionDetails.LegalAddress,
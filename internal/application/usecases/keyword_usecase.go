@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/mappers"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type KeywordUsecase interface {
+	Search(ctx context.Context, prefix string, langCode string) ([]*dtos.KeywordResponse, error)
+	Merge(ctx context.Context, req *dtos.MergeKeywordsRequest, langCode string) (*dtos.KeywordResponse, error)
+}
+
+type keywordUsecase struct {
+	keywordRepo repositories.KeywordRepository
+	store       *postgres.Store
+	validator   *validator.Validate
+}
+
+func NewKeywordUsecase(
+	keywordRepo repositories.KeywordRepository,
+	store *postgres.Store,
+	validator *validator.Validate,
+) KeywordUsecase {
+	return &keywordUsecase{
+		keywordRepo: keywordRepo,
+		store:       store,
+		validator:   validator,
+	}
+}
+
+// keywordSuggestionLimit caps the number of keywords suggested for a prefix
+const keywordSuggestionLimit = 20
+
+// keywordPrefixEscaper escapes the wildcards of LIKE so the prefix is matched literally
+var keywordPrefixEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Search suggests keywords with a label in any language starting with prefix, most used first
+func (uc *keywordUsecase) Search(ctx context.Context, prefix string, langCode string) ([]*dtos.KeywordResponse, error) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - prefix is required to search keywords"))
+	}
+
+	keywords, err := uc.keywordRepo.SearchByPrefix(ctx, keywordPrefixEscaper.Replace(prefix), keywordSuggestionLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*dtos.KeywordResponse, len(keywords))
+	for index, keyword := range keywords {
+		resp[index] = mappers.MapKeywordDomainToResponseDTO(keyword, langCode)
+	}
+
+	return resp, nil
+}
+
+// Merge folds the source keywords into the target, key topics of the sources are relinked to the target
+func (uc *keywordUsecase) Merge(ctx context.Context, req *dtos.MergeKeywordsRequest, langCode string) (*dtos.KeywordResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to merge keywords: %w", err))
+	}
+
+	sourceIDs := make([]int64, 0, len(req.SourceIDs))
+	seenIDs := map[int64]bool{}
+	for _, sourceID := range req.SourceIDs {
+		if sourceID == req.TargetID {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - keyword(%d) cannot be merged into itself", sourceID))
+		}
+
+		if !seenIDs[sourceID] {
+			seenIDs[sourceID] = true
+			sourceIDs = append(sourceIDs, sourceID)
+		}
+	}
+
+	var mergedKeyword *domain.Keyword
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txKeywordRepo := postgres.NewPgKeywordRepositoryWithQuery(q)
+
+		keywords, err := txKeywordRepo.GetByIDs(ctx, append([]int64{req.TargetID}, sourceIDs...))
+		if err != nil {
+			return err
+		}
+
+		if len(keywords) != len(sourceIDs)+1 {
+			return custom_errors.NotFound(fmt.Errorf("some of the keywords(%v) to merge do not exist", append([]int64{req.TargetID}, sourceIDs...)))
+		}
+
+		if err := txKeywordRepo.Merge(ctx, req.TargetID, sourceIDs); err != nil {
+			return err
+		}
+
+		keywords, err = txKeywordRepo.GetByIDs(ctx, []int64{req.TargetID})
+		if err != nil {
+			return err
+		}
+
+		mergedKeyword = keywords[0]
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.MapKeywordDomainToResponseDTO(mergedKeyword, langCode), nil
+}
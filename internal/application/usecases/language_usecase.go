@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"context"
+	"fmt"
+)
+
+type LanguageUsecase interface {
+	GetEnabled(ctx context.Context) []*dtos.LanguageResponse
+	Reload(ctx context.Context) error
+}
+
+type languageUsecase struct {
+	languageRepo repositories.LanguageRepository
+}
+
+func NewLanguageUsecase(languageRepo repositories.LanguageRepository) LanguageUsecase {
+	return &languageUsecase{
+		languageRepo: languageRepo,
+	}
+}
+
+// GetEnabled lists languages content can be requested in, as currently held by the registry
+func (uc *languageUsecase) GetEnabled(ctx context.Context) []*dtos.LanguageResponse {
+	enabledLanguages := languages.Enabled()
+	defaultCode := languages.Default()
+
+	resp := make([]*dtos.LanguageResponse, len(enabledLanguages))
+	for index, language := range enabledLanguages {
+		resp[index] = &dtos.LanguageResponse{
+			Code:       language.Code,
+			Name:       language.Name,
+			NativeName: language.NativeName,
+			IsDefault:  language.Code == defaultCode,
+		}
+	}
+
+	return resp
+}
+
+// Reload replaces the language registry with the content of the languages table
+func (uc *languageUsecase) Reload(ctx context.Context) error {
+	allLanguages, err := uc.languageRepo.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := languages.Load(allLanguages); err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to load language registry: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/mappers"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type OrgUnitUsecase interface {
+	GetTree(ctx context.Context, institutionID int64, langCode string) ([]*dtos.OrgUnitResponse, error)
+	Create(ctx context.Context, req *dtos.CreateOrgUnitRequest) (*dtos.OrgUnitResponse, error)
+	Update(ctx context.Context, req *dtos.UpdateOrgUnitRequest) (*dtos.OrgUnitResponse, error)
+	Delete(ctx context.Context, institutionID int64, id int64) error
+}
+
+type orgUnitUsecase struct {
+	orgUnitRepo     repositories.OrgUnitRepository
+	institutionRepo repositories.InstitutionRepository
+	store           *postgres.Store
+	validator       *validator.Validate
+}
+
+func NewOrgUnitUsecase(
+	orgUnitRepo repositories.OrgUnitRepository,
+	institutionRepo repositories.InstitutionRepository,
+	store *postgres.Store,
+	validator *validator.Validate,
+) OrgUnitUsecase {
+	return &orgUnitUsecase{
+		orgUnitRepo:     orgUnitRepo,
+		institutionRepo: institutionRepo,
+		store:           store,
+		validator:       validator,
+	}
+}
+
+// GetTree returns top level units of the institution with their sub-units nested, ordered by sort order
+func (uc *orgUnitUsecase) GetTree(ctx context.Context, institutionID int64, langCode string) ([]*dtos.OrgUnitResponse, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive organizational units", institutionID))
+	}
+
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to retrive organizational units", langCode))
+	}
+
+	orgUnits, err := uc.orgUnitRepo.GetByInstitutionID(ctx, institutionID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgUnitsByID := make(map[int64]*domain.OrgUnit, len(orgUnits))
+	for _, orgUnit := range orgUnits {
+		orgUnitsByID[orgUnit.ID] = orgUnit
+	}
+
+	roots := []*domain.OrgUnit{}
+	for _, orgUnit := range orgUnits {
+		parent, ok := orgUnitsByID[orgUnit.ParentID]
+		if !ok {
+			roots = append(roots, orgUnit)
+			continue
+		}
+
+		parent.Children = append(parent.Children, orgUnit)
+	}
+
+	resp := make([]*dtos.OrgUnitResponse, len(roots))
+	for index, root := range roots {
+		resp[index] = mappers.MapOrgUnitDomainToResponseDTO(root, langCode)
+	}
+
+	return resp, nil
+}
+
+func (uc *orgUnitUsecase) Create(ctx context.Context, req *dtos.CreateOrgUnitRequest) (*dtos.OrgUnitResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to create organizational unit: %w", err))
+	}
+
+	if err := uc.authorize(ctx, req.InstitutionID); err != nil {
+		return nil, err
+	}
+
+	if req.ParentID != 0 {
+		if err := uc.checkParent(ctx, req.InstitutionID, 0, req.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	orgUnit := &domain.OrgUnit{
+		InstitutionID: req.InstitutionID,
+		ParentID:      req.ParentID,
+		UnitType:      req.UnitType,
+		SortOrder:     req.SortOrder,
+		Names:         trimOrgUnitNames(req.Names),
+	}
+
+	var createdOrgUnit *domain.OrgUnit
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		var err error
+		createdOrgUnit, err = postgres.NewPgOrgUnitRepositoryWithQuery(q).Create(ctx, orgUnit)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.MapOrgUnitDomainToResponseDTO(createdOrgUnit, middleware.GetLanguageFromContext(ctx)), nil
+}
+
+func (uc *orgUnitUsecase) Update(ctx context.Context, req *dtos.UpdateOrgUnitRequest) (*dtos.OrgUnitResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to update organizational unit: %w", err))
+	}
+
+	if err := uc.authorize(ctx, req.InstitutionID); err != nil {
+		return nil, err
+	}
+
+	orgUnit, err := uc.getOfInstitution(ctx, req.InstitutionID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ParentID != nil && *req.ParentID != orgUnit.ParentID {
+		if *req.ParentID != 0 {
+			if err := uc.checkParent(ctx, req.InstitutionID, orgUnit.ID, *req.ParentID); err != nil {
+				return nil, err
+			}
+		}
+
+		orgUnit.ParentID = *req.ParentID
+	}
+	if req.UnitType != nil {
+		orgUnit.UnitType = *req.UnitType
+	}
+	if req.SortOrder != nil {
+		orgUnit.SortOrder = *req.SortOrder
+	}
+	if req.Names != nil {
+		orgUnit.Names = trimOrgUnitNames(req.Names)
+	}
+
+	var updatedOrgUnit *domain.OrgUnit
+	err = uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		var err error
+		updatedOrgUnit, err = postgres.NewPgOrgUnitRepositoryWithQuery(q).Update(ctx, orgUnit)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.MapOrgUnitDomainToResponseDTO(updatedOrgUnit, middleware.GetLanguageFromContext(ctx)), nil
+}
+
+// Delete removes a unit without sub-units, work experiences in the unit stay linked to the institution only
+func (uc *orgUnitUsecase) Delete(ctx context.Context, institutionID int64, id int64) error {
+	if institutionID <= 0 || id <= 0 {
+		return custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d), ID(%d) to delete organizational unit", institutionID, id))
+	}
+
+	if err := uc.authorize(ctx, institutionID); err != nil {
+		return err
+	}
+
+	if _, err := uc.getOfInstitution(ctx, institutionID, id); err != nil {
+		return err
+	}
+
+	return uc.orgUnitRepo.Delete(ctx, id)
+}
+
+// authorize lets administrators of the application and of the institution manage its units
+func (uc *orgUnitUsecase) authorize(ctx context.Context, institutionID int64) error {
+	if role, _ := middleware.GetUserRoleFromContext(ctx); role == domain.UserRoleAdmin {
+		return nil
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return custom_errors.Unauthorized(fmt.Errorf("user is not authenticated"))
+	}
+
+	isAdmin, err := uc.institutionRepo.IsAdmin(ctx, institutionID, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return custom_errors.Forbidden(fmt.Errorf("user(%d) is not allowed to manage organizational units of institution(%d)", userID, institutionID))
+	}
+
+	return nil
+}
+
+// getOfInstitution retrives the unit, units of other institutions are reported as not existing
+func (uc *orgUnitUsecase) getOfInstitution(ctx context.Context, institutionID int64, id int64) (*domain.OrgUnit, error) {
+	orgUnit, err := uc.orgUnitRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if orgUnit.InstitutionID != institutionID {
+		return nil, custom_errors.NotFound(fmt.Errorf("organizational unit with given ID(%d) does not exist in institution(%d)", id, institutionID))
+	}
+
+	return orgUnit, nil
+}
+
+// checkParent makes sure the parent is a unit of the same institution and, for an existing unit, does not lie below it
+func (uc *orgUnitUsecase) checkParent(ctx context.Context, institutionID int64, id int64, parentID int64) error {
+	parent, err := uc.orgUnitRepo.GetByID(ctx, parentID)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return custom_errors.BadRequest(fmt.Errorf("parent unit(%d) does not exist", parentID))
+		}
+		return err
+	}
+
+	if parent.InstitutionID != institutionID {
+		return custom_errors.BadRequest(fmt.Errorf("parent unit(%d) does not belong to institution(%d)", parentID, institutionID))
+	}
+
+	if id == 0 {
+		return nil
+	}
+
+	inSubtree, err := uc.orgUnitRepo.IsInSubtree(ctx, id, parentID)
+	if err != nil {
+		return err
+	}
+	if inSubtree {
+		return custom_errors.BadRequest(fmt.Errorf("unit(%d) can not be moved below itself or its sub-unit(%d)", id, parentID))
+	}
+
+	return nil
+}
+
+func trimOrgUnitNames(names map[string]string) map[string]string {
+	trimmed := make(map[string]string, len(names))
+	for languageCode, name := range names {
+		trimmed[languageCode] = strings.TrimSpace(name)
+	}
+
+	return trimmed
+}
@@ -0,0 +1,367 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"backend/internal/shared/mappers"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// publicationClaimSuggestionsLimit limits the amount of publications suggested to claim at once
+const publicationClaimSuggestionsLimit = 50
+
+type PublicationUsecase interface {
+	GetByID(ctx context.Context, id int64) (*dtos.PublicationResponse, error)
+	ListClaimSuggestions(ctx context.Context) ([]*dtos.PublicationResponse, error)
+	Claim(ctx context.Context, req *dtos.ClaimPublicationRequest) (*dtos.EmployeePublicationResponse, error)
+	Unclaim(ctx context.Context, publicationID int64) error
+}
+
+type publicationUsecase struct {
+	publicationRepo repositories.PublicationRepository
+	employeeRepo    repositories.EmployeeRepository
+	store           *postgres.Store
+	validator       *validator.Validate
+}
+
+func NewPublicationUsecase(
+	publicationRepo repositories.PublicationRepository,
+	employeeRepo repositories.EmployeeRepository,
+	store *postgres.Store,
+	validator *validator.Validate,
+) PublicationUsecase {
+	return &publicationUsecase{
+		publicationRepo: publicationRepo,
+		employeeRepo:    employeeRepo,
+		store:           store,
+		validator:       validator,
+	}
+}
+
+func (uc *publicationUsecase) GetByID(ctx context.Context, id int64) (*dtos.PublicationResponse, error) {
+	if id <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - ID(%d) to retrive publication", id))
+	}
+
+	publication, err := uc.publicationRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.MapPublicationDomainToResponseDTO(publication), nil
+}
+
+// ListClaimSuggestions returns publications of colleagues where the current employee
+// appears in the author list but has not claimed the authorship yet
+func (uc *publicationUsecase) ListClaimSuggestions(ctx context.Context) ([]*dtos.PublicationResponse, error) {
+	employee, err := uc.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	publications, err := uc.publicationRepo.ListClaimSuggestions(ctx, employee.ID, publicationClaimSuggestionsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*dtos.PublicationResponse, len(publications))
+	for index, publication := range publications {
+		publicationWithAuthors, err := uc.publicationRepo.GetByID(ctx, publication.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		resp[index] = mappers.MapPublicationDomainToResponseDTO(publicationWithAuthors)
+	}
+
+	return resp, nil
+}
+
+// Claim marks the current employee as one of the authors of the publication
+// and adds the publication to the employee's publication list
+func (uc *publicationUsecase) Claim(ctx context.Context, req *dtos.ClaimPublicationRequest) (*dtos.EmployeePublicationResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to claim publication: %w", err))
+	}
+
+	if !languages.IsEnabled(req.LanguageCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - LanguageCode(%s) to claim publication", req.LanguageCode))
+	}
+
+	employee, err := uc.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *dtos.EmployeePublicationResponse
+	err = uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txPublicationRepo := postgres.NewPgPublicationRepositoryWithQuery(q)
+		txEmployeePublicationRepo := postgres.NewPgEmployeePublicationRepositoryWithQuery(q)
+
+		publication, err := txPublicationRepo.GetByID(ctx, req.PublicationID)
+		if err != nil {
+			return err
+		}
+
+		claimedAuthor, err := txPublicationRepo.GetAuthorByEmployeeID(ctx, publication.ID, employee.ID)
+		if err != nil {
+			return err
+		}
+
+		if claimedAuthor != nil {
+			return custom_errors.BadRequest(fmt.Errorf("publication(%d) is already claimed by the employee", publication.ID))
+		}
+
+		if err := claimPublicationAuthor(ctx, q, publication, employee.ID, req.LanguageCode, req.AuthorPosition); err != nil {
+			return err
+		}
+
+		authorNames := make([]string, len(publication.Authors))
+		for index, author := range publication.Authors {
+			authorNames[index] = author.AuthorName
+		}
+
+		employeePublication, err := txEmployeePublicationRepo.Create(ctx, &domain.EmployeePublication{
+			EmployeeID:        employee.ID,
+			LanguageCode:      req.LanguageCode,
+			PublicationTitle:  publication.PublicationTitle,
+			LinkToPublication: publication.LinkToPublication,
+			Authors:           authorNames,
+			PublicationYear:   publication.PublicationYear,
+			Venue:             publication.Venue,
+			DOI:               publication.DOI,
+			PublicationType:   publication.PublicationType,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := txEmployeePublicationRepo.SetPublicationID(ctx, employeePublication.ID, publication.ID); err != nil {
+			return err
+		}
+		employeePublication.PublicationID = publication.ID
+		employeePublication.Source = domain.SourceManual
+
+		if err := postgres.NewPgPublicationCitationRepositoryWithQuery(q).RefreshEmployeeMetrics(ctx, employee.ID); err != nil {
+			return err
+		}
+
+		resp = mappers.MapEmployeePublicationDomainToResponseDTO(employeePublication)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Unclaim releases the authorship and removes the publication from the employee's publication list in all languages
+func (uc *publicationUsecase) Unclaim(ctx context.Context, publicationID int64) error {
+	if publicationID <= 0 {
+		return custom_errors.BadRequest(fmt.Errorf("invalid input - PublicationID(%d) to unclaim publication", publicationID))
+	}
+
+	employee, err := uc.currentEmployee(ctx)
+	if err != nil {
+		return err
+	}
+
+	return uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txPublicationRepo := postgres.NewPgPublicationRepositoryWithQuery(q)
+		txEmployeePublicationRepo := postgres.NewPgEmployeePublicationRepositoryWithQuery(q)
+
+		if err := txPublicationRepo.UnclaimAuthor(ctx, publicationID, employee.ID); err != nil {
+			return err
+		}
+
+		if err := txEmployeePublicationRepo.DeleteByPublicationID(ctx, employee.ID, publicationID); err != nil {
+			return err
+		}
+
+		return postgres.NewPgPublicationCitationRepositoryWithQuery(q).RefreshEmployeeMetrics(ctx, employee.ID)
+	})
+}
+
+func (uc *publicationUsecase) currentEmployee(ctx context.Context) (*domain.Employee, error) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, custom_errors.Unauthorized(fmt.Errorf("user is not authenticated"))
+	}
+
+	return uc.employeeRepo.GetByUserID(ctx, userID)
+}
+
+// linkEmployeePublication attaches the employee's publication entry to the shared publication
+// with the same DOI or title (creating it when there is none) and claims the authorship for the employee.
+// Must be called inside of a transaction.
+func linkEmployeePublication(ctx context.Context, q *sqlc.Queries, employeePublication *domain.EmployeePublication) error {
+	txPublicationRepo := postgres.NewPgPublicationRepositoryWithQuery(q)
+	txEmployeePublicationRepo := postgres.NewPgEmployeePublicationRepositoryWithQuery(q)
+
+	publication, err := txPublicationRepo.FindByDOIOrTitle(ctx, employeePublication.DOI, employeePublication.PublicationTitle)
+	if err != nil {
+		return err
+	}
+
+	if publication == nil {
+		authors := make([]*domain.PublicationAuthor, 0, len(employeePublication.Authors))
+		for _, authorName := range employeePublication.Authors {
+			if authorName = strings.TrimSpace(authorName); authorName != "" {
+				authors = append(authors, &domain.PublicationAuthor{AuthorName: authorName})
+			}
+		}
+
+		publication, err = txPublicationRepo.Create(ctx, &domain.Publication{
+			PublicationTitle:  employeePublication.PublicationTitle,
+			LinkToPublication: employeePublication.LinkToPublication,
+			PublicationYear:   employeePublication.PublicationYear,
+			Venue:             employeePublication.Venue,
+			DOI:               employeePublication.DOI,
+			PublicationType:   employeePublication.PublicationType,
+			Authors:           authors,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if publication.ID != employeePublication.PublicationID {
+		previousPublicationID := employeePublication.PublicationID
+		if err := txEmployeePublicationRepo.SetPublicationID(ctx, employeePublication.ID, publication.ID); err != nil {
+			return err
+		}
+		employeePublication.PublicationID = publication.ID
+
+		if previousPublicationID != 0 {
+			if err := releasePublicationAuthorIfUnused(ctx, q, previousPublicationID, employeePublication.EmployeeID); err != nil {
+				return err
+			}
+		}
+
+		// the shared publication may already carry citations
+		if err := postgres.NewPgPublicationCitationRepositoryWithQuery(q).RefreshEmployeeMetrics(ctx, employeePublication.EmployeeID); err != nil {
+			return err
+		}
+	}
+
+	claimedAuthor, err := txPublicationRepo.GetAuthorByEmployeeID(ctx, publication.ID, employeePublication.EmployeeID)
+	if err != nil || claimedAuthor != nil {
+		return err
+	}
+
+	return claimPublicationAuthor(ctx, q, publication, employeePublication.EmployeeID, employeePublication.LanguageCode, 0)
+}
+
+// claimPublicationAuthor assigns an author entry of the publication to the employee.
+// With authorPosition the exact entry is claimed, otherwise the first unclaimed author
+// matching the employee's surname is used and, when there is none, the employee is appended to the list.
+func claimPublicationAuthor(ctx context.Context, q *sqlc.Queries, publication *domain.Publication, employeeID int64, langCode string, authorPosition int32) error {
+	txPublicationRepo := postgres.NewPgPublicationRepositoryWithQuery(q)
+
+	var author *domain.PublicationAuthor
+	if authorPosition > 0 {
+		for _, publicationAuthor := range publication.Authors {
+			if publicationAuthor.Position == authorPosition {
+				author = publicationAuthor
+				break
+			}
+		}
+
+		if author == nil {
+			return custom_errors.BadRequest(fmt.Errorf("publication(%d) has no author at position %d", publication.ID, authorPosition))
+		}
+	} else {
+		matchedAuthor, err := txPublicationRepo.FindMatchingUnclaimedAuthor(ctx, publication.ID, employeeID)
+		if err != nil {
+			return err
+		}
+		author = matchedAuthor
+	}
+
+	if author != nil {
+		claimed, err := txPublicationRepo.ClaimAuthor(ctx, author.ID, employeeID)
+		if err != nil {
+			return err
+		}
+
+		if claimed {
+			return nil
+		}
+
+		if authorPosition > 0 {
+			return custom_errors.BadRequest(fmt.Errorf("author at position %d of publication(%d) is already claimed", authorPosition, publication.ID))
+		}
+	}
+
+	authorName, err := employeeAuthorName(ctx, q, employeeID, langCode)
+	if err != nil || authorName == "" {
+		return err
+	}
+
+	_, err = txPublicationRepo.AddAuthor(ctx, &domain.PublicationAuthor{
+		PublicationID: publication.ID,
+		AuthorName:    authorName,
+		EmployeeID:    employeeID,
+		ClaimedAt:     time.Now(),
+	})
+	return err
+}
+
+// releasePublicationAuthorIfUnused unclaims the authorship when none of the employee's entries point to the publication anymore
+// and drops its citations from the employee's metrics
+func releasePublicationAuthorIfUnused(ctx context.Context, q *sqlc.Queries, publicationID int64, employeeID int64) error {
+	txPublicationRepo := postgres.NewPgPublicationRepositoryWithQuery(q)
+	txEmployeePublicationRepo := postgres.NewPgEmployeePublicationRepositoryWithQuery(q)
+	txPublicationCitationRepo := postgres.NewPgPublicationCitationRepositoryWithQuery(q)
+
+	count, err := txEmployeePublicationRepo.CountByPublicationID(ctx, employeeID, publicationID)
+	if err != nil || count > 0 {
+		return err
+	}
+
+	if err := txPublicationRepo.UnclaimAuthor(ctx, publicationID, employeeID); err != nil {
+		return err
+	}
+
+	return txPublicationCitationRepo.RefreshEmployeeMetrics(ctx, employeeID)
+}
+
+// employeeAuthorName builds "Name Surname" of the employee preferring details in the given language,
+// empty string is returned when the employee has not filled in the details yet
+func employeeAuthorName(ctx context.Context, q *sqlc.Queries, employeeID int64, langCode string) (string, error) {
+	txEmployeeDetailsRepo := postgres.NewPGEmployeeDetailsRepositoryWithQueries(q)
+
+	employeeDetails, err := txEmployeeDetailsRepo.GetByEmployeeID(ctx, employeeID)
+	if err != nil && !custom_errors.IsNotFound(err) {
+		return "", err
+	}
+
+	var selectedDetails *domain.EmployeeDetails
+	for _, details := range employeeDetails {
+		if !details.IsEmployeeDetailsNew {
+			continue
+		}
+
+		if selectedDetails == nil || details.LanguageCode == langCode {
+			selectedDetails = details
+		}
+	}
+
+	if selectedDetails == nil {
+		return "", nil
+	}
+
+	return strings.TrimSpace(selectedDetails.Name + " " + selectedDetails.Surname), nil
+}
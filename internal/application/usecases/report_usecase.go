@@ -1,9 +1,11 @@
 package usecases
 
 import (
+	"backend/internal/domain"
 	"backend/internal/infrastructure/http/middleware"
 	"backend/internal/infrastructure/persistence/postgres"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"fmt"
 	"os"
@@ -14,6 +16,23 @@ import (
 	"github.com/xuri/excelize/v2"
 )
 
+// summaryDataDegreeColumns - degree levels having a column in the summary report, in the order of the template columns starting at B
+var summaryDataDegreeColumns = []string{
+	domain.DegreeLevelBachelor,
+	domain.DegreeLevelSpecialist,
+	domain.DegreeLevelMaster,
+	domain.DegreeLevelInternship,
+	domain.DegreeLevelResidency,
+	domain.DegreeLevelCandidateOfScience,
+	domain.DegreeLevelPhD,
+	domain.DegreeLevelDoctorOfScience,
+}
+
+// summaryDataDegreeColumn - letter of the column of the degree level at columnIndex of summaryDataDegreeColumns
+func summaryDataDegreeColumn(columnIndex int) string {
+	return string(rune('B' + columnIndex))
+}
+
 type ReportUsecase interface {
 	GenerateSummaryDataReport(ctx context.Context) (*string, *string, error)
 }
@@ -43,72 +62,63 @@ func (uc *reportUsecase) GenerateSummaryDataReport(ctx context.Context) (*string
 		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive summary data: %w", err))
 	}
 
-	type ExcelData struct {
-		BachelorCount        int64
-		SpecialistCount      int64
-		MastersCount         int64
-		IntershipCount       int64
-		ResidencyCount       int64
-		CandidateOfScience   int64
-		PhdCount             int64
-		DoctorOfScienceCount int64
-		Total                int64
+	publicationCountsQueryResult, err := uc.store.GetPublicationCountsByWorkplace(ctx, middleware.GetLanguageFromContext(ctx))
+	if err != nil {
+		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive publication counts: %w", err))
 	}
-	excelData := map[string]ExcelData{}
-	degreeKeys := []string{
-		"Бакалавр",
-		"Магистр",
-		"Мутахассис",
-		"Номзади илм",
-		"Интернатура",
-		"Ординатура",
-		"PhD (Доктори фалсафа)",
-		"Доктори илм",
+
+	// workplaces linked to an institution are grouped by the institution whatever their spelling,
+	// the rest by the workplace as it was typed
+	type WorkplaceKey struct {
+		InstitutionID int64
+		Workplace     string
 	}
 
-	totalPerDegree := make(map[string]*int64)
+	publicationCounts := make(map[WorkplaceKey]int64, len(publicationCountsQueryResult))
+	for _, entry := range publicationCountsQueryResult {
+		publicationCounts[WorkplaceKey{InstitutionID: entry.InstitutionID.Int64, Workplace: entry.Workplace}] = entry.PublicationCount
+	}
 
-	for _, key := range degreeKeys {
-		var count int64 = 0
-		totalPerDegree[key] = &count
+	type ExcelData struct {
+		DegreeCounts     map[string]int64
+		Total            int64
+		PublicationCount int64
 	}
+	excelData := map[WorkplaceKey]ExcelData{}
+	totalPerDegree := map[string]int64{}
 
 	for _, entry := range summaryDataReportQueryResult {
-		if _, ok := excelData[entry.Workplace]; !ok {
-			excelData[entry.Workplace] = ExcelData{}
+		workplaceKey := WorkplaceKey{InstitutionID: entry.InstitutionID.Int64, Workplace: entry.Workplace}
+		if _, ok := excelData[workplaceKey]; !ok {
+			excelData[workplaceKey] = ExcelData{
+				DegreeCounts:     map[string]int64{},
+				PublicationCount: publicationCounts[workplaceKey],
+			}
 		}
 
-		tempData := excelData[entry.Workplace]
-
-		switch entry.DegreeLevel {
-		case "Бакалавр":
-			tempData.BachelorCount++
-
-		case "Магистр":
-			tempData.MastersCount++
-
-		case "Мутахассис":
-			tempData.SpecialistCount++
-
-		case "Номзади илм":
-			tempData.CandidateOfScience++
-
-		case "Интернатура":
-			tempData.IntershipCount++
+		// degrees of a level without a column of their own, or not mapped to a level, are counted only in the total
+		tempData := excelData[workplaceKey]
+		if entry.DegreeLevelCode.Valid {
+			tempData.DegreeCounts[entry.DegreeLevelCode.String]++
+			totalPerDegree[entry.DegreeLevelCode.String]++
+		}
 
-		case "Ординатура":
-			tempData.ResidencyCount++
+		tempData.Total++
+		excelData[workplaceKey] = tempData
+	}
 
-		case "PhD (Доктори фалсафа)":
-			tempData.PhdCount++
+	degreeLevelNamesQueryResult, err := uc.store.GetAllDegreeLevelNames(ctx)
+	if err != nil {
+		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive names of degree levels: %w", err))
+	}
 
-		case "Доктори илм":
-			tempData.DoctorOfScienceCount++
+	degreeLevelNames := map[string]map[string]string{}
+	for _, entry := range degreeLevelNamesQueryResult {
+		if _, ok := degreeLevelNames[entry.DegreeLevelCode]; !ok {
+			degreeLevelNames[entry.DegreeLevelCode] = map[string]string{}
 		}
 
-		tempData.Total++
-		*totalPerDegree[entry.DegreeLevel]++
-		excelData[entry.Workplace] = tempData
+		degreeLevelNames[entry.DegreeLevelCode][entry.LanguageCode] = entry.Name
 	}
 
 	executablePath, err := os.Executable()
@@ -161,98 +171,70 @@ func (uc *reportUsecase) GenerateSummaryDataReport(ctx context.Context) (*string
 		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error setting up cell style for numbers: %w", err))
 	}
 
-	index := 0
-	for institutionName, values := range excelData {
-		if err := f.SetCellStyle(sheetName, "A"+fmt.Sprint(startingRow+index), "A"+fmt.Sprint(startingRow+index), institutionNamesCellStyle); err != nil {
-			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error setting up the style in the excel for institutionNames: %w", err))
-		}
-
-		if err := f.SetCellStyle(sheetName, "B"+fmt.Sprint(startingRow+index), "J"+fmt.Sprint(startingRow+index), numbersCellStyle); err != nil {
-			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error setting up the style in the excel for degree cells: %w", err))
-		}
-
-		if err := f.SetCellStr(sheetName, "A"+fmt.Sprint(startingRow+index), institutionName); err != nil {
-			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the A%d: %w", startingRow+index, err))
-		}
-
-		if err := f.SetCellInt(sheetName, "B"+fmt.Sprint(startingRow+index), values.BachelorCount); err != nil {
-			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the B%d: %w", startingRow+index, err))
-		}
-
-		if err := f.SetCellInt(sheetName, "C"+fmt.Sprint(startingRow+index), values.SpecialistCount); err != nil {
-			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the C%d: %w", startingRow+index, err))
-		}
+	if err := f.SetCellStr(sheetName, "K1", "Интишорот"); err != nil {
+		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the K1: %w", err))
+	}
 
-		if err := f.SetCellInt(sheetName, "D"+fmt.Sprint(startingRow+index), values.MastersCount); err != nil {
-			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the D%d: %w", startingRow+index, err))
+	for columnIndex, degreeLevelCode := range summaryDataDegreeColumns {
+		name := languages.Localize(degreeLevelNames[degreeLevelCode], middleware.GetLanguageFromContext(ctx))
+		if name == "" {
+			continue
 		}
 
-		if err := f.SetCellInt(sheetName, "E"+fmt.Sprint(startingRow+index), values.IntershipCount); err != nil {
-			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the E%d: %w", startingRow+index, err))
+		cell := summaryDataDegreeColumn(columnIndex) + "1"
+		if err := f.SetCellStr(sheetName, cell, name); err != nil {
+			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the %s: %w", cell, err))
 		}
+	}
 
-		if err := f.SetCellInt(sheetName, "F"+fmt.Sprint(startingRow+index), values.ResidencyCount); err != nil {
-			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the F%d: %w", startingRow+index, err))
+	var totalPublications, overallTotal int64
+	index := 0
+	for workplaceKey, values := range excelData {
+		if err := f.SetCellStyle(sheetName, "A"+fmt.Sprint(startingRow+index), "A"+fmt.Sprint(startingRow+index), institutionNamesCellStyle); err != nil {
+			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error setting up the style in the excel for institutionNames: %w", err))
 		}
 
-		if err := f.SetCellInt(sheetName, "G"+fmt.Sprint(startingRow+index), values.CandidateOfScience); err != nil {
-			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the G%d: %w", startingRow+index, err))
+		if err := f.SetCellStyle(sheetName, "B"+fmt.Sprint(startingRow+index), "K"+fmt.Sprint(startingRow+index), numbersCellStyle); err != nil {
+			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error setting up the style in the excel for degree cells: %w", err))
 		}
 
-		if err := f.SetCellInt(sheetName, "H"+fmt.Sprint(startingRow+index), values.PhdCount); err != nil {
-			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the H%d: %w", startingRow+index, err))
+		if err := f.SetCellStr(sheetName, "A"+fmt.Sprint(startingRow+index), workplaceKey.Workplace); err != nil {
+			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the A%d: %w", startingRow+index, err))
 		}
 
-		if err := f.SetCellInt(sheetName, "I"+fmt.Sprint(startingRow+index), values.DoctorOfScienceCount); err != nil {
-			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the I%d: %w", startingRow+index, err))
+		for columnIndex, degreeLevelCode := range summaryDataDegreeColumns {
+			column := summaryDataDegreeColumn(columnIndex)
+			if err := f.SetCellInt(sheetName, column+fmt.Sprint(startingRow+index), values.DegreeCounts[degreeLevelCode]); err != nil {
+				return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the %s%d: %w", column, startingRow+index, err))
+			}
 		}
 
 		if err := f.SetCellInt(sheetName, "J"+fmt.Sprint(startingRow+index), values.Total); err != nil {
 			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the J%d: %w", startingRow+index, err))
 		}
 
-		index++
-	}
-
-	overallTotal := 0
-	for _, values := range totalPerDegree {
-		overallTotal += int(*values)
-	}
-
-	if err := f.SetCellInt(sheetName, "B"+fmt.Sprint(startingRow+index), *totalPerDegree["Бакалавр"]); err != nil {
-		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the B%d: %w", startingRow+index, err))
-	}
-
-	if err := f.SetCellInt(sheetName, "C"+fmt.Sprint(startingRow+index), *totalPerDegree["Магистр"]); err != nil {
-		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the C%d: %w", startingRow+index, err))
-	}
-
-	if err := f.SetCellInt(sheetName, "D"+fmt.Sprint(startingRow+index), *totalPerDegree["Мутахассис"]); err != nil {
-		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the D%d: %w", startingRow+index, err))
-	}
-
-	if err := f.SetCellInt(sheetName, "E"+fmt.Sprint(startingRow+index), *totalPerDegree["Номзади илм"]); err != nil {
-		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the E%d: %w", startingRow+index, err))
-	}
-
-	if err := f.SetCellInt(sheetName, "F"+fmt.Sprint(startingRow+index), *totalPerDegree["Интернатура"]); err != nil {
-		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the F%d: %w", startingRow+index, err))
-	}
+		if err := f.SetCellInt(sheetName, "K"+fmt.Sprint(startingRow+index), values.PublicationCount); err != nil {
+			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the K%d: %w", startingRow+index, err))
+		}
 
-	if err := f.SetCellInt(sheetName, "G"+fmt.Sprint(startingRow+index), *totalPerDegree["Ординатура"]); err != nil {
-		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the G%d: %w", startingRow+index, err))
+		totalPublications += values.PublicationCount
+		overallTotal += values.Total
+		index++
 	}
 
-	if err := f.SetCellInt(sheetName, "H"+fmt.Sprint(startingRow+index), *totalPerDegree["PhD (Доктори фалсафа)"]); err != nil {
-		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the H%d: %w", startingRow+index, err))
+	for columnIndex, degreeLevelCode := range summaryDataDegreeColumns {
+		column := summaryDataDegreeColumn(columnIndex)
+		if err := f.SetCellInt(sheetName, column+fmt.Sprint(startingRow+index), totalPerDegree[degreeLevelCode]); err != nil {
+			return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the %s%d: %w", column, startingRow+index, err))
+		}
 	}
 
-	if err := f.SetCellInt(sheetName, "I"+fmt.Sprint(startingRow+index), *totalPerDegree["Доктори илм"]); err != nil {
-		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the I%d: %w", startingRow+index, err))
+	if err := f.SetCellInt(sheetName, "J"+fmt.Sprint(startingRow+index), overallTotal); err != nil {
+		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the J%d: %w", startingRow+index, err))
 	}
 
-	if err := f.SetCellInt(sheetName, "J"+fmt.Sprint(startingRow+index), int64(overallTotal)); err != nil {
-		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the J%d: %w", startingRow+index, err))
+	if err := f.SetCellInt(sheetName, "K"+fmt.Sprint(startingRow+index), totalPublications); err != nil {
+		return nil, nil, custom_errors.InternalServerError(fmt.Errorf("Error writing on the K%d: %w", startingRow+index, err))
 	}
 
 	currentTime := time.Now()
@@ -0,0 +1,195 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/mappers"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type ResearchFieldUsecase interface {
+	GetAll(ctx context.Context, langCode string) ([]*dtos.ResearchFieldResponse, error)
+	Upsert(ctx context.Context, req *dtos.UpsertResearchFieldRequest, langCode string) (*dtos.ResearchFieldResponse, error)
+	GetStats(ctx context.Context, level string, parentCode string, langCode string) ([]*dtos.ResearchFieldStatsResponse, error)
+}
+
+type researchFieldUsecase struct {
+	researchFieldRepo repositories.ResearchFieldRepository
+	store             *postgres.Store
+	validator         *validator.Validate
+}
+
+func NewResearchFieldUsecase(
+	researchFieldRepo repositories.ResearchFieldRepository,
+	store *postgres.Store,
+	validator *validator.Validate,
+) ResearchFieldUsecase {
+	return &researchFieldUsecase{
+		researchFieldRepo: researchFieldRepo,
+		store:             store,
+		validator:         validator,
+	}
+}
+
+// researchFieldCodePattern matches dotted codes such as 1, 1.2 or 1.2.05
+var researchFieldCodePattern = regexp.MustCompile(`^[0-9A-Za-z]+(\.[0-9A-Za-z]+)*$`)
+
+// researchFieldChildLevels maps a level to the level of its children, subfields have none
+var researchFieldChildLevels = map[string]string{
+	domain.ResearchFieldLevelDomain: domain.ResearchFieldLevelField,
+	domain.ResearchFieldLevelField:  domain.ResearchFieldLevelSubfield,
+}
+
+// checkResearchField rejects codes absent from the research field taxonomy, it is shared by the usecases of research records
+func checkResearchField(ctx context.Context, researchFieldRepo repositories.ResearchFieldRepository, code string) error {
+	exists, err := researchFieldRepo.Exists(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return custom_errors.BadRequest(fmt.Errorf("invalid input - ResearchFieldCode(%s) is not in the research field taxonomy", code))
+	}
+
+	return nil
+}
+
+// GetAll lists every node of the taxonomy, named in langCode. Nodes refer to their parent by ParentCode
+func (uc *researchFieldUsecase) GetAll(ctx context.Context, langCode string) ([]*dtos.ResearchFieldResponse, error) {
+	researchFields, err := uc.researchFieldRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*dtos.ResearchFieldResponse, len(researchFields))
+	for index, researchField := range researchFields {
+		resp[index] = mappers.MapResearchFieldDomainToResponseDTO(researchField, langCode)
+	}
+
+	return resp, nil
+}
+
+// Upsert adds the node or updates an existing one. A node keeps its level, so it can only move
+// to another parent of the same level as before and the levels of its descendants stay valid
+func (uc *researchFieldUsecase) Upsert(ctx context.Context, req *dtos.UpsertResearchFieldRequest, langCode string) (*dtos.ResearchFieldResponse, error) {
+	req.Code = strings.TrimSpace(req.Code)
+	req.ParentCode = strings.TrimSpace(req.ParentCode)
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to save research field: %w", err))
+	}
+
+	if !researchFieldCodePattern.MatchString(req.Code) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - Code(%s) of research field is not a dotted code", req.Code))
+	}
+
+	researchField := &domain.ResearchField{
+		Code:       req.Code,
+		ParentCode: req.ParentCode,
+		Level:      domain.ResearchFieldLevelDomain,
+		SortOrder:  req.SortOrder,
+		Names:      req.Names,
+	}
+
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txResearchFieldRepo := postgres.NewPgResearchFieldRepositoryWithQuery(q)
+
+		if researchField.ParentCode != "" {
+			parent, err := txResearchFieldRepo.GetByCode(ctx, researchField.ParentCode)
+			if err != nil {
+				if custom_errors.IsNotFound(err) {
+					return custom_errors.BadRequest(fmt.Errorf("parent(%s) of research field(%s) does not exist", researchField.ParentCode, researchField.Code))
+				}
+
+				return err
+			}
+
+			level, ok := researchFieldChildLevels[parent.Level]
+			if !ok {
+				return custom_errors.BadRequest(fmt.Errorf("parent(%s) of research field(%s) is a %s and can not have children", parent.Code, researchField.Code, parent.Level))
+			}
+
+			researchField.Level = level
+		}
+
+		existing, err := txResearchFieldRepo.GetByCode(ctx, researchField.Code)
+		if err != nil && !custom_errors.IsNotFound(err) {
+			return err
+		}
+
+		if existing != nil && existing.Level != researchField.Level {
+			return custom_errors.BadRequest(fmt.Errorf("research field(%s) is a %s and can not become a %s", researchField.Code, existing.Level, researchField.Level))
+		}
+
+		_, err = txResearchFieldRepo.Upsert(ctx, researchField)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	researchFields, err := uc.researchFieldRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, saved := range researchFields {
+		if saved.Code == researchField.Code {
+			return mappers.MapResearchFieldDomainToResponseDTO(saved, langCode), nil
+		}
+	}
+
+	return mappers.MapResearchFieldDomainToResponseDTO(researchField, langCode), nil
+}
+
+// GetStats counts the researchers and institutions per node, narrowed to the level and the parent when they are given
+func (uc *researchFieldUsecase) GetStats(ctx context.Context, level string, parentCode string, langCode string) ([]*dtos.ResearchFieldStatsResponse, error) {
+	level = strings.TrimSpace(level)
+	parentCode = strings.TrimSpace(parentCode)
+	switch level {
+	case "", domain.ResearchFieldLevelDomain, domain.ResearchFieldLevelField, domain.ResearchFieldLevelSubfield:
+	default:
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - Level(%s) to retrive research field stats", level))
+	}
+
+	if parentCode != "" {
+		exists, err := uc.researchFieldRepo.Exists(ctx, parentCode)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, custom_errors.NotFound(fmt.Errorf("research field(%s) does not exist", parentCode))
+		}
+	}
+
+	stats, err := uc.researchFieldRepo.GetStats(ctx, level, parentCode)
+	if err != nil {
+		return nil, err
+	}
+
+	researchFields, err := uc.researchFieldRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	namesByCode := make(map[string]map[string]string, len(researchFields))
+	for _, researchField := range researchFields {
+		namesByCode[researchField.Code] = researchField.Names
+	}
+
+	resp := make([]*dtos.ResearchFieldStatsResponse, len(stats))
+	for index, stat := range stats {
+		resp[index] = mappers.MapResearchFieldStatsDomainToResponseDTO(stat, namesByCode[stat.Code], langCode)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,152 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/mappers"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type SpecialityUsecase interface {
+	GetChildren(ctx context.Context, parentCode string, langCode string) ([]*dtos.SpecialityResponse, error)
+	Import(ctx context.Context, req *dtos.ImportSpecialitiesRequest) (*dtos.ImportSpecialitiesResponse, error)
+}
+
+type specialityUsecase struct {
+	specialityRepo repositories.SpecialityRepository
+	store          *postgres.Store
+	validator      *validator.Validate
+}
+
+func NewSpecialityUsecase(
+	specialityRepo repositories.SpecialityRepository,
+	store *postgres.Store,
+	validator *validator.Validate,
+) SpecialityUsecase {
+	return &specialityUsecase{
+		specialityRepo: specialityRepo,
+		store:          store,
+		validator:      validator,
+	}
+}
+
+// GetChildren lists the children of the classifier node, top level nodes when parentCode is empty
+func (uc *specialityUsecase) GetChildren(ctx context.Context, parentCode string, langCode string) ([]*dtos.SpecialityResponse, error) {
+	parentCode = strings.TrimSpace(parentCode)
+	if parentCode != "" {
+		exists, err := uc.specialityRepo.Exists(ctx, parentCode)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, custom_errors.NotFound(fmt.Errorf("speciality(%s) does not exist", parentCode))
+		}
+	}
+
+	specialities, err := uc.specialityRepo.GetByParentCode(ctx, parentCode)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*dtos.SpecialityResponse, len(specialities))
+	for index, speciality := range specialities {
+		resp[index] = mappers.MapSpecialityDomainToResponseDTO(speciality, langCode)
+	}
+
+	return resp, nil
+}
+
+// Import inserts or updates the nodes of the classifier in one transaction, nodes missing from the request are kept
+// since degrees may still refer to them. Parents are saved before their children whatever the order of the request
+func (uc *specialityUsecase) Import(ctx context.Context, req *dtos.ImportSpecialitiesRequest) (*dtos.ImportSpecialitiesResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to import specialities: %w", err))
+	}
+
+	existingSpecialities, err := uc.specialityRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// parent of every node as it will be after the import
+	parentCodes := make(map[string]string, len(existingSpecialities)+len(req.Specialities))
+	for _, speciality := range existingSpecialities {
+		parentCodes[speciality.Code] = speciality.ParentCode
+	}
+
+	imported := make(map[string]bool, len(req.Specialities))
+	for _, speciality := range req.Specialities {
+		if imported[speciality.Code] {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to import specialities: speciality(%s) is listed more than once", speciality.Code))
+		}
+
+		imported[speciality.Code] = true
+		parentCodes[speciality.Code] = speciality.ParentCode
+	}
+
+	depths := make(map[string]int, len(req.Specialities))
+	for _, speciality := range req.Specialities {
+		depth := 0
+		for code := speciality.ParentCode; code != ""; code = parentCodes[code] {
+			if _, ok := parentCodes[code]; !ok {
+				return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to import specialities: parent(%s) of speciality(%s) does not exist", code, speciality.Code))
+			}
+
+			depth++
+			if depth > len(parentCodes) {
+				return nil, custom_errors.BadRequest(fmt.Errorf("invalid input to import specialities: speciality(%s) is its own ancestor", speciality.Code))
+			}
+		}
+
+		depths[speciality.Code] = depth
+	}
+
+	specialities := make([]*dtos.ImportSpecialityRequest, len(req.Specialities))
+	copy(specialities, req.Specialities)
+	sort.SliceStable(specialities, func(i, j int) bool {
+		return depths[specialities[i].Code] < depths[specialities[j].Code]
+	})
+
+	resp := &dtos.ImportSpecialitiesResponse{}
+	err = uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		specialityRepo := postgres.NewPgSpecialityRepositoryWithQuery(q)
+		for _, speciality := range specialities {
+			names := make(map[string]string, len(speciality.Names))
+			for languageCode, name := range speciality.Names {
+				names[languageCode] = strings.TrimSpace(name)
+			}
+
+			isCreated, err := specialityRepo.Upsert(ctx, &domain.Speciality{
+				Code:       speciality.Code,
+				ParentCode: speciality.ParentCode,
+				Names:      names,
+			})
+			if err != nil {
+				return err
+			}
+
+			if isCreated {
+				resp.Created++
+			} else {
+				resp.Updated++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
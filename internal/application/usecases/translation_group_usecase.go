@@ -0,0 +1,155 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
+	"context"
+	"fmt"
+	"slices"
+)
+
+type TranslationGroupUsecase interface {
+	GetMissingByEmployeeID(ctx context.Context, employeeID int64) ([]*dtos.MissingTranslationResponse, error)
+	GetMissingByInstitutionID(ctx context.Context, institutionID int64) ([]*dtos.MissingTranslationResponse, error)
+}
+
+type translationGroupUsecase struct {
+	employeeRepo         repositories.EmployeeRepository
+	institutionRepo      repositories.InstitutionRepository
+	translationGroupRepo repositories.TranslationGroupRepository
+}
+
+func NewTranslationGroupUsecase(
+	employeeRepo repositories.EmployeeRepository,
+	institutionRepo repositories.InstitutionRepository,
+	translationGroupRepo repositories.TranslationGroupRepository,
+) TranslationGroupUsecase {
+	return &translationGroupUsecase{
+		employeeRepo:         employeeRepo,
+		institutionRepo:      institutionRepo,
+		translationGroupRepo: translationGroupRepo,
+	}
+}
+
+func (uc *translationGroupUsecase) GetMissingByEmployeeID(ctx context.Context, employeeID int64) ([]*dtos.MissingTranslationResponse, error) {
+	if employeeID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - EmployeeID(%d) to retrive missing translations", employeeID))
+	}
+
+	employee, err := uc.employeeRepo.GetByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// titles of every section are listed whatever their visibility, so only those who maintain the profile see them
+	if err := uc.authorizeEmployee(ctx, employee); err != nil {
+		return nil, err
+	}
+
+	translationGroups, err := uc.translationGroupRepo.GetByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapMissingTranslations(translationGroups), nil
+}
+
+func (uc *translationGroupUsecase) GetMissingByInstitutionID(ctx context.Context, institutionID int64) ([]*dtos.MissingTranslationResponse, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - InstitutionID(%d) to retrive missing translations", institutionID))
+	}
+
+	if err := uc.authorizeInstitution(ctx, institutionID); err != nil {
+		return nil, err
+	}
+
+	translationGroups, err := uc.translationGroupRepo.GetByInstitutionID(ctx, institutionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapMissingTranslations(translationGroups), nil
+}
+
+// authorizeEmployee lets the employee, a global admin or an admin of the institution the employee works in see the missing translations
+func (uc *translationGroupUsecase) authorizeEmployee(ctx context.Context, employee *domain.Employee) error {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return custom_errors.Unauthorized(fmt.Errorf("user is not authenticated"))
+	}
+
+	if employee.UserID == userID {
+		return nil
+	}
+
+	if role, _ := middleware.GetUserRoleFromContext(ctx); role == domain.UserRoleAdmin {
+		return nil
+	}
+
+	if employee.CurrentInstitutionID != 0 {
+		isAdmin, err := uc.institutionRepo.IsAdmin(ctx, employee.CurrentInstitutionID, userID)
+		if err != nil {
+			return err
+		}
+		if isAdmin {
+			return nil
+		}
+	}
+
+	return custom_errors.Forbidden(fmt.Errorf("user(%d) is not allowed to see missing translations of employee(%d)", userID, employee.ID))
+}
+
+// authorizeInstitution lets a global admin or an admin of the institution see the missing translations
+func (uc *translationGroupUsecase) authorizeInstitution(ctx context.Context, institutionID int64) error {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return custom_errors.Unauthorized(fmt.Errorf("user is not authenticated"))
+	}
+
+	if role, _ := middleware.GetUserRoleFromContext(ctx); role == domain.UserRoleAdmin {
+		return nil
+	}
+
+	isAdmin, err := uc.institutionRepo.IsAdmin(ctx, institutionID, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return custom_errors.Forbidden(fmt.Errorf("user(%d) is not allowed to see missing translations of institution(%d)", userID, institutionID))
+	}
+
+	return nil
+}
+
+// mapMissingTranslations keeps groups lacking any of the enabled languages, every entry is expected to be filled in all of them
+func mapMissingTranslations(translationGroups []*domain.TranslationGroup) []*dtos.MissingTranslationResponse {
+	enabledLanguageCodes := languages.Codes()
+	resp := []*dtos.MissingTranslationResponse{}
+	for _, translationGroup := range translationGroups {
+		missingLanguageCodes := []string{}
+		for _, langCode := range enabledLanguageCodes {
+			if !slices.Contains(translationGroup.LanguageCodes, langCode) {
+				missingLanguageCodes = append(missingLanguageCodes, langCode)
+			}
+		}
+
+		if len(missingLanguageCodes) == 0 {
+			continue
+		}
+
+		resp = append(resp, &dtos.MissingTranslationResponse{
+			Resource:               translationGroup.Resource,
+			TranslationGroupID:     translationGroup.ID,
+			RecordID:               translationGroup.RecordID,
+			Title:                  translationGroup.Title,
+			AvailableLanguageCodes: translationGroup.LanguageCodes,
+			MissingLanguageCodes:   missingLanguageCodes,
+		})
+	}
+
+	return resp
+}
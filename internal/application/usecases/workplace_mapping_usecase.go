@@ -0,0 +1,276 @@
+package usecases
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/infrastructure/persistence/postgres"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/mappers"
+	"backend/internal/shared/reconciliation"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DefaultWorkplaceClusterThreshold is the similarity at which spellings are considered to name the same employer
+const DefaultWorkplaceClusterThreshold = 0.6
+
+type WorkplaceMappingUsecase interface {
+	GetClusters(ctx context.Context, params *dtos.WorkplaceClustersQueryParameters) ([]*dtos.WorkplaceClusterResponse, error)
+	Apply(ctx context.Context, req *dtos.ApplyWorkplaceMappingRequest) (*dtos.WorkplaceMappingResponse, error)
+	Undo(ctx context.Context, batchID int64) (*dtos.UndoWorkplaceMappingResponse, error)
+	ListBatches(ctx context.Context, page, limit int64) ([]*dtos.WorkplaceMappingBatchResponse, error)
+}
+
+type workplaceMappingUsecase struct {
+	workplaceMappingRepo repositories.WorkplaceMappingRepository
+	store                *postgres.Store
+	validator            *validator.Validate
+}
+
+func NewWorkplaceMappingUsecase(
+	workplaceMappingRepo repositories.WorkplaceMappingRepository,
+	store *postgres.Store,
+	validator *validator.Validate,
+) WorkplaceMappingUsecase {
+	return &workplaceMappingUsecase{
+		workplaceMappingRepo: workplaceMappingRepo,
+		store:                store,
+		validator:            validator,
+	}
+}
+
+// GetClusters groups distinct spellings of workplaces by similarity, largest clusters first,
+// and suggests the institution with the most similar title for each of them
+func (uc *workplaceMappingUsecase) GetClusters(ctx context.Context, params *dtos.WorkplaceClustersQueryParameters) ([]*dtos.WorkplaceClusterResponse, error) {
+	if params.Threshold == 0 {
+		params.Threshold = DefaultWorkplaceClusterThreshold
+	}
+	if err := uc.validator.Struct(params); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid parameters to cluster workplaces: %w", err))
+	}
+
+	variants, err := uc.workplaceMappingRepo.ListWorkplaceVariants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	institutionTitles, err := uc.workplaceMappingRepo.ListInstitutionTitles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	titles := []string{}
+	titleInstitutionIDs := []int64{}
+	for _, details := range institutionTitles {
+		for _, title := range []string{details.InstitutionTitleShort, details.InstitutionTitleLong} {
+			if strings.TrimSpace(title) == "" {
+				continue
+			}
+			titles = append(titles, title)
+			titleInstitutionIDs = append(titleInstitutionIDs, details.InstitutionID)
+		}
+	}
+	matcher := reconciliation.NewMatcher(titles)
+
+	workplaces := make([]string, len(variants))
+	for index, variant := range variants {
+		workplaces[index] = variant.Workplace
+	}
+
+	clusters := []*domain.WorkplaceCluster{}
+	for _, indexes := range reconciliation.Cluster(workplaces, params.Threshold) {
+		cluster := &domain.WorkplaceCluster{}
+		var mostFrequent *domain.WorkplaceVariant
+		for _, index := range indexes {
+			variant := variants[index]
+			cluster.Variants = append(cluster.Variants, variant)
+			cluster.ExperienceCount += variant.ExperienceCount
+			cluster.LinkedCount += variant.LinkedCount
+			if mostFrequent == nil || variant.ExperienceCount > mostFrequent.ExperienceCount {
+				mostFrequent = variant
+			}
+		}
+
+		if params.UnlinkedOnly && cluster.LinkedCount == cluster.ExperienceCount {
+			continue
+		}
+
+		cluster.Key = reconciliation.Normalize(mostFrequent.Workplace)
+		sort.SliceStable(cluster.Variants, func(i, j int) bool {
+			return cluster.Variants[i].ExperienceCount > cluster.Variants[j].ExperienceCount
+		})
+
+		if titleIndex, score := matcher.Match(mostFrequent.Workplace); titleIndex >= 0 && score >= params.Threshold {
+			cluster.SuggestedInstitutionID = titleInstitutionIDs[titleIndex]
+			cluster.SuggestionScore = score
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].ExperienceCount > clusters[j].ExperienceCount
+	})
+
+	resp := make([]*dtos.WorkplaceClusterResponse, len(clusters))
+	for index, cluster := range clusters {
+		resp[index] = mappers.MapWorkplaceClusterDomainToResponseDTO(cluster)
+	}
+
+	return resp, nil
+}
+
+// Apply links work experiences spelled as one of the workplaces, and their translations, to the institution.
+// Changes are recorded as a batch of the undo log, a dry run only lists them
+func (uc *workplaceMappingUsecase) Apply(ctx context.Context, req *dtos.ApplyWorkplaceMappingRequest) (*dtos.WorkplaceMappingResponse, error) {
+	if err := uc.validator.Struct(req); err != nil {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid request body to map workplaces: %w", err))
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, custom_errors.Unauthorized(fmt.Errorf("user is not authenticated"))
+	}
+
+	workplaces := []string{}
+	seen := map[string]bool{}
+	for _, workplace := range req.Workplaces {
+		workplace = strings.TrimSpace(workplace)
+		if workplace == "" || seen[workplace] {
+			continue
+		}
+		seen[workplace] = true
+		workplaces = append(workplaces, workplace)
+	}
+	if len(workplaces) == 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid request body to map workplaces: no workplace provided"))
+	}
+
+	resp := &dtos.WorkplaceMappingResponse{
+		DryRun:  req.DryRun,
+		Changes: []*dtos.WorkplaceMappingChangeResponse{},
+	}
+
+	if req.DryRun {
+		changes, err := uc.workplaceMappingRepo.GetCandidates(ctx, workplaces, req.InstitutionID, req.Overwrite)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, change := range changes {
+			resp.Changes = append(resp.Changes, mappers.MapWorkplaceMappingChangeDomainToResponseDTO(change))
+		}
+
+		return resp, nil
+	}
+
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txWorkplaceMappingRepo := postgres.NewPgWorkplaceMappingRepositoryWithQuery(q)
+
+		changes, err := txWorkplaceMappingRepo.GetCandidates(ctx, workplaces, req.InstitutionID, req.Overwrite)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+
+		batch, err := txWorkplaceMappingRepo.Apply(ctx, &domain.WorkplaceMappingBatch{
+			InstitutionID: req.InstitutionID,
+			Workplaces:    workplaces,
+			Overwrite:     req.Overwrite,
+			CreatedBy:     userID,
+		}, changes)
+		if err != nil {
+			return err
+		}
+
+		resp.Batch = mappers.MapWorkplaceMappingBatchDomainToResponseDTO(batch)
+		for _, change := range changes {
+			resp.Changes = append(resp.Changes, mappers.MapWorkplaceMappingChangeDomainToResponseDTO(change))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Undo restores institutions the batch changed. Work experiences relinked since then are skipped
+func (uc *workplaceMappingUsecase) Undo(ctx context.Context, batchID int64) (*dtos.UndoWorkplaceMappingResponse, error) {
+	if batchID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid input - ID(%d) to undo workplace mapping", batchID))
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, custom_errors.Unauthorized(fmt.Errorf("user is not authenticated"))
+	}
+
+	var resp *dtos.UndoWorkplaceMappingResponse
+	err := uc.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		txWorkplaceMappingRepo := postgres.NewPgWorkplaceMappingRepositoryWithQuery(q)
+
+		batch, err := txWorkplaceMappingRepo.GetBatchByIDForUpdate(ctx, batchID)
+		if err != nil {
+			return err
+		}
+		if !batch.UndoneAt.IsZero() {
+			return custom_errors.BadRequest(fmt.Errorf("workplace mapping(%d) is already undone", batchID))
+		}
+
+		restored, err := txWorkplaceMappingRepo.Undo(ctx, batchID, userID)
+		if err != nil {
+			return err
+		}
+
+		batch, err = txWorkplaceMappingRepo.GetBatchByIDForUpdate(ctx, batchID)
+		if err != nil {
+			return err
+		}
+
+		resp = &dtos.UndoWorkplaceMappingResponse{
+			Batch:         mappers.MapWorkplaceMappingBatchDomainToResponseDTO(batch),
+			RestoredCount: restored,
+			SkippedCount:  batch.ChangeCount - restored,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (uc *workplaceMappingUsecase) ListBatches(ctx context.Context, page, limit int64) ([]*dtos.WorkplaceMappingBatchResponse, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+
+	batches, err := uc.workplaceMappingRepo.ListBatches(ctx, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*dtos.WorkplaceMappingBatchResponse, len(batches))
+	for index, batch := range batches {
+		resp[index] = mappers.MapWorkplaceMappingBatchDomainToResponseDTO(batch)
+	}
+
+	return resp, nil
+}
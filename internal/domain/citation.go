@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// PublicationCitation is the citation count of a shared publication,
+// Source is "manual" when entered by an employee or the name of the registry it was imported from
+type PublicationCitation struct {
+	PublicationID int64
+	CitationCount int32
+	Source        string
+	UpdatedAt     time.Time
+}
+
+// EmployeeCitationMetrics are bibliometric indicators computed over the employee's publications
+type EmployeeCitationMetrics struct {
+	EmployeeID     int64
+	HIndex         int32
+	I10Index       int32
+	TotalCitations int32
+	UpdatedAt      time.Time
+}
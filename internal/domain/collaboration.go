@@ -0,0 +1,27 @@
+package domain
+
+// Kinds of shared work connecting two employees in the collaboration network
+const (
+	CollaborationKindPublication = "publication"
+	CollaborationKindProject     = "project"
+	CollaborationKindEvent       = "event"
+	CollaborationKindCommunity   = "community"
+)
+
+// CollaborationLink counts the items of one kind an employee shares with a collaborator
+type CollaborationLink struct {
+	EmployeeID     int64
+	CollaboratorID int64
+	Kind           string
+	Weight         int32
+}
+
+type CollaborationNode struct {
+	EmployeeID           int64
+	UniqueID             string
+	Surname              string
+	Name                 string
+	Middlename           string
+	CurrentWorkplace     string
+	CurrentInstitutionID int64
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// ConsentDocument is the text of a version of the terms of publishing personal data in one language
+type ConsentDocument struct {
+	ID           int64
+	Version      int32
+	LanguageCode string
+	Title        string
+	Body         string
+	PublishedAt  time.Time
+}
+
+// UserConsent records the acceptance of a version of the terms by a user,
+// LanguageCode is the language the terms were read in
+type UserConsent struct {
+	ID               int64
+	UserID           int64
+	Email            string
+	EmployeeUniqueID string
+	DocumentVersion  int32
+	LanguageCode     string
+	IPAddress        string
+	AcceptedAt       time.Time
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// Codes of the degree levels seeded with the vocabulary
+const (
+	DegreeLevelBachelor           = "bachelor"
+	DegreeLevelSpecialist         = "specialist"
+	DegreeLevelMaster             = "master"
+	DegreeLevelInternship         = "internship"
+	DegreeLevelResidency          = "residency"
+	DegreeLevelCandidateOfScience = "candidate_of_science"
+	DegreeLevelPhD                = "phd"
+	DegreeLevelDoctorOfScience    = "doctor_of_science"
+)
+
+// DegreeLevel is an entry of the controlled vocabulary of academic degree levels.
+// Code is stable and language independent, levels of a higher Rank come after the lower ones
+type DegreeLevel struct {
+	Code string
+	Rank int32
+	// Names of the level by language code
+	Names     map[string]string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// HighestAcademicDegree is a distinct highest academic degree of employees named for display,
+// free text entered before the vocabulary existed is its own Code and Name
+type HighestAcademicDegree struct {
+	Code string
+	Name string
+}
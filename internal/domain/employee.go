@@ -3,13 +3,18 @@ package domain
 import "time"
 
 type Employee struct {
-	ID        int64
-	UserID    int64
-	UniqueID  string
-	Gender    string
-	Tin       string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID       int64
+	UserID   int64
+	UniqueID string
+	Gender   string
+	Tin      string
+	ORCID    string
+	// CurrentInstitutionID and CurrentOrgUnitID are the institution and its unit of the latest ongoing work experience,
+	// zero when it is not linked to one
+	CurrentInstitutionID int64
+	CurrentOrgUnitID     int64
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
 
 	Degrees                                []EmployeeDegree
 	Details                                []*EmployeeDetails
@@ -0,0 +1,61 @@
+package domain
+
+// Items of the profile completeness checklist
+const (
+	CompletenessItemDetails               = "details"
+	CompletenessItemDegree                = "degree"
+	CompletenessItemCurrentWork           = "current_work"
+	CompletenessItemMainResearchArea      = "main_research_area"
+	CompletenessItemKeyTopic              = "key_topic"
+	CompletenessItemPublication           = "publication"
+	CompletenessItemResearchActivity      = "research_activity"
+	CompletenessItemScientificAward       = "scientific_award"
+	CompletenessItemPatent                = "patent"
+	CompletenessItemProfessionalCommunity = "professional_community"
+	CompletenessItemRefresherCourse       = "refresher_course"
+	CompletenessItemEvent                 = "event"
+	CompletenessItemSocial                = "social"
+	CompletenessItemGender                = "gender"
+	CompletenessItemOrcid                 = "orcid"
+)
+
+// Reasons an employee is left out of the personnel listing in a language
+const (
+	ListingReasonNoDetails               = "no_details"
+	ListingReasonNoSocials               = "no_socials"
+	ListingReasonNoHighestAcademicDegree = "no_highest_academic_degree"
+	ListingReasonNoSpeciality            = "no_speciality"
+	ListingReasonNoCurrentWorkplace      = "no_current_workplace"
+	ListingReasonNoConsent               = "no_consent"
+)
+
+// EmployeeCompletenessCounts counts the filled sections of an employee in one language,
+// socials, gender, ORCID, consent and the denormalized fields do not depend on the language
+type EmployeeCompletenessCounts struct {
+	EmployeeID                 int64
+	UniqueID                   string
+	LanguageCode               string
+	Surname                    string
+	Name                       string
+	Middlename                 string
+	HasDetails                 bool
+	DegreeCount                int64
+	WorkExperienceCount        int64
+	OngoingWorkExperienceCount int64
+	MainResearchAreaCount      int64
+	KeyTopicCount              int64
+	PublicationCount           int64
+	ScientificAwardCount       int64
+	PatentCount                int64
+	ProfessionalCommunityCount int64
+	RefresherCourseCount       int64
+	EventCount                 int64
+	ResearchActivityCount      int64
+	SocialCount                int64
+	HasGender                  bool
+	HasOrcid                   bool
+	HasHighestAcademicDegree   bool
+	HasSpeciality              bool
+	HasCurrentWorkplace        bool
+	HasConsent                 bool
+}
@@ -6,9 +6,15 @@ type EmployeeDegree struct {
 	ID                 int64
 	EmployeeID         int64
 	LanguageCode       string
+	TranslationGroupID string
+	// DegreeLevelCode is a code of domain.DegreeLevel, DegreeLevel is its name in the language of the entry
+	// or the free text of an entry made before the vocabulary existed.
+	// SpecialityCode links Speciality to a node of the speciality classifier, empty when it is not linked
+	DegreeLevelCode    string
 	DegreeLevel        string
 	UniversityName     string
 	Speciality         string
+	SpecialityCode     string
 	DateStart          time.Time
 	DateEnd            time.Time
 	GivenBy            string
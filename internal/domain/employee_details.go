@@ -2,6 +2,8 @@ package domain
 
 import "time"
 
+// EmployeeDetails is a name of the employee in a language. IsEmployeeDetailsNew marks the current name,
+// the others are previous names used between EffectiveFrom and EffectiveTo, zero dates being unknown
 type EmployeeDetails struct {
 	ID                   int64
 	EmployeeID           int64
@@ -10,6 +12,8 @@ type EmployeeDetails struct {
 	Name                 string
 	Middlename           string
 	IsEmployeeDetailsNew bool
+	EffectiveFrom        time.Time
+	EffectiveTo          time.Time
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 }
@@ -3,28 +3,32 @@ package domain
 import "time"
 
 type EmployeeMainResearchArea struct {
-	ID           int64
-	EmployeeID   int64
-	LanguageCode string
-	Area         string
-	Discipline   string
-	KeyTopics    []*ResearchAreaKeyTopic
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID                 int64
+	EmployeeID         int64
+	LanguageCode       string
+	TranslationGroupID string
+	Area               string
+	Discipline         string
+	ResearchFieldCode  string
+	KeyTopics          []*ResearchAreaKeyTopic
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
 
 type ResearchAreaKeyTopic struct {
 	ID                         int64
 	EmployeeMainResearchAreaID int64
+	LanguageCode               string
 	KeyTopicTitle              string
+	KeywordID                  int64
 	CreatedAt                  time.Time
 	UpdatedAt                  time.Time
 }
 
-func(d *ResearchAreaKeyTopic) GetID() int64 {
-  return d.ID
+func (d *ResearchAreaKeyTopic) GetID() int64 {
+	return d.ID
 }
 
-func(d *ResearchAreaKeyTopic) IsNew() bool {
-  return d.ID == 0
+func (d *ResearchAreaKeyTopic) IsNew() bool {
+	return d.ID == 0
 }
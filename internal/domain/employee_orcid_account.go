@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Sources of imported employee records
+const (
+	SourceManual = "manual"
+	SourceORCID  = "orcid"
+)
+
+type EmployeeOrcidAccount struct {
+	ID           int64
+	EmployeeID   int64
+	ORCID        string
+	AccessToken  string
+	RefreshToken string
+	Scope        string
+	ExpiresAt    time.Time
+	LastSyncedAt time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -3,11 +3,12 @@ package domain
 import "time"
 
 type EmployeeParticipationInEvent struct {
-	ID                             int64
-	EmployeeID                     int64
-	LanguageCode                   string
-	EventTitle                     string
-	EventDate                      time.Time
-	CreatedAt                      time.Time
-	UpdatedAt                      time.Time
+	ID                 int64
+	EmployeeID         int64
+	LanguageCode       string
+	TranslationGroupID string
+	EventTitle         string
+	EventDate          time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
@@ -6,6 +6,7 @@ type EmployeeParticipationInProfessionalCommunity struct {
 	ID                          int64
 	EmployeeID                  int64
 	LanguageCode                string
+	TranslationGroupID          string
 	ProfessionalCommunityTitle  string
 	RoleInProfessionalCommunity string
 	CreatedAt                   time.Time
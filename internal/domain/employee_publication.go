@@ -3,11 +3,21 @@ package domain
 import "time"
 
 type EmployeePublication struct {
-	ID                int64
-	EmployeeID        int64
-	LanguageCode      string
-	PublicationTitle  string
-	LinkToPublication string
-	CreatedAt         time.Time
-	UpdatedAt         time.Time
+	ID                 int64
+	EmployeeID         int64
+	LanguageCode       string
+	TranslationGroupID string
+	PublicationTitle   string
+	LinkToPublication  string
+	Authors            []string
+	PublicationYear    int32
+	Venue              string
+	DOI                string
+	PublicationType    string
+	Source             string
+	ExternalID         string
+	PublicationID      int64
+	Citation           *PublicationCitation
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
@@ -6,6 +6,7 @@ type EmployeeResearchActivity struct {
 	ID                    int64
 	EmployeeID            int64
 	LanguageCode          string
+	TranslationGroupID    string
 	ResearchActivityTitle string
 	EmployeeRole          string
 	CreatedAt             time.Time
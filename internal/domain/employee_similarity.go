@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// EmployeeSimilarity is a researcher similar to an employee with the counts of what they share
+type EmployeeSimilarity struct {
+	EmployeeID            int64
+	UniqueID              string
+	Surname               string
+	Name                  string
+	Middlename            string
+	HighestAcademicDegree string
+	CurrentWorkplace      string
+	CurrentInstitutionID  int64
+	Score                 float64
+	SharedResearchAreas   int32
+	SharedKeywords        int32
+	SharedEvents          int32
+	SharedInstitutions    int32
+	ComputedAt            time.Time
+}
+
+// EmployeeSimilarityWeights weigh the kinds of overlap between two employees
+type EmployeeSimilarityWeights struct {
+	ResearchArea float64
+	Keyword      float64
+	Event        float64
+	Institution  float64
+}
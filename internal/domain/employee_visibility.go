@@ -0,0 +1,114 @@
+package domain
+
+// Visibility levels of profile sections and fields, from the widest audience to the narrowest.
+// A viewer reaching a level sees everything up to it: administrators also see what is shared with the institution
+const (
+	VisibilityPublic        = "public"
+	VisibilityAuthenticated = "authenticated"
+	VisibilityInstitution   = "institution"
+	VisibilityAdmin         = "admin"
+	VisibilityPrivate       = "private"
+)
+
+// VisibilityLevels are ordered from the widest audience to the narrowest
+var VisibilityLevels = []string{
+	VisibilityPublic,
+	VisibilityAuthenticated,
+	VisibilityInstitution,
+	VisibilityAdmin,
+	VisibilityPrivate,
+}
+
+// Sections of the profile employees choose visibility of
+const (
+	VisibilitySectionDetails                 = "details"
+	VisibilitySectionDegrees                 = "degrees"
+	VisibilitySectionWorkExperiences         = "work_experiences"
+	VisibilitySectionMainResearchAreas       = "main_research_areas"
+	VisibilitySectionPublications            = "publications"
+	VisibilitySectionScientificAwards        = "scientific_awards"
+	VisibilitySectionPatents                 = "patents"
+	VisibilitySectionProfessionalCommunities = "professional_communities"
+	VisibilitySectionRefresherCourses        = "refresher_courses"
+	VisibilitySectionEvents                  = "events"
+	VisibilitySectionResearchActivities      = "research_activities"
+	VisibilitySectionSocials                 = "socials"
+)
+
+// Fields of the profile employees choose visibility of, date of birth and phone are reserved for fields about to be stored
+const (
+	VisibilityFieldGender      = "gender"
+	VisibilityFieldORCID       = "orcid"
+	VisibilityFieldTIN         = "tin"
+	VisibilityFieldDateOfBirth = "date_of_birth"
+	VisibilityFieldPhone       = "phone"
+)
+
+// DefaultVisibility holds the level of every section and field an employee has not chosen one for,
+// personal identifiers are shown to nobody but the employee
+var DefaultVisibility = map[string]string{
+	VisibilitySectionDetails:                 VisibilityPublic,
+	VisibilitySectionDegrees:                 VisibilityPublic,
+	VisibilitySectionWorkExperiences:         VisibilityPublic,
+	VisibilitySectionMainResearchAreas:       VisibilityPublic,
+	VisibilitySectionPublications:            VisibilityPublic,
+	VisibilitySectionScientificAwards:        VisibilityPublic,
+	VisibilitySectionPatents:                 VisibilityPublic,
+	VisibilitySectionProfessionalCommunities: VisibilityPublic,
+	VisibilitySectionRefresherCourses:        VisibilityPublic,
+	VisibilitySectionEvents:                  VisibilityPublic,
+	VisibilitySectionResearchActivities:      VisibilityPublic,
+	VisibilitySectionSocials:                 VisibilityPublic,
+	VisibilityFieldGender:                    VisibilityPublic,
+	VisibilityFieldORCID:                     VisibilityPublic,
+	VisibilityFieldTIN:                       VisibilityPrivate,
+	VisibilityFieldDateOfBirth:               VisibilityPrivate,
+	VisibilityFieldPhone:                     VisibilityPrivate,
+}
+
+// EmployeeVisibility holds the levels chosen by the employee keyed by section or field,
+// Access is the narrowest level the current viewer reaches, it depends on the current institution of the employee
+type EmployeeVisibility struct {
+	EmployeeID    int64
+	InstitutionID int64
+	Levels        map[string]string
+	Access        string
+}
+
+// LevelOf returns the level chosen for the target or its default
+func (v *EmployeeVisibility) LevelOf(target string) string {
+	if level, ok := v.Levels[target]; ok {
+		return level
+	}
+
+	if level, ok := DefaultVisibility[target]; ok {
+		return level
+	}
+
+	return VisibilityPrivate
+}
+
+// Allows reports whether the viewer sees the target, an unknown access is the one of the public
+func (v *EmployeeVisibility) Allows(target string) bool {
+	access, ok := visibilityRank(v.Access)
+	if !ok {
+		access = 0
+	}
+
+	level, ok := visibilityRank(v.LevelOf(target))
+	if !ok {
+		level = len(VisibilityLevels) - 1
+	}
+
+	return level <= access
+}
+
+func visibilityRank(level string) (int, bool) {
+	for rank, l := range VisibilityLevels {
+		if l == level {
+			return rank, true
+		}
+	}
+
+	return 0, false
+}
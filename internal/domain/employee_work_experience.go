@@ -3,15 +3,22 @@ package domain
 import "time"
 
 type EmployeeWorkExperience struct {
-	ID           int64
-	EmployeeID   int64
-	LanguageCode string
-	Workplace    string
-	JobTitle     string
-	Description  string
-	DateStart    time.Time
-	DateEnd      time.Time
-	Ongoing      bool
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID                 int64
+	EmployeeID         int64
+	LanguageCode       string
+	TranslationGroupID string
+	// InstitutionID links the workplace to an institution of the directory, zero for foreign or unlisted employers.
+	// OrgUnitID is the unit of the institution, zero when it is not specified
+	InstitutionID int64
+	OrgUnitID     int64
+	Workplace     string
+	JobTitle      string
+	Description   string
+	DateStart     time.Time
+	DateEnd       time.Time
+	Ongoing       bool
+	Source        string
+	ExternalID    string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
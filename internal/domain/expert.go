@@ -0,0 +1,40 @@
+package domain
+
+// Sections of a profile the expert search matches against
+const (
+	ExpertSectionResearchArea = "research_area"
+	ExpertSectionKeyTopic     = "key_topic"
+	ExpertSectionPublication  = "publication"
+	ExpertSectionPatent       = "patent"
+	ExpertSectionProject      = "project"
+	ExpertSectionDegree       = "degree"
+)
+
+// ExpertSearchFilter narrows the expert search, empty values are ignored
+type ExpertSearchFilter struct {
+	Terms           []string
+	LanguageCode    string
+	InstitutionID   int64
+	DegreeLevelCode string
+}
+
+// ExpertMatch is a profile item containing some of the searched terms.
+// ItemKey is shared by the translations of the item
+type ExpertMatch struct {
+	EmployeeID   int64
+	Section      string
+	ItemKey      string
+	Title        string
+	MatchedTerms []string
+}
+
+type ExpertProfile struct {
+	EmployeeID            int64
+	UniqueID              string
+	Surname               string
+	Name                  string
+	Middlename            string
+	HighestAcademicDegree string
+	CurrentWorkplace      string
+	CurrentInstitutionID  int64
+}
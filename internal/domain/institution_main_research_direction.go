@@ -3,12 +3,14 @@ package domain
 import "time"
 
 type InstitutionMainResearchDirection struct {
-	ID                      int64
-	InstitutionID           int64
-	LanguageCode            string
+	ID                     int64
+	InstitutionID          int64
+	LanguageCode           string
+	TranslationGroupID     string
 	ResearchDirectionTitle string
-	Discipline              string
-	AreaOfResearch          string
-	CreatedAt               time.Time
-	UpdatedAt               time.Time
+	Discipline             string
+	AreaOfResearch         string
+	ResearchFieldCode      string
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
 }
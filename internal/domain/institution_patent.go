@@ -3,14 +3,15 @@ package domain
 import "time"
 
 type InstitutionPatent struct {
-	ID               int64
-	InstitutionID    int64
-	LanguageCode     string
-	PatentTitle      string
-	Discipline       string
-	Description      string
-	ImplementedIn    string
-	LinkToPatentFile string
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	ID                 int64
+	InstitutionID      int64
+	LanguageCode       string
+	TranslationGroupID string
+	PatentTitle        string
+	Discipline         string
+	Description        string
+	ImplementedIn      string
+	LinkToPatentFile   string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
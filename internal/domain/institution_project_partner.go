@@ -9,6 +9,7 @@ type InstitutionProjectPartner struct {
 	PartnerName          string
 	LinkToPartner        string
 	LanguageCode         string
+	TranslationGroupID   string
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 }
@@ -6,6 +6,7 @@ type InstitutionRanking struct {
 	ID                  int64
 	InstitutionID       int64
 	LanguageCode        string
+	TranslationGroupID  string
 	RankingTitle        string
 	RankingType         string
 	DateReceived        time.Time
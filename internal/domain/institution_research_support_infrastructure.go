@@ -6,6 +6,7 @@ type InstitutionResearchSupportInfrastructure struct {
 	ID                                 int64
 	InstitutionID                      int64
 	LanguageCode                       string
+	TranslationGroupID                 string
 	ResearchSupportInfrastructureTitle string
 	ResearchSupportInfrastructureType  string
 	TINOfLegalEntity                   string
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// Keyword is an entry of the global index of research topics that key topics refer to.
+// Labels holds the preferred label by language code, Synonyms the other labels, mostly left by merges
+type Keyword struct {
+	ID            int64
+	Labels        map[string]string
+	Synonyms      map[string][]string
+	EmployeeCount int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// Language is an entry of the language registry, Code is a lower-case BCP 47 tag(e.g. "tg", "tg-latn").
+// Content is accepted and served only in enabled languages, the default one is served when the client has no preference
+type Language struct {
+	Code       string
+	Name       string
+	NativeName string
+	Enabled    bool
+	IsDefault  bool
+	SortOrder  int32
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
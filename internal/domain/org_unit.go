@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// Types of organizational units
+const (
+	OrgUnitTypeFaculty    = "faculty"
+	OrgUnitTypeDepartment = "department"
+	OrgUnitTypeLaboratory = "laboratory"
+	OrgUnitTypeInstitute  = "institute"
+	OrgUnitTypeCenter     = "center"
+	OrgUnitTypeDivision   = "division"
+	OrgUnitTypeOther      = "other"
+)
+
+// OrgUnit is a faculty, department, laboratory or alike inside of an institution.
+// Units form a tree per institution, ParentID is zero for top level units
+type OrgUnit struct {
+	ID            int64
+	InstitutionID int64
+	ParentID      int64
+	UnitType      string
+	SortOrder     int32
+	// Names of the unit by language code
+	Names     map[string]string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Children []*OrgUnit
+}
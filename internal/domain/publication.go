@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// Publication is the canonical record of a paper shared by all of its co-authors
+type Publication struct {
+	ID                int64
+	PublicationTitle  string
+	LinkToPublication string
+	PublicationYear   int32
+	Venue             string
+	DOI               string
+	PublicationType   string
+	Authors           []*PublicationAuthor
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// PublicationAuthor is an entry of the author list, EmployeeID is set once the author is claimed by an employee
+type PublicationAuthor struct {
+	ID               int64
+	PublicationID    int64
+	Position         int32
+	AuthorName       string
+	EmployeeID       int64
+	EmployeeUniqueID string
+	ClaimedAt        time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
@@ -0,0 +1,15 @@
+package domain
+
+// PublicationMetadata is the bibliographic record of a publication
+// resolved from an external registry by its DOI
+type PublicationMetadata struct {
+	DOI               string
+	PublicationTitle  string
+	LinkToPublication string
+	Authors           []string
+	PublicationYear   int32
+	Venue             string
+	PublicationType   string
+	// CitationCount is nil when the registry does not report citations
+	CitationCount *int32
+}
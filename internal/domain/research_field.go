@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// Levels of the research field taxonomy from the top, a node is one level below its parent
+const (
+	ResearchFieldLevelDomain   = "domain"
+	ResearchFieldLevelField    = "field"
+	ResearchFieldLevelSubfield = "subfield"
+)
+
+// ResearchField is a node of the Fields of Science taxonomy, ParentCode is empty for domains
+type ResearchField struct {
+	Code       string
+	ParentCode string
+	Level      string
+	SortOrder  int32
+	// Names of the research field by language code
+	Names     map[string]string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ResearchFieldStats counts the researchers and institutions whose research records are linked to the node or its descendants
+type ResearchFieldStats struct {
+	Code             string
+	ParentCode       string
+	Level            string
+	ResearcherCount  int64
+	InstitutionCount int64
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// Speciality is a node of the national speciality classifier, ParentCode is empty for top level groups
+type Speciality struct {
+	Code       string
+	ParentCode string
+	// Names of the speciality by language code
+	Names       map[string]string
+	HasChildren bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,11 @@
+package domain
+
+// TranslationGroup links language versions of the same entry,
+// Resource names the kind of the entry and RecordID with Title identify its first version
+type TranslationGroup struct {
+	ID            string
+	Resource      string
+	RecordID      int64
+	Title         string
+	LanguageCodes []string
+}
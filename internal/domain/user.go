@@ -2,10 +2,18 @@ package domain
 
 import "time"
 
+// Roles of users, every registered user gets UserRoleUser
+const (
+	UserRoleUser  = "user"
+	UserRoleAdmin = "admin"
+)
+
 type User struct {
-	ID           int64
-	Email        string
-	PasswordHash string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID                int64
+	Email             string
+	PasswordHash      string
+	PreferredLanguage string
+	Role              string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// WorkplaceVariant is a distinct spelling of a workplace in one language
+type WorkplaceVariant struct {
+	Workplace       string
+	LanguageCode    string
+	ExperienceCount int64
+	LinkedCount     int64
+	InstitutionIDs  []int64
+}
+
+// WorkplaceCluster groups spellings that most likely name the same employer
+type WorkplaceCluster struct {
+	Key                    string
+	Variants               []*WorkplaceVariant
+	ExperienceCount        int64
+	LinkedCount            int64
+	SuggestedInstitutionID int64
+	SuggestionScore        float64
+}
+
+// WorkplaceMappingChange is the institution a work experience is linked to before and after a mapping
+type WorkplaceMappingChange struct {
+	WorkExperienceID int64
+	EmployeeID       int64
+	LanguageCode     string
+	Workplace        string
+	OldInstitutionID int64
+	NewInstitutionID int64
+}
+
+// WorkplaceMappingBatch is an entry of the undo log, one per applied mapping
+type WorkplaceMappingBatch struct {
+	ID            int64
+	InstitutionID int64
+	Workplaces    []string
+	Overwrite     bool
+	CreatedBy     int64
+	CreatedAt     time.Time
+	UndoneBy      int64
+	UndoneAt      time.Time
+	ChangeCount   int64
+}
@@ -58,6 +58,58 @@ type Config struct {
 	// --- Authorization (Casbin) Settings
 	// Path to the Casbin model definition file
 	CasbinModelPath string `env:"CASBIN_MODEL_PATH" env-default:"config/casbin/rbac_model.conf"`
+
+	// --- PUBLICATION METADATA (DOI) SETTINGS ---
+	// Base URL of Crossref compatible API used to resolve DOIs
+	CrossrefBaseURL string `env:"CROSSREF_BASE_URL" env-default:"https://api.crossref.org"`
+	// Contact email sent to Crossref to be routed into the "polite" pool
+	CrossrefMailto string `env:"CROSSREF_MAILTO" env-default:""`
+	// Timeout of a single request to Crossref (in seconds)
+	CrossrefTimeout int `env:"CROSSREF_TIMEOUT" env-default:"10"`
+	// Time resolved DOI records are kept in cache (in minutes)
+	DOICacheTTL int `env:"DOI_CACHE_TTL" env-default:"1440"`
+
+	// --- ORCID SETTINGS ---
+	// Base URL of ORCID serving OAuth endpoints, https://sandbox.orcid.org for testing
+	OrcidBaseURL string `env:"ORCID_BASE_URL" env-default:"https://orcid.org"`
+	// Base URL of ORCID record API including the version
+	OrcidAPIBaseURL string `env:"ORCID_API_BASE_URL" env-default:"https://pub.orcid.org/v3.0"`
+	// Credentials of the application registered on ORCID
+	OrcidClientID     string `env:"ORCID_CLIENT_ID" env-default:""`
+	OrcidClientSecret string `env:"ORCID_CLIENT_SECRET" env-default:""`
+	// Base64 of a 32 byte key ORCID tokens are encrypted with in DB(e.g. output of "openssl rand -base64 32"),
+	// required once ORCID_CLIENT_ID is set
+	OrcidTokenKey string `env:"ORCID_TOKEN_KEY" env-default:""`
+	// Page of the frontend ORCID redirects to after authorization
+	OrcidRedirectURI string `env:"ORCID_REDIRECT_URI" env-default:""`
+	// Scope requested during authorization
+	OrcidScope string `env:"ORCID_SCOPE" env-default:"/authenticate"`
+	// Timeout of a single request to ORCID (in seconds)
+	OrcidTimeout int `env:"ORCID_TIMEOUT" env-default:"15"`
+	// Interval between synchronizations of a linked ORCID record (in minutes)
+	OrcidSyncInterval int `env:"ORCID_SYNC_INTERVAL" env-default:"1440"`
+	// Language imported ORCID works and employments are stored under
+	OrcidImportLanguage string `env:"ORCID_IMPORT_LANGUAGE" env-default:"en"`
+
+	// --- LINKED DATA SETTINGS ---
+	// Public base URL of the frontend, used to build identifiers of profiles in JSON-LD output(e.g. https://edugov.tj)
+	PublicBaseURL string `env:"PUBLIC_BASE_URL" env-default:""`
+
+	// --- LANGUAGE SETTINGS ---
+	// Interval between reloads of the language registry from the database (in minutes)
+	LanguageReloadInterval int `env:"LANGUAGE_RELOAD_INTERVAL" env-default:"5"`
+	// Order of languages tried when an entry has no translation in the requested language(e.g. en,ru,tg),
+	// enabled languages of the registry are tried in their registry order when empty
+	LanguageFallbackChain []string `env:"LANGUAGE_FALLBACK_CHAIN" env-separator:"," env-default:""`
+
+	// --- PROXY SETTINGS ---
+	// Addresses or CIDR ranges of the reverse proxies in front of the application(e.g. 127.0.0.1,10.0.0.0/8),
+	// X-Forwarded-For and X-Real-IP are ignored on connections from anywhere else
+	TrustedProxies []string `env:"TRUSTED_PROXIES" env-separator:"," env-default:""`
+
+	// --- RECOMMENDATION SETTINGS ---
+	// Interval between recomputations of similar researchers (in minutes)
+	SimilarityRefreshInterval int `env:"SIMILARITY_REFRESH_INTERVAL" env-default:"360"`
 }
 
 func LoadConfig(path string) (*Config, error) {
@@ -105,5 +157,26 @@ func LoadConfig(path string) (*Config, error) {
 		return nil, fmt.Errorf("all database connection pool settings should be positive integers")
 	}
 
+	if cfg.CrossrefTimeout <= 0 || cfg.DOICacheTTL <= 0 {
+		return nil, fmt.Errorf("CROSSREF_TIMEOUT and DOI_CACHE_TTL should be positive integers")
+	}
+
+	if cfg.OrcidTimeout <= 0 || cfg.OrcidSyncInterval <= 0 {
+		return nil, fmt.Errorf("ORCID_TIMEOUT and ORCID_SYNC_INTERVAL should be positive integers")
+	}
+	if cfg.OrcidClientID != "" && cfg.OrcidTokenKey == "" {
+		return nil, fmt.Errorf("ORCID_TOKEN_KEY environment variable is required when ORCID_CLIENT_ID is set")
+	}
+	if cfg.LanguageReloadInterval <= 0 {
+		return nil, fmt.Errorf("LANGUAGE_RELOAD_INTERVAL should be a positive integer")
+	}
+	if cfg.SimilarityRefreshInterval <= 0 {
+		return nil, fmt.Errorf("SIMILARITY_REFRESH_INTERVAL should be a positive integer")
+	}
+	// language codes are checked against the language registry once it is loaded from the database
+	if cfg.OrcidImportLanguage == "" {
+		return nil, fmt.Errorf("ORCID_IMPORT_LANGUAGE environment variable is required")
+	}
+
 	return cfg, nil
 }
@@ -10,20 +10,23 @@ import (
 )
 
 type AuthHandler struct {
-	authUsecase  usecases.AuthUsecase
-	cookieDomain string
-	cookieSecure bool
+	authUsecase    usecases.AuthUsecase
+	cookieDomain   string
+	cookieSecure   bool
+	trustedProxies utils.TrustedProxies
 }
 
 func NewAuthHandler(
 	authUsecase usecases.AuthUsecase,
 	cookieDomain string,
 	cookieSecure bool,
+	trustedProxies utils.TrustedProxies,
 ) *AuthHandler {
 	return &AuthHandler{
-		authUsecase:  authUsecase,
-		cookieDomain: cookieDomain,
-		cookieSecure: cookieSecure,
+		authUsecase:    authUsecase,
+		cookieDomain:   cookieDomain,
+		cookieSecure:   cookieSecure,
+		trustedProxies: trustedProxies,
 	}
 }
 
@@ -37,6 +40,25 @@ func (h *AuthHandler) Me(w http.ResponseWriter, r *http.Request) {
 	utils.RespondWithJSON(w, r, http.StatusOK, resp)
 }
 
+// PUT /auth/me/preferred-language
+// Request body - dtos.UpdatePreferredLanguageRequest
+// Response body - dtos.PreferredLanguageResponse
+func (h *AuthHandler) UpdatePreferredLanguage(w http.ResponseWriter, r *http.Request) {
+	var req dtos.UpdatePreferredLanguageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondWithError(w, r, fmt.Errorf("invalid request body to update preferred language: %w", err))
+		return
+	}
+
+	resp, err := h.authUsecase.UpdatePreferredLanguage(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
 // POST /auth/register
 // Request body - dtos.AuthRequest
 // Response body - none
@@ -46,6 +68,7 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		utils.RespondWithError(w, r, fmt.Errorf("invalid request body to register: %w", err))
 		return
 	}
+	req.IPAddress = utils.ClientIP(r, h.trustedProxies)
 
 	err := h.authUsecase.Register(r.Context(), &req)
 	if err != nil {
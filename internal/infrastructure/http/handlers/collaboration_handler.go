@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/graph"
+	"backend/internal/shared/utils"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type CollaborationHandler struct {
+	collaborationUC usecases.CollaborationUsecase
+}
+
+func NewCollaborationHandler(collaborationUC usecases.CollaborationUsecase) *CollaborationHandler {
+	return &CollaborationHandler{
+		collaborationUC: collaborationUC,
+	}
+}
+
+// GET /employee/{uid}/network?format=json|gexf
+// Request body - none
+// Response body - dtos.CollaborationGraphResponse, the ego network of the employee, or a GEXF file
+func (h *CollaborationHandler) GetEgoNetwork(w http.ResponseWriter, r *http.Request) {
+	format, err := parseNetworkFormat(r)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	uid := r.PathValue("uid")
+	resp, err := h.collaborationUC.GetEgoNetwork(r.Context(), uid, middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	h.respond(w, r, format, resp, fmt.Sprintf("Collaboration network of employee %s", uid), fmt.Sprintf("network_%s", uid))
+}
+
+// GET /institution/{id}/network?format=json|gexf&include_external=true
+// Request body - none
+// Response body - dtos.CollaborationGraphResponse, the network of the current employees of the institution, or a GEXF file
+func (h *CollaborationHandler) GetInstitutionNetwork(w http.ResponseWriter, r *http.Request) {
+	format, err := parseNetworkFormat(r)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	institutionID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to retrive collaboration network of institution: %w", err)))
+		return
+	}
+
+	var includeExternal bool
+	if value := r.URL.Query().Get("include_external"); value != "" {
+		includeExternal, err = strconv.ParseBool(value)
+		if err != nil {
+			utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid include_external parameter provided: %w", err)))
+			return
+		}
+	}
+
+	resp, err := h.collaborationUC.GetInstitutionNetwork(r.Context(), institutionID, includeExternal, middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	h.respond(w, r, format, resp, fmt.Sprintf("Collaboration network of institution %d", institutionID), fmt.Sprintf("network_institution_%d", institutionID))
+}
+
+func (h *CollaborationHandler) respond(w http.ResponseWriter, r *http.Request, format string, resp *dtos.CollaborationGraphResponse, description string, filename string) {
+	if format == graph.FormatJSON {
+		utils.RespondWithJSON(w, r, http.StatusOK, resp)
+		return
+	}
+
+	content, err := h.collaborationUC.RenderGEXF(resp, description)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/gexf+xml; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.gexf", filename))
+	w.WriteHeader(http.StatusOK)
+	w.Write(content)
+}
+
+func parseNetworkFormat(r *http.Request) (string, error) {
+	format := r.URL.Query().Get("format")
+	switch format {
+	case "":
+		return graph.FormatJSON, nil
+	case graph.FormatJSON, graph.FormatGEXF:
+		return format, nil
+	}
+
+	return "", custom_errors.BadRequest(fmt.Errorf("invalid format parameter provided: %s, expected json or gexf", format))
+}
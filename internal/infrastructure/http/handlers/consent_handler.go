@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ConsentHandler struct {
+	consentUC      usecases.ConsentUsecase
+	trustedProxies utils.TrustedProxies
+}
+
+func NewConsentHandler(consentUC usecases.ConsentUsecase, trustedProxies utils.TrustedProxies) *ConsentHandler {
+	return &ConsentHandler{
+		consentUC:      consentUC,
+		trustedProxies: trustedProxies,
+	}
+}
+
+// GET /consent/terms
+// Request body - none
+// Response body - dtos.ConsentDocumentResponse of the latest terms in the language of the request
+func (h *ConsentHandler) GetCurrent(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.consentUC.GetCurrent(r.Context(), middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// GET /auth/consent
+// Request body - none
+// Response body - dtos.ConsentStatusResponse of the signed in user
+func (h *ConsentHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.consentUC.GetStatus(r.Context())
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// POST /auth/consent
+// Request body - dtos.AcceptConsentRequest
+// Response body - dtos.ConsentStatusResponse of the signed in user
+func (h *ConsentHandler) Accept(w http.ResponseWriter, r *http.Request) {
+	var req dtos.AcceptConsentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to accept consent: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+	req.IPAddress = utils.ClientIP(r, h.trustedProxies)
+
+	resp, err := h.consentUC.Accept(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusCreated, resp)
+}
+
+// POST /consent-documents
+// Request body - dtos.PublishConsentDocumentsRequest
+// Response body - []dtos.ConsentDocumentResponse of the new version
+func (h *ConsentHandler) Publish(w http.ResponseWriter, r *http.Request) {
+	var req dtos.PublishConsentDocumentsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to publish consent documents: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.consentUC.Publish(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusCreated, resp)
+}
+
+// GET /consents/export?format=csv|json
+// Request body - none
+// Response body - consents.csv attachment by default, []dtos.UserConsentResponse for format=json
+func (h *ConsentHandler) Export(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "json" {
+		utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid format(%s) to export consents, expected csv or json", format)))
+		return
+	}
+
+	records, err := h.consentUC.GetAllRecords(r.Context())
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	if format == "json" {
+		utils.RespondWithJSON(w, r, http.StatusOK, records)
+		return
+	}
+
+	content, err := h.consentUC.RenderCSV(records)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", "attachment; filename=consents.csv")
+	w.WriteHeader(http.StatusOK)
+	w.Write(content)
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/utils"
+	"net/http"
+)
+
+type DegreeLevelHandler struct {
+	degreeLevelUC usecases.DegreeLevelUsecase
+}
+
+func NewDegreeLevelHandler(degreeLevelUC usecases.DegreeLevelUsecase) *DegreeLevelHandler {
+	return &DegreeLevelHandler{
+		degreeLevelUC: degreeLevelUC,
+	}
+}
+
+// GET /degree-levels
+// Request body - none
+// Response body - []dtos.DegreeLevelResponse ordered by rank
+func (h *DegreeLevelHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.degreeLevelUC.GetAll(r.Context(), middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type EmployeeCompletenessHandler struct {
+	employeeCompletenessUC usecases.EmployeeCompletenessUsecase
+}
+
+func NewEmployeeCompletenessHandler(employeeCompletenessUC usecases.EmployeeCompletenessUsecase) *EmployeeCompletenessHandler {
+	return &EmployeeCompletenessHandler{
+		employeeCompletenessUC: employeeCompletenessUC,
+	}
+}
+
+// GET /employee/{uid}/completeness
+// Request body - none
+// Response body - []dtos.EmployeeCompletenessResponse, one per enabled language
+func (h *EmployeeCompletenessHandler) GetByUniqueID(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.employeeCompletenessUC.GetByUniqueID(r.Context(), r.PathValue("uid"), middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// GET /admin/institutions/{id}/completeness?language_code=&limit=
+// Request body - none
+// Response body - []dtos.EmployeeCompletenessResponse, least complete profiles in language_code first, at most 200 and 20 by default
+func (h *EmployeeCompletenessHandler) GetLeastCompleteByInstitutionID(w http.ResponseWriter, r *http.Request) {
+	institutionID, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to retrive profile completeness of institution: %w", err)))
+		return
+	}
+
+	var limit int64
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid limit parameter provided: %w", err)))
+			return
+		}
+		limit = parsed
+	}
+
+	langCode := middleware.GetLanguageFromContext(r.Context())
+	profileLangCode := r.URL.Query().Get("language_code")
+	if profileLangCode == "" {
+		profileLangCode = langCode
+	}
+
+	resp, err := h.employeeCompletenessUC.GetLeastCompleteByInstitutionID(r.Context(), institutionID, profileLangCode, limit, langCode)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
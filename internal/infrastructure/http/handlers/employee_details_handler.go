@@ -55,3 +55,22 @@ func (h *employeeDetailsHandler) Update(w http.ResponseWriter, r *http.Request)
 
   utils.RespondWithJSON(w, r, http.StatusOK, resp)
 }
+
+// DELETE /employee/details/{id}
+// Request body - none
+// Response body - none
+func (h *employeeDetailsHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to delete employee details by ID: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	if err := h.employeeDetailsUC.Delete(r.Context(), id); err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
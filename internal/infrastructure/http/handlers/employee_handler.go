@@ -5,10 +5,13 @@ import (
 	"backend/internal/application/usecases"
 	"backend/internal/infrastructure/http/middleware"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/cv"
 	"backend/internal/shared/utils"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -134,8 +137,21 @@ func (h *EmployeeHandler) Delete(w http.ResponseWriter, r *http.Request) {
 
 // GET /employee/{uiq}
 // Request body - none
-// Response body - dtos.EmployeeResponse
+// Response body - dtos.EmployeeResponse, or dtos.PersonJSONLD when "Accept: application/ld+json" is requested
+// Sections and fields are left out by the visibility the employee chose for the signed in user or the public
 func (h *EmployeeHandler) GetByUID(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Accept")
+	if utils.NegotiateMediaType(r, utils.MediaTypeJSON, utils.MediaTypeJSONLD) == utils.MediaTypeJSONLD {
+		resp, err := h.employeeUC.GetLinkedDataByUniqueID(r.Context(), r.PathValue("uid"))
+		if err != nil {
+			utils.RespondWithError(w, r, err)
+			return
+		}
+
+		utils.RespondWithJSONLD(w, r, http.StatusOK, resp)
+		return
+	}
+
 	resp, err := h.employeeUC.GetByUniqueID(r.Context(), r.PathValue("uid"))
 	if err != nil {
 		utils.RespondWithError(w, r, err)
@@ -145,6 +161,40 @@ func (h *EmployeeHandler) GetByUID(w http.ResponseWriter, r *http.Request) {
 	utils.RespondWithJSON(w, r, http.StatusOK, resp)
 }
 
+// GET /employee/{uid}/cv?format=pdf|docx&lang=tg|ru|en&template=classic|modern|compact
+// Request body - none
+// Response body - CV file in requested format
+func (h *EmployeeHandler) GenerateCV(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	uid := r.PathValue("uid")
+
+	format := query.Get("format")
+	if format == "" {
+		format = cv.FormatPDF
+	}
+
+	ctx := r.Context()
+	if lang := query.Get("lang"); lang != "" {
+		ctx = context.WithValue(ctx, middleware.LanguageContextKey, lang)
+	}
+
+	content, err := h.employeeUC.GenerateCV(ctx, uid, format, query.Get("template"))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	contentType := "application/pdf"
+	if format == cv.FormatDOCX {
+		contentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=cv_%s_%s.%s", uid, middleware.GetLanguageFromContext(ctx), format))
+	w.WriteHeader(http.StatusOK)
+	w.Write(content)
+}
+
 // GET /employee/profile-picture/{uid}
 // Request body - none
 // Response body - none
@@ -190,7 +240,12 @@ func (h *EmployeeHandler) GetProfilePicture(w http.ResponseWriter, r *http.Reque
 
 // GET /employee/personnel
 // Request body - none
-// Request param - dtos.PersonnelPaginatedQueryParameters
+// Request param - dtos.PersonnelPaginatedQueryParameters,
+// min_h_index, min_i10_index, min_citations, sort_by=h_index|i10_index|citations
+// institution_id - employees currently working in the institution
+// org_unit_id - employees currently working in the unit or any of its sub-units
+// speciality_code - employees whose speciality is the classifier node or any node below it
+// and keyword - employees with a key topic of the keyword, matched by any of its labels
 // Response body - none
 func (h *EmployeeHandler) GetPersonnelPaginated(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
@@ -199,13 +254,31 @@ func (h *EmployeeHandler) GetPersonnelPaginated(w http.ResponseWriter, r *http.R
 		UID:            query.Get("uid"),
 		AcademicDegree: query.Get("academic_degree"),
 		Speciality:     query.Get("speciality"),
+		SpecialityCode: query.Get("speciality_code"),
+		Keyword:        query.Get("keyword"),
 		Name:           query.Get("name"),
 		Surname:        query.Get("surname"),
 		Middlename:     query.Get("middlename"),
 		Workplace:      query.Get("workplace"),
+		SortBy:         query.Get("sort_by"),
 		LanguageCode:   middleware.GetLanguageFromContext(r.Context()),
 	}
 
+	if err := parsePersonnelCitationFilter(query, filter); err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	if err := parsePersonnelInstitutionFilter(query, filter); err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	if err := parsePersonnelOrgUnitFilter(query, filter); err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
 	page, err := strconv.ParseInt(query.Get("page"), 0, 64)
 	if err != nil {
 		utils.RespondWithError(w, r, custom_errors.InternalServerError(fmt.Errorf("invalid page parameter provided: %w", err)))
@@ -238,6 +311,8 @@ func (h *EmployeeHandler) GetPersonnelCountPaginated(w http.ResponseWriter, r *h
 		UID:            query.Get("uid"),
 		AcademicDegree: query.Get("academic_degree"),
 		Speciality:     query.Get("speciality"),
+		SpecialityCode: query.Get("speciality_code"),
+		Keyword:        query.Get("keyword"),
 		Name:           query.Get("name"),
 		Surname:        query.Get("surname"),
 		Middlename:     query.Get("middlename"),
@@ -245,6 +320,21 @@ func (h *EmployeeHandler) GetPersonnelCountPaginated(w http.ResponseWriter, r *h
 		LanguageCode:   middleware.GetLanguageFromContext(r.Context()),
 	}
 
+	if err := parsePersonnelCitationFilter(query, filter); err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	if err := parsePersonnelInstitutionFilter(query, filter); err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	if err := parsePersonnelOrgUnitFilter(query, filter); err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
 	fmt.Println("COUNT HANDLER FILTER DATA: ", filter)
 
 	personnel, err := h.employeeUC.GetPersonnelCountPaginated(r.Context(), filter)
@@ -257,7 +347,7 @@ func (h *EmployeeHandler) GetPersonnelCountPaginated(w http.ResponseWriter, r *h
 }
 
 func (h *EmployeeHandler) ListUniqueHighestAcademicDegrees(w http.ResponseWriter, r *http.Request) {
-	degrees, err := h.employeeUC.ListUniqueHighestAcademicDegrees(r.Context())
+	degrees, err := h.employeeUC.ListUniqueHighestAcademicDegrees(r.Context(), middleware.GetLanguageFromContext(r.Context()))
 	if err != nil {
 		utils.RespondWithError(w, r, err)
 		return
@@ -275,3 +365,60 @@ func (h *EmployeeHandler) ListUniqueSpecialities(w http.ResponseWriter, r *http.
 
 	utils.RespondWithJSON(w, r, http.StatusOK, specialities)
 }
+
+// parsePersonnelCitationFilter reads optional min_h_index, min_i10_index and min_citations query parameters
+func parsePersonnelCitationFilter(query url.Values, filter *dtos.PersonnelPaginatedQueryParameters) error {
+	citationFilters := []struct {
+		name   string
+		target *int32
+	}{
+		{"min_h_index", &filter.MinHIndex},
+		{"min_i10_index", &filter.MinI10Index},
+		{"min_citations", &filter.MinCitations},
+	}
+
+	for _, citationFilter := range citationFilters {
+		value := query.Get(citationFilter.name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return custom_errors.BadRequest(fmt.Errorf("invalid %s parameter provided: %w", citationFilter.name, err))
+		}
+		*citationFilter.target = int32(parsed)
+	}
+
+	return nil
+}
+
+func parsePersonnelInstitutionFilter(query url.Values, filter *dtos.PersonnelPaginatedQueryParameters) error {
+	value := query.Get("institution_id")
+	if value == "" {
+		return nil
+	}
+
+	institutionID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || institutionID <= 0 {
+		return custom_errors.BadRequest(fmt.Errorf("invalid institution_id parameter provided: %s", value))
+	}
+	filter.InstitutionID = institutionID
+
+	return nil
+}
+
+func parsePersonnelOrgUnitFilter(query url.Values, filter *dtos.PersonnelPaginatedQueryParameters) error {
+	value := query.Get("org_unit_id")
+	if value == "" {
+		return nil
+	}
+
+	orgUnitID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || orgUnitID <= 0 {
+		return custom_errors.BadRequest(fmt.Errorf("invalid org_unit_id parameter provided: %s", value))
+	}
+	filter.OrgUnitID = orgUnitID
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/usecases"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type EmployeeOrcidHandler struct {
+	employeeOrcidUC usecases.EmployeeOrcidUsecase
+}
+
+func NewEmployeeOrcidHandler(employeeOrcidUC usecases.EmployeeOrcidUsecase) *EmployeeOrcidHandler {
+	return &EmployeeOrcidHandler{
+		employeeOrcidUC: employeeOrcidUC,
+	}
+}
+
+// GET /employee/orcid
+// Request body - None
+// Response body - dto.EmployeeOrcidAccountResponse
+func (h *EmployeeOrcidHandler) Get(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.employeeOrcidUC.Get(r.Context())
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// POST /employee/orcid/authorize
+// Request body - None
+// Response body - dto.EmployeeOrcidAuthorizationResponse
+func (h *EmployeeOrcidHandler) StartLinking(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.employeeOrcidUC.StartLinking(r.Context())
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// POST /employee/orcid/link
+// Request body - dto.LinkEmployeeOrcidRequest
+// Response body - dto.EmployeeOrcidAccountResponse
+func (h *EmployeeOrcidHandler) CompleteLinking(w http.ResponseWriter, r *http.Request) {
+	var req dtos.LinkEmployeeOrcidRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to link orcid account: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.employeeOrcidUC.CompleteLinking(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// PUT /employee/orcid
+// Request body - dto.UpdateEmployeeOrcidRequest
+// Response body - dto.EmployeeOrcidAccountResponse
+func (h *EmployeeOrcidHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var req dtos.UpdateEmployeeOrcidRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to update orcid: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.employeeOrcidUC.UpdateORCID(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// DELETE /employee/orcid
+// Request body - None
+// Response body - None
+func (h *EmployeeOrcidHandler) Unlink(w http.ResponseWriter, r *http.Request) {
+	if err := h.employeeOrcidUC.Unlink(r.Context()); err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// POST /employee/orcid/sync
+// Request body - None
+// Response body - dto.EmployeeOrcidSyncResponse
+func (h *EmployeeOrcidHandler) Sync(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.employeeOrcidUC.Sync(r.Context())
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
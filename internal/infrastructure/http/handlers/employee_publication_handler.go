@@ -4,10 +4,12 @@ import (
 	"backend/internal/application/dtos"
 	"backend/internal/application/usecases"
 	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/bibliography"
 	"backend/internal/shared/custom_errors"
 	"backend/internal/shared/utils"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 )
@@ -102,3 +104,162 @@ func (h *EmployeePublicationHandler) GetByEmployeeIDAndLanguageCode(w http.Respo
 
 	utils.RespondWithJSON(w, r, http.StatusOK, resp)
 }
+
+// POST /employee/publication/import/preview/{employeeID}
+// Request body - multipart form with BibTeX or RIS file under "file" field
+// Response body - dtos.EmployeePublicationImportPreviewResponse
+func (h *EmployeePublicationHandler) PreviewImport(w http.ResponseWriter, r *http.Request) {
+	const MAX_UPLOAD_SIZE = 5 * 1024 * 1024
+
+	employeeID, err := strconv.Atoi(r.PathValue("employeeID"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to preview employee publication import by employeeID: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, MAX_UPLOAD_SIZE)
+	if err := r.ParseMultipartForm(MAX_UPLOAD_SIZE); err != nil {
+		utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("The uploaded file is too big. Please choose a file that is less than 5MB in size.")))
+		return
+	}
+
+	file, handler, err := r.FormFile("file")
+	if err != nil {
+		utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("Error retrieving the file: %w", err)))
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		utils.RespondWithError(w, r, custom_errors.InternalServerError(fmt.Errorf("Error reading uploaded file: %w", err)))
+		return
+	}
+
+	langCode := middleware.GetLanguageFromContext(r.Context())
+	resp, err := h.employeeDegreeUC.PreviewImport(r.Context(), int64(employeeID), langCode, handler.Filename, content)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// POST /employee/publication/import
+// Request body - dtos.ImportEmployeePublicationsRequest
+// Response body - dtos.EmployeePublicationImportResponse
+func (h *EmployeePublicationHandler) Import(w http.ResponseWriter, r *http.Request) {
+	var req dtos.ImportEmployeePublicationsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to import employee publications: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	req.LanguageCode = middleware.GetLanguageFromContext(r.Context())
+	resp, err := h.employeeDegreeUC.Import(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusCreated, resp)
+}
+
+// GET /employee/publication/{employeeID}/export?format=bibtex|ris|csl-json
+// Request body - none
+// Response body - publications file in requested format
+func (h *EmployeePublicationHandler) Export(w http.ResponseWriter, r *http.Request) {
+	employeeID, err := strconv.Atoi(r.PathValue("employeeID"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to export employee publications by employeeID: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = bibliography.FormatBibTeX
+	}
+
+	langCode := middleware.GetLanguageFromContext(r.Context())
+	content, err := h.employeeDegreeUC.Export(r.Context(), int64(employeeID), langCode, format)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	contentType, extension := "application/x-bibtex", ".bib"
+	switch format {
+	case bibliography.FormatRIS:
+		contentType, extension = "application/x-research-info-systems", ".ris"
+	case bibliography.FormatCSLJSON:
+		contentType, extension = "application/vnd.citationstyles.csl+json", ".json"
+	}
+
+	w.Header().Set("Content-Type", contentType+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=publications_%d%s", employeeID, extension))
+	w.WriteHeader(http.StatusOK)
+	w.Write(content)
+}
+
+// GET /employee/publication/resolve?doi=
+// Request body - none
+// Response body - dtos.PublicationMetadataResponse
+func (h *EmployeePublicationHandler) ResolveDOI(w http.ResponseWriter, r *http.Request) {
+	doi := r.URL.Query().Get("doi")
+	if doi == "" {
+		utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("doi query parameter is required to resolve publication metadata")))
+		return
+	}
+
+	resp, err := h.employeeDegreeUC.ResolveDOI(r.Context(), doi)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// PUT /employee/publication/citations
+// Request body - dtos.UpdateEmployeePublicationCitationsRequest
+// Response body - dtos.EmployeePublicationResponse
+func (h *EmployeePublicationHandler) UpdateCitations(w http.ResponseWriter, r *http.Request) {
+	var req dtos.UpdateEmployeePublicationCitationsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to update employee publication citations: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.employeeDegreeUC.UpdateCitations(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// POST /employee/publication/citations/import/{employeeID}
+// Request body - none
+// Response body - dtos.EmployeePublicationCitationImportResponse
+func (h *EmployeePublicationHandler) ImportCitations(w http.ResponseWriter, r *http.Request) {
+	employeeID, err := strconv.Atoi(r.PathValue("employeeID"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to import employee publication citations by employeeID: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.employeeDegreeUC.ImportCitations(r.Context(), int64(employeeID))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type EmployeeSimilarityHandler struct {
+	employeeSimilarityUC usecases.EmployeeSimilarityUsecase
+}
+
+func NewEmployeeSimilarityHandler(employeeSimilarityUC usecases.EmployeeSimilarityUsecase) *EmployeeSimilarityHandler {
+	return &EmployeeSimilarityHandler{
+		employeeSimilarityUC: employeeSimilarityUC,
+	}
+}
+
+// GET /employee/{uid}/similar?limit=
+// Request body - none
+// Response body - []dtos.SimilarEmployeeResponse, most similar first, at most 20 and 5 by default
+func (h *EmployeeSimilarityHandler) GetSimilar(w http.ResponseWriter, r *http.Request) {
+	var limit int64
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid limit parameter provided: %w", err)))
+			return
+		}
+		limit = parsed
+	}
+
+	resp, err := h.employeeSimilarityUC.GetSimilar(r.Context(), r.PathValue("uid"), limit, middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
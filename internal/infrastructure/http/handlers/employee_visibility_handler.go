@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/usecases"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type EmployeeVisibilityHandler struct {
+	employeeVisibilityUC usecases.EmployeeVisibilityUsecase
+}
+
+func NewEmployeeVisibilityHandler(employeeVisibilityUC usecases.EmployeeVisibilityUsecase) *EmployeeVisibilityHandler {
+	return &EmployeeVisibilityHandler{
+		employeeVisibilityUC: employeeVisibilityUC,
+	}
+}
+
+// GET /employee/visibility
+// Request body - none
+// Response body - []dtos.EmployeeVisibilitySettingResponse of the signed in employee
+func (h *EmployeeVisibilityHandler) Get(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.employeeVisibilityUC.Get(r.Context())
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// PUT /employee/visibility
+// Request body - dtos.UpdateEmployeeVisibilityRequest
+// Response body - []dtos.EmployeeVisibilitySettingResponse of the signed in employee
+func (h *EmployeeVisibilityHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var req dtos.UpdateEmployeeVisibilityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to update visibility: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.employeeVisibilityUC.Update(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// RequireSection serves next only when the section of the profile of the employee in the employeeID path parameter
+// is shared with the viewer of the request, to be wrapped in the optional auth middleware
+func (h *EmployeeVisibilityHandler) RequireSection(section string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		employeeID, err := strconv.ParseInt(r.PathValue("employeeID"), 10, 64)
+		if err != nil {
+			err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to retrive %s by employeeID: %w", section, err))
+			utils.RespondWithError(w, r, err)
+			return
+		}
+
+		if err := h.employeeVisibilityUC.AuthorizeSection(r.Context(), employeeID, section); err != nil {
+			utils.RespondWithError(w, r, err)
+			return
+		}
+
+		next(w, r)
+	}
+}
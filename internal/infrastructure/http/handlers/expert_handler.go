@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type ExpertHandler struct {
+	expertUC usecases.ExpertUsecase
+}
+
+func NewExpertHandler(expertUC usecases.ExpertUsecase) *ExpertHandler {
+	return &ExpertHandler{
+		expertUC: expertUC,
+	}
+}
+
+// GET /employee/experts?q=
+// Request body - none
+// Optional query parameters:
+// language_code - only profiles and items in the language
+// institution_id - employees currently working in the institution
+// degree_level_code - employees holding a degree of the level
+// weights - section weights overriding the defaults, as research_area:3,key_topic:3,publication:2,patent:2,project:1.5,degree:1
+// limit - at most 50 experts, 10 by default
+// Response body - []dtos.ExpertResponse, best matching first with the items explaining each score
+func (h *ExpertHandler) Search(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	params := &dtos.ExpertSearchQueryParameters{
+		Query:               query.Get("q"),
+		ProfileLanguageCode: query.Get("language_code"),
+		DegreeLevelCode:     query.Get("degree_level_code"),
+	}
+
+	if value := query.Get("institution_id"); value != "" {
+		institutionID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || institutionID <= 0 {
+			utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid institution_id parameter provided: %s", value)))
+			return
+		}
+		params.InstitutionID = institutionID
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid limit parameter provided: %w", err)))
+			return
+		}
+		params.Limit = limit
+	}
+
+	weights, err := parseExpertWeights(query.Get("weights"))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+	params.Weights = weights
+
+	resp, err := h.expertUC.Search(r.Context(), params, middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// parseExpertWeights reads comma separated section:weight pairs
+func parseExpertWeights(value string) (map[string]float64, error) {
+	weights := map[string]float64{}
+	if value == "" {
+		return weights, nil
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		section, rawWeight, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid weights parameter provided, expected section:weight in %s", pair))
+		}
+
+		weight, err := strconv.ParseFloat(strings.TrimSpace(rawWeight), 64)
+		if err != nil {
+			return nil, custom_errors.BadRequest(fmt.Errorf("invalid weight of section(%s) provided: %w", section, err))
+		}
+		weights[strings.TrimSpace(section)] = weight
+	}
+
+	return weights, nil
+}
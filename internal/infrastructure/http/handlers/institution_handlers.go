@@ -3,8 +3,11 @@ package handlers
 import (
 	"backend/internal/application/usecases"
 	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
 	"backend/internal/shared/utils"
+	"fmt"
 	"net/http"
+	"strconv"
 )
 
 type institutionHandler struct {
@@ -39,3 +42,35 @@ func (h *institutionHandler) GetAllInstitutionName(w http.ResponseWriter, r *htt
 
 	utils.RespondWithJSON(w, r, http.StatusOK, institutionNames)
 }
+
+// GET /institution/{id}
+// Request body - none
+// Response body - dtos.InstitutionResponse, or dtos.CollegeOrUniversityJSONLD when "Accept: application/ld+json" is requested
+func (h *institutionHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to retrive institution by ID: %w", err)))
+		return
+	}
+
+	langCode := middleware.GetLanguageFromContext(r.Context())
+	w.Header().Add("Vary", "Accept")
+	if utils.NegotiateMediaType(r, utils.MediaTypeJSON, utils.MediaTypeJSONLD) == utils.MediaTypeJSONLD {
+		resp, err := h.institutionUC.GetLinkedDataByID(r.Context(), id, langCode)
+		if err != nil {
+			utils.RespondWithError(w, r, err)
+			return
+		}
+
+		utils.RespondWithJSONLD(w, r, http.StatusOK, resp)
+		return
+	}
+
+	resp, err := h.institutionUC.GetByID(r.Context(), id, langCode)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type KeywordHandler struct {
+	keywordUC usecases.KeywordUsecase
+}
+
+func NewKeywordHandler(keywordUC usecases.KeywordUsecase) *KeywordHandler {
+	return &KeywordHandler{
+		keywordUC: keywordUC,
+	}
+}
+
+// GET /keywords?prefix=
+// Request body - none
+// Response body - []dtos.KeywordResponse, keywords with a label in any language starting with prefix, most used first
+func (h *KeywordHandler) Search(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.keywordUC.Search(r.Context(), r.URL.Query().Get("prefix"), middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// POST /admin/keywords/merge
+// Request body - dtos.MergeKeywordsRequest
+// Response body - dtos.KeywordResponse, the target keyword after the merge
+func (h *KeywordHandler) Merge(w http.ResponseWriter, r *http.Request) {
+	var req dtos.MergeKeywordsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to merge keywords: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.keywordUC.Merge(r.Context(), &req, middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
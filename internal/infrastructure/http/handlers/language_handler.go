@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"backend/internal/application/usecases"
+	"backend/internal/shared/utils"
+	"net/http"
+)
+
+type LanguageHandler struct {
+	languageUC usecases.LanguageUsecase
+}
+
+func NewLanguageHandler(languageUC usecases.LanguageUsecase) *LanguageHandler {
+	return &LanguageHandler{
+		languageUC: languageUC,
+	}
+}
+
+// GET /languages
+// Request body - none
+// Response body - []dtos.LanguageResponse
+func (h *LanguageHandler) GetEnabled(w http.ResponseWriter, r *http.Request) {
+	utils.RespondWithJSON(w, r, http.StatusOK, h.languageUC.GetEnabled(r.Context()))
+}
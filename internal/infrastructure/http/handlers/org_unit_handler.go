@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type OrgUnitHandler struct {
+	orgUnitUC usecases.OrgUnitUsecase
+}
+
+func NewOrgUnitHandler(orgUnitUC usecases.OrgUnitUsecase) *OrgUnitHandler {
+	return &OrgUnitHandler{
+		orgUnitUC: orgUnitUC,
+	}
+}
+
+// GET /institution/{id}/units
+// Request body - none
+// Response body - []dtos.OrgUnitResponse, top level units with sub-units nested in children
+func (h *OrgUnitHandler) GetTree(w http.ResponseWriter, r *http.Request) {
+	institutionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to retrive organizational units: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.orgUnitUC.GetTree(r.Context(), int64(institutionID), middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// POST /institution/{id}/units
+// Request body - dtos.CreateOrgUnitRequest
+// Response body - dtos.OrgUnitResponse
+func (h *OrgUnitHandler) Create(w http.ResponseWriter, r *http.Request) {
+	institutionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to create organizational unit: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	var req dtos.CreateOrgUnitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to create organizational unit: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	req.InstitutionID = int64(institutionID)
+	resp, err := h.orgUnitUC.Create(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusCreated, resp)
+}
+
+// PUT /institution/{id}/units/{unitID}
+// Request body - dtos.UpdateOrgUnitRequest
+// Response body - dtos.OrgUnitResponse
+func (h *OrgUnitHandler) Update(w http.ResponseWriter, r *http.Request) {
+	institutionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to update organizational unit: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	unitID, err := strconv.Atoi(r.PathValue("unitID"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to update organizational unit: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	var req dtos.UpdateOrgUnitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to update organizational unit: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	req.ID = int64(unitID)
+	req.InstitutionID = int64(institutionID)
+	resp, err := h.orgUnitUC.Update(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// DELETE /institution/{id}/units/{unitID}
+// Request body - none
+// Response body - none
+func (h *OrgUnitHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	institutionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to delete organizational unit: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	unitID, err := strconv.Atoi(r.PathValue("unitID"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to delete organizational unit: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	if err := h.orgUnitUC.Delete(r.Context(), int64(institutionID), int64(unitID)); err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
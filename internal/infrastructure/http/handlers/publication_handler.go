@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type PublicationHandler struct {
+	publicationUC usecases.PublicationUsecase
+}
+
+func NewPublicationHandler(publicationUC usecases.PublicationUsecase) *PublicationHandler {
+	return &PublicationHandler{
+		publicationUC: publicationUC,
+	}
+}
+
+// GET /employee/shared-publication/{id}
+// Request body - none
+// Response body - dtos.PublicationResponse
+func (h *PublicationHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to retrive publication by ID: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.publicationUC.GetByID(r.Context(), int64(id))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// GET /employee/shared-publication/suggestions
+// Request body - none
+// Response body - []dtos.PublicationResponse
+func (h *PublicationHandler) ListClaimSuggestions(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.publicationUC.ListClaimSuggestions(r.Context())
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// POST /employee/shared-publication/claim
+// Request body - dtos.ClaimPublicationRequest
+// Response body - dtos.EmployeePublicationResponse
+func (h *PublicationHandler) Claim(w http.ResponseWriter, r *http.Request) {
+	var req dtos.ClaimPublicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to claim publication: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	req.LanguageCode = middleware.GetLanguageFromContext(r.Context())
+	resp, err := h.publicationUC.Claim(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusCreated, resp)
+}
+
+// DELETE /employee/shared-publication/{id}/claim
+// Request body - none
+// Response body - none
+func (h *PublicationHandler) Unclaim(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to unclaim publication: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	if err := h.publicationUC.Unclaim(r.Context(), int64(id)); err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
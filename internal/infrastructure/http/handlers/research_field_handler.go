@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ResearchFieldHandler struct {
+	researchFieldUC usecases.ResearchFieldUsecase
+}
+
+func NewResearchFieldHandler(researchFieldUC usecases.ResearchFieldUsecase) *ResearchFieldHandler {
+	return &ResearchFieldHandler{
+		researchFieldUC: researchFieldUC,
+	}
+}
+
+// GET /research-fields
+// Request body - none
+// Response body - []dtos.ResearchFieldResponse, every node of the taxonomy
+func (h *ResearchFieldHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.researchFieldUC.GetAll(r.Context(), middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// GET /research-fields/stats?level=&parent_code=
+// Request body - none
+// Response body - []dtos.ResearchFieldStatsResponse, researchers and institutions per node including its descendants
+func (h *ResearchFieldHandler) GetStats(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	resp, err := h.researchFieldUC.GetStats(r.Context(), query.Get("level"), query.Get("parent_code"), middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// PUT /admin/research-fields/{code}
+// Request body - dtos.UpsertResearchFieldRequest
+// Response body - dtos.ResearchFieldResponse
+func (h *ResearchFieldHandler) Upsert(w http.ResponseWriter, r *http.Request) {
+	var req dtos.UpsertResearchFieldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to save research field: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	req.Code = r.PathValue("code")
+	resp, err := h.researchFieldUC.Upsert(r.Context(), &req, middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"backend/internal/application/usecases"
+	"backend/internal/infrastructure/http/middleware"
+	"backend/internal/shared/utils"
+	"net/http"
+)
+
+type SpecialityHandler struct {
+	specialityUC usecases.SpecialityUsecase
+}
+
+func NewSpecialityHandler(specialityUC usecases.SpecialityUsecase) *SpecialityHandler {
+	return &SpecialityHandler{
+		specialityUC: specialityUC,
+	}
+}
+
+// GET /specialities?parent_code=
+// Request body - none
+// Response body - []dtos.SpecialityResponse, children of the classifier node or top level nodes without parent_code
+func (h *SpecialityHandler) GetChildren(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.specialityUC.GetChildren(r.Context(), r.URL.Query().Get("parent_code"), middleware.GetLanguageFromContext(r.Context()))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
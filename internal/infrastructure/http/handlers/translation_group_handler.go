@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"backend/internal/application/usecases"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type TranslationGroupHandler struct {
+	translationGroupUC usecases.TranslationGroupUsecase
+}
+
+func NewTranslationGroupHandler(translationGroupUC usecases.TranslationGroupUsecase) *TranslationGroupHandler {
+	return &TranslationGroupHandler{
+		translationGroupUC: translationGroupUC,
+	}
+}
+
+// GET /employee/translations/missing/{employeeID}
+// Request body - none
+// Response body - []dtos.MissingTranslationResponse
+func (h *TranslationGroupHandler) GetMissingByEmployeeID(w http.ResponseWriter, r *http.Request) {
+	employeeID, err := strconv.Atoi(r.PathValue("employeeID"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to retrive missing translations of employee: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.translationGroupUC.GetMissingByEmployeeID(r.Context(), int64(employeeID))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// GET /institution/translations/missing/{institutionID}
+// Request body - none
+// Response body - []dtos.MissingTranslationResponse
+func (h *TranslationGroupHandler) GetMissingByInstitutionID(w http.ResponseWriter, r *http.Request) {
+	institutionID, err := strconv.Atoi(r.PathValue("institutionID"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to retrive missing translations of institution: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.translationGroupUC.GetMissingByInstitutionID(r.Context(), int64(institutionID))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
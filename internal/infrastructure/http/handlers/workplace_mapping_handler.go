@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/application/usecases"
+	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type WorkplaceMappingHandler struct {
+	workplaceMappingUC usecases.WorkplaceMappingUsecase
+}
+
+func NewWorkplaceMappingHandler(workplaceMappingUC usecases.WorkplaceMappingUsecase) *WorkplaceMappingHandler {
+	return &WorkplaceMappingHandler{
+		workplaceMappingUC: workplaceMappingUC,
+	}
+}
+
+// GET /admin/workplaces/clusters?threshold=0.6&unlinked_only=true
+// Request body - none
+// Response body - []dtos.WorkplaceClusterResponse
+func (h *WorkplaceMappingHandler) GetClusters(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	params := &dtos.WorkplaceClustersQueryParameters{}
+
+	if threshold := query.Get("threshold"); threshold != "" {
+		value, err := strconv.ParseFloat(threshold, 64)
+		if err != nil {
+			utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid threshold parameter provided: %w", err)))
+			return
+		}
+		params.Threshold = value
+	}
+
+	if unlinkedOnly := query.Get("unlinked_only"); unlinkedOnly != "" {
+		value, err := strconv.ParseBool(unlinkedOnly)
+		if err != nil {
+			utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid unlinked_only parameter provided: %w", err)))
+			return
+		}
+		params.UnlinkedOnly = value
+	}
+
+	resp, err := h.workplaceMappingUC.GetClusters(r.Context(), params)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// POST /admin/workplaces/mappings
+// Request body - dtos.ApplyWorkplaceMappingRequest
+// Response body - dtos.WorkplaceMappingResponse
+func (h *WorkplaceMappingHandler) Apply(w http.ResponseWriter, r *http.Request) {
+	var req dtos.ApplyWorkplaceMappingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request body to map workplaces: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.workplaceMappingUC.Apply(r.Context(), &req)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	status := http.StatusCreated
+	if resp.Batch == nil {
+		status = http.StatusOK
+	}
+
+	utils.RespondWithJSON(w, r, status, resp)
+}
+
+// GET /admin/workplaces/mappings?page=1&limit=50
+// Request body - none
+// Response body - []dtos.WorkplaceMappingBatchResponse
+func (h *WorkplaceMappingHandler) ListBatches(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var page, limit int64
+	if rawPage := query.Get("page"); rawPage != "" {
+		value, err := strconv.ParseInt(rawPage, 0, 64)
+		if err != nil {
+			utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid page parameter provided: %w", err)))
+			return
+		}
+		page = value
+	}
+
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		value, err := strconv.ParseInt(rawLimit, 0, 64)
+		if err != nil {
+			utils.RespondWithError(w, r, custom_errors.BadRequest(fmt.Errorf("invalid limit parameter provided: %w", err)))
+			return
+		}
+		limit = value
+	}
+
+	resp, err := h.workplaceMappingUC.ListBatches(r.Context(), page, limit)
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
+
+// POST /admin/workplaces/mappings/{id}/undo
+// Request body - none
+// Response body - dtos.UndoWorkplaceMappingResponse
+func (h *WorkplaceMappingHandler) Undo(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		err = custom_errors.BadRequest(fmt.Errorf("invalid request path parameter to undo workplace mapping: %w", err))
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	resp, err := h.workplaceMappingUC.Undo(r.Context(), int64(id))
+	if err != nil {
+		utils.RespondWithError(w, r, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, r, http.StatusOK, resp)
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 )
 
@@ -53,6 +54,7 @@ func CreateAuthMiddleware(
 
 			// 3. Inject UserID and UserRole into the request context.
 			ctx := context.WithValue(r.Context(), UserIDContextKey, claims.UserID)
+			ctx = context.WithValue(ctx, UserRoleContextKey, claims.Role)
 
 			// 4. Call the next handler in the chain with the updated context.
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -65,8 +67,52 @@ func GetUserIDFromContext(ctx context.Context) (int64, bool) {
 	return userID, ok
 }
 
+// CreateRoleMiddleware is a factory that creates a middleware letting through users having one of the given roles.
+// It relies on the role injected by the authentication middleware, so it has to be wrapped by it.
+func CreateRoleMiddleware(
+	respondWithError func(w http.ResponseWriter, r *http.Request, err error),
+	roles ...string,
+) func(next http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			userRole, ok := GetUserRoleFromContext(r.Context())
+			if !ok || !slices.Contains(roles, userRole) {
+				respondWithError(w, r, custom_errors.Forbidden(fmt.Errorf("user role(%s) is not allowed to access %s", userRole, r.URL.Path)))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		}
+	}
+}
+
 // GetUserRoleFromContext is a helper function to retrieve the UserRole from the request context.
 func GetUserRoleFromContext(ctx context.Context) (string, bool) {
 	userRole, ok := ctx.Value(UserRoleContextKey).(string)
 	return userRole, ok
 }
+
+// CreateOptionalAuthMiddleware is a factory that creates a middleware for public routes serving more to signed in users.
+// It injects UserID and UserRole of a valid bearer access token, requests without one or with an invalid one go through anonymously.
+func CreateOptionalAuthMiddleware(tokenManager *security.TokenManager) func(next http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			headerParts := strings.Split(r.Header.Get("Authorization"), " ")
+			if len(headerParts) != 2 || strings.ToLower(headerParts[0]) != "bearer" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			claims, err := tokenManager.ValidateAccessToken(headerParts[1])
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), UserIDContextKey, claims.UserID)
+			ctx = context.WithValue(ctx, UserRoleContextKey, claims.Role)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+	}
+}
@@ -1,36 +1,205 @@
 package middleware
 
 import (
+	"backend/internal/infrastructure/security"
+	"backend/internal/shared/languages"
 	"context"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 )
 
 const LanguageContextKey string = "language"
 
-func LanguageMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		acceptLanguageHeader := r.Header.Get("Accept-Language")
+// LanguageQueryParameter overrides every other source of language preference
+const LanguageQueryParameter = "lang"
 
-		lang := strings.ToLower(strings.TrimSpace(acceptLanguageHeader))
+type languageRange struct {
+	tag     string
+	quality float64
+}
 
-		if lang != "en" && lang != "ru" && lang != "tg" {
-			lang = "tg"
-		}
+// CreateLanguageMiddleware is a factory that creates a middleware detecting the language of the request.
+// The language is picked, in order of precedence, from:
+// 1. the ?lang= query parameter
+// 2. the preferred language of the signed in user carried by the access token
+// 3. the Accept-Language header (RFC 9110, section 12.5.4)
+// and falls back to the default language of the registry. Only enabled languages of the registry are picked.
+// The picked language is sent back in Content-Language.
+func CreateLanguageMiddleware(tokenManager *security.TokenManager) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lang, ok := supportedLanguage(r.URL.Query().Get(LanguageQueryParameter))
+			if !ok {
+				lang, ok = preferredLanguageFromToken(tokenManager, r.Header.Get("Authorization"))
+			}
+			if !ok {
+				lang, ok = NegotiateLanguage(r.Header.Values("Accept-Language"), languages.Codes())
+			}
+			if !ok {
+				lang = languages.Default()
+			}
 
-		ctx := context.WithValue(r.Context(), LanguageContextKey, lang)
-		r = r.WithContext(ctx)
+			w.Header().Set("Content-Language", lang)
+			w.Header().Add("Vary", "Accept-Language")
+			w.Header().Add("Vary", "Authorization")
 
-		next.ServeHTTP(w, r)
-	})
+			ctx := context.WithValue(r.Context(), LanguageContextKey, lang)
+			r = r.WithContext(ctx)
+
+			next.ServeHTTP(w, r)
+		})
+	}
 }
 
-// Get Language from Context, if absent set to "tg"
+// Get Language from Context, if absent set to the default language of the registry
 func GetLanguageFromContext(ctx context.Context) string {
 	language, ok := ctx.Value(LanguageContextKey).(string)
 	if !ok || language == "" {
-		return "tg"
+		return languages.Default()
 	}
 
 	return language
 }
+
+// NegotiateLanguage picks the supported language the client prefers according to Accept-Language header values.
+// Language ranges are matched with the lookup scheme of RFC 4647, section 3.4: subtags are removed from the end
+// of a range until it matches, so "ru-RU" is served in "ru". Ranges with q=0 exclude the language.
+// The second result is false when none of the supported languages is acceptable.
+func NegotiateLanguage(values []string, supported []string) (string, bool) {
+	ranges := parseAcceptLanguage(values)
+
+	excluded := map[string]bool{}
+	for _, accepted := range ranges {
+		if accepted.quality == 0 && accepted.tag != "*" {
+			excluded[accepted.tag] = true
+		}
+	}
+
+	for _, accepted := range ranges {
+		if accepted.quality == 0 {
+			continue
+		}
+
+		if accepted.tag == "*" {
+			for _, lang := range supported {
+				if !excluded[lang] {
+					return lang, true
+				}
+			}
+			continue
+		}
+
+		for tag := accepted.tag; tag != ""; tag = truncateLanguageTag(tag) {
+			for _, lang := range supported {
+				if tag == lang && !excluded[tag] {
+					return lang, true
+				}
+			}
+		}
+	}
+
+	return "", false
+}
+
+// parseAcceptLanguage splits Accept-Language header values into language ranges ordered by quality,
+// ranges of equal quality keep the order they were sent in. Invalid ranges are skipped
+func parseAcceptLanguage(values []string) []languageRange {
+	ranges := []languageRange{}
+	for _, value := range values {
+		for _, element := range strings.Split(value, ",") {
+			parameters := strings.Split(element, ";")
+			tag := strings.ToLower(strings.TrimSpace(parameters[0]))
+			if !isLanguageRange(tag) {
+				continue
+			}
+
+			accepted := languageRange{tag: tag, quality: 1}
+			for _, parameter := range parameters[1:] {
+				name, rawValue, _ := strings.Cut(strings.TrimSpace(parameter), "=")
+				if !strings.EqualFold(strings.TrimSpace(name), "q") {
+					continue
+				}
+
+				quality, err := strconv.ParseFloat(strings.TrimSpace(rawValue), 64)
+				if err != nil || quality < 0 || quality > 1 {
+					quality = 0
+				}
+				accepted.quality = quality
+			}
+
+			ranges = append(ranges, accepted)
+		}
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	return ranges
+}
+
+// isLanguageRange reports whether tag is "*" or 1*8ALPHA *("-" 1*8alphanum) as defined by RFC 4647, section 2.1
+func isLanguageRange(tag string) bool {
+	if tag == "*" {
+		return true
+	}
+
+	for index, subtag := range strings.Split(tag, "-") {
+		if len(subtag) == 0 || len(subtag) > 8 {
+			return false
+		}
+
+		for _, char := range subtag {
+			isAlpha := char >= 'a' && char <= 'z'
+			isDigit := char >= '0' && char <= '9'
+			if !isAlpha && !(isDigit && index > 0) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// truncateLanguageTag removes the last subtag of tag, single letter subtags are removed together with the following one
+func truncateLanguageTag(tag string) string {
+	index := strings.LastIndex(tag, "-")
+	if index < 0 {
+		return ""
+	}
+
+	tag = tag[:index]
+	if index >= 2 && tag[index-2] == '-' {
+		tag = tag[:index-2]
+	}
+
+	return tag
+}
+
+// supportedLanguage normalizes lang and reports whether it is enabled in the registry
+func supportedLanguage(lang string) (string, bool) {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if !languages.IsEnabled(lang) {
+		return "", false
+	}
+
+	return lang, true
+}
+
+// preferredLanguageFromToken reads the preferred language of the user from a valid bearer access token.
+// Invalid tokens are ignored here, rejecting them is left to the auth middleware
+func preferredLanguageFromToken(tokenManager *security.TokenManager, authHeader string) (string, bool) {
+	headerParts := strings.Split(authHeader, " ")
+	if tokenManager == nil || len(headerParts) != 2 || strings.ToLower(headerParts[0]) != "bearer" {
+		return "", false
+	}
+
+	claims, err := tokenManager.ValidateAccessToken(headerParts[1])
+	if err != nil {
+		return "", false
+	}
+
+	return supportedLanguage(claims.PreferredLanguage)
+}
@@ -0,0 +1,29 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunPeriodically calls job right away and then every period until ctx is cancelled.
+// Errors are only logged, the job is retried on the next tick.
+func RunPeriodically(ctx context.Context, name string, period time.Duration, job func(ctx context.Context) error) {
+	go func() {
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+
+		for {
+			if err := job(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("WARNING: background job %s failed: %v", name, err)
+			}
+
+			select {
+			case <-ctx.Done():
+				log.Printf("Background job %s stopped", name)
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,71 @@
+package metadata
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"context"
+	"sync"
+	"time"
+)
+
+type cachedResolverEntry struct {
+	metadata  *domain.PublicationMetadata
+	expiresAt time.Time
+}
+
+// cachedResolver keeps successfully resolved records in memory
+// so repeated lookups of the same DOI do not hit the external registry
+type cachedResolver struct {
+	resolver repositories.PublicationMetadataResolver
+	ttl      time.Duration
+	mu       sync.RWMutex
+	entries  map[string]cachedResolverEntry
+}
+
+func NewCachedResolver(resolver repositories.PublicationMetadataResolver, ttl time.Duration) repositories.PublicationMetadataResolver {
+	return &cachedResolver{
+		resolver: resolver,
+		ttl:      ttl,
+		entries:  map[string]cachedResolverEntry{},
+	}
+}
+
+func (r *cachedResolver) ResolveDOI(ctx context.Context, doi string) (*domain.PublicationMetadata, error) {
+	r.mu.RLock()
+	entry, ok := r.entries[doi]
+	r.mu.RUnlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return copyPublicationMetadata(entry.metadata), nil
+	}
+
+	publicationMetadata, err := r.resolver.ResolveDOI(ctx, doi)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	now := time.Now()
+	for key, cached := range r.entries {
+		if now.After(cached.expiresAt) {
+			delete(r.entries, key)
+		}
+	}
+	r.entries[doi] = cachedResolverEntry{
+		metadata:  copyPublicationMetadata(publicationMetadata),
+		expiresAt: now.Add(r.ttl),
+	}
+	r.mu.Unlock()
+
+	return publicationMetadata, nil
+}
+
+// copyPublicationMetadata prevents callers from mutating cached records
+func copyPublicationMetadata(publicationMetadata *domain.PublicationMetadata) *domain.PublicationMetadata {
+	copied := *publicationMetadata
+	copied.Authors = append([]string{}, publicationMetadata.Authors...)
+	if publicationMetadata.CitationCount != nil {
+		citationCount := *publicationMetadata.CitationCount
+		copied.CitationCount = &citationCount
+	}
+	return &copied
+}
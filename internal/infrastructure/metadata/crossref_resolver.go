@@ -0,0 +1,163 @@
+package metadata
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// crossrefTypes maps Crossref work types onto publication types used across the application
+var crossrefTypes = map[string]string{
+	"journal-article":     "article",
+	"proceedings-article": "inproceedings",
+	"book":                "book",
+	"monograph":           "book",
+	"edited-book":         "book",
+	"book-chapter":        "incollection",
+	"dissertation":        "thesis",
+	"report":              "report",
+}
+
+type crossrefResolver struct {
+	baseURL    string
+	mailto     string
+	httpClient *http.Client
+}
+
+// NewCrossrefResolver creates a resolver for Crossref compatible "works" API.
+// baseURL is configurable so that a local stub can be used instead of api.crossref.org
+func NewCrossrefResolver(baseURL string, mailto string, timeout time.Duration) repositories.PublicationMetadataResolver {
+	return &crossrefResolver{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		mailto:  mailto,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type crossrefWorkResponse struct {
+	Status  string          `json:"status"`
+	Message crossrefMessage `json:"message"`
+}
+
+type crossrefMessage struct {
+	DOI            string           `json:"DOI"`
+	URL            string           `json:"URL"`
+	Type           string           `json:"type"`
+	Title          []string         `json:"title"`
+	ContainerTitle []string         `json:"container-title"`
+	Publisher      string           `json:"publisher"`
+	Author         []crossrefAuthor `json:"author"`
+	Issued         crossrefDate     `json:"issued"`
+	PublishedPrint crossrefDate     `json:"published-print"`
+	CitationCount  *int32           `json:"is-referenced-by-count"`
+}
+
+type crossrefAuthor struct {
+	Given  string `json:"given"`
+	Family string `json:"family"`
+	Name   string `json:"name"`
+}
+
+type crossrefDate struct {
+	DateParts [][]*int32 `json:"date-parts"`
+}
+
+func (d crossrefDate) year() int32 {
+	if len(d.DateParts) == 0 || len(d.DateParts[0]) == 0 || d.DateParts[0][0] == nil {
+		return 0
+	}
+
+	return *d.DateParts[0][0]
+}
+
+func (r *crossrefResolver) ResolveDOI(ctx context.Context, doi string) (*domain.PublicationMetadata, error) {
+	endpoint := r.baseURL + "/works/" + strings.ReplaceAll(url.PathEscape(doi), "%2F", "/")
+	if r.mailto != "" {
+		endpoint += "?mailto=" + url.QueryEscape(r.mailto)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to build crossref request for DOI(%s): %w", doi, err))
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := r.httpClient.Do(request)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to request crossref for DOI(%s): %w", doi, err))
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, custom_errors.NotFound(fmt.Errorf("publication with DOI(%s) was not found", doi))
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("crossref responded with status %d for DOI(%s)", response.StatusCode, doi))
+	}
+
+	var work crossrefWorkResponse
+	if err := json.NewDecoder(response.Body).Decode(&work); err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to decode crossref response for DOI(%s): %w", doi, err))
+	}
+
+	return mapCrossrefMessage(&work.Message, doi), nil
+}
+
+func mapCrossrefMessage(message *crossrefMessage, doi string) *domain.PublicationMetadata {
+	publicationMetadata := &domain.PublicationMetadata{
+		DOI:               strings.ToLower(message.DOI),
+		LinkToPublication: message.URL,
+		Authors:           []string{},
+		PublicationYear:   message.PublishedPrint.year(),
+		PublicationType:   "misc",
+		CitationCount:     message.CitationCount,
+	}
+
+	if publicationMetadata.DOI == "" {
+		publicationMetadata.DOI = doi
+	}
+
+	if publicationMetadata.LinkToPublication == "" {
+		publicationMetadata.LinkToPublication = "https://doi.org/" + publicationMetadata.DOI
+	}
+
+	if publicationMetadata.PublicationYear == 0 {
+		publicationMetadata.PublicationYear = message.Issued.year()
+	}
+
+	if publicationType, ok := crossrefTypes[message.Type]; ok {
+		publicationMetadata.PublicationType = publicationType
+	}
+
+	if len(message.Title) > 0 {
+		publicationMetadata.PublicationTitle = strings.Join(strings.Fields(message.Title[0]), " ")
+	}
+
+	if len(message.ContainerTitle) > 0 {
+		publicationMetadata.Venue = message.ContainerTitle[0]
+	} else {
+		publicationMetadata.Venue = message.Publisher
+	}
+
+	for _, author := range message.Author {
+		name := strings.TrimSpace(author.Given + " " + author.Family)
+		if name == "" {
+			name = author.Name
+		}
+		if name != "" {
+			publicationMetadata.Authors = append(publicationMetadata.Authors, name)
+		}
+	}
+
+	return publicationMetadata
+}
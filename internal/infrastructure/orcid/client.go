@@ -0,0 +1,343 @@
+package orcid
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/shared/bibliography"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// workTypes maps ORCID work types onto publication types used across the application
+var workTypes = map[string]string{
+	"journal-article":     "article",
+	"conference-paper":    "inproceedings",
+	"book":                "book",
+	"book-chapter":        "incollection",
+	"dissertation":        "thesis",
+	"dissertation-thesis": "thesis",
+	"report":              "report",
+}
+
+type Config struct {
+	// Base URL of ORCID site serving /oauth/authorize and /oauth/token, e.g. https://orcid.org
+	BaseURL string
+	// Base URL of ORCID record API including version, e.g. https://pub.orcid.org/v3.0
+	APIBaseURL   string
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+	Scope        string
+	Timeout      time.Duration
+}
+
+type client struct {
+	config     Config
+	httpClient *http.Client
+}
+
+func NewClient(config Config) repositories.OrcidClient {
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+	config.APIBaseURL = strings.TrimRight(config.APIBaseURL, "/")
+
+	return &client{
+		config: config,
+		httpClient: &http.Client{
+			Timeout: config.Timeout,
+		},
+	}
+}
+
+func (c *client) AuthorizationURL(state string) string {
+	query := url.Values{}
+	query.Set("client_id", c.config.ClientID)
+	query.Set("response_type", "code")
+	query.Set("scope", c.config.Scope)
+	query.Set("redirect_uri", c.config.RedirectURI)
+	query.Set("state", state)
+
+	return c.config.BaseURL + "/oauth/authorize?" + query.Encode()
+}
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Scope        string `json:"scope"`
+	Name         string `json:"name"`
+	ORCID        string `json:"orcid"`
+}
+
+func (c *client) ExchangeCode(ctx context.Context, code string) (*domain.EmployeeOrcidAccount, error) {
+	form := url.Values{}
+	form.Set("client_id", c.config.ClientID)
+	form.Set("client_secret", c.config.ClientSecret)
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.config.RedirectURI)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.BaseURL+"/oauth/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to build orcid token request: %w", err))
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to request orcid token: %w", err))
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusBadRequest || response.StatusCode == http.StatusUnauthorized {
+		return nil, custom_errors.BadRequest(fmt.Errorf("orcid rejected the authorization code"))
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("orcid token endpoint responded with status %d", response.StatusCode))
+	}
+
+	var token tokenResponse
+	if err := json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to decode orcid token response: %w", err))
+	}
+
+	account := &domain.EmployeeOrcidAccount{
+		ORCID:        token.ORCID,
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		Scope:        token.Scope,
+	}
+	if token.ExpiresIn > 0 {
+		account.ExpiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return account, nil
+}
+
+type stringValue struct {
+	Value string `json:"value"`
+}
+
+type fuzzyDate struct {
+	Year  *stringValue `json:"year"`
+	Month *stringValue `json:"month"`
+	Day   *stringValue `json:"day"`
+}
+
+// toTime converts a partial ORCID date, missing month and day default to 1.
+// Returns zero time if the year is not set
+func (d *fuzzyDate) toTime() time.Time {
+	if d == nil || d.Year == nil {
+		return time.Time{}
+	}
+
+	year, err := strconv.Atoi(d.Year.Value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	month, day := 1, 1
+	if d.Month != nil {
+		if parsedMonth, err := strconv.Atoi(d.Month.Value); err == nil && parsedMonth >= 1 && parsedMonth <= 12 {
+			month = parsedMonth
+		}
+	}
+	if d.Day != nil {
+		if parsedDay, err := strconv.Atoi(d.Day.Value); err == nil && parsedDay >= 1 && parsedDay <= 31 {
+			day = parsedDay
+		}
+	}
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+type worksResponse struct {
+	Group []struct {
+		WorkSummary []workSummary `json:"work-summary"`
+	} `json:"group"`
+}
+
+type workSummary struct {
+	PutCode int64 `json:"put-code"`
+	Title   *struct {
+		Title *stringValue `json:"title"`
+	} `json:"title"`
+	ExternalIDs *struct {
+		ExternalID []struct {
+			Type  string `json:"external-id-type"`
+			Value string `json:"external-id-value"`
+		} `json:"external-id"`
+	} `json:"external-ids"`
+	URL             *stringValue `json:"url"`
+	Type            string       `json:"type"`
+	PublicationDate *fuzzyDate   `json:"publication-date"`
+	JournalTitle    *stringValue `json:"journal-title"`
+}
+
+func (c *client) GetWorks(ctx context.Context, account *domain.EmployeeOrcidAccount) ([]*domain.EmployeePublication, error) {
+	var works worksResponse
+	if err := c.getRecordSection(ctx, account, "works", &works); err != nil {
+		return nil, err
+	}
+
+	publications := []*domain.EmployeePublication{}
+	for _, group := range works.Group {
+		// the first summary of the group is the preferred version of the work
+		if len(group.WorkSummary) == 0 {
+			continue
+		}
+		work := group.WorkSummary[0]
+
+		if work.Title == nil || work.Title.Title == nil || strings.TrimSpace(work.Title.Title.Value) == "" {
+			continue
+		}
+
+		publication := &domain.EmployeePublication{
+			PublicationTitle: strings.TrimSpace(work.Title.Title.Value),
+			Authors:          []string{},
+			PublicationType:  "misc",
+			Source:           domain.SourceORCID,
+			ExternalID:       strconv.FormatInt(work.PutCode, 10),
+		}
+
+		if publicationType, ok := workTypes[work.Type]; ok {
+			publication.PublicationType = publicationType
+		}
+
+		if work.JournalTitle != nil {
+			publication.Venue = work.JournalTitle.Value
+		}
+
+		if publicationDate := work.PublicationDate.toTime(); !publicationDate.IsZero() {
+			publication.PublicationYear = int32(publicationDate.Year())
+		}
+
+		if work.ExternalIDs != nil {
+			for _, externalID := range work.ExternalIDs.ExternalID {
+				if strings.EqualFold(externalID.Type, "doi") {
+					publication.DOI = bibliography.NormalizeDOI(externalID.Value)
+					break
+				}
+			}
+		}
+
+		if work.URL != nil && work.URL.Value != "" {
+			publication.LinkToPublication = work.URL.Value
+		} else if publication.DOI != "" {
+			publication.LinkToPublication = "https://doi.org/" + publication.DOI
+		} else {
+			publication.LinkToPublication = fmt.Sprintf("https://orcid.org/%s/work/%d", account.ORCID, work.PutCode)
+		}
+
+		publications = append(publications, publication)
+	}
+
+	return publications, nil
+}
+
+type employmentsResponse struct {
+	AffiliationGroup []struct {
+		Summaries []struct {
+			EmploymentSummary *employmentSummary `json:"employment-summary"`
+		} `json:"summaries"`
+	} `json:"affiliation-group"`
+}
+
+type employmentSummary struct {
+	PutCode        int64      `json:"put-code"`
+	DepartmentName string     `json:"department-name"`
+	RoleTitle      string     `json:"role-title"`
+	StartDate      *fuzzyDate `json:"start-date"`
+	EndDate        *fuzzyDate `json:"end-date"`
+	Organization   *struct {
+		Name string `json:"name"`
+	} `json:"organization"`
+}
+
+func (c *client) GetEmployments(ctx context.Context, account *domain.EmployeeOrcidAccount) ([]*domain.EmployeeWorkExperience, error) {
+	var employments employmentsResponse
+	if err := c.getRecordSection(ctx, account, "employments", &employments); err != nil {
+		return nil, err
+	}
+
+	workExperiences := []*domain.EmployeeWorkExperience{}
+	for _, group := range employments.AffiliationGroup {
+		for _, summary := range group.Summaries {
+			employment := summary.EmploymentSummary
+			if employment == nil || employment.Organization == nil || employment.Organization.Name == "" {
+				continue
+			}
+
+			// date_start is mandatory for work experiences
+			dateStart := employment.StartDate.toTime()
+			if dateStart.IsZero() {
+				continue
+			}
+
+			dateEnd := employment.EndDate.toTime()
+			workExperiences = append(workExperiences, &domain.EmployeeWorkExperience{
+				Workplace:   truncate(employment.Organization.Name, 255),
+				JobTitle:    truncate(employment.RoleTitle, 255),
+				Description: truncate(employment.DepartmentName, 255),
+				DateStart:   dateStart,
+				DateEnd:     dateEnd,
+				Ongoing:     dateEnd.IsZero(),
+				Source:      domain.SourceORCID,
+				ExternalID:  strconv.FormatInt(employment.PutCode, 10),
+			})
+		}
+	}
+
+	return workExperiences, nil
+}
+
+// getRecordSection reads a summary section (works, employments, ...) of the ORCID record
+func (c *client) getRecordSection(ctx context.Context, account *domain.EmployeeOrcidAccount, section string, target any) error {
+	endpoint := fmt.Sprintf("%s/%s/%s", c.config.APIBaseURL, url.PathEscape(account.ORCID), section)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to build orcid %s request: %w", section, err))
+	}
+	request.Header.Set("Accept", "application/json")
+	if account.AccessToken != "" {
+		request.Header.Set("Authorization", "Bearer "+account.AccessToken)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to request orcid %s of %s: %w", section, account.ORCID, err))
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+		return custom_errors.Unauthorized(fmt.Errorf("orcid access token of %s was rejected, the account has to be linked again", account.ORCID))
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return custom_errors.InternalServerError(fmt.Errorf("orcid %s endpoint responded with status %d for %s", section, response.StatusCode, account.ORCID))
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(target); err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to decode orcid %s of %s: %w", section, account.ORCID, err))
+	}
+
+	return nil
+}
+
+func truncate(value string, maxRunes int) string {
+	runes := []rune(strings.TrimSpace(value))
+	if len(runes) <= maxRunes {
+		return string(runes)
+	}
+
+	return string(runes[:maxRunes])
+}
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_employee_publications_doi;
+
+ALTER TABLE employee_publications
+  DROP COLUMN authors,
+  DROP COLUMN publication_year,
+  DROP COLUMN venue,
+  DROP COLUMN doi,
+  DROP COLUMN publication_type,
+  ALTER COLUMN publication_title TYPE VARCHAR(255);
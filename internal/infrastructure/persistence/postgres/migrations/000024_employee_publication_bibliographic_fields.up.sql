@@ -0,0 +1,10 @@
+ALTER TABLE employee_publications
+  ALTER COLUMN publication_title TYPE VARCHAR(1023),
+  ADD COLUMN authors TEXT[] NOT NULL DEFAULT '{}',
+  ADD COLUMN publication_year INT,
+  ADD COLUMN venue VARCHAR(511),
+  ADD COLUMN doi VARCHAR(255),
+  ADD COLUMN publication_type VARCHAR(63);
+
+CREATE INDEX IF NOT EXISTS idx_employee_publications_doi
+  ON employee_publications (doi);
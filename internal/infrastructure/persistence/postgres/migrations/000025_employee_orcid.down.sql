@@ -0,0 +1,17 @@
+ALTER TABLE employee_work_experiences
+  DROP CONSTRAINT IF EXISTS employee_work_experiences_source_external_id_key,
+  DROP COLUMN external_id,
+  DROP COLUMN source;
+
+ALTER TABLE employee_publications
+  DROP CONSTRAINT IF EXISTS employee_publications_source_external_id_key,
+  DROP COLUMN external_id,
+  DROP COLUMN source;
+
+DROP TABLE IF EXISTS orcid_oauth_states;
+
+DROP TABLE IF EXISTS employee_orcid_accounts;
+
+ALTER TABLE employees
+  DROP CONSTRAINT IF EXISTS employees_orcid_key,
+  DROP COLUMN orcid;
@@ -0,0 +1,52 @@
+ALTER TABLE employees
+  ADD COLUMN orcid VARCHAR(19),
+  ADD CONSTRAINT employees_orcid_key UNIQUE (orcid);
+
+CREATE TABLE IF NOT EXISTS employee_orcid_accounts (
+  id BIGSERIAL,
+  employee_id BIGINT NOT NULL,
+  orcid VARCHAR(19) NOT NULL,
+  access_token TEXT NOT NULL,
+  refresh_token TEXT NOT NULL DEFAULT '',
+  scope VARCHAR(255) NOT NULL DEFAULT '',
+  expires_at TIMESTAMPTZ,
+  last_synced_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT employee_orcid_accounts_pkey
+    PRIMARY KEY (id),
+  CONSTRAINT employee_orcid_accounts_employee_id_key
+    UNIQUE (employee_id),
+  CONSTRAINT fk_employees_employee_orcid_accounts
+    FOREIGN KEY (employee_id)
+    REFERENCES employees (id)
+    ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS orcid_oauth_states (
+  state VARCHAR(64) NOT NULL,
+  employee_id BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT orcid_oauth_states_pkey
+    PRIMARY KEY (state),
+  CONSTRAINT fk_employees_orcid_oauth_states
+    FOREIGN KEY (employee_id)
+    REFERENCES employees (id)
+    ON DELETE CASCADE
+);
+
+-- source tells where the row came from: 'manual' for entries typed in by the user,
+-- 'orcid' for entries imported from ORCID (external_id then holds ORCID put-code)
+ALTER TABLE employee_publications
+  ADD COLUMN source VARCHAR(31) NOT NULL DEFAULT 'manual',
+  ADD COLUMN external_id VARCHAR(255),
+  ADD CONSTRAINT employee_publications_source_external_id_key
+    UNIQUE (employee_id, language_code, source, external_id);
+
+ALTER TABLE employee_work_experiences
+  ADD COLUMN source VARCHAR(31) NOT NULL DEFAULT 'manual',
+  ADD COLUMN external_id VARCHAR(255),
+  ADD CONSTRAINT employee_work_experiences_source_external_id_key
+    UNIQUE (employee_id, language_code, source, external_id);
@@ -0,0 +1,6 @@
+ALTER TABLE employee_publications
+  DROP CONSTRAINT IF EXISTS fk_publications_employee_publications,
+  DROP COLUMN IF EXISTS publication_id;
+
+DROP TABLE IF EXISTS publication_authors;
+DROP TABLE IF EXISTS publications;
@@ -0,0 +1,165 @@
+-- publications holds one canonical row per paper, employee_publications rows of every
+-- co-author (and every language) point to it through publication_id
+CREATE TABLE IF NOT EXISTS publications (
+  id BIGSERIAL,
+  publication_title VARCHAR(1023) NOT NULL,
+  normalized_title VARCHAR(1023) NOT NULL,
+  link_to_publication VARCHAR(511) NOT NULL DEFAULT '',
+  publication_year INT,
+  venue VARCHAR(511) NOT NULL DEFAULT '',
+  doi VARCHAR(255),
+  publication_type VARCHAR(63) NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT publications_pkey
+    PRIMARY KEY (id),
+  CONSTRAINT publications_doi_key
+    UNIQUE (doi)
+);
+
+CREATE INDEX IF NOT EXISTS idx_publications_normalized_title
+  ON publications (normalized_title);
+
+-- author list of the publication, employee_id is set once the author is matched to (claimed by) an employee
+CREATE TABLE IF NOT EXISTS publication_authors (
+  id BIGSERIAL,
+  publication_id BIGINT NOT NULL,
+  position INT NOT NULL,
+  author_name VARCHAR(255) NOT NULL,
+  employee_id BIGINT,
+  claimed_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT publication_authors_pkey
+    PRIMARY KEY (id),
+  CONSTRAINT publication_authors_position_key
+    UNIQUE (publication_id, position),
+  CONSTRAINT publication_authors_employee_id_key
+    UNIQUE (publication_id, employee_id),
+  CONSTRAINT fk_publications_publication_authors
+    FOREIGN KEY (publication_id)
+    REFERENCES publications (id)
+    ON DELETE CASCADE,
+  CONSTRAINT fk_employees_publication_authors
+    FOREIGN KEY (employee_id)
+    REFERENCES employees (id)
+    ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_publication_authors_employee_id
+  ON publication_authors (employee_id);
+
+ALTER TABLE employee_publications
+  ADD COLUMN publication_id BIGINT,
+  ADD CONSTRAINT fk_publications_employee_publications
+    FOREIGN KEY (publication_id)
+    REFERENCES publications (id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employee_publications_publication_id
+  ON employee_publications (publication_id);
+
+-- Backfill: one canonical publication per DOI, or per normalized title when DOI is missing
+WITH keyed AS (
+  SELECT
+    ep.*,
+    lower(regexp_replace(ep.publication_title, '[^[:alnum:]]+', '', 'g')) AS normalized_title,
+    COALESCE(NULLIF(ep.doi, ''), 'title:' || lower(regexp_replace(ep.publication_title, '[^[:alnum:]]+', '', 'g'))) AS publication_key
+  FROM employee_publications ep
+),
+representatives AS (
+  SELECT DISTINCT ON (publication_key) *
+  FROM keyed
+  ORDER BY publication_key, cardinality(authors) DESC, id
+)
+INSERT INTO publications (
+  publication_title,
+  normalized_title,
+  link_to_publication,
+  publication_year,
+  venue,
+  doi,
+  publication_type
+)
+SELECT
+  publication_title,
+  normalized_title,
+  link_to_publication,
+  publication_year,
+  COALESCE(venue, ''),
+  NULLIF(doi, ''),
+  COALESCE(publication_type, '')
+FROM representatives;
+
+UPDATE employee_publications ep
+SET publication_id = p.id
+FROM publications p
+WHERE (p.doi IS NOT NULL AND p.doi = NULLIF(ep.doi, ''))
+   OR (p.doi IS NULL AND NULLIF(ep.doi, '') IS NULL
+       AND p.normalized_title = lower(regexp_replace(ep.publication_title, '[^[:alnum:]]+', '', 'g')));
+
+-- author lists are taken from the row with the longest list
+INSERT INTO publication_authors (publication_id, position, author_name)
+SELECT DISTINCT ON (ep.publication_id, author.position)
+  ep.publication_id,
+  author.position,
+  left(author.name, 255)
+FROM employee_publications ep
+CROSS JOIN LATERAL unnest(ep.authors) WITH ORDINALITY AS author(name, position)
+WHERE ep.publication_id IS NOT NULL
+  AND ep.id = (
+    SELECT ep2.id
+    FROM employee_publications ep2
+    WHERE ep2.publication_id = ep.publication_id
+    ORDER BY cardinality(ep2.authors) DESC, ep2.id
+    LIMIT 1
+  )
+ORDER BY ep.publication_id, author.position;
+
+-- every employee having the paper claims the first unclaimed author whose name contains their surname
+WITH matches AS (
+  SELECT DISTINCT ON (pa.publication_id, ep.employee_id)
+    pa.id AS author_id,
+    ep.employee_id
+  FROM employee_publications ep
+  JOIN employee_details ed ON ed.employee_id = ep.employee_id AND ed.is_employee_details_new IS TRUE
+  JOIN publication_authors pa ON pa.publication_id = ep.publication_id
+  WHERE btrim(ed.surname) <> ''
+    AND pa.author_name ILIKE '%' || btrim(ed.surname) || '%'
+  ORDER BY pa.publication_id, ep.employee_id, pa.position
+),
+unique_matches AS (
+  SELECT DISTINCT ON (author_id) author_id, employee_id
+  FROM matches
+  ORDER BY author_id, employee_id
+)
+UPDATE publication_authors pa
+SET employee_id = um.employee_id, claimed_at = now()
+FROM unique_matches um
+WHERE pa.id = um.author_id;
+
+-- employees that could not be matched by name are appended to the author list
+INSERT INTO publication_authors (publication_id, position, author_name, employee_id, claimed_at)
+SELECT
+  missing.publication_id,
+  COALESCE((SELECT max(pa.position) FROM publication_authors pa WHERE pa.publication_id = missing.publication_id), 0)
+    + row_number() OVER (PARTITION BY missing.publication_id ORDER BY missing.employee_id),
+  missing.author_name,
+  missing.employee_id,
+  now()
+FROM (
+  SELECT DISTINCT ON (ep.publication_id, ep.employee_id)
+    ep.publication_id,
+    ep.employee_id,
+    left(btrim(COALESCE(ed.name, '') || ' ' || COALESCE(ed.surname, '')), 255) AS author_name
+  FROM employee_publications ep
+  LEFT JOIN employee_details ed ON ed.employee_id = ep.employee_id AND ed.is_employee_details_new IS TRUE
+  WHERE ep.publication_id IS NOT NULL
+    AND NOT EXISTS (
+      SELECT 1 FROM publication_authors pa
+      WHERE pa.publication_id = ep.publication_id AND pa.employee_id = ep.employee_id
+    )
+  ORDER BY ep.publication_id, ep.employee_id, ed.language_code
+) missing;
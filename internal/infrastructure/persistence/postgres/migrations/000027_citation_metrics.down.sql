@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS employee_citation_metrics;
+DROP TABLE IF EXISTS publication_citations;
@@ -0,0 +1,36 @@
+-- source tells where the count came from: 'manual' when typed in by the employee,
+-- 'crossref' when imported from the DOI registry
+CREATE TABLE IF NOT EXISTS publication_citations (
+  publication_id BIGINT NOT NULL,
+  citation_count INT NOT NULL DEFAULT 0,
+  source VARCHAR(63) NOT NULL DEFAULT 'manual',
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT publication_citations_pkey
+    PRIMARY KEY (publication_id),
+  CONSTRAINT publication_citations_citation_count_check
+    CHECK (citation_count >= 0),
+  CONSTRAINT fk_publications_publication_citations
+    FOREIGN KEY (publication_id)
+    REFERENCES publications (id)
+    ON DELETE CASCADE
+);
+
+-- bibliometric indicators are kept precomputed so the personnel directory can sort and filter by them
+CREATE TABLE IF NOT EXISTS employee_citation_metrics (
+  employee_id BIGINT NOT NULL,
+  h_index INT NOT NULL DEFAULT 0,
+  i10_index INT NOT NULL DEFAULT 0,
+  total_citations INT NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT employee_citation_metrics_pkey
+    PRIMARY KEY (employee_id),
+  CONSTRAINT fk_employees_employee_citation_metrics
+    FOREIGN KEY (employee_id)
+    REFERENCES employees (id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_citation_metrics_h_index ON employee_citation_metrics (h_index);
+CREATE INDEX IF NOT EXISTS idx_employee_citation_metrics_total_citations ON employee_citation_metrics (total_citations);
@@ -0,0 +1,89 @@
+DROP TRIGGER IF EXISTS institution_project_partners_translation_group_owner_trg ON institution_project_partners;
+DROP INDEX IF EXISTS institution_project_partners_translation_group_language_key;
+ALTER TABLE institution_project_partners DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS institution_projects_translation_group_owner_trg ON institution_projects;
+DROP INDEX IF EXISTS institution_projects_translation_group_language_key;
+ALTER TABLE institution_projects DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS institution_conferences_translation_group_owner_trg ON institution_conferences;
+DROP INDEX IF EXISTS institution_conferences_translation_group_language_key;
+ALTER TABLE institution_conferences DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS institution_research_support_infrastructures_translation_group_owner_trg ON institution_research_support_infrastructures;
+DROP INDEX IF EXISTS institution_research_support_infrastructures_translation_group_language_key;
+ALTER TABLE institution_research_support_infrastructures DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS institution_main_research_directions_translation_group_owner_trg ON institution_main_research_directions;
+DROP INDEX IF EXISTS institution_main_research_directions_translation_group_language_key;
+ALTER TABLE institution_main_research_directions DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS institution_magazines_translation_group_owner_trg ON institution_magazines;
+DROP INDEX IF EXISTS institution_magazines_translation_group_language_key;
+ALTER TABLE institution_magazines DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS institution_achievements_translation_group_owner_trg ON institution_achievements;
+DROP INDEX IF EXISTS institution_achievements_translation_group_language_key;
+ALTER TABLE institution_achievements DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS institution_partnerships_translation_group_owner_trg ON institution_partnerships;
+DROP INDEX IF EXISTS institution_partnerships_translation_group_language_key;
+ALTER TABLE institution_partnerships DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS institution_patents_translation_group_owner_trg ON institution_patents;
+DROP INDEX IF EXISTS institution_patents_translation_group_language_key;
+ALTER TABLE institution_patents DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS institution_rankings_translation_group_owner_trg ON institution_rankings;
+DROP INDEX IF EXISTS institution_rankings_translation_group_language_key;
+ALTER TABLE institution_rankings DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS institution_licences_translation_group_owner_trg ON institution_licences;
+DROP INDEX IF EXISTS institution_licences_translation_group_language_key;
+ALTER TABLE institution_licences DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS institution_accreditations_translation_group_owner_trg ON institution_accreditations;
+DROP INDEX IF EXISTS institution_accreditations_translation_group_language_key;
+ALTER TABLE institution_accreditations DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS employee_research_activities_translation_group_owner_trg ON employee_research_activities;
+DROP INDEX IF EXISTS employee_research_activities_translation_group_language_key;
+ALTER TABLE employee_research_activities DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS employee_participation_in_events_translation_group_owner_trg ON employee_participation_in_events;
+DROP INDEX IF EXISTS employee_participation_in_events_translation_group_language_key;
+ALTER TABLE employee_participation_in_events DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS employee_refresher_courses_translation_group_owner_trg ON employee_refresher_courses;
+DROP INDEX IF EXISTS employee_refresher_courses_translation_group_language_key;
+ALTER TABLE employee_refresher_courses DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS employee_participation_in_professional_communities_translation_group_owner_trg ON employee_participation_in_professional_communities;
+DROP INDEX IF EXISTS employee_participation_in_professional_communities_translation_group_language_key;
+ALTER TABLE employee_participation_in_professional_communities DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS employee_patents_translation_group_owner_trg ON employee_patents;
+DROP INDEX IF EXISTS employee_patents_translation_group_language_key;
+ALTER TABLE employee_patents DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS employee_scientific_awards_translation_group_owner_trg ON employee_scientific_awards;
+DROP INDEX IF EXISTS employee_scientific_awards_translation_group_language_key;
+ALTER TABLE employee_scientific_awards DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS employee_publications_translation_group_owner_trg ON employee_publications;
+DROP INDEX IF EXISTS employee_publications_translation_group_language_key;
+ALTER TABLE employee_publications DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS employee_main_research_areas_translation_group_owner_trg ON employee_main_research_areas;
+DROP INDEX IF EXISTS employee_main_research_areas_translation_group_language_key;
+ALTER TABLE employee_main_research_areas DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS employee_work_experiences_translation_group_owner_trg ON employee_work_experiences;
+DROP INDEX IF EXISTS employee_work_experiences_translation_group_language_key;
+ALTER TABLE employee_work_experiences DROP COLUMN IF EXISTS translation_group_id;
+
+DROP TRIGGER IF EXISTS employee_degrees_translation_group_owner_trg ON employee_degrees;
+DROP INDEX IF EXISTS employee_degrees_translation_group_language_key;
+ALTER TABLE employee_degrees DROP COLUMN IF EXISTS translation_group_id;
+
+DROP FUNCTION IF EXISTS check_translation_group_owner();
@@ -0,0 +1,315 @@
+-- translation_group_id links rows describing the same record in different languages,
+-- a group holds at most one row per language and all its rows belong to the same owner.
+-- employee_details and institution_details are already one row per owner and language, so they are left as is.
+-- Existing rows can not be matched reliably and start in their own groups,
+-- except employee publications which are grouped by the canonical publication they point to.
+
+CREATE OR REPLACE FUNCTION check_translation_group_owner()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_owner_column TEXT := TG_ARGV[0];
+  v_conflicting BOOLEAN;
+BEGIN
+  EXECUTE format(
+    'SELECT EXISTS (SELECT 1 FROM %I WHERE translation_group_id = $1 AND id <> $2 AND %I::text IS DISTINCT FROM $3)',
+    TG_TABLE_NAME,
+    v_owner_column
+  )
+  INTO v_conflicting
+  USING NEW.translation_group_id, NEW.id, to_jsonb(NEW) ->> v_owner_column;
+
+  IF v_conflicting THEN
+    RAISE EXCEPTION 'translation group % of % belongs to another %', NEW.translation_group_id, TG_TABLE_NAME, v_owner_column
+      USING ERRCODE = 'check_violation', CONSTRAINT = 'translation_group_owner';
+  END IF;
+
+  RETURN NEW;
+END;
+$$;
+
+ALTER TABLE employee_degrees
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE employee_work_experiences
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE employee_main_research_areas
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE employee_publications
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE employee_scientific_awards
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE employee_patents
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE employee_participation_in_professional_communities
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE employee_refresher_courses
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE employee_participation_in_events
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE employee_research_activities
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_accreditations
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_licences
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_rankings
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_patents
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_partnerships
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_achievements
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_magazines
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_main_research_directions
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_research_support_infrastructures
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_conferences
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_projects
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+ALTER TABLE institution_project_partners
+  ADD COLUMN IF NOT EXISTS translation_group_id UUID NOT NULL DEFAULT gen_random_uuid();
+
+WITH grouped_publications AS (
+  SELECT
+    ep.id,
+    first_value(ep.translation_group_id) OVER (PARTITION BY ep.employee_id, ep.publication_id ORDER BY ep.id) AS translation_group_id,
+    count(*) OVER (PARTITION BY ep.employee_id, ep.publication_id) AS rows_in_group,
+    count(*) OVER (PARTITION BY ep.employee_id, ep.publication_id, ep.language_code) AS rows_in_language
+  FROM employee_publications ep
+  WHERE ep.publication_id IS NOT NULL
+)
+UPDATE employee_publications ep
+SET translation_group_id = gp.translation_group_id
+FROM grouped_publications gp
+WHERE ep.id = gp.id
+  AND gp.rows_in_group > 1
+  AND NOT EXISTS (
+    SELECT 1
+    FROM grouped_publications other
+    WHERE other.translation_group_id = gp.translation_group_id
+      AND other.rows_in_language > 1
+  );
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_degrees_translation_group_language_key
+  ON employee_degrees (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS employee_degrees_translation_group_owner_trg ON employee_degrees;
+CREATE TRIGGER employee_degrees_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON employee_degrees
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('employee_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_work_experiences_translation_group_language_key
+  ON employee_work_experiences (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS employee_work_experiences_translation_group_owner_trg ON employee_work_experiences;
+CREATE TRIGGER employee_work_experiences_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON employee_work_experiences
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('employee_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_main_research_areas_translation_group_language_key
+  ON employee_main_research_areas (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS employee_main_research_areas_translation_group_owner_trg ON employee_main_research_areas;
+CREATE TRIGGER employee_main_research_areas_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON employee_main_research_areas
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('employee_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_publications_translation_group_language_key
+  ON employee_publications (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS employee_publications_translation_group_owner_trg ON employee_publications;
+CREATE TRIGGER employee_publications_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON employee_publications
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('employee_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_scientific_awards_translation_group_language_key
+  ON employee_scientific_awards (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS employee_scientific_awards_translation_group_owner_trg ON employee_scientific_awards;
+CREATE TRIGGER employee_scientific_awards_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON employee_scientific_awards
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('employee_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_patents_translation_group_language_key
+  ON employee_patents (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS employee_patents_translation_group_owner_trg ON employee_patents;
+CREATE TRIGGER employee_patents_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON employee_patents
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('employee_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_participation_in_professional_communities_translation_group_language_key
+  ON employee_participation_in_professional_communities (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS employee_participation_in_professional_communities_translation_group_owner_trg ON employee_participation_in_professional_communities;
+CREATE TRIGGER employee_participation_in_professional_communities_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON employee_participation_in_professional_communities
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('employee_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_refresher_courses_translation_group_language_key
+  ON employee_refresher_courses (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS employee_refresher_courses_translation_group_owner_trg ON employee_refresher_courses;
+CREATE TRIGGER employee_refresher_courses_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON employee_refresher_courses
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('employee_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_participation_in_events_translation_group_language_key
+  ON employee_participation_in_events (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS employee_participation_in_events_translation_group_owner_trg ON employee_participation_in_events;
+CREATE TRIGGER employee_participation_in_events_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON employee_participation_in_events
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('employee_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_research_activities_translation_group_language_key
+  ON employee_research_activities (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS employee_research_activities_translation_group_owner_trg ON employee_research_activities;
+CREATE TRIGGER employee_research_activities_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON employee_research_activities
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('employee_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_accreditations_translation_group_language_key
+  ON institution_accreditations (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_accreditations_translation_group_owner_trg ON institution_accreditations;
+CREATE TRIGGER institution_accreditations_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_accreditations
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_licences_translation_group_language_key
+  ON institution_licences (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_licences_translation_group_owner_trg ON institution_licences;
+CREATE TRIGGER institution_licences_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_licences
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_rankings_translation_group_language_key
+  ON institution_rankings (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_rankings_translation_group_owner_trg ON institution_rankings;
+CREATE TRIGGER institution_rankings_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_rankings
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_patents_translation_group_language_key
+  ON institution_patents (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_patents_translation_group_owner_trg ON institution_patents;
+CREATE TRIGGER institution_patents_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_patents
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_partnerships_translation_group_language_key
+  ON institution_partnerships (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_partnerships_translation_group_owner_trg ON institution_partnerships;
+CREATE TRIGGER institution_partnerships_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_partnerships
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_achievements_translation_group_language_key
+  ON institution_achievements (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_achievements_translation_group_owner_trg ON institution_achievements;
+CREATE TRIGGER institution_achievements_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_achievements
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_magazines_translation_group_language_key
+  ON institution_magazines (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_magazines_translation_group_owner_trg ON institution_magazines;
+CREATE TRIGGER institution_magazines_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_magazines
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_main_research_directions_translation_group_language_key
+  ON institution_main_research_directions (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_main_research_directions_translation_group_owner_trg ON institution_main_research_directions;
+CREATE TRIGGER institution_main_research_directions_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_main_research_directions
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_research_support_infrastructures_translation_group_language_key
+  ON institution_research_support_infrastructures (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_research_support_infrastructures_translation_group_owner_trg ON institution_research_support_infrastructures;
+CREATE TRIGGER institution_research_support_infrastructures_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_research_support_infrastructures
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_conferences_translation_group_language_key
+  ON institution_conferences (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_conferences_translation_group_owner_trg ON institution_conferences;
+CREATE TRIGGER institution_conferences_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_conferences
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_projects_translation_group_language_key
+  ON institution_projects (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_projects_translation_group_owner_trg ON institution_projects;
+CREATE TRIGGER institution_projects_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_projects
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_id');
+
+CREATE UNIQUE INDEX IF NOT EXISTS institution_project_partners_translation_group_language_key
+  ON institution_project_partners (translation_group_id, language_code);
+
+DROP TRIGGER IF EXISTS institution_project_partners_translation_group_owner_trg ON institution_project_partners;
+CREATE TRIGGER institution_project_partners_translation_group_owner_trg
+BEFORE INSERT OR UPDATE OF translation_group_id ON institution_project_partners
+FOR EACH ROW
+EXECUTE FUNCTION check_translation_group_owner('institution_project_id');
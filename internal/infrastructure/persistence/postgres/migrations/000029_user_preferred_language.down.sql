@@ -0,0 +1,3 @@
+ALTER TABLE users
+  DROP CONSTRAINT IF EXISTS users_preferred_language_check,
+  DROP COLUMN IF EXISTS preferred_language;
@@ -0,0 +1,5 @@
+-- preferred_language is NULL until the user picks a language, requests then fall back to Accept-Language
+ALTER TABLE users
+  ADD COLUMN IF NOT EXISTS preferred_language VARCHAR(2),
+  ADD CONSTRAINT users_preferred_language_check
+    CHECK (preferred_language IN ('en', 'ru', 'tg'));
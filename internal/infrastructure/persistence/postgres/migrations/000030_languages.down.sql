@@ -0,0 +1,103 @@
+ALTER TABLE users
+  DROP CONSTRAINT IF EXISTS fk_languages_users,
+  ALTER COLUMN preferred_language TYPE VARCHAR(2),
+  ADD CONSTRAINT users_preferred_language_check
+    CHECK (preferred_language IN ('en', 'ru', 'tg'));
+
+ALTER TABLE employee_details
+  DROP CONSTRAINT IF EXISTS fk_languages_employee_details,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE employee_degrees
+  DROP CONSTRAINT IF EXISTS fk_languages_employee_degrees,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE employee_work_experiences
+  DROP CONSTRAINT IF EXISTS fk_languages_employee_work_experiences,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE employee_main_research_areas
+  DROP CONSTRAINT IF EXISTS fk_languages_employee_main_research_areas,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE employee_publications
+  DROP CONSTRAINT IF EXISTS fk_languages_employee_publications,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE employee_scientific_awards
+  DROP CONSTRAINT IF EXISTS fk_languages_employee_scientific_awards,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE employee_patents
+  DROP CONSTRAINT IF EXISTS fk_languages_employee_patents,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE employee_participation_in_professional_communities
+  DROP CONSTRAINT IF EXISTS fk_languages_employee_participation_in_professional_communities,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE employee_refresher_courses
+  DROP CONSTRAINT IF EXISTS fk_languages_employee_refresher_courses,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE employee_participation_in_events
+  DROP CONSTRAINT IF EXISTS fk_languages_employee_participation_in_events,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE employee_research_activities
+  DROP CONSTRAINT IF EXISTS fk_languages_employee_research_activities,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_details
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_details,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_accreditations
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_accreditations,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_licences
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_licences,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_rankings
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_rankings,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_patents
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_patents,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_partnerships
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_partnerships,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_achievements
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_achievements,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_magazines
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_magazines,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_main_research_directions
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_main_research_directions,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_research_support_infrastructures
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_research_support_infrastructures,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_conferences
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_conferences,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_projects
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_projects,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+ALTER TABLE institution_project_partners
+  DROP CONSTRAINT IF EXISTS fk_languages_institution_project_partners,
+  ALTER COLUMN language_code TYPE CHAR(2);
+
+DROP TABLE IF EXISTS languages;
@@ -0,0 +1,184 @@
+-- languages is the registry of languages content can be written and served in.
+-- Adding a language is an INSERT here: codes are lower-case BCP 47 tags (e.g. 'uz', 'tg-latn'),
+-- disabled languages keep their content but are neither accepted nor served.
+CREATE TABLE IF NOT EXISTS languages (
+  code VARCHAR(16) NOT NULL,
+  name VARCHAR(63) NOT NULL,
+  native_name VARCHAR(63) NOT NULL,
+  enabled BOOLEAN NOT NULL DEFAULT true,
+  is_default BOOLEAN NOT NULL DEFAULT false,
+  sort_order INT NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT languages_pkey
+    PRIMARY KEY (code),
+  CONSTRAINT languages_code_check
+    CHECK (code = lower(code)),
+  CONSTRAINT languages_default_enabled_check
+    CHECK (NOT is_default OR enabled)
+);
+
+-- exactly one default language, it is served when the client has no acceptable preference
+CREATE UNIQUE INDEX IF NOT EXISTS languages_is_default_key
+  ON languages (is_default)
+  WHERE is_default;
+
+INSERT INTO languages (code, name, native_name, enabled, is_default, sort_order)
+VALUES
+  ('tg', 'Tajik', 'Тоҷикӣ', true, true, 1),
+  ('ru', 'Russian', 'Русский', true, false, 2),
+  ('en', 'English', 'English', true, false, 3)
+ON CONFLICT (code) DO NOTHING;
+
+-- language codes outgrow CHAR(2) once script subtags are used
+ALTER TABLE employee_details
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_employee_details
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE employee_degrees
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_employee_degrees
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE employee_work_experiences
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_employee_work_experiences
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE employee_main_research_areas
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_employee_main_research_areas
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE employee_publications
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_employee_publications
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE employee_scientific_awards
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_employee_scientific_awards
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE employee_patents
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_employee_patents
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE employee_participation_in_professional_communities
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_employee_participation_in_professional_communities
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE employee_refresher_courses
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_employee_refresher_courses
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE employee_participation_in_events
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_employee_participation_in_events
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE employee_research_activities
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_employee_research_activities
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_details
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_details
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_accreditations
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_accreditations
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_licences
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_licences
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_rankings
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_rankings
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_patents
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_patents
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_partnerships
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_partnerships
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_achievements
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_achievements
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_magazines
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_magazines
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_main_research_directions
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_main_research_directions
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_research_support_infrastructures
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_research_support_infrastructures
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_conferences
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_conferences
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_projects
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_projects
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE institution_project_partners
+  ALTER COLUMN language_code TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_institution_project_partners
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code);
+
+ALTER TABLE users
+  DROP CONSTRAINT IF EXISTS users_preferred_language_check,
+  ALTER COLUMN preferred_language TYPE VARCHAR(16),
+  ADD CONSTRAINT fk_languages_users
+    FOREIGN KEY (preferred_language)
+    REFERENCES languages (code);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_employee_details_employee_id;
+DROP INDEX IF EXISTS employee_details_current_name_key;
+
+ALTER TABLE employee_details
+  DROP CONSTRAINT IF EXISTS employee_details_effective_period_check,
+  DROP COLUMN IF EXISTS effective_to,
+  DROP COLUMN IF EXISTS effective_from;
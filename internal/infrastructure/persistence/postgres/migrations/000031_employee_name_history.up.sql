@@ -0,0 +1,29 @@
+-- employee_details is the name history of an employee per language:
+-- the row flagged is_employee_details_new is the current name, the others are previous names
+-- kept for search and attribution of earlier publications.
+-- effective_from/effective_to bound the period a name was in use, NULL meaning unknown or still in use.
+ALTER TABLE employee_details
+  ADD COLUMN IF NOT EXISTS effective_from DATE,
+  ADD COLUMN IF NOT EXISTS effective_to DATE,
+  ADD CONSTRAINT employee_details_effective_period_check
+    CHECK (effective_from IS NULL OR effective_to IS NULL OR effective_to >= effective_from);
+
+-- the old update flow allowed several current names per language, the most recently updated one stays current
+UPDATE employee_details ed
+SET is_employee_details_new = false
+WHERE ed.is_employee_details_new IS TRUE
+  AND EXISTS (
+    SELECT 1
+    FROM employee_details other
+    WHERE other.employee_id = ed.employee_id
+      AND other.language_code = ed.language_code
+      AND other.is_employee_details_new IS TRUE
+      AND (other.updated_at, other.id) > (ed.updated_at, ed.id)
+  );
+
+CREATE UNIQUE INDEX IF NOT EXISTS employee_details_current_name_key
+  ON employee_details (employee_id, language_code)
+  WHERE is_employee_details_new;
+
+CREATE INDEX IF NOT EXISTS idx_employee_details_employee_id
+  ON employee_details (employee_id);
@@ -0,0 +1,56 @@
+CREATE OR REPLACE FUNCTION refresh_employee_denormalized_fields(p_employee_id BIGINT)
+RETURNS VOID
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_highest_academic_degree VARCHAR;
+  v_speciality VARCHAR;
+  v_current_workplace VARCHAR;
+BEGIN
+  IF p_employee_id IS NULL THEN
+    RETURN;
+  END IF;
+
+  -- Rule from request:
+  -- highest_academic_degree <- latest employee_degrees.degree_level
+  -- speciality <- latest employee_degrees.speciality
+  SELECT
+    ed.speciality,
+    ed.degree_level
+  INTO
+    v_speciality,
+    v_highest_academic_degree
+  FROM employee_degrees ed
+  WHERE ed.employee_id = p_employee_id
+  ORDER BY ed.date_end DESC NULLS LAST, ed.id DESC
+  LIMIT 1;
+
+  -- current_workplace <- latest ongoing employee_work_experiences.workplace
+  SELECT
+    we.workplace
+  INTO
+    v_current_workplace
+  FROM employee_work_experiences we
+  WHERE we.employee_id = p_employee_id
+    AND we.on_going IS TRUE
+  ORDER BY we.date_start DESC NULLS LAST, we.id DESC
+  LIMIT 1;
+
+  UPDATE employees e
+  SET
+    highest_academic_degree = v_highest_academic_degree,
+    speciality = v_speciality,
+    current_workplace = v_current_workplace
+  WHERE e.id = p_employee_id;
+END;
+$$;
+
+DROP INDEX IF EXISTS idx_employees_current_institution_id;
+ALTER TABLE employees
+  DROP CONSTRAINT IF EXISTS fk_institutions_employees_current_institution,
+  DROP COLUMN IF EXISTS current_institution_id;
+
+DROP INDEX IF EXISTS idx_employee_work_experiences_institution_id;
+ALTER TABLE employee_work_experiences
+  DROP CONSTRAINT IF EXISTS fk_institutions_employee_work_experiences,
+  DROP COLUMN IF EXISTS institution_id;
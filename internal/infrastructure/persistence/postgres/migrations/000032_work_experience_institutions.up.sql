@@ -0,0 +1,106 @@
+-- work experiences optionally reference an institution of the directory,
+-- the free text workplace is kept as the displayed name and for foreign or unlisted employers
+ALTER TABLE employee_work_experiences
+  ADD COLUMN IF NOT EXISTS institution_id BIGINT,
+  ADD CONSTRAINT fk_institutions_employee_work_experiences
+    FOREIGN KEY (institution_id)
+    REFERENCES institutions (id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employee_work_experiences_institution_id
+  ON employee_work_experiences (institution_id);
+
+ALTER TABLE employees
+  ADD COLUMN IF NOT EXISTS current_institution_id BIGINT,
+  ADD CONSTRAINT fk_institutions_employees_current_institution
+    FOREIGN KEY (current_institution_id)
+    REFERENCES institutions (id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employees_current_institution_id
+  ON employees (current_institution_id);
+
+-- link workplaces spelled exactly as a title of a single institution in the same language
+UPDATE employee_work_experiences we
+SET institution_id = matched.institution_id
+FROM (
+  SELECT
+    lower(btrim(titles.title)) AS title,
+    idt.language_code,
+    min(idt.institution_id) AS institution_id
+  FROM institution_details idt
+  CROSS JOIN LATERAL unnest(ARRAY[idt.institution_title_short, idt.institution_title_long]) AS titles(title)
+  WHERE btrim(titles.title) <> ''
+  GROUP BY lower(btrim(titles.title)), idt.language_code
+  HAVING count(DISTINCT idt.institution_id) = 1
+) matched
+WHERE we.institution_id IS NULL
+  AND we.language_code = matched.language_code
+  AND lower(btrim(we.workplace)) = matched.title;
+
+-- translations of a work experience share the institution
+UPDATE employee_work_experiences we
+SET institution_id = linked.institution_id
+FROM (
+  SELECT DISTINCT ON (translation_group_id)
+    translation_group_id,
+    institution_id
+  FROM employee_work_experiences
+  WHERE institution_id IS NOT NULL
+  ORDER BY translation_group_id, id
+) linked
+WHERE we.translation_group_id = linked.translation_group_id
+  AND we.institution_id IS NULL;
+
+CREATE OR REPLACE FUNCTION refresh_employee_denormalized_fields(p_employee_id BIGINT)
+RETURNS VOID
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_highest_academic_degree VARCHAR;
+  v_speciality VARCHAR;
+  v_current_workplace VARCHAR;
+  v_current_institution_id BIGINT;
+BEGIN
+  IF p_employee_id IS NULL THEN
+    RETURN;
+  END IF;
+
+  -- highest_academic_degree <- latest employee_degrees.degree_level
+  -- speciality <- latest employee_degrees.speciality
+  SELECT
+    ed.speciality,
+    ed.degree_level
+  INTO
+    v_speciality,
+    v_highest_academic_degree
+  FROM employee_degrees ed
+  WHERE ed.employee_id = p_employee_id
+  ORDER BY ed.date_end DESC NULLS LAST, ed.id DESC
+  LIMIT 1;
+
+  -- current_workplace, current_institution_id <- latest ongoing employee_work_experiences
+  SELECT
+    we.workplace,
+    we.institution_id
+  INTO
+    v_current_workplace,
+    v_current_institution_id
+  FROM employee_work_experiences we
+  WHERE we.employee_id = p_employee_id
+    AND we.on_going IS TRUE
+  ORDER BY we.date_start DESC NULLS LAST, we.id DESC
+  LIMIT 1;
+
+  UPDATE employees e
+  SET
+    highest_academic_degree = v_highest_academic_degree,
+    speciality = v_speciality,
+    current_workplace = v_current_workplace,
+    current_institution_id = v_current_institution_id
+  WHERE e.id = p_employee_id;
+END;
+$$;
+
+SELECT refresh_employee_denormalized_fields(e.id)
+FROM employees e;
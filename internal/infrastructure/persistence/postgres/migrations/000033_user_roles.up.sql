@@ -0,0 +1,5 @@
+-- role of the user in the application, administrators are granted by updating the column directly
+ALTER TABLE users
+  ADD COLUMN IF NOT EXISTS role VARCHAR(31) NOT NULL DEFAULT 'user',
+  ADD CONSTRAINT users_role_check
+    CHECK (role IN ('user', 'admin'));
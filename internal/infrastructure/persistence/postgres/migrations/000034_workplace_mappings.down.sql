@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS workplace_mapping_changes;
+DROP TABLE IF EXISTS workplace_mapping_batches;
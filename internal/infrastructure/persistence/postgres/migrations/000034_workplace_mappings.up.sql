@@ -0,0 +1,47 @@
+-- undo log of workplace reconciliation: every bulk mapping of workplace spellings to an institution is a batch,
+-- the institution each touched work experience had before the batch is kept so the batch can be reverted
+CREATE TABLE IF NOT EXISTS workplace_mapping_batches (
+  id BIGSERIAL,
+  institution_id BIGINT,
+  workplaces TEXT[] NOT NULL,
+  overwrite BOOLEAN NOT NULL DEFAULT false,
+  created_by BIGINT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  undone_by BIGINT,
+  undone_at TIMESTAMPTZ,
+
+  CONSTRAINT workplace_mapping_batches_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_institutions_workplace_mapping_batches
+    FOREIGN KEY (institution_id)
+    REFERENCES institutions (id)
+    ON DELETE SET NULL,
+  CONSTRAINT fk_users_workplace_mapping_batches_created_by
+    FOREIGN KEY (created_by)
+    REFERENCES users (id)
+    ON DELETE SET NULL,
+  CONSTRAINT fk_users_workplace_mapping_batches_undone_by
+    FOREIGN KEY (undone_by)
+    REFERENCES users (id)
+    ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS workplace_mapping_changes (
+  id BIGSERIAL,
+  batch_id BIGINT NOT NULL,
+  work_experience_id BIGINT NOT NULL,
+  old_institution_id BIGINT,
+  new_institution_id BIGINT,
+
+  CONSTRAINT workplace_mapping_changes_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_workplace_mapping_batches_workplace_mapping_changes
+    FOREIGN KEY (batch_id)
+    REFERENCES workplace_mapping_batches (id)
+    ON DELETE CASCADE,
+  CONSTRAINT fk_employee_work_experiences_workplace_mapping_changes
+    FOREIGN KEY (work_experience_id)
+    REFERENCES employee_work_experiences (id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_workplace_mapping_changes_batch_id
+  ON workplace_mapping_changes (batch_id);
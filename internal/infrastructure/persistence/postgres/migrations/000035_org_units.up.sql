@@ -0,0 +1,176 @@
+-- organizational units (faculties, departments, laboratories, ...) form a tree inside of an institution,
+-- a unit without parent is a top level unit of the institution
+CREATE TABLE IF NOT EXISTS org_units (
+  id BIGSERIAL,
+  institution_id BIGINT NOT NULL,
+  parent_id BIGINT,
+  unit_type VARCHAR(31) NOT NULL,
+  sort_order INT NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT org_units_pkey
+    PRIMARY KEY (id),
+  CONSTRAINT org_units_id_institution_id_key
+    UNIQUE (id, institution_id),
+  CONSTRAINT org_units_unit_type_check
+    CHECK (unit_type IN ('faculty', 'department', 'laboratory', 'institute', 'center', 'division', 'other')),
+  CONSTRAINT org_units_parent_check
+    CHECK (parent_id <> id),
+
+  CONSTRAINT fk_institutions_org_units
+    FOREIGN KEY (institution_id)
+    REFERENCES institutions (id)
+    ON DELETE CASCADE,
+  -- the parent is a unit of the same institution, units with children can not be deleted
+  CONSTRAINT fk_org_units_parent
+    FOREIGN KEY (parent_id, institution_id)
+    REFERENCES org_units (id, institution_id)
+    ON DELETE RESTRICT
+);
+
+CREATE INDEX IF NOT EXISTS idx_org_units_institution_id ON org_units (institution_id);
+CREATE INDEX IF NOT EXISTS idx_org_units_parent_id ON org_units (parent_id);
+
+-- names of units, one per language
+CREATE TABLE IF NOT EXISTS org_unit_names (
+  org_unit_id BIGINT NOT NULL,
+  language_code VARCHAR(16) NOT NULL,
+  name VARCHAR(511) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT org_unit_names_pkey
+    PRIMARY KEY (org_unit_id, language_code),
+  CONSTRAINT org_unit_names_name_check
+    CHECK (btrim(name) <> ''),
+
+  CONSTRAINT fk_org_units_org_unit_names
+    FOREIGN KEY (org_unit_id)
+    REFERENCES org_units (id)
+    ON DELETE CASCADE,
+  CONSTRAINT fk_languages_org_unit_names
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code)
+);
+
+-- users managing the structure of an institution, granted by inserting rows directly
+CREATE TABLE IF NOT EXISTS institution_admins (
+  institution_id BIGINT NOT NULL,
+  user_id BIGINT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT institution_admins_pkey
+    PRIMARY KEY (institution_id, user_id),
+
+  CONSTRAINT fk_institutions_institution_admins
+    FOREIGN KEY (institution_id)
+    REFERENCES institutions (id)
+    ON DELETE CASCADE,
+  CONSTRAINT fk_users_institution_admins
+    FOREIGN KEY (user_id)
+    REFERENCES users (id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_institution_admins_user_id ON institution_admins (user_id);
+
+ALTER TABLE employee_work_experiences
+  ADD COLUMN IF NOT EXISTS org_unit_id BIGINT,
+  ADD CONSTRAINT fk_org_units_employee_work_experiences
+    FOREIGN KEY (org_unit_id)
+    REFERENCES org_units (id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employee_work_experiences_org_unit_id
+  ON employee_work_experiences (org_unit_id);
+
+ALTER TABLE employees
+  ADD COLUMN IF NOT EXISTS current_org_unit_id BIGINT,
+  ADD CONSTRAINT fk_org_units_employees_current_org_unit
+    FOREIGN KEY (current_org_unit_id)
+    REFERENCES org_units (id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employees_current_org_unit_id
+  ON employees (current_org_unit_id);
+
+-- the unit of a work experience belongs to its institution,
+-- a unit left behind when the institution is changed or unlinked(e.g. by a workplace mapping) is cleared
+CREATE OR REPLACE FUNCTION trg_clear_foreign_org_unit()
+RETURNS TRIGGER
+LANGUAGE plpgsql
+AS $$
+BEGIN
+  IF NEW.org_unit_id IS NOT NULL AND NOT EXISTS (
+    SELECT 1
+    FROM org_units ou
+    WHERE ou.id = NEW.org_unit_id
+      AND ou.institution_id = NEW.institution_id
+  ) THEN
+    NEW.org_unit_id := NULL;
+  END IF;
+
+  RETURN NEW;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS employee_work_experiences_clear_foreign_org_unit_trg ON employee_work_experiences;
+CREATE TRIGGER employee_work_experiences_clear_foreign_org_unit_trg
+BEFORE INSERT OR UPDATE OF institution_id, org_unit_id ON employee_work_experiences
+FOR EACH ROW
+EXECUTE FUNCTION trg_clear_foreign_org_unit();
+
+CREATE OR REPLACE FUNCTION refresh_employee_denormalized_fields(p_employee_id BIGINT)
+RETURNS VOID
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_highest_academic_degree VARCHAR;
+  v_speciality VARCHAR;
+  v_current_workplace VARCHAR;
+  v_current_institution_id BIGINT;
+  v_current_org_unit_id BIGINT;
+BEGIN
+  IF p_employee_id IS NULL THEN
+    RETURN;
+  END IF;
+
+  -- highest_academic_degree <- latest employee_degrees.degree_level
+  -- speciality <- latest employee_degrees.speciality
+  SELECT
+    ed.speciality,
+    ed.degree_level
+  INTO
+    v_speciality,
+    v_highest_academic_degree
+  FROM employee_degrees ed
+  WHERE ed.employee_id = p_employee_id
+  ORDER BY ed.date_end DESC NULLS LAST, ed.id DESC
+  LIMIT 1;
+
+  -- current_workplace, current_institution_id, current_org_unit_id <- latest ongoing employee_work_experiences
+  SELECT
+    we.workplace,
+    we.institution_id,
+    we.org_unit_id
+  INTO
+    v_current_workplace,
+    v_current_institution_id,
+    v_current_org_unit_id
+  FROM employee_work_experiences we
+  WHERE we.employee_id = p_employee_id
+    AND we.on_going IS TRUE
+  ORDER BY we.date_start DESC NULLS LAST, we.id DESC
+  LIMIT 1;
+
+  UPDATE employees e
+  SET
+    highest_academic_degree = v_highest_academic_degree,
+    speciality = v_speciality,
+    current_workplace = v_current_workplace,
+    current_institution_id = v_current_institution_id,
+    current_org_unit_id = v_current_org_unit_id
+  WHERE e.id = p_employee_id;
+END;
+$$;
@@ -0,0 +1,78 @@
+-- entries entered against the vocabulary get the name of their level back as free text
+UPDATE employee_degrees
+SET degree_level = coalesce(
+  (
+    SELECT dln.name
+    FROM degree_level_names dln
+    WHERE dln.degree_level_code = employee_degrees.degree_level_code
+      AND dln.language_code = employee_degrees.language_code
+  ),
+  employee_degrees.degree_level_code
+)
+WHERE employee_degrees.degree_level IS NULL;
+
+CREATE OR REPLACE FUNCTION refresh_employee_denormalized_fields(p_employee_id BIGINT)
+RETURNS VOID
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_highest_academic_degree VARCHAR;
+  v_speciality VARCHAR;
+  v_current_workplace VARCHAR;
+  v_current_institution_id BIGINT;
+  v_current_org_unit_id BIGINT;
+BEGIN
+  IF p_employee_id IS NULL THEN
+    RETURN;
+  END IF;
+
+  -- highest_academic_degree <- latest employee_degrees.degree_level
+  -- speciality <- latest employee_degrees.speciality
+  SELECT
+    ed.speciality,
+    ed.degree_level
+  INTO
+    v_speciality,
+    v_highest_academic_degree
+  FROM employee_degrees ed
+  WHERE ed.employee_id = p_employee_id
+  ORDER BY ed.date_end DESC NULLS LAST, ed.id DESC
+  LIMIT 1;
+
+  -- current_workplace, current_institution_id, current_org_unit_id <- latest ongoing employee_work_experiences
+  SELECT
+    we.workplace,
+    we.institution_id,
+    we.org_unit_id
+  INTO
+    v_current_workplace,
+    v_current_institution_id,
+    v_current_org_unit_id
+  FROM employee_work_experiences we
+  WHERE we.employee_id = p_employee_id
+    AND we.on_going IS TRUE
+  ORDER BY we.date_start DESC NULLS LAST, we.id DESC
+  LIMIT 1;
+
+  UPDATE employees e
+  SET
+    highest_academic_degree = v_highest_academic_degree,
+    speciality = v_speciality,
+    current_workplace = v_current_workplace,
+    current_institution_id = v_current_institution_id,
+    current_org_unit_id = v_current_org_unit_id
+  WHERE e.id = p_employee_id;
+END;
+$$;
+
+DROP INDEX IF EXISTS idx_employee_degrees_degree_level_code;
+ALTER TABLE employee_degrees
+  DROP CONSTRAINT IF EXISTS fk_degree_levels_employee_degrees,
+  DROP COLUMN IF EXISTS degree_level_code,
+  ALTER COLUMN degree_level SET NOT NULL;
+
+SELECT refresh_employee_denormalized_fields(e.id)
+FROM employees e;
+
+DROP TABLE IF EXISTS degree_level_names;
+DROP TABLE IF EXISTS degree_levels;
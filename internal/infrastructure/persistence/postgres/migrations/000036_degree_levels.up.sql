@@ -0,0 +1,204 @@
+-- degree_levels is the controlled vocabulary of academic degree levels,
+-- codes are stable and language independent, rank orders the levels from the lowest up
+CREATE TABLE IF NOT EXISTS degree_levels (
+  code VARCHAR(31) NOT NULL,
+  rank INT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT degree_levels_pkey
+    PRIMARY KEY (code),
+  CONSTRAINT degree_levels_code_check
+    CHECK (code ~ '^[a-z][a-z0-9_]*$')
+);
+
+-- names of degree levels, one per language
+CREATE TABLE IF NOT EXISTS degree_level_names (
+  degree_level_code VARCHAR(31) NOT NULL,
+  language_code VARCHAR(16) NOT NULL,
+  name VARCHAR(127) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT degree_level_names_pkey
+    PRIMARY KEY (degree_level_code, language_code),
+  CONSTRAINT degree_level_names_name_check
+    CHECK (btrim(name) <> ''),
+
+  CONSTRAINT fk_degree_levels_degree_level_names
+    FOREIGN KEY (degree_level_code)
+    REFERENCES degree_levels (code)
+    ON DELETE CASCADE,
+  CONSTRAINT fk_languages_degree_level_names
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code)
+);
+
+INSERT INTO degree_levels (code, rank)
+VALUES
+  ('bachelor', 1),
+  ('specialist', 2),
+  ('master', 3),
+  ('internship', 4),
+  ('residency', 5),
+  ('candidate_of_science', 6),
+  ('phd', 7),
+  ('doctor_of_science', 8)
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO degree_level_names (degree_level_code, language_code, name)
+VALUES
+  ('bachelor', 'tg', 'Бакалавр'),
+  ('bachelor', 'ru', 'Бакалавр'),
+  ('bachelor', 'en', 'Bachelor'),
+  ('specialist', 'tg', 'Мутахассис'),
+  ('specialist', 'ru', 'Специалист'),
+  ('specialist', 'en', 'Specialist'),
+  ('master', 'tg', 'Магистр'),
+  ('master', 'ru', 'Магистр'),
+  ('master', 'en', 'Master'),
+  ('internship', 'tg', 'Интернатура'),
+  ('internship', 'ru', 'Интернатура'),
+  ('internship', 'en', 'Internship'),
+  ('residency', 'tg', 'Ординатура'),
+  ('residency', 'ru', 'Ординатура'),
+  ('residency', 'en', 'Residency'),
+  ('candidate_of_science', 'tg', 'Номзади илм'),
+  ('candidate_of_science', 'ru', 'Кандидат наук'),
+  ('candidate_of_science', 'en', 'Candidate of Sciences'),
+  ('phd', 'tg', 'PhD (Доктори фалсафа)'),
+  ('phd', 'ru', 'PhD (Доктор философии)'),
+  ('phd', 'en', 'PhD (Doctor of Philosophy)'),
+  ('doctor_of_science', 'tg', 'Доктори илм'),
+  ('doctor_of_science', 'ru', 'Доктор наук'),
+  ('doctor_of_science', 'en', 'Doctor of Sciences')
+ON CONFLICT (degree_level_code, language_code) DO NOTHING;
+
+-- degree_level keeps the free text of entries made before the vocabulary existed,
+-- it is only shown for entries whose text could not be mapped to a code
+ALTER TABLE employee_degrees
+  ALTER COLUMN degree_level DROP NOT NULL,
+  ADD COLUMN IF NOT EXISTS degree_level_code VARCHAR(31),
+  ADD CONSTRAINT fk_degree_levels_employee_degrees
+    FOREIGN KEY (degree_level_code)
+    REFERENCES degree_levels (code);
+
+CREATE INDEX IF NOT EXISTS idx_employee_degrees_degree_level_code
+  ON employee_degrees (degree_level_code);
+
+-- employees.highest_academic_degree holds the code of the latest degree, or its free text when it has none
+CREATE OR REPLACE FUNCTION refresh_employee_denormalized_fields(p_employee_id BIGINT)
+RETURNS VOID
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_highest_academic_degree VARCHAR;
+  v_speciality VARCHAR;
+  v_current_workplace VARCHAR;
+  v_current_institution_id BIGINT;
+  v_current_org_unit_id BIGINT;
+BEGIN
+  IF p_employee_id IS NULL THEN
+    RETURN;
+  END IF;
+
+  -- highest_academic_degree <- latest employee_degrees.degree_level_code, degree_level when unmapped
+  -- speciality <- latest employee_degrees.speciality
+  SELECT
+    ed.speciality,
+    coalesce(ed.degree_level_code, ed.degree_level)
+  INTO
+    v_speciality,
+    v_highest_academic_degree
+  FROM employee_degrees ed
+  WHERE ed.employee_id = p_employee_id
+  ORDER BY ed.date_end DESC NULLS LAST, ed.id DESC
+  LIMIT 1;
+
+  -- current_workplace, current_institution_id, current_org_unit_id <- latest ongoing employee_work_experiences
+  SELECT
+    we.workplace,
+    we.institution_id,
+    we.org_unit_id
+  INTO
+    v_current_workplace,
+    v_current_institution_id,
+    v_current_org_unit_id
+  FROM employee_work_experiences we
+  WHERE we.employee_id = p_employee_id
+    AND we.on_going IS TRUE
+  ORDER BY we.date_start DESC NULLS LAST, we.id DESC
+  LIMIT 1;
+
+  UPDATE employees e
+  SET
+    highest_academic_degree = v_highest_academic_degree,
+    speciality = v_speciality,
+    current_workplace = v_current_workplace,
+    current_institution_id = v_current_institution_id,
+    current_org_unit_id = v_current_org_unit_id
+  WHERE e.id = p_employee_id;
+END;
+$$;
+
+-- existing free text is mapped by comparing it, lower-cased and without spaces and punctuation,
+-- with the names of the levels first and then with known spellings, abbreviations and truncations.
+-- Patterns of a lower priority win, so "PhD (Доктори фалсафа)" is not taken for "Доктори илм".
+WITH degree_level_patterns (pattern, degree_level_code, priority) AS (
+  SELECT
+    regexp_replace(lower(dln.name), '[[:space:][:punct:]]+', '', 'g'),
+    dln.degree_level_code,
+    0
+  FROM degree_level_names dln
+  UNION ALL
+  VALUES
+    ('%phd%', 'phd', 1),
+    ('%докторифалсафа%', 'phd', 1),
+    ('%докторфилософии%', 'phd', 1),
+    ('%doctorofphilosophy%', 'phd', 1),
+    ('номзад%', 'candidate_of_science', 2),
+    ('кандидат%', 'candidate_of_science', 2),
+    ('candidate%', 'candidate_of_science', 2),
+    ('доктор%', 'doctor_of_science', 3),
+    ('doctor%', 'doctor_of_science', 3),
+    ('dsc%', 'doctor_of_science', 3),
+    ('бакалав%', 'bachelor', 4),
+    ('bachelor%', 'bachelor', 4),
+    ('магист%', 'master', 4),
+    ('master%', 'master', 4),
+    ('мутахас%', 'specialist', 4),
+    ('специали%', 'specialist', 4),
+    ('specialist%', 'specialist', 4),
+    ('интерн%', 'internship', 4),
+    ('intern%', 'internship', 4),
+    ('%ординат%', 'residency', 4),
+    ('residen%', 'residency', 4)
+),
+normalized_degrees AS (
+  SELECT
+    ed.id,
+    regexp_replace(lower(ed.degree_level), '[[:space:][:punct:]]+', '', 'g') AS value
+  FROM employee_degrees ed
+  WHERE ed.degree_level_code IS NULL
+)
+UPDATE employee_degrees
+SET degree_level_code = (
+  SELECT p.degree_level_code
+  FROM degree_level_patterns p
+  WHERE nd.value LIKE p.pattern
+  ORDER BY p.priority
+  LIMIT 1
+)
+FROM normalized_degrees nd
+WHERE nd.id = employee_degrees.id;
+
+-- the level is the same in every translation of an entry, unmapped translations take it from a mapped one
+UPDATE employee_degrees
+SET degree_level_code = source.degree_level_code
+FROM employee_degrees source
+WHERE employee_degrees.degree_level_code IS NULL
+  AND source.degree_level_code IS NOT NULL
+  AND source.translation_group_id = employee_degrees.translation_group_id;
+
+SELECT refresh_employee_denormalized_fields(e.id)
+FROM employees e;
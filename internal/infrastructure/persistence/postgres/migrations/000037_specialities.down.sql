@@ -0,0 +1,66 @@
+CREATE OR REPLACE FUNCTION refresh_employee_denormalized_fields(p_employee_id BIGINT)
+RETURNS VOID
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_highest_academic_degree VARCHAR;
+  v_speciality VARCHAR;
+  v_current_workplace VARCHAR;
+  v_current_institution_id BIGINT;
+  v_current_org_unit_id BIGINT;
+BEGIN
+  IF p_employee_id IS NULL THEN
+    RETURN;
+  END IF;
+
+  -- highest_academic_degree <- latest employee_degrees.degree_level_code, degree_level when unmapped
+  -- speciality <- latest employee_degrees.speciality
+  SELECT
+    ed.speciality,
+    coalesce(ed.degree_level_code, ed.degree_level)
+  INTO
+    v_speciality,
+    v_highest_academic_degree
+  FROM employee_degrees ed
+  WHERE ed.employee_id = p_employee_id
+  ORDER BY ed.date_end DESC NULLS LAST, ed.id DESC
+  LIMIT 1;
+
+  -- current_workplace, current_institution_id, current_org_unit_id <- latest ongoing employee_work_experiences
+  SELECT
+    we.workplace,
+    we.institution_id,
+    we.org_unit_id
+  INTO
+    v_current_workplace,
+    v_current_institution_id,
+    v_current_org_unit_id
+  FROM employee_work_experiences we
+  WHERE we.employee_id = p_employee_id
+    AND we.on_going IS TRUE
+  ORDER BY we.date_start DESC NULLS LAST, we.id DESC
+  LIMIT 1;
+
+  UPDATE employees e
+  SET
+    highest_academic_degree = v_highest_academic_degree,
+    speciality = v_speciality,
+    current_workplace = v_current_workplace,
+    current_institution_id = v_current_institution_id,
+    current_org_unit_id = v_current_org_unit_id
+  WHERE e.id = p_employee_id;
+END;
+$$;
+
+DROP INDEX IF EXISTS idx_employees_speciality_code;
+ALTER TABLE employees
+  DROP CONSTRAINT IF EXISTS fk_specialities_employees_speciality,
+  DROP COLUMN IF EXISTS speciality_code;
+
+DROP INDEX IF EXISTS idx_employee_degrees_speciality_code;
+ALTER TABLE employee_degrees
+  DROP CONSTRAINT IF EXISTS fk_specialities_employee_degrees,
+  DROP COLUMN IF EXISTS speciality_code;
+
+DROP TABLE IF EXISTS speciality_names;
+DROP TABLE IF EXISTS specialities;
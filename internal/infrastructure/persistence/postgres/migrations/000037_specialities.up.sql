@@ -0,0 +1,124 @@
+-- specialities is the national classifier of specialities, loaded from a file by the import command.
+-- Codes are those of the classifier, a speciality without parent is a top level group
+CREATE TABLE IF NOT EXISTS specialities (
+  code VARCHAR(63) NOT NULL,
+  parent_code VARCHAR(63),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT specialities_pkey
+    PRIMARY KEY (code),
+  CONSTRAINT specialities_code_check
+    CHECK (btrim(code) = code AND code <> ''),
+  CONSTRAINT specialities_parent_check
+    CHECK (parent_code <> code),
+
+  CONSTRAINT fk_specialities_parent
+    FOREIGN KEY (parent_code)
+    REFERENCES specialities (code)
+    ON DELETE RESTRICT
+);
+
+CREATE INDEX IF NOT EXISTS idx_specialities_parent_code ON specialities (parent_code);
+
+-- names of specialities, one per language
+CREATE TABLE IF NOT EXISTS speciality_names (
+  speciality_code VARCHAR(63) NOT NULL,
+  language_code VARCHAR(16) NOT NULL,
+  name VARCHAR(511) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT speciality_names_pkey
+    PRIMARY KEY (speciality_code, language_code),
+  CONSTRAINT speciality_names_name_check
+    CHECK (btrim(name) <> ''),
+
+  CONSTRAINT fk_specialities_speciality_names
+    FOREIGN KEY (speciality_code)
+    REFERENCES specialities (code)
+    ON DELETE CASCADE,
+  CONSTRAINT fk_languages_speciality_names
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code)
+);
+
+-- speciality stays the free text title of the degree, speciality_code links it to the classifier
+ALTER TABLE employee_degrees
+  ADD COLUMN IF NOT EXISTS speciality_code VARCHAR(63),
+  ADD CONSTRAINT fk_specialities_employee_degrees
+    FOREIGN KEY (speciality_code)
+    REFERENCES specialities (code)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employee_degrees_speciality_code
+  ON employee_degrees (speciality_code);
+
+ALTER TABLE employees
+  ADD COLUMN IF NOT EXISTS speciality_code VARCHAR(63),
+  ADD CONSTRAINT fk_specialities_employees_speciality
+    FOREIGN KEY (speciality_code)
+    REFERENCES specialities (code)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employees_speciality_code
+  ON employees (speciality_code);
+
+-- employees.speciality_code is the classifier node of the latest degree, the personnel search filters by it
+CREATE OR REPLACE FUNCTION refresh_employee_denormalized_fields(p_employee_id BIGINT)
+RETURNS VOID
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_highest_academic_degree VARCHAR;
+  v_speciality VARCHAR;
+  v_speciality_code VARCHAR;
+  v_current_workplace VARCHAR;
+  v_current_institution_id BIGINT;
+  v_current_org_unit_id BIGINT;
+BEGIN
+  IF p_employee_id IS NULL THEN
+    RETURN;
+  END IF;
+
+  -- highest_academic_degree <- latest employee_degrees.degree_level_code, degree_level when unmapped
+  -- speciality, speciality_code <- latest employee_degrees.speciality, speciality_code
+  SELECT
+    ed.speciality,
+    ed.speciality_code,
+    coalesce(ed.degree_level_code, ed.degree_level)
+  INTO
+    v_speciality,
+    v_speciality_code,
+    v_highest_academic_degree
+  FROM employee_degrees ed
+  WHERE ed.employee_id = p_employee_id
+  ORDER BY ed.date_end DESC NULLS LAST, ed.id DESC
+  LIMIT 1;
+
+  -- current_workplace, current_institution_id, current_org_unit_id <- latest ongoing employee_work_experiences
+  SELECT
+    we.workplace,
+    we.institution_id,
+    we.org_unit_id
+  INTO
+    v_current_workplace,
+    v_current_institution_id,
+    v_current_org_unit_id
+  FROM employee_work_experiences we
+  WHERE we.employee_id = p_employee_id
+    AND we.on_going IS TRUE
+  ORDER BY we.date_start DESC NULLS LAST, we.id DESC
+  LIMIT 1;
+
+  UPDATE employees e
+  SET
+    highest_academic_degree = v_highest_academic_degree,
+    speciality = v_speciality,
+    speciality_code = v_speciality_code,
+    current_workplace = v_current_workplace,
+    current_institution_id = v_current_institution_id,
+    current_org_unit_id = v_current_org_unit_id
+  WHERE e.id = p_employee_id;
+END;
+$$;
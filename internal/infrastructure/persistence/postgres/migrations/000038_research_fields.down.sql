@@ -0,0 +1,8 @@
+ALTER TABLE institution_main_research_directions
+  DROP COLUMN IF EXISTS research_field_code;
+
+ALTER TABLE employee_main_research_areas
+  DROP COLUMN IF EXISTS research_field_code;
+
+DROP TABLE IF EXISTS research_field_names;
+DROP TABLE IF EXISTS research_fields;
@@ -0,0 +1,325 @@
+-- research_fields is the Fields of Science taxonomy of research areas, three levels deep:
+-- domains at the top, fields in a domain and subfields in a field.
+-- Domains and fields are those of the OECD Fields of Science classification, subfields are added by administrators
+CREATE TABLE IF NOT EXISTS research_fields (
+  code VARCHAR(31) NOT NULL,
+  parent_code VARCHAR(31),
+  level VARCHAR(15) NOT NULL,
+  sort_order INT NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT research_fields_pkey
+    PRIMARY KEY (code),
+  CONSTRAINT research_fields_code_check
+    CHECK (code ~ '^[0-9A-Za-z]+(\.[0-9A-Za-z]+)*$'),
+  CONSTRAINT research_fields_level_check
+    CHECK (level IN ('domain', 'field', 'subfield')),
+  CONSTRAINT research_fields_parent_check
+    CHECK ((level = 'domain') = (parent_code IS NULL) AND parent_code <> code),
+
+  CONSTRAINT fk_research_fields_parent
+    FOREIGN KEY (parent_code)
+    REFERENCES research_fields (code)
+    ON DELETE RESTRICT
+);
+
+CREATE INDEX IF NOT EXISTS idx_research_fields_parent_code ON research_fields (parent_code);
+
+-- names of research fields, one per language
+CREATE TABLE IF NOT EXISTS research_field_names (
+  research_field_code VARCHAR(31) NOT NULL,
+  language_code VARCHAR(16) NOT NULL,
+  name VARCHAR(511) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT research_field_names_pkey
+    PRIMARY KEY (research_field_code, language_code),
+  CONSTRAINT research_field_names_name_check
+    CHECK (btrim(name) <> ''),
+
+  CONSTRAINT fk_research_fields_research_field_names
+    FOREIGN KEY (research_field_code)
+    REFERENCES research_fields (code)
+    ON DELETE CASCADE,
+  CONSTRAINT fk_languages_research_field_names
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code)
+);
+
+INSERT INTO research_fields (code, parent_code, level, sort_order)
+VALUES
+  ('1', NULL, 'domain', 1),
+  ('2', NULL, 'domain', 2),
+  ('3', NULL, 'domain', 3),
+  ('4', NULL, 'domain', 4),
+  ('5', NULL, 'domain', 5),
+  ('6', NULL, 'domain', 6),
+  ('1.1', '1', 'field', 1),
+  ('1.2', '1', 'field', 2),
+  ('1.3', '1', 'field', 3),
+  ('1.4', '1', 'field', 4),
+  ('1.5', '1', 'field', 5),
+  ('1.6', '1', 'field', 6),
+  ('1.7', '1', 'field', 7),
+  ('2.1', '2', 'field', 1),
+  ('2.2', '2', 'field', 2),
+  ('2.3', '2', 'field', 3),
+  ('2.4', '2', 'field', 4),
+  ('2.5', '2', 'field', 5),
+  ('2.6', '2', 'field', 6),
+  ('2.7', '2', 'field', 7),
+  ('2.8', '2', 'field', 8),
+  ('2.9', '2', 'field', 9),
+  ('2.10', '2', 'field', 10),
+  ('2.11', '2', 'field', 11),
+  ('3.1', '3', 'field', 1),
+  ('3.2', '3', 'field', 2),
+  ('3.3', '3', 'field', 3),
+  ('3.4', '3', 'field', 4),
+  ('3.5', '3', 'field', 5),
+  ('4.1', '4', 'field', 1),
+  ('4.2', '4', 'field', 2),
+  ('4.3', '4', 'field', 3),
+  ('4.4', '4', 'field', 4),
+  ('4.5', '4', 'field', 5),
+  ('5.1', '5', 'field', 1),
+  ('5.2', '5', 'field', 2),
+  ('5.3', '5', 'field', 3),
+  ('5.4', '5', 'field', 4),
+  ('5.5', '5', 'field', 5),
+  ('5.6', '5', 'field', 6),
+  ('5.7', '5', 'field', 7),
+  ('5.8', '5', 'field', 8),
+  ('5.9', '5', 'field', 9),
+  ('6.1', '6', 'field', 1),
+  ('6.2', '6', 'field', 2),
+  ('6.3', '6', 'field', 3),
+  ('6.4', '6', 'field', 4),
+  ('6.5', '6', 'field', 5)
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO research_field_names (research_field_code, language_code, name)
+VALUES
+  ('1', 'tg', 'Илмҳои табиӣ'),
+  ('1', 'ru', 'Естественные науки'),
+  ('1', 'en', 'Natural sciences'),
+  ('2', 'tg', 'Муҳандисӣ ва технология'),
+  ('2', 'ru', 'Техника и технологии'),
+  ('2', 'en', 'Engineering and technology'),
+  ('3', 'tg', 'Илмҳои тиббӣ ва тандурустӣ'),
+  ('3', 'ru', 'Медицинские науки и здравоохранение'),
+  ('3', 'en', 'Medical and health sciences'),
+  ('4', 'tg', 'Илмҳои кишоварзӣ'),
+  ('4', 'ru', 'Сельскохозяйственные науки'),
+  ('4', 'en', 'Agricultural sciences'),
+  ('5', 'tg', 'Илмҳои ҷамъиятӣ'),
+  ('5', 'ru', 'Социальные науки'),
+  ('5', 'en', 'Social sciences'),
+  ('6', 'tg', 'Илмҳои гуманитарӣ'),
+  ('6', 'ru', 'Гуманитарные науки'),
+  ('6', 'en', 'Humanities'),
+  ('1.1', 'tg', 'Математика'),
+  ('1.1', 'ru', 'Математика'),
+  ('1.1', 'en', 'Mathematics'),
+  ('1.2', 'tg', 'Илмҳои компютерӣ ва иттилоотӣ'),
+  ('1.2', 'ru', 'Компьютерные и информационные науки'),
+  ('1.2', 'en', 'Computer and information sciences'),
+  ('1.3', 'tg', 'Илмҳои физикӣ'),
+  ('1.3', 'ru', 'Физические науки'),
+  ('1.3', 'en', 'Physical sciences'),
+  ('1.4', 'tg', 'Илмҳои химиявӣ'),
+  ('1.4', 'ru', 'Химические науки'),
+  ('1.4', 'en', 'Chemical sciences'),
+  ('1.5', 'tg', 'Илмҳо дар бораи Замин ва муҳити зист'),
+  ('1.5', 'ru', 'Науки о Земле и смежные экологические науки'),
+  ('1.5', 'en', 'Earth and related environmental sciences'),
+  ('1.6', 'tg', 'Илмҳои биологӣ'),
+  ('1.6', 'ru', 'Биологические науки'),
+  ('1.6', 'en', 'Biological sciences'),
+  ('1.7', 'tg', 'Дигар илмҳои табиӣ'),
+  ('1.7', 'ru', 'Другие естественные науки'),
+  ('1.7', 'en', 'Other natural sciences'),
+  ('2.1', 'tg', 'Сохтмон'),
+  ('2.1', 'ru', 'Гражданское строительство'),
+  ('2.1', 'en', 'Civil engineering'),
+  ('2.2', 'tg', 'Электротехника, электроника ва технологияҳои иттилоотӣ'),
+  ('2.2', 'ru', 'Электротехника, электроника и информационные технологии'),
+  ('2.2', 'en', 'Electrical engineering, electronic engineering, information engineering'),
+  ('2.3', 'tg', 'Муҳандисии механикӣ'),
+  ('2.3', 'ru', 'Механика и машиностроение'),
+  ('2.3', 'en', 'Mechanical engineering'),
+  ('2.4', 'tg', 'Технологияи химиявӣ'),
+  ('2.4', 'ru', 'Химические технологии'),
+  ('2.4', 'en', 'Chemical engineering'),
+  ('2.5', 'tg', 'Маводшиносӣ'),
+  ('2.5', 'ru', 'Материаловедение'),
+  ('2.5', 'en', 'Materials engineering'),
+  ('2.6', 'tg', 'Техникаи тиббӣ'),
+  ('2.6', 'ru', 'Медицинская техника'),
+  ('2.6', 'en', 'Medical engineering'),
+  ('2.7', 'tg', 'Муҳандисии экологӣ'),
+  ('2.7', 'ru', 'Экологическая инженерия'),
+  ('2.7', 'en', 'Environmental engineering'),
+  ('2.8', 'tg', 'Биотехнологияи экологӣ'),
+  ('2.8', 'ru', 'Экологическая биотехнология'),
+  ('2.8', 'en', 'Environmental biotechnology'),
+  ('2.9', 'tg', 'Биотехнологияи саноатӣ'),
+  ('2.9', 'ru', 'Промышленная биотехнология'),
+  ('2.9', 'en', 'Industrial biotechnology'),
+  ('2.10', 'tg', 'Нанотехнология'),
+  ('2.10', 'ru', 'Нанотехнологии'),
+  ('2.10', 'en', 'Nano-technology'),
+  ('2.11', 'tg', 'Дигар илмҳои муҳандисӣ ва технологӣ'),
+  ('2.11', 'ru', 'Другие технические науки и технологии'),
+  ('2.11', 'en', 'Other engineering and technologies'),
+  ('3.1', 'tg', 'Тибби бунёдӣ'),
+  ('3.1', 'ru', 'Фундаментальная медицина'),
+  ('3.1', 'en', 'Basic medicine'),
+  ('3.2', 'tg', 'Тибби клиникӣ'),
+  ('3.2', 'ru', 'Клиническая медицина'),
+  ('3.2', 'en', 'Clinical medicine'),
+  ('3.3', 'tg', 'Илмҳои тандурустӣ'),
+  ('3.3', 'ru', 'Науки о здоровье'),
+  ('3.3', 'en', 'Health sciences'),
+  ('3.4', 'tg', 'Биотехнологияи тиббӣ'),
+  ('3.4', 'ru', 'Медицинская биотехнология'),
+  ('3.4', 'en', 'Medical biotechnology'),
+  ('3.5', 'tg', 'Дигар илмҳои тиббӣ'),
+  ('3.5', 'ru', 'Другие медицинские науки'),
+  ('3.5', 'en', 'Other medical sciences'),
+  ('4.1', 'tg', 'Кишоварзӣ, ҷангалпарварӣ ва моҳипарварӣ'),
+  ('4.1', 'ru', 'Сельское, лесное и рыбное хозяйство'),
+  ('4.1', 'en', 'Agriculture, forestry, and fisheries'),
+  ('4.2', 'tg', 'Чорводорӣ ва истеҳсоли шир'),
+  ('4.2', 'ru', 'Животноводство и молочное дело'),
+  ('4.2', 'en', 'Animal and dairy science'),
+  ('4.3', 'tg', 'Байторӣ'),
+  ('4.3', 'ru', 'Ветеринария'),
+  ('4.3', 'en', 'Veterinary science'),
+  ('4.4', 'tg', 'Биотехнологияи кишоварзӣ'),
+  ('4.4', 'ru', 'Сельскохозяйственная биотехнология'),
+  ('4.4', 'en', 'Agricultural biotechnology'),
+  ('4.5', 'tg', 'Дигар илмҳои кишоварзӣ'),
+  ('4.5', 'ru', 'Другие сельскохозяйственные науки'),
+  ('4.5', 'en', 'Other agricultural sciences'),
+  ('5.1', 'tg', 'Психология'),
+  ('5.1', 'ru', 'Психология'),
+  ('5.1', 'en', 'Psychology'),
+  ('5.2', 'tg', 'Иқтисод ва соҳибкорӣ'),
+  ('5.2', 'ru', 'Экономика и бизнес'),
+  ('5.2', 'en', 'Economics and business'),
+  ('5.3', 'tg', 'Илмҳои педагогӣ'),
+  ('5.3', 'ru', 'Науки об образовании'),
+  ('5.3', 'en', 'Educational sciences'),
+  ('5.4', 'tg', 'Ҷомеашиносӣ'),
+  ('5.4', 'ru', 'Социология'),
+  ('5.4', 'en', 'Sociology'),
+  ('5.5', 'tg', 'Ҳуқуқ'),
+  ('5.5', 'ru', 'Право'),
+  ('5.5', 'en', 'Law'),
+  ('5.6', 'tg', 'Сиёсатшиносӣ'),
+  ('5.6', 'ru', 'Политология'),
+  ('5.6', 'en', 'Political science'),
+  ('5.7', 'tg', 'Ҷуғрофияи иҷтимоӣ ва иқтисодӣ'),
+  ('5.7', 'ru', 'Социальная и экономическая география'),
+  ('5.7', 'en', 'Social and economic geography'),
+  ('5.8', 'tg', 'Воситаҳои ахбори омма ва коммуникатсия'),
+  ('5.8', 'ru', 'СМИ и массовые коммуникации'),
+  ('5.8', 'en', 'Media and communications'),
+  ('5.9', 'tg', 'Дигар илмҳои ҷамъиятӣ'),
+  ('5.9', 'ru', 'Другие социальные науки'),
+  ('5.9', 'en', 'Other social sciences'),
+  ('6.1', 'tg', 'Таърих ва бостоншиносӣ'),
+  ('6.1', 'ru', 'История и археология'),
+  ('6.1', 'en', 'History and archaeology'),
+  ('6.2', 'tg', 'Забон ва адабиёт'),
+  ('6.2', 'ru', 'Языки и литература'),
+  ('6.2', 'en', 'Languages and literature'),
+  ('6.3', 'tg', 'Фалсафа, ахлоқ ва дин'),
+  ('6.3', 'ru', 'Философия, этика и религиоведение'),
+  ('6.3', 'en', 'Philosophy, ethics and religion'),
+  ('6.4', 'tg', 'Санъат'),
+  ('6.4', 'ru', 'Искусствоведение'),
+  ('6.4', 'en', 'Arts'),
+  ('6.5', 'tg', 'Дигар илмҳои гуманитарӣ'),
+  ('6.5', 'ru', 'Другие гуманитарные науки'),
+  ('6.5', 'en', 'Other humanities')
+ON CONFLICT (research_field_code, language_code) DO NOTHING;
+
+-- area, discipline and area_of_research stay free text, research_field_code links the record to the taxonomy
+ALTER TABLE employee_main_research_areas
+  ADD COLUMN IF NOT EXISTS research_field_code VARCHAR(31),
+  ADD CONSTRAINT fk_research_fields_employee_main_research_areas
+    FOREIGN KEY (research_field_code)
+    REFERENCES research_fields (code)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employee_main_research_areas_research_field_code
+  ON employee_main_research_areas (research_field_code);
+
+ALTER TABLE institution_main_research_directions
+  ADD COLUMN IF NOT EXISTS research_field_code VARCHAR(31),
+  ADD CONSTRAINT fk_research_fields_institution_main_research_directions
+    FOREIGN KEY (research_field_code)
+    REFERENCES research_fields (code)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_institution_main_research_directions_research_field_code
+  ON institution_main_research_directions (research_field_code);
+
+-- existing records are linked when their discipline or area, lower-cased and without spaces and punctuation,
+-- is the name of a research field in any language
+WITH research_field_patterns AS (
+  SELECT
+    regexp_replace(lower(rfn.name), '[[:space:][:punct:]]+', '', 'g') AS pattern,
+    rfn.research_field_code
+  FROM research_field_names rfn
+)
+UPDATE employee_main_research_areas
+SET research_field_code = (
+  SELECT p.research_field_code
+  FROM research_field_patterns p
+  WHERE p.pattern IN (
+    regexp_replace(lower(employee_main_research_areas.discipline), '[[:space:][:punct:]]+', '', 'g'),
+    regexp_replace(lower(employee_main_research_areas.area), '[[:space:][:punct:]]+', '', 'g')
+  )
+  ORDER BY p.research_field_code
+  LIMIT 1
+)
+WHERE research_field_code IS NULL;
+
+WITH research_field_patterns AS (
+  SELECT
+    regexp_replace(lower(rfn.name), '[[:space:][:punct:]]+', '', 'g') AS pattern,
+    rfn.research_field_code
+  FROM research_field_names rfn
+)
+UPDATE institution_main_research_directions
+SET research_field_code = (
+  SELECT p.research_field_code
+  FROM research_field_patterns p
+  WHERE p.pattern IN (
+    regexp_replace(lower(institution_main_research_directions.discipline), '[[:space:][:punct:]]+', '', 'g'),
+    regexp_replace(lower(institution_main_research_directions.area_of_research), '[[:space:][:punct:]]+', '', 'g')
+  )
+  ORDER BY p.research_field_code
+  LIMIT 1
+)
+WHERE research_field_code IS NULL;
+
+-- the field is the same in every translation of a record, unmapped translations take it from a mapped one
+UPDATE employee_main_research_areas
+SET research_field_code = source.research_field_code
+FROM employee_main_research_areas source
+WHERE employee_main_research_areas.research_field_code IS NULL
+  AND source.research_field_code IS NOT NULL
+  AND source.translation_group_id = employee_main_research_areas.translation_group_id;
+
+UPDATE institution_main_research_directions
+SET research_field_code = source.research_field_code
+FROM institution_main_research_directions source
+WHERE institution_main_research_directions.research_field_code IS NULL
+  AND source.research_field_code IS NOT NULL
+  AND source.translation_group_id = institution_main_research_directions.translation_group_id;
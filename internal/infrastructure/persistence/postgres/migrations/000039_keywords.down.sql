@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_employee_main_research_area_key_topics_keyword_id;
+DROP INDEX IF EXISTS idx_employee_main_research_area_key_topics_research_area_id;
+
+ALTER TABLE employee_main_research_area_key_topics
+  DROP CONSTRAINT IF EXISTS fk_employee_main_research_areas_key_topics,
+  DROP COLUMN IF EXISTS keyword_id,
+  DROP COLUMN IF EXISTS language_code;
+
+DROP TABLE IF EXISTS keyword_labels;
+DROP TABLE IF EXISTS keywords;
+
+DROP FUNCTION IF EXISTS normalize_keyword_label(TEXT);
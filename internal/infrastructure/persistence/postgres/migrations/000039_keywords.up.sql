@@ -0,0 +1,126 @@
+-- labels are compared lower-cased, trimmed and with runs of white space collapsed,
+-- so "Machine  Learning" and "machine learning" are the same keyword
+CREATE OR REPLACE FUNCTION normalize_keyword_label(p_label TEXT)
+RETURNS TEXT
+LANGUAGE sql
+IMMUTABLE
+AS $$
+  SELECT lower(regexp_replace(btrim(p_label), '\s+', ' ', 'g'));
+$$;
+
+-- keywords is the global index of research topics, every key topic with one of its labels refers to it
+CREATE TABLE IF NOT EXISTS keywords (
+  id BIGSERIAL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT keywords_pkey
+    PRIMARY KEY (id)
+);
+
+-- labels of keywords. A normalized label belongs to one keyword per language, the preferred label of a language
+-- is the one shown, the others are synonyms left by merges and still resolve to the keyword
+CREATE TABLE IF NOT EXISTS keyword_labels (
+  id BIGSERIAL,
+  keyword_id BIGINT NOT NULL,
+  language_code VARCHAR(16) NOT NULL,
+  label VARCHAR(255) NOT NULL,
+  normalized_label VARCHAR(255) GENERATED ALWAYS AS (normalize_keyword_label(label)) STORED,
+  is_preferred BOOLEAN NOT NULL DEFAULT false,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT keyword_labels_pkey
+    PRIMARY KEY (id),
+  CONSTRAINT keyword_labels_label_check
+    CHECK (btrim(label) <> ''),
+
+  CONSTRAINT fk_keywords_keyword_labels
+    FOREIGN KEY (keyword_id)
+    REFERENCES keywords (id)
+    ON DELETE CASCADE,
+  CONSTRAINT fk_languages_keyword_labels
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS keyword_labels_language_normalized_label_key
+  ON keyword_labels (language_code, normalized_label);
+
+CREATE UNIQUE INDEX IF NOT EXISTS keyword_labels_preferred_key
+  ON keyword_labels (keyword_id, language_code)
+  WHERE is_preferred;
+
+CREATE INDEX IF NOT EXISTS idx_keyword_labels_keyword_id
+  ON keyword_labels (keyword_id);
+
+-- autocomplete matches labels by prefix
+CREATE INDEX IF NOT EXISTS idx_keyword_labels_normalized_label_pattern
+  ON keyword_labels (normalized_label text_pattern_ops);
+
+-- key topics left behind by deleted research areas are unreachable
+DELETE FROM employee_main_research_area_key_topics kt
+WHERE NOT EXISTS (
+  SELECT 1
+  FROM employee_main_research_areas mra
+  WHERE mra.id = kt.employee_main_research_area_id
+);
+
+ALTER TABLE employee_main_research_area_key_topics
+  ADD COLUMN IF NOT EXISTS language_code VARCHAR(16),
+  ADD COLUMN IF NOT EXISTS keyword_id BIGINT,
+  ADD CONSTRAINT fk_employee_main_research_areas_key_topics
+    FOREIGN KEY (employee_main_research_area_id)
+    REFERENCES employee_main_research_areas (id)
+    ON DELETE CASCADE,
+  ADD CONSTRAINT fk_languages_employee_main_research_area_key_topics
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code),
+  ADD CONSTRAINT fk_keywords_employee_main_research_area_key_topics
+    FOREIGN KEY (keyword_id)
+    REFERENCES keywords (id)
+    ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employee_main_research_area_key_topics_research_area_id
+  ON employee_main_research_area_key_topics (employee_main_research_area_id);
+
+CREATE INDEX IF NOT EXISTS idx_employee_main_research_area_key_topics_keyword_id
+  ON employee_main_research_area_key_topics (keyword_id);
+
+-- existing topics are in the language of their research area
+UPDATE employee_main_research_area_key_topics kt
+SET language_code = mra.language_code
+FROM employee_main_research_areas mra
+WHERE mra.id = kt.employee_main_research_area_id;
+
+ALTER TABLE employee_main_research_area_key_topics
+  ALTER COLUMN language_code SET NOT NULL;
+
+-- one keyword for every distinct title of a language, the first spelling entered becomes its label
+DO $$
+DECLARE
+  r RECORD;
+  v_keyword_id BIGINT;
+BEGIN
+  FOR r IN
+    SELECT DISTINCT ON (kt.language_code, normalize_keyword_label(kt.key_topic_title))
+      kt.language_code,
+      btrim(kt.key_topic_title) AS label
+    FROM employee_main_research_area_key_topics kt
+    WHERE btrim(kt.key_topic_title) <> ''
+    ORDER BY kt.language_code, normalize_keyword_label(kt.key_topic_title), kt.id
+  LOOP
+    INSERT INTO keywords DEFAULT VALUES
+    RETURNING id INTO v_keyword_id;
+
+    INSERT INTO keyword_labels (keyword_id, language_code, label, is_preferred)
+    VALUES (v_keyword_id, r.language_code, r.label, true);
+  END LOOP;
+END;
+$$;
+
+UPDATE employee_main_research_area_key_topics kt
+SET keyword_id = kl.keyword_id
+FROM keyword_labels kl
+WHERE kl.language_code = kt.language_code
+  AND kl.normalized_label = normalize_keyword_label(kt.key_topic_title);
@@ -0,0 +1,29 @@
+-- researchers similar to an employee, recomputed as a whole by a background job so profile pages only read it.
+-- shared_* count the research fields/areas, keywords, events and institutions both employees have
+CREATE TABLE IF NOT EXISTS employee_similarities (
+  employee_id BIGINT NOT NULL,
+  similar_employee_id BIGINT NOT NULL,
+  score DOUBLE PRECISION NOT NULL,
+  shared_research_areas INT NOT NULL DEFAULT 0,
+  shared_keywords INT NOT NULL DEFAULT 0,
+  shared_events INT NOT NULL DEFAULT 0,
+  shared_institutions INT NOT NULL DEFAULT 0,
+  computed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT employee_similarities_pkey
+    PRIMARY KEY (employee_id, similar_employee_id),
+  CONSTRAINT employee_similarities_self_check
+    CHECK (employee_id <> similar_employee_id),
+
+  CONSTRAINT fk_employees_employee_similarities
+    FOREIGN KEY (employee_id)
+    REFERENCES employees (id)
+    ON DELETE CASCADE,
+  CONSTRAINT fk_employees_employee_similarities_similar
+    FOREIGN KEY (similar_employee_id)
+    REFERENCES employees (id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_similarities_employee_id_score ON employee_similarities (employee_id, score DESC);
+CREATE INDEX IF NOT EXISTS idx_employee_similarities_similar_employee_id ON employee_similarities (similar_employee_id);
@@ -0,0 +1,18 @@
+-- visibility levels chosen by employees for sections and fields of their profile,
+-- only levels differing from the defaults of the application have to be stored
+CREATE TABLE IF NOT EXISTS employee_visibility_settings (
+  employee_id BIGINT NOT NULL,
+  target VARCHAR(63) NOT NULL,
+  level VARCHAR(31) NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT employee_visibility_settings_pkey
+    PRIMARY KEY (employee_id, target),
+  CONSTRAINT employee_visibility_settings_level_check
+    CHECK (level IN ('public', 'authenticated', 'institution', 'admin', 'private')),
+
+  CONSTRAINT fk_employees_employee_visibility_settings
+    FOREIGN KEY (employee_id)
+    REFERENCES employees (id)
+    ON DELETE CASCADE
+);
@@ -0,0 +1,43 @@
+-- terms of publishing personal data. A version is published in one or more languages at once,
+-- the latest version is the one users have to accept to be listed in the directory
+CREATE TABLE IF NOT EXISTS consent_documents (
+  id BIGSERIAL,
+  version INT NOT NULL,
+  language_code VARCHAR(16) NOT NULL,
+  title VARCHAR(255) NOT NULL,
+  body TEXT NOT NULL,
+  published_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT consent_documents_pkey
+    PRIMARY KEY (id),
+  CONSTRAINT consent_documents_version_language_code_key
+    UNIQUE (version, language_code),
+  CONSTRAINT consent_documents_version_check
+    CHECK (version > 0),
+
+  CONSTRAINT fk_languages_consent_documents
+    FOREIGN KEY (language_code)
+    REFERENCES languages (code)
+);
+
+-- acceptances of consent documents kept as evidence, users accept every new version again
+CREATE TABLE IF NOT EXISTS user_consents (
+  id BIGSERIAL,
+  user_id BIGINT NOT NULL,
+  document_version INT NOT NULL,
+  language_code VARCHAR(16) NOT NULL,
+  ip_address VARCHAR(45) NOT NULL DEFAULT '',
+  accepted_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+  CONSTRAINT user_consents_pkey
+    PRIMARY KEY (id),
+  CONSTRAINT user_consents_user_id_document_version_key
+    UNIQUE (user_id, document_version),
+
+  CONSTRAINT fk_users_user_consents
+    FOREIGN KEY (user_id)
+    REFERENCES users (id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_consents_document_version ON user_consents (document_version);
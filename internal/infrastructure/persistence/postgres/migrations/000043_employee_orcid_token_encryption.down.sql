@@ -0,0 +1,2 @@
+-- tokens dropped by the up migration can not be restored, accounts have to be linked again to get them
+SELECT 1;
@@ -0,0 +1,7 @@
+-- tokens are encrypted by the application from now on, the ones stored in plaintext before are dropped.
+-- Records of such accounts are still synced through the public ORCID API until they are linked again
+UPDATE employee_orcid_accounts
+SET
+  access_token = '',
+  refresh_token = '',
+  updated_at = now();
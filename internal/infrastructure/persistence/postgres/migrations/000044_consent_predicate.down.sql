@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS has_latest_consent(BIGINT);
@@ -0,0 +1,16 @@
+-- has_latest_consent is the single condition every public listing and profile read applies:
+-- the user accepted the latest terms of publishing personal data, nobody is held back while none are published
+CREATE OR REPLACE FUNCTION has_latest_consent(p_user_id BIGINT)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+  SELECT
+    NOT EXISTS (SELECT 1 FROM consent_documents)
+    OR EXISTS (
+      SELECT 1
+      FROM user_consents uc
+      WHERE uc.user_id = p_user_id
+        AND uc.document_version = (SELECT max(cd.version) FROM consent_documents cd)
+    );
+$$;
@@ -0,0 +1 @@
+DROP FUNCTION IF EXISTS employee_section_is_public(BIGINT, TEXT);
@@ -0,0 +1,15 @@
+-- employee_section_is_public tells whether the employee shares a section of the profile with anonymous visitors,
+-- every section is public unless the employee stored another level (see domain.DefaultVisibility)
+CREATE OR REPLACE FUNCTION employee_section_is_public(p_employee_id BIGINT, p_target TEXT)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+  SELECT NOT EXISTS (
+    SELECT 1
+    FROM employee_visibility_settings evs
+    WHERE evs.employee_id = p_employee_id
+      AND evs.target = p_target
+      AND evs.level <> 'public'
+  );
+$$;
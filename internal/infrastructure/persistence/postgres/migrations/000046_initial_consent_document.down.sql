@@ -0,0 +1,15 @@
+-- the seeded terms stay, acceptances recorded against them are evidence
+CREATE OR REPLACE FUNCTION has_latest_consent(p_user_id BIGINT)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+  SELECT
+    NOT EXISTS (SELECT 1 FROM consent_documents)
+    OR EXISTS (
+      SELECT 1
+      FROM user_consents uc
+      WHERE uc.user_id = p_user_id
+        AND uc.document_version = (SELECT max(cd.version) FROM consent_documents cd)
+    );
+$$;
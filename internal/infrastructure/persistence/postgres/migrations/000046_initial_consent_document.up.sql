@@ -0,0 +1,39 @@
+-- the first version of the terms of publishing personal data, so that nobody is listed in the directory
+-- before accepting them. Users registered earlier have no acceptance: they are left out of public listings
+-- and profiles until they sign in, where the login response carries consentRequired, and accept the terms
+-- through POST /auth/consent. Administrators replace the text by publishing the next version.
+INSERT INTO consent_documents (version, language_code, title, body)
+SELECT 1, d.language_code, d.title, d.body
+FROM (
+  VALUES
+    (
+      'tg',
+      'Шартҳои нашри маълумоти шахсӣ',
+      'Бо қабули ин шартҳо шумо розӣ мешавед, ки маълумоти профили шумо (ному насаб, дараҷаи илмӣ, ҷои кор, нашрияҳо ва дигар бахшҳое, ки шумо оммавӣ гузоштаед) дар феҳристи олимон нашр карда шавад. Намоиши ҳар як бахшро дар танзимоти профил тағйир додан мумкин аст.'
+    ),
+    (
+      'ru',
+      'Условия публикации персональных данных',
+      'Принимая эти условия, вы соглашаетесь на публикацию данных вашего профиля (ФИО, учёная степень, место работы, публикации и другие разделы, открытые вами для всех) в реестре исследователей. Видимость каждого раздела можно изменить в настройках профиля.'
+    ),
+    (
+      'en',
+      'Terms of publishing personal data',
+      'By accepting these terms you agree that the data of your profile (name, academic degree, workplace, publications and other sections you share with the public) is published in the directory of researchers. The visibility of every section can be changed in the settings of the profile.'
+    )
+) AS d (language_code, title, body)
+WHERE NOT EXISTS (SELECT 1 FROM consent_documents);
+
+-- with terms always published, only an acceptance of the latest version lets an employee be listed
+CREATE OR REPLACE FUNCTION has_latest_consent(p_user_id BIGINT)
+RETURNS BOOLEAN
+LANGUAGE sql
+STABLE
+AS $$
+  SELECT EXISTS (
+    SELECT 1
+    FROM user_consents uc
+    WHERE uc.user_id = p_user_id
+      AND uc.document_version = (SELECT max(cd.version) FROM consent_documents cd)
+  );
+$$;
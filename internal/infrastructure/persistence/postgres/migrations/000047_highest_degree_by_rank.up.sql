@@ -0,0 +1,63 @@
+-- the highest academic degree of an employee is chosen by the rank of its level, not by the date it ended
+CREATE OR REPLACE FUNCTION refresh_employee_denormalized_fields(p_employee_id BIGINT)
+RETURNS VOID
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  v_highest_academic_degree VARCHAR;
+  v_speciality VARCHAR;
+  v_speciality_code VARCHAR;
+  v_current_workplace VARCHAR;
+  v_current_institution_id BIGINT;
+  v_current_org_unit_id BIGINT;
+BEGIN
+  IF p_employee_id IS NULL THEN
+    RETURN;
+  END IF;
+
+  -- highest_academic_degree <- employee_degrees.degree_level_code of the highest rank, degree_level when unmapped
+  -- speciality, speciality_code <- employee_degrees.speciality, speciality_code of the same degree
+  -- a Master's finished after a PhD does not replace it, unmapped degrees rank below every level
+  SELECT
+    ed.speciality,
+    ed.speciality_code,
+    coalesce(ed.degree_level_code, ed.degree_level)
+  INTO
+    v_speciality,
+    v_speciality_code,
+    v_highest_academic_degree
+  FROM employee_degrees ed
+  LEFT JOIN degree_levels dl ON dl.code = ed.degree_level_code
+  WHERE ed.employee_id = p_employee_id
+  ORDER BY dl.rank DESC NULLS LAST, ed.date_end DESC NULLS LAST, ed.id DESC
+  LIMIT 1;
+
+  -- current_workplace, current_institution_id, current_org_unit_id <- latest ongoing employee_work_experiences
+  SELECT
+    we.workplace,
+    we.institution_id,
+    we.org_unit_id
+  INTO
+    v_current_workplace,
+    v_current_institution_id,
+    v_current_org_unit_id
+  FROM employee_work_experiences we
+  WHERE we.employee_id = p_employee_id
+    AND we.on_going IS TRUE
+  ORDER BY we.date_start DESC NULLS LAST, we.id DESC
+  LIMIT 1;
+
+  UPDATE employees e
+  SET
+    highest_academic_degree = v_highest_academic_degree,
+    speciality = v_speciality,
+    speciality_code = v_speciality_code,
+    current_workplace = v_current_workplace,
+    current_institution_id = v_current_institution_id,
+    current_org_unit_id = v_current_org_unit_id
+  WHERE e.id = p_employee_id;
+END;
+$$;
+
+SELECT refresh_employee_denormalized_fields(e.id)
+FROM employees e;
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type pgCollaborationRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgCollaborationRepository(store *Store) repositories.CollaborationRepository {
+	return &pgCollaborationRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgCollaborationRepositoryWithQuery(q *sqlc.Queries) repositories.CollaborationRepository {
+	return &pgCollaborationRepository{
+		queries: q,
+	}
+}
+
+func (r *pgCollaborationRepository) GetLinks(ctx context.Context, employeeIDs []int64, includeExternal bool, maxTokenEmployees int32) ([]*domain.CollaborationLink, error) {
+	linksResult, err := r.queries.GetCollaborationEdges(ctx, sqlc.GetCollaborationEdgesParams{
+		MaxTokenEmployees: maxTokenEmployees,
+		EmployeeIds:       employeeIDs,
+		IncludeExternal:   includeExternal,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive collaborations of employees: %w", err))
+	}
+
+	links := make([]*domain.CollaborationLink, len(linksResult))
+	for index, link := range linksResult {
+		links[index] = &domain.CollaborationLink{
+			EmployeeID:     link.EmployeeID,
+			CollaboratorID: link.CollaboratorID,
+			Kind:           link.Kind,
+			Weight:         link.Weight,
+		}
+	}
+
+	return links, nil
+}
+
+func (r *pgCollaborationRepository) GetNodesByIDs(ctx context.Context, ids []int64, langCodes []string) ([]*domain.CollaborationNode, error) {
+	nodesResult, err := r.queries.GetCollaborationNodesByIDs(ctx, sqlc.GetCollaborationNodesByIDsParams{
+		LanguageCodes: langCodes,
+		Ids:           ids,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employees of collaboration network: %w", err))
+	}
+
+	nodes := make([]*domain.CollaborationNode, len(nodesResult))
+	for index, node := range nodesResult {
+		nodes[index] = &domain.CollaborationNode{
+			EmployeeID:           node.ID,
+			UniqueID:             node.UniqueID,
+			Surname:              node.Surname,
+			Name:                 node.Name,
+			Middlename:           node.Middlename,
+			CurrentWorkplace:     node.CurrentWorkplace,
+			CurrentInstitutionID: node.CurrentInstitutionID,
+		}
+	}
+
+	return nodes, nil
+}
+
+func (r *pgCollaborationRepository) GetEmployeeIDsByInstitutionID(ctx context.Context, institutionID int64) ([]int64, error) {
+	employeeIDs, err := r.queries.GetEmployeeIDsByCurrentInstitutionID(ctx, pgtype.Int8{
+		Int64: institutionID,
+		Valid: true,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employees of institution(%d): %w", institutionID, err))
+	}
+
+	return employeeIDs, nil
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+)
+
+type pgConsentRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgConsentRepository(store *Store) repositories.ConsentRepository {
+	return &pgConsentRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgConsentRepositoryWithQuery(q *sqlc.Queries) repositories.ConsentRepository {
+	return &pgConsentRepository{
+		queries: q,
+	}
+}
+
+func (r *pgConsentRepository) CreateDocument(ctx context.Context, document *domain.ConsentDocument) (*domain.ConsentDocument, error) {
+	documentResult, err := r.queries.CreateConsentDocument(ctx, sqlc.CreateConsentDocumentParams{
+		Version:      document.Version,
+		LanguageCode: document.LanguageCode,
+		Title:        document.Title,
+		Body:         document.Body,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create consent document(version %d, %s): %w", document.Version, document.LanguageCode, err))
+	}
+
+	return mapConsentDocumentRow(documentResult), nil
+}
+
+func (r *pgConsentRepository) GetLatestVersion(ctx context.Context) (int32, error) {
+	version, err := r.queries.GetLatestConsentDocumentVersion(ctx)
+	if err != nil {
+		return 0, custom_errors.InternalServerError(fmt.Errorf("failed to retrive latest version of consent documents: %w", err))
+	}
+
+	return version, nil
+}
+
+func (r *pgConsentRepository) GetDocumentsByVersion(ctx context.Context, version int32) ([]*domain.ConsentDocument, error) {
+	documentsResult, err := r.queries.GetConsentDocumentsByVersion(ctx, version)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive consent documents of version(%d): %w", version, err))
+	}
+
+	documents := make([]*domain.ConsentDocument, len(documentsResult))
+	for index, document := range documentsResult {
+		documents[index] = mapConsentDocumentRow(document)
+	}
+
+	return documents, nil
+}
+
+func (r *pgConsentRepository) CreateUserConsent(ctx context.Context, consent *domain.UserConsent) error {
+	err := r.queries.CreateUserConsent(ctx, sqlc.CreateUserConsentParams{
+		UserID:          consent.UserID,
+		DocumentVersion: consent.DocumentVersion,
+		LanguageCode:    consent.LanguageCode,
+		IpAddress:       consent.IPAddress,
+	})
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to record consent of user(%d) to version(%d): %w", consent.UserID, consent.DocumentVersion, err))
+	}
+
+	return nil
+}
+
+func (r *pgConsentRepository) GetLatestAcceptedVersion(ctx context.Context, userID int64) (int32, error) {
+	version, err := r.queries.GetLatestUserConsentVersion(ctx, userID)
+	if err != nil {
+		return 0, custom_errors.InternalServerError(fmt.Errorf("failed to retrive consent of user(%d): %w", userID, err))
+	}
+
+	return version, nil
+}
+
+func (r *pgConsentRepository) HasLatestConsent(ctx context.Context, userID int64) (bool, error) {
+	hasConsent, err := r.queries.HasLatestUserConsent(ctx, userID)
+	if err != nil {
+		return false, custom_errors.InternalServerError(fmt.Errorf("failed to retrive consent of user(%d): %w", userID, err))
+	}
+
+	return hasConsent, nil
+}
+
+func (r *pgConsentRepository) GetAllUserConsents(ctx context.Context) ([]*domain.UserConsent, error) {
+	consentsResult, err := r.queries.GetAllUserConsents(ctx)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive consents of users: %w", err))
+	}
+
+	consents := make([]*domain.UserConsent, len(consentsResult))
+	for index, consent := range consentsResult {
+		consents[index] = &domain.UserConsent{
+			ID:               consent.ID,
+			UserID:           consent.UserID,
+			Email:            consent.Email,
+			EmployeeUniqueID: consent.EmployeeUniqueID,
+			DocumentVersion:  consent.DocumentVersion,
+			LanguageCode:     consent.LanguageCode,
+			IPAddress:        consent.IpAddress,
+			AcceptedAt:       consent.AcceptedAt.Time,
+		}
+	}
+
+	return consents, nil
+}
+
+func mapConsentDocumentRow(document sqlc.ConsentDocument) *domain.ConsentDocument {
+	return &domain.ConsentDocument{
+		ID:           document.ID,
+		Version:      document.Version,
+		LanguageCode: document.LanguageCode,
+		Title:        document.Title,
+		Body:         document.Body,
+		PublishedAt:  document.PublishedAt.Time,
+	}
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+)
+
+type pgDegreeLevelRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgDegreeLevelRepository(store *Store) repositories.DegreeLevelRepository {
+	return &pgDegreeLevelRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgDegreeLevelRepositoryWithQuery(q *sqlc.Queries) repositories.DegreeLevelRepository {
+	return &pgDegreeLevelRepository{
+		queries: q,
+	}
+}
+
+func (r *pgDegreeLevelRepository) GetAll(ctx context.Context) ([]*domain.DegreeLevel, error) {
+	degreeLevelsResult, err := r.queries.GetAllDegreeLevels(ctx)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive degree levels: %w", err))
+	}
+
+	namesResult, err := r.queries.GetAllDegreeLevelNames(ctx)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive names of degree levels: %w", err))
+	}
+
+	degreeLevels := make([]*domain.DegreeLevel, len(degreeLevelsResult))
+	degreeLevelsByCode := make(map[string]*domain.DegreeLevel, len(degreeLevelsResult))
+	for index, degreeLevel := range degreeLevelsResult {
+		degreeLevels[index] = &domain.DegreeLevel{
+			Code:      degreeLevel.Code,
+			Rank:      degreeLevel.Rank,
+			Names:     map[string]string{},
+			CreatedAt: degreeLevel.CreatedAt.Time,
+			UpdatedAt: degreeLevel.UpdatedAt.Time,
+		}
+		degreeLevelsByCode[degreeLevel.Code] = degreeLevels[index]
+	}
+
+	for _, name := range namesResult {
+		if degreeLevel, ok := degreeLevelsByCode[name.DegreeLevelCode]; ok {
+			degreeLevel.Names[name.LanguageCode] = name.Name
+		}
+	}
+
+	return degreeLevels, nil
+}
+
+func (r *pgDegreeLevelRepository) Exists(ctx context.Context, code string) (bool, error) {
+	exists, err := r.queries.IsDegreeLevelExisting(ctx, code)
+	if err != nil {
+		return false, custom_errors.InternalServerError(fmt.Errorf("failed to check degree level(%s): %w", code, err))
+	}
+
+	return exists, nil
+}
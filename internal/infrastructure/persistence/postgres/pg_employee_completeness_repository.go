@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+)
+
+type pgEmployeeCompletenessRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgEmployeeCompletenessRepository(store *Store) repositories.EmployeeCompletenessRepository {
+	return &pgEmployeeCompletenessRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgEmployeeCompletenessRepositoryWithQuery(q *sqlc.Queries) repositories.EmployeeCompletenessRepository {
+	return &pgEmployeeCompletenessRepository{
+		queries: q,
+	}
+}
+
+func (r *pgEmployeeCompletenessRepository) GetByUniqueID(ctx context.Context, uniqueID string) ([]*domain.EmployeeCompletenessCounts, error) {
+	countsResult, err := r.queries.GetEmployeeCompletenessCounts(ctx, sqlc.GetEmployeeCompletenessCountsParams{
+		UniqueID: uniqueID,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive completeness of employee(%s): %w", uniqueID, err))
+	}
+
+	counts := make([]*domain.EmployeeCompletenessCounts, len(countsResult))
+	for index, c := range countsResult {
+		counts[index] = mapEmployeeCompletenessCountsRow(c)
+	}
+
+	return counts, nil
+}
+
+func (r *pgEmployeeCompletenessRepository) GetByInstitutionID(ctx context.Context, institutionID int64, langCode string) ([]*domain.EmployeeCompletenessCounts, error) {
+	countsResult, err := r.queries.GetEmployeeCompletenessCounts(ctx, sqlc.GetEmployeeCompletenessCountsParams{
+		InstitutionID: institutionID,
+		LanguageCode:  langCode,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive completeness of employees of institution(%d): %w", institutionID, err))
+	}
+
+	counts := make([]*domain.EmployeeCompletenessCounts, len(countsResult))
+	for index, c := range countsResult {
+		counts[index] = mapEmployeeCompletenessCountsRow(c)
+	}
+
+	return counts, nil
+}
+
+func mapEmployeeCompletenessCountsRow(c sqlc.GetEmployeeCompletenessCountsRow) *domain.EmployeeCompletenessCounts {
+	return &domain.EmployeeCompletenessCounts{
+		EmployeeID:                 c.ID,
+		UniqueID:                   c.UniqueID,
+		LanguageCode:               c.LanguageCode,
+		Surname:                    c.Surname,
+		Name:                       c.Name,
+		Middlename:                 c.Middlename,
+		HasDetails:                 c.HasDetails,
+		DegreeCount:                c.DegreeCount,
+		WorkExperienceCount:        c.WorkExperienceCount,
+		OngoingWorkExperienceCount: c.OngoingWorkExperienceCount,
+		MainResearchAreaCount:      c.MainResearchAreaCount,
+		KeyTopicCount:              c.KeyTopicCount,
+		PublicationCount:           c.PublicationCount,
+		ScientificAwardCount:       c.ScientificAwardCount,
+		PatentCount:                c.PatentCount,
+		ProfessionalCommunityCount: c.ProfessionalCommunityCount,
+		RefresherCourseCount:       c.RefresherCourseCount,
+		EventCount:                 c.EventCount,
+		ResearchActivityCount:      c.ResearchActivityCount,
+		SocialCount:                c.SocialCount,
+		HasGender:                  c.HasGender,
+		HasOrcid:                   c.HasOrcid,
+		HasHighestAcademicDegree:   c.HasHighestAcademicDegree,
+		HasSpeciality:              c.HasSpeciality,
+		HasCurrentWorkplace:        c.HasCurrentWorkplace,
+		HasConsent:                 c.HasConsent,
+	}
+}
@@ -30,10 +30,14 @@ func NewPgEmployeeDegreeRepositoryWithQuery(q *sqlc.Queries) repositories.Employ
 }
 
 func (r *pgEmployeeDegreeRepository) Create(ctx context.Context, employeeDegree *domain.EmployeeDegree) (*domain.EmployeeDegree, error) {
+	translationGroupID, err := translationGroupParam(employeeDegree.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	employeeDegreeResult, err := r.queries.CreateEmployeeDegree(ctx, sqlc.CreateEmployeeDegreeParams{
 		EmployeeID:     employeeDegree.EmployeeID,
 		LanguageCode:   employeeDegree.LanguageCode,
-		DegreeLevel:    employeeDegree.DegreeLevel,
 		UniversityName: employeeDegree.UniversityName,
 		Speciality:     employeeDegree.Speciality,
 		DateStart: pgtype.Date{
@@ -52,12 +56,22 @@ func (r *pgEmployeeDegreeRepository) Create(ctx context.Context, employeeDegree
 			Time:  employeeDegree.DateDegreeRecieved,
 			Valid: !employeeDegree.DateDegreeRecieved.IsZero(),
 		},
+		TranslationGroupID: translationGroupID,
+		DegreeLevelCode: pgtype.Text{
+			String: employeeDegree.DegreeLevelCode,
+			Valid:  employeeDegree.DegreeLevelCode != "",
+		},
+		SpecialityCode: pgtype.Text{
+			String: employeeDegree.SpecialityCode,
+			Valid:  employeeDegree.SpecialityCode != "",
+		},
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create employee degree: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create employee degree: %w", err))
 	}
 
 	employeeDegree.ID = employeeDegreeResult.ID
+	employeeDegree.TranslationGroupID = translationGroupID.String()
 	employeeDegree.CreatedAt = employeeDegreeResult.CreatedAt.Time
 	employeeDegree.UpdatedAt = employeeDegreeResult.UpdatedAt.Time
 
@@ -67,7 +81,6 @@ func (r *pgEmployeeDegreeRepository) Create(ctx context.Context, employeeDegree
 func (r *pgEmployeeDegreeRepository) Update(ctx context.Context, employeeDegree *domain.EmployeeDegree) (*domain.EmployeeDegree, error) {
 	updateEmployeeDegreeResult, err := r.queries.UpdateEmployeeDegree(ctx, sqlc.UpdateEmployeeDegreeParams{
 		ID:             employeeDegree.ID,
-		DegreeLevel:    employeeDegree.DegreeLevel,
 		UniversityName: employeeDegree.UniversityName,
 		Speciality:     employeeDegree.Speciality,
 		DateStart: pgtype.Date{
@@ -86,6 +99,14 @@ func (r *pgEmployeeDegreeRepository) Update(ctx context.Context, employeeDegree
 			Time:  employeeDegree.DateDegreeRecieved,
 			Valid: !employeeDegree.DateDegreeRecieved.IsZero(),
 		},
+		DegreeLevelCode: pgtype.Text{
+			String: employeeDegree.DegreeLevelCode,
+			Valid:  employeeDegree.DegreeLevelCode != "",
+		},
+		SpecialityCode: pgtype.Text{
+			String: employeeDegree.SpecialityCode,
+			Valid:  employeeDegree.SpecialityCode != "",
+		},
 	})
 
 	employeeDegree.CreatedAt = updateEmployeeDegreeResult.CreatedAt.Time
@@ -95,6 +116,10 @@ func (r *pgEmployeeDegreeRepository) Update(ctx context.Context, employeeDegree
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to update employee degree: %w", err))
 	}
 
+	if err := r.queries.SyncEmployeeDegreeTranslations(ctx, employeeDegree.ID); err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to sync translations of employee degree: %w", err))
+	}
+
 	return employeeDegree, nil
 }
 
@@ -116,9 +141,12 @@ func (r *pgEmployeeDegreeRepository) GetByID(ctx context.Context, id int64) (*do
 		ID:                 employeeDegreeResult.ID,
 		EmployeeID:         employeeDegreeResult.EmployeeID,
 		LanguageCode:       employeeDegreeResult.LanguageCode,
-		DegreeLevel:        employeeDegreeResult.DegreeLevel,
+		TranslationGroupID: employeeDegreeResult.TranslationGroupID.String(),
+		DegreeLevelCode:    employeeDegreeResult.DegreeLevelCode.String,
+		DegreeLevel:        employeeDegreeResult.DegreeLevelName,
 		UniversityName:     employeeDegreeResult.UniversityName,
 		Speciality:         employeeDegreeResult.Speciality,
+		SpecialityCode:     employeeDegreeResult.SpecialityCode.String,
 		DateStart:          employeeDegreeResult.DateStart.Time,
 		DateEnd:            employeeDegreeResult.DateEnd.Time,
 		GivenBy:            employeeDegreeResult.GivenBy.String,
@@ -129,12 +157,16 @@ func (r *pgEmployeeDegreeRepository) GetByID(ctx context.Context, id int64) (*do
 }
 
 func (r *pgEmployeeDegreeRepository) GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeDegree, error) {
-	employeeDegreesResult, err := r.queries.GetEmployeeDegreesByEmployeeIDAndLanguageCode(ctx, sqlc.GetEmployeeDegreesByEmployeeIDAndLanguageCodeParams{
-		EmployeeID:   employeeID,
-		LanguageCode: langCode,
+	return r.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, []string{langCode})
+}
+
+func (r *pgEmployeeDegreeRepository) GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeDegree, error) {
+	employeeDegreesResult, err := r.queries.GetEmployeeDegreesByEmployeeIDAndLanguageCodes(ctx, sqlc.GetEmployeeDegreesByEmployeeIDAndLanguageCodesParams{
+		EmployeeID:    employeeID,
+		LanguageCodes: langCodes,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee degrees with given EmployeeID(%d) and language_code(%s): %w", employeeID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee degrees with given EmployeeID(%d) and language_codes(%v): %w", employeeID, langCodes, err))
 	}
 
 	employeeDegrees := make([]*domain.EmployeeDegree, len(employeeDegreesResult))
@@ -143,9 +175,12 @@ func (r *pgEmployeeDegreeRepository) GetByEmployeeIDAndLanguageCode(ctx context.
 			ID:                 degree.ID,
 			EmployeeID:         degree.EmployeeID,
 			LanguageCode:       degree.LanguageCode,
-			DegreeLevel:        degree.DegreeLevel,
+			TranslationGroupID: degree.TranslationGroupID.String(),
+			DegreeLevelCode:    degree.DegreeLevelCode.String,
+			DegreeLevel:        degree.DegreeLevelName,
 			UniversityName:     degree.UniversityName,
 			Speciality:         degree.Speciality,
+			SpecialityCode:     degree.SpecialityCode.String,
 			DateStart:          degree.DateStart.Time,
 			DateEnd:            degree.DateEnd.Time,
 			GivenBy:            degree.GivenBy.String,
@@ -7,6 +7,7 @@ import (
 	"backend/internal/shared/custom_errors"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -40,6 +41,14 @@ func (r *pgEmployeeDetailsRepository) Create(ctx context.Context, employeeDetail
 			String: employeeDetails.Middlename,
 		},
 		IsEmployeeDetailsNew: employeeDetails.IsEmployeeDetailsNew,
+		EffectiveFrom: pgtype.Date{
+			Time:  employeeDetails.EffectiveFrom,
+			Valid: !employeeDetails.EffectiveFrom.IsZero(),
+		},
+		EffectiveTo: pgtype.Date{
+			Time:  employeeDetails.EffectiveTo,
+			Valid: !employeeDetails.EffectiveTo.IsZero(),
+		},
 	})
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create emplyoee details: %w", err))
@@ -62,6 +71,14 @@ func (r *pgEmployeeDetailsRepository) Update(ctx context.Context, employeeDetail
 		},
 		ID:                   employeeDetails.ID,
 		IsEmployeeDetailsNew: employeeDetails.IsEmployeeDetailsNew,
+		EffectiveFrom: pgtype.Date{
+			Time:  employeeDetails.EffectiveFrom,
+			Valid: !employeeDetails.EffectiveFrom.IsZero(),
+		},
+		EffectiveTo: pgtype.Date{
+			Time:  employeeDetails.EffectiveTo,
+			Valid: !employeeDetails.EffectiveTo.IsZero(),
+		},
 	})
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to update employee_detials: %w", err))
@@ -95,6 +112,8 @@ func (r *pgEmployeeDetailsRepository) GetByID(ctx context.Context, id int64) (*d
 		Name:                 employeeDetailsResult.Name,
 		Middlename:           employeeDetailsResult.Middlename.String,
 		IsEmployeeDetailsNew: employeeDetailsResult.IsEmployeeDetailsNew,
+		EffectiveFrom:        employeeDetailsResult.EffectiveFrom.Time,
+		EffectiveTo:          employeeDetailsResult.EffectiveTo.Time,
 		CreatedAt:            employeeDetailsResult.CreatedAt.Time,
 		UpdatedAt:            employeeDetailsResult.UpdatedAt.Time,
 	}, nil
@@ -116,6 +135,8 @@ func (r *pgEmployeeDetailsRepository) GetByEmployeeID(ctx context.Context, emplo
 			Name:                 details.Name,
 			Middlename:           details.Middlename.String,
 			IsEmployeeDetailsNew: details.IsEmployeeDetailsNew,
+			EffectiveFrom:        details.EffectiveFrom.Time,
+			EffectiveTo:          details.EffectiveTo.Time,
 			CreatedAt:            details.CreatedAt.Time,
 			UpdatedAt:            details.UpdatedAt.Time,
 		}
@@ -141,7 +162,26 @@ func (r *pgEmployeeDetailsRepository) GetCurrentDetailsByEmployeeIDAndLanguageCo
 		Surname:              employeeDetailsResult.Surname,
 		Middlename:           employeeDetailsResult.Middlename.String,
 		IsEmployeeDetailsNew: employeeDetailsResult.IsEmployeeDetailsNew,
+		EffectiveFrom:        employeeDetailsResult.EffectiveFrom.Time,
+		EffectiveTo:          employeeDetailsResult.EffectiveTo.Time,
 		CreatedAt:            employeeDetailsResult.CreatedAt.Time,
 		UpdatedAt:            employeeDetailsResult.UpdatedAt.Time,
 	}, nil
 }
+
+func (r *pgEmployeeDetailsRepository) ArchiveCurrentDetails(ctx context.Context, employeeID int64, langCode string, exceptID int64, effectiveTo time.Time) error {
+	err := r.queries.ArchiveCurrentEmployeeDetails(ctx, sqlc.ArchiveCurrentEmployeeDetailsParams{
+		EffectiveTo: pgtype.Date{
+			Time:  effectiveTo,
+			Valid: !effectiveTo.IsZero(),
+		},
+		EmployeeID:   employeeID,
+		LanguageCode: langCode,
+		ExceptID:     exceptID,
+	})
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to archive current employee details by employeeID(%d) and languageCode(%s): %w", employeeID, langCode, err))
+	}
+
+	return nil
+}
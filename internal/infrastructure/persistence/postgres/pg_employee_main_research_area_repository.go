@@ -7,6 +7,8 @@ import (
 	"backend/internal/shared/custom_errors"
 	"context"
 	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type pgEmployeeMainResearchAreaRepository struct {
@@ -28,17 +30,28 @@ func NewPgEmployeeMainResearchAreaRepositoryWithQueries(q *sqlc.Queries) reposit
 }
 
 func (r *pgEmployeeMainResearchAreaRepository) CreateMRA(ctx context.Context, employeeMRA *domain.EmployeeMainResearchArea) (*domain.EmployeeMainResearchArea, error) {
+	translationGroupID, err := translationGroupParam(employeeMRA.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	employeeMRAResult, err := r.queries.CreateEmployeeMainResearchArea(ctx, sqlc.CreateEmployeeMainResearchAreaParams{
-		EmployeeID:   employeeMRA.EmployeeID,
-		LanguageCode: employeeMRA.LanguageCode,
-		Discipline:   employeeMRA.Discipline,
-		Area:         employeeMRA.Area,
+		EmployeeID:         employeeMRA.EmployeeID,
+		LanguageCode:       employeeMRA.LanguageCode,
+		Discipline:         employeeMRA.Discipline,
+		Area:               employeeMRA.Area,
+		TranslationGroupID: translationGroupID,
+		ResearchFieldCode: pgtype.Text{
+			String: employeeMRA.ResearchFieldCode,
+			Valid:  employeeMRA.ResearchFieldCode != "",
+		},
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create employee main research area: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create employee main research area: %w", err))
 	}
 
 	employeeMRA.ID = employeeMRAResult.ID
+	employeeMRA.TranslationGroupID = translationGroupID.String()
 	employeeMRA.CreatedAt = employeeMRAResult.CreatedAt.Time
 	employeeMRA.UpdatedAt = employeeMRAResult.UpdatedAt.Time
 
@@ -49,6 +62,11 @@ func (r *pgEmployeeMainResearchAreaRepository) CreateRAKT(ctx context.Context, r
 	raktResult, err := r.queries.CreateEmployeeMainResearchAreaKeyTopic(ctx, sqlc.CreateEmployeeMainResearchAreaKeyTopicParams{
 		EmployeeMainResearchAreaID: rakt.EmployeeMainResearchAreaID,
 		KeyTopicTitle:              rakt.KeyTopicTitle,
+		LanguageCode:               rakt.LanguageCode,
+		KeywordID: pgtype.Int8{
+			Int64: rakt.KeywordID,
+			Valid: rakt.KeywordID != 0,
+		},
 	})
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create main research area key topic: %w", err))
@@ -66,11 +84,19 @@ func (r *pgEmployeeMainResearchAreaRepository) UpdateMRA(ctx context.Context, em
 		ID:         employeeMRA.ID,
 		Area:       employeeMRA.Area,
 		Discipline: employeeMRA.Discipline,
+		ResearchFieldCode: pgtype.Text{
+			String: employeeMRA.ResearchFieldCode,
+			Valid:  employeeMRA.ResearchFieldCode != "",
+		},
 	})
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to update employee main research are: %w", err))
 	}
 
+	if err := r.queries.SyncEmployeeMainResearchAreaTranslations(ctx, employeeMRA.ID); err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to sync translations of employee main research area: %w", err))
+	}
+
 	employeeMRA.CreatedAt = updateEmployeeMRAResult.CreatedAt.Time
 	employeeMRA.UpdatedAt = updateEmployeeMRAResult.UpdatedAt.Time
 
@@ -81,6 +107,10 @@ func (r *pgEmployeeMainResearchAreaRepository) UpdateRAKT(ctx context.Context, r
 	updatedRAKT, err := r.queries.UpdateEmployeeMainResearchAreaKeyTopic(ctx, sqlc.UpdateEmployeeMainResearchAreaKeyTopicParams{
 		ID:            rakt.ID,
 		KeyTopicTitle: rakt.KeyTopicTitle,
+		KeywordID: pgtype.Int8{
+			Int64: rakt.KeywordID,
+			Valid: rakt.KeywordID != 0,
+		},
 	})
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to update main research area key topic: %w", err))
@@ -123,13 +153,15 @@ func (r *pgEmployeeMainResearchAreaRepository) GetMRAByID(ctx context.Context, i
 	}
 
 	return &domain.EmployeeMainResearchArea{
-		ID:           employeeMRAResult.ID,
-		EmployeeID:   employeeMRAResult.EmployeeID,
-		LanguageCode: employeeMRAResult.LanguageCode,
-		Area:         employeeMRAResult.Area,
-		Discipline:   employeeMRAResult.Discipline,
-		CreatedAt:    employeeMRAResult.CreatedAt.Time,
-		UpdatedAt:    employeeMRAResult.UpdatedAt.Time,
+		ID:                 employeeMRAResult.ID,
+		EmployeeID:         employeeMRAResult.EmployeeID,
+		LanguageCode:       employeeMRAResult.LanguageCode,
+		TranslationGroupID: employeeMRAResult.TranslationGroupID.String(),
+		Area:               employeeMRAResult.Area,
+		Discipline:         employeeMRAResult.Discipline,
+		ResearchFieldCode:  employeeMRAResult.ResearchFieldCode.String,
+		CreatedAt:          employeeMRAResult.CreatedAt.Time,
+		UpdatedAt:          employeeMRAResult.UpdatedAt.Time,
 	}, nil
 }
 
@@ -142,30 +174,38 @@ func (r *pgEmployeeMainResearchAreaRepository) GetRAKTByID(ctx context.Context,
 	return &domain.ResearchAreaKeyTopic{
 		ID:                         rakt.ID,
 		EmployeeMainResearchAreaID: rakt.EmployeeMainResearchAreaID,
+		LanguageCode:               rakt.LanguageCode,
 		KeyTopicTitle:              rakt.KeyTopicTitle,
+		KeywordID:                  rakt.KeywordID.Int64,
 		CreatedAt:                  rakt.CreatedAt.Time,
 		UpdatedAt:                  rakt.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgEmployeeMainResearchAreaRepository) GetMRAByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeMainResearchArea, error) {
-	employeeMRAsResult, err := r.queries.GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCode(ctx, sqlc.GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodeParams{
-		EmployeeID:   employeeID,
-		LanguageCode: langCode,
+	return r.GetMRAByEmployeeIDAndLanguageCodes(ctx, employeeID, []string{langCode})
+}
+
+func (r *pgEmployeeMainResearchAreaRepository) GetMRAByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeMainResearchArea, error) {
+	employeeMRAsResult, err := r.queries.GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodes(ctx, sqlc.GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodesParams{
+		EmployeeID:    employeeID,
+		LanguageCodes: langCodes,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee main research areas by given ID(%d) and language_code(%s): %w", employeeID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee main research areas by given ID(%d) and language_codes(%v): %w", employeeID, langCodes, err))
 	}
 
 	employeeMRAs := make([]*domain.EmployeeMainResearchArea, len(employeeMRAsResult))
 	for indexMRA, employeeMRA := range employeeMRAsResult {
 		employeeMRAs[indexMRA] = &domain.EmployeeMainResearchArea{
-			ID:           employeeMRA.ID,
-			LanguageCode: employeeMRA.LanguageCode,
-			Area:         employeeMRA.Area,
-			Discipline:   employeeMRA.Discipline,
-			CreatedAt:    employeeMRA.CreatedAt.Time,
-			UpdatedAt:    employeeMRA.UpdatedAt.Time,
+			ID:                 employeeMRA.ID,
+			LanguageCode:       employeeMRA.LanguageCode,
+			TranslationGroupID: employeeMRA.TranslationGroupID.String(),
+			Area:               employeeMRA.Area,
+			Discipline:         employeeMRA.Discipline,
+			ResearchFieldCode:  employeeMRA.ResearchFieldCode.String,
+			CreatedAt:          employeeMRA.CreatedAt.Time,
+			UpdatedAt:          employeeMRA.UpdatedAt.Time,
 		}
 	}
 
@@ -183,7 +223,9 @@ func (r *pgEmployeeMainResearchAreaRepository) GetRAKTByMRAIDAndLanguageCode(ctx
 		rakts[index] = &domain.ResearchAreaKeyTopic{
 			ID:                         rakt.ID,
 			EmployeeMainResearchAreaID: rakt.EmployeeMainResearchAreaID,
+			LanguageCode:               rakt.LanguageCode,
 			KeyTopicTitle:              rakt.KeyTopicTitle,
+			KeywordID:                  rakt.KeywordID.Int64,
 			CreatedAt:                  rakt.CreatedAt.Time,
 			UpdatedAt:                  rakt.UpdatedAt.Time,
 		}
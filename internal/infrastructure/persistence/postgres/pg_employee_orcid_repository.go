@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/infrastructure/security"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// pgEmployeeOrcidRepository keeps the tokens of linked accounts encrypted with tokenCipher
+type pgEmployeeOrcidRepository struct {
+	store       *Store
+	queries     *sqlc.Queries
+	tokenCipher *security.TokenCipher
+}
+
+func NewPgEmployeeOrcidRepository(store *Store, tokenCipher *security.TokenCipher) repositories.EmployeeOrcidRepository {
+	return &pgEmployeeOrcidRepository{
+		store:       store,
+		queries:     store.Queries,
+		tokenCipher: tokenCipher,
+	}
+}
+
+func NewPgEmployeeOrcidRepositoryWithQuery(q *sqlc.Queries, tokenCipher *security.TokenCipher) repositories.EmployeeOrcidRepository {
+	return &pgEmployeeOrcidRepository{
+		queries:     q,
+		tokenCipher: tokenCipher,
+	}
+}
+
+func (r *pgEmployeeOrcidRepository) CreateOAuthState(ctx context.Context, state string, employeeID int64) error {
+	err := r.queries.CreateOrcidOAuthState(ctx, sqlc.CreateOrcidOAuthStateParams{
+		State:      state,
+		EmployeeID: employeeID,
+	})
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to create orcid oauth state for EmployeeID(%d): %w", employeeID, err))
+	}
+
+	return nil
+}
+
+func (r *pgEmployeeOrcidRepository) ConsumeOAuthState(ctx context.Context, state string) (int64, time.Time, error) {
+	stateResult, err := r.queries.ConsumeOrcidOAuthState(ctx, state)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return 0, time.Time{}, custom_errors.BadRequest(fmt.Errorf("unknown or already used orcid oauth state"))
+		}
+
+		return 0, time.Time{}, custom_errors.InternalServerError(fmt.Errorf("failed to consume orcid oauth state: %w", err))
+	}
+
+	return stateResult.EmployeeID, stateResult.CreatedAt.Time, nil
+}
+
+func (r *pgEmployeeOrcidRepository) DeleteExpiredOAuthStates(ctx context.Context, createdBefore time.Time) error {
+	err := r.queries.DeleteExpiredOrcidOAuthStates(ctx, pgtype.Timestamptz{
+		Time:  createdBefore,
+		Valid: true,
+	})
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to delete expired orcid oauth states: %w", err))
+	}
+
+	return nil
+}
+
+func (r *pgEmployeeOrcidRepository) UpsertAccount(ctx context.Context, account *domain.EmployeeOrcidAccount) (*domain.EmployeeOrcidAccount, error) {
+	accessToken, err := r.tokenCipher.Encrypt(account.AccessToken)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to encrypt orcid access token of EmployeeID(%d): %w", account.EmployeeID, err))
+	}
+
+	refreshToken, err := r.tokenCipher.Encrypt(account.RefreshToken)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to encrypt orcid refresh token of EmployeeID(%d): %w", account.EmployeeID, err))
+	}
+
+	accountResult, err := r.queries.UpsertEmployeeOrcidAccount(ctx, sqlc.UpsertEmployeeOrcidAccountParams{
+		EmployeeID:   account.EmployeeID,
+		Orcid:        account.ORCID,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		Scope:        account.Scope,
+		ExpiresAt: pgtype.Timestamptz{
+			Time:  account.ExpiresAt,
+			Valid: !account.ExpiresAt.IsZero(),
+		},
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to save orcid account of EmployeeID(%d): %w", account.EmployeeID, err))
+	}
+
+	account.ID = accountResult.ID
+	account.CreatedAt = accountResult.CreatedAt.Time
+	account.UpdatedAt = accountResult.UpdatedAt.Time
+
+	return account, nil
+}
+
+func (r *pgEmployeeOrcidRepository) GetAccountByEmployeeID(ctx context.Context, employeeID int64) (*domain.EmployeeOrcidAccount, error) {
+	accountResult, err := r.queries.GetEmployeeOrcidAccountByEmployeeID(ctx, employeeID)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive orcid account of EmployeeID(%d): %w", employeeID, err))
+	}
+
+	return mapEmployeeOrcidAccountRow(accountResult), nil
+}
+
+func (r *pgEmployeeOrcidRepository) GetAccessToken(ctx context.Context, employeeID int64) (string, error) {
+	accessTokenResult, err := r.queries.GetEmployeeOrcidAccountAccessTokenByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return "", custom_errors.InternalServerError(fmt.Errorf("failed to retrive orcid access token of EmployeeID(%d): %w", employeeID, err))
+	}
+
+	accessToken, err := r.tokenCipher.Decrypt(accessTokenResult)
+	if err != nil {
+		return "", custom_errors.InternalServerError(fmt.Errorf("failed to decrypt orcid access token of EmployeeID(%d): %w", employeeID, err))
+	}
+
+	return accessToken, nil
+}
+
+func (r *pgEmployeeOrcidRepository) DeleteAccountByEmployeeID(ctx context.Context, employeeID int64) error {
+	if err := r.queries.DeleteEmployeeOrcidAccountByEmployeeID(ctx, employeeID); err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to delete orcid account of EmployeeID(%d): %w", employeeID, err))
+	}
+
+	return nil
+}
+
+func (r *pgEmployeeOrcidRepository) ListAccountsDueForSync(ctx context.Context, syncedBefore time.Time, limit int32) ([]*domain.EmployeeOrcidAccount, error) {
+	accountsResult, err := r.queries.ListEmployeeOrcidAccountsDueForSync(ctx, sqlc.ListEmployeeOrcidAccountsDueForSyncParams{
+		SyncedBefore: pgtype.Timestamptz{
+			Time:  syncedBefore,
+			Valid: true,
+		},
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive orcid accounts due for sync: %w", err))
+	}
+
+	accounts := make([]*domain.EmployeeOrcidAccount, len(accountsResult))
+	for index, account := range accountsResult {
+		accounts[index] = mapEmployeeOrcidAccountRow(account)
+	}
+
+	return accounts, nil
+}
+
+func (r *pgEmployeeOrcidRepository) MarkAccountSynced(ctx context.Context, employeeID int64) error {
+	if err := r.queries.UpdateEmployeeOrcidAccountLastSyncedAt(ctx, employeeID); err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to update last sync time of orcid account of EmployeeID(%d): %w", employeeID, err))
+	}
+
+	return nil
+}
+
+// mapEmployeeOrcidAccountRow leaves the tokens out, the access token is read through GetAccessToken
+func mapEmployeeOrcidAccountRow(account sqlc.EmployeeOrcidAccount) *domain.EmployeeOrcidAccount {
+	return &domain.EmployeeOrcidAccount{
+		ID:           account.ID,
+		EmployeeID:   account.EmployeeID,
+		ORCID:        account.Orcid,
+		Scope:        account.Scope,
+		ExpiresAt:    account.ExpiresAt.Time,
+		LastSyncedAt: account.LastSyncedAt.Time,
+		CreatedAt:    account.CreatedAt.Time,
+		UpdatedAt:    account.UpdatedAt.Time,
+	}
+}
@@ -30,6 +30,11 @@ func NewPgEmployeeParticipationInEventRepositoryWithQuery(q *sqlc.Queries) repos
 }
 
 func (r *pgEmployeeParticipationInEventRepository) Create(ctx context.Context, employeePIE *domain.EmployeeParticipationInEvent) (*domain.EmployeeParticipationInEvent, error) {
+	translationGroupID, err := translationGroupParam(employeePIE.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	employeePIEResult, err := r.queries.CreateEmployeeParticipationInEvent(ctx, sqlc.CreateEmployeeParticipationInEventParams{
 		EmployeeID:   employeePIE.EmployeeID,
 		LanguageCode: employeePIE.LanguageCode,
@@ -38,12 +43,14 @@ func (r *pgEmployeeParticipationInEventRepository) Create(ctx context.Context, e
 			Time:  employeePIE.EventDate,
 			Valid: !employeePIE.EventDate.IsZero(),
 		},
+		TranslationGroupID: translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create employee participation in event: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create employee participation in event: %w", err))
 	}
 
 	employeePIE.ID = employeePIEResult.ID
+	employeePIE.TranslationGroupID = translationGroupID.String()
 	employeePIE.CreatedAt = employeePIEResult.CreatedAt.Time
 	employeePIE.UpdatedAt = employeePIEResult.UpdatedAt.Time
 
@@ -66,6 +73,10 @@ func (r *pgEmployeeParticipationInEventRepository) Update(ctx context.Context, e
 	employeePIE.CreatedAt = updateEmployeeParticipationInEventResult.CreatedAt.Time
 	employeePIE.UpdatedAt = updateEmployeeParticipationInEventResult.UpdatedAt.Time
 
+	if err := r.queries.SyncEmployeeParticipationInEventTranslations(ctx, employeePIE.ID); err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to sync translations of employee participation in event: %w", err))
+	}
+
 	return employeePIE, nil
 }
 
@@ -81,38 +92,44 @@ func (r *pgEmployeeParticipationInEventRepository) GetByID(ctx context.Context,
 	employeePIE, err := r.queries.GetEmployeeParticipationInEventByID(ctx, id)
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee participation in event with give ID(%d): %w", id, err))
-	} 
+	}
 
 	return &domain.EmployeeParticipationInEvent{
-		ID:                             employeePIE.ID,
-		EmployeeID:                     employeePIE.EmployeeID,
-		LanguageCode:                   employeePIE.LanguageCode,
-		EventTitle:                     employeePIE.EventTitle,
-		EventDate:                      employeePIE.EventDate.Time,
-		CreatedAt:                      employeePIE.CreatedAt.Time,
-		UpdatedAt:                      employeePIE.UpdatedAt.Time,
+		ID:                 employeePIE.ID,
+		EmployeeID:         employeePIE.EmployeeID,
+		LanguageCode:       employeePIE.LanguageCode,
+		TranslationGroupID: employeePIE.TranslationGroupID.String(),
+		EventTitle:         employeePIE.EventTitle,
+		EventDate:          employeePIE.EventDate.Time,
+		CreatedAt:          employeePIE.CreatedAt.Time,
+		UpdatedAt:          employeePIE.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgEmployeeParticipationInEventRepository) GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeParticipationInEvent, error) {
-	employeePIEsResult, err := r.queries.GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCode(ctx, sqlc.GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodeParams{
-		EmployeeID:   employeeID,
-		LanguageCode: langCode,
+	return r.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, []string{langCode})
+}
+
+func (r *pgEmployeeParticipationInEventRepository) GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeParticipationInEvent, error) {
+	employeePIEsResult, err := r.queries.GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodes(ctx, sqlc.GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodesParams{
+		EmployeeID:    employeeID,
+		LanguageCodes: langCodes,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee participation in events with given EmployeeID(%d) and language_code(%s): %w", employeeID, langCode, err))
-	} 
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee participation in events with given EmployeeID(%d) and language_codes(%v): %w", employeeID, langCodes, err))
+	}
 
 	employeePIEs := make([]*domain.EmployeeParticipationInEvent, len(employeePIEsResult))
 	for index, employeePIE := range employeePIEsResult {
 		employeePIEs[index] = &domain.EmployeeParticipationInEvent{
-			ID:                             employeePIE.ID,
-			EmployeeID:                     employeePIE.EmployeeID,
-			LanguageCode:                   employeePIE.LanguageCode,
-			EventTitle:                     employeePIE.EventTitle,
-			EventDate:                      employeePIE.EventDate.Time,
-			CreatedAt:                      employeePIE.CreatedAt.Time,
-			UpdatedAt:                      employeePIE.UpdatedAt.Time,
+			ID:                 employeePIE.ID,
+			EmployeeID:         employeePIE.EmployeeID,
+			LanguageCode:       employeePIE.LanguageCode,
+			TranslationGroupID: employeePIE.TranslationGroupID.String(),
+			EventTitle:         employeePIE.EventTitle,
+			EventDate:          employeePIE.EventDate.Time,
+			CreatedAt:          employeePIE.CreatedAt.Time,
+			UpdatedAt:          employeePIE.UpdatedAt.Time,
 		}
 	}
 
@@ -28,17 +28,24 @@ func NewPgEmployeeParticipationInProfessionalCommunityRepositoryWithQuery(q *sql
 }
 
 func (r *pgEmployeeParticipationInProfessionalCommunityRepository) Create(ctx context.Context, employeePIPC *domain.EmployeeParticipationInProfessionalCommunity) (*domain.EmployeeParticipationInProfessionalCommunity, error) {
+	translationGroupID, err := translationGroupParam(employeePIPC.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	participationInProfessionalCommunity, err := r.queries.CreateEmployeeParticipationInProfessionalCommunity(ctx, sqlc.CreateEmployeeParticipationInProfessionalCommunityParams{
 		EmployeeID:                  employeePIPC.EmployeeID,
 		LanguageCode:                employeePIPC.LanguageCode,
 		ProfessionalCommunityTitle:  employeePIPC.ProfessionalCommunityTitle,
 		RoleInProfessionalCommunity: employeePIPC.RoleInProfessionalCommunity,
+		TranslationGroupID:          translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create employee participation in professional community: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create employee participation in professional community: %w", err))
 	}
 
 	employeePIPC.ID = participationInProfessionalCommunity.ID
+	employeePIPC.TranslationGroupID = translationGroupID.String()
 	employeePIPC.CreatedAt = participationInProfessionalCommunity.CreatedAt.Time
 	employeePIPC.UpdatedAt = participationInProfessionalCommunity.UpdatedAt.Time
 
@@ -73,12 +80,13 @@ func (r *pgEmployeeParticipationInProfessionalCommunityRepository) GetByID(ctx c
 	employeePIPC, err := r.queries.GetEmployeeParticipationInProfessionalCommunityByID(ctx, id)
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee participation in professional community with give ID(%d): %w", id, err))
-	} 
+	}
 
 	return &domain.EmployeeParticipationInProfessionalCommunity{
 		ID:                          employeePIPC.ID,
 		EmployeeID:                  employeePIPC.EmployeeID,
 		LanguageCode:                employeePIPC.LanguageCode,
+		TranslationGroupID:          employeePIPC.TranslationGroupID.String(),
 		ProfessionalCommunityTitle:  employeePIPC.ProfessionalCommunityTitle,
 		RoleInProfessionalCommunity: employeePIPC.RoleInProfessionalCommunity,
 		CreatedAt:                   employeePIPC.CreatedAt.Time,
@@ -87,12 +95,16 @@ func (r *pgEmployeeParticipationInProfessionalCommunityRepository) GetByID(ctx c
 }
 
 func (r *pgEmployeeParticipationInProfessionalCommunityRepository) GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeParticipationInProfessionalCommunity, error) {
-	employeePIPCsResult, err := r.queries.GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCode(ctx, sqlc.GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodeParams{
-		EmployeeID:   employeeID,
-		LanguageCode: langCode,
+	return r.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, []string{langCode})
+}
+
+func (r *pgEmployeeParticipationInProfessionalCommunityRepository) GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeParticipationInProfessionalCommunity, error) {
+	employeePIPCsResult, err := r.queries.GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodes(ctx, sqlc.GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodesParams{
+		EmployeeID:    employeeID,
+		LanguageCodes: langCodes,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee participation in professional communitys with given EmployeeID(%d) and language_code(%s): %w", employeeID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee participation in professional communitys with given EmployeeID(%d) and language_codes(%v): %w", employeeID, langCodes, err))
 	}
 
 	employeePIPCs := make([]*domain.EmployeeParticipationInProfessionalCommunity, len(employeePIPCsResult))
@@ -101,6 +113,7 @@ func (r *pgEmployeeParticipationInProfessionalCommunityRepository) GetByEmployee
 			ID:                          participationInProfessionalCommunity.ID,
 			EmployeeID:                  participationInProfessionalCommunity.EmployeeID,
 			LanguageCode:                participationInProfessionalCommunity.LanguageCode,
+			TranslationGroupID:          participationInProfessionalCommunity.TranslationGroupID.String(),
 			ProfessionalCommunityTitle:  participationInProfessionalCommunity.ProfessionalCommunityTitle,
 			RoleInProfessionalCommunity: participationInProfessionalCommunity.RoleInProfessionalCommunity,
 			CreatedAt:                   participationInProfessionalCommunity.CreatedAt.Time,
@@ -28,17 +28,24 @@ func NewPgEmployeePatentRepositoryWithQuery(q *sqlc.Queries) repositories.Employ
 }
 
 func (r *pgEmployeePatentRepository) Create(ctx context.Context, employeePatent *domain.EmployeePatent) (*domain.EmployeePatent, error) {
+	translationGroupID, err := translationGroupParam(employeePatent.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	employeePatentResult, err := r.queries.CreateEmployeePatent(ctx, sqlc.CreateEmployeePatentParams{
-		EmployeeID:       employeePatent.EmployeeID,
-		LanguageCode:     employeePatent.LanguageCode,
-		PatentTitle:      employeePatent.PatentTitle,
-		Description:      employeePatent.Description,
+		EmployeeID:         employeePatent.EmployeeID,
+		LanguageCode:       employeePatent.LanguageCode,
+		PatentTitle:        employeePatent.PatentTitle,
+		Description:        employeePatent.Description,
+		TranslationGroupID: translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create employee patent: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create employee patent: %w", err))
 	}
 
 	employeePatent.ID = employeePatentResult.ID
+	employeePatent.TranslationGroupID = translationGroupID.String()
 	employeePatent.CreatedAt = employeePatentResult.CreatedAt.Time
 	employeePatent.UpdatedAt = employeePatentResult.UpdatedAt.Time
 
@@ -77,35 +84,41 @@ func (r *pgEmployeePatentRepository) GetByID(ctx context.Context, id int64) (*do
 	}
 
 	return &domain.EmployeePatent{
-		ID:               employeePatentResult.ID,
-		EmployeeID:       employeePatentResult.EmployeeID,
-		LanguageCode:     employeePatentResult.LanguageCode,
-		PatentTitle:      employeePatentResult.PatentTitle,
-		Description:      employeePatentResult.Description,
-		CreatedAt:        employeePatentResult.CreatedAt.Time,
-		UpdatedAt:        employeePatentResult.UpdatedAt.Time,
+		ID:                 employeePatentResult.ID,
+		EmployeeID:         employeePatentResult.EmployeeID,
+		LanguageCode:       employeePatentResult.LanguageCode,
+		TranslationGroupID: employeePatentResult.TranslationGroupID.String(),
+		PatentTitle:        employeePatentResult.PatentTitle,
+		Description:        employeePatentResult.Description,
+		CreatedAt:          employeePatentResult.CreatedAt.Time,
+		UpdatedAt:          employeePatentResult.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgEmployeePatentRepository) GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeePatent, error) {
-	employeePatentsResult, err := r.queries.GetEmployeePatentsByEmployeeIDAndLanguageCode(ctx, sqlc.GetEmployeePatentsByEmployeeIDAndLanguageCodeParams{
-		EmployeeID:   employeeID,
-		LanguageCode: langCode,
+	return r.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, []string{langCode})
+}
+
+func (r *pgEmployeePatentRepository) GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeePatent, error) {
+	employeePatentsResult, err := r.queries.GetEmployeePatentsByEmployeeIDAndLanguageCodes(ctx, sqlc.GetEmployeePatentsByEmployeeIDAndLanguageCodesParams{
+		EmployeeID:    employeeID,
+		LanguageCodes: langCodes,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee patents with given EmployeeID(%d) and language_code(%s): %w", employeeID, langCode, err))
-	} 
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee patents with given EmployeeID(%d) and language_codes(%v): %w", employeeID, langCodes, err))
+	}
 
 	employeePatents := make([]*domain.EmployeePatent, len(employeePatentsResult))
 	for index, employeePatentResult := range employeePatentsResult {
 		employeePatents[index] = &domain.EmployeePatent{
-			ID:               employeePatentResult.ID,
-			EmployeeID:       employeePatentResult.EmployeeID,
-			LanguageCode:     employeePatentResult.LanguageCode,
-			PatentTitle:      employeePatentResult.PatentTitle,
-			Description:      employeePatentResult.Description,
-			CreatedAt:        employeePatentResult.CreatedAt.Time,
-			UpdatedAt:        employeePatentResult.UpdatedAt.Time,
+			ID:                 employeePatentResult.ID,
+			EmployeeID:         employeePatentResult.EmployeeID,
+			LanguageCode:       employeePatentResult.LanguageCode,
+			TranslationGroupID: employeePatentResult.TranslationGroupID.String(),
+			PatentTitle:        employeePatentResult.PatentTitle,
+			Description:        employeePatentResult.Description,
+			CreatedAt:          employeePatentResult.CreatedAt.Time,
+			UpdatedAt:          employeePatentResult.UpdatedAt.Time,
 		}
 	}
 
@@ -7,6 +7,8 @@ import (
 	"backend/internal/shared/custom_errors"
 	"context"
 	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type pgEmployeePublicationRepository struct {
@@ -28,17 +30,34 @@ func NewPgEmployeePublicationRepositoryWithQuery(q *sqlc.Queries) repositories.E
 }
 
 func (r *pgEmployeePublicationRepository) Create(ctx context.Context, employeePublication *domain.EmployeePublication) (*domain.EmployeePublication, error) {
+	authors := employeePublication.Authors
+	if authors == nil {
+		authors = []string{}
+	}
+
+	translationGroupID, err := translationGroupParam(employeePublication.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	employeePublicationResult, err := r.queries.CreateEmployeePublication(ctx, sqlc.CreateEmployeePublicationParams{
-		EmployeeID:        employeePublication.EmployeeID,
-		LanguageCode:      employeePublication.LanguageCode,
-		PublicationTitle:  employeePublication.PublicationTitle,
-		LinkToPublication: employeePublication.LinkToPublication,
+		EmployeeID:         employeePublication.EmployeeID,
+		LanguageCode:       employeePublication.LanguageCode,
+		PublicationTitle:   employeePublication.PublicationTitle,
+		LinkToPublication:  employeePublication.LinkToPublication,
+		Authors:            authors,
+		PublicationYear:    pgtype.Int4{Int32: employeePublication.PublicationYear, Valid: employeePublication.PublicationYear != 0},
+		Venue:              pgtype.Text{String: employeePublication.Venue, Valid: employeePublication.Venue != ""},
+		Doi:                pgtype.Text{String: employeePublication.DOI, Valid: employeePublication.DOI != ""},
+		PublicationType:    pgtype.Text{String: employeePublication.PublicationType, Valid: employeePublication.PublicationType != ""},
+		TranslationGroupID: translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create employee publicaiton: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create employee publicaiton: %w", err))
 	}
 
 	employeePublication.ID = employeePublicationResult.ID
+	employeePublication.TranslationGroupID = translationGroupID.String()
 	employeePublication.CreatedAt = employeePublicationResult.CreatedAt.Time
 	employeePublication.UpdatedAt = employeePublicationResult.UpdatedAt.Time
 
@@ -50,6 +69,11 @@ func (r *pgEmployeePublicationRepository) Update(ctx context.Context, employeePu
 		ID:                employeePublication.ID,
 		PublicationTitle:  employeePublication.PublicationTitle,
 		LinkToPublication: employeePublication.LinkToPublication,
+		Authors:           employeePublication.Authors,
+		PublicationYear:   pgtype.Int4{Int32: employeePublication.PublicationYear, Valid: employeePublication.PublicationYear != 0},
+		Venue:             pgtype.Text{String: employeePublication.Venue, Valid: employeePublication.Venue != ""},
+		Doi:               pgtype.Text{String: employeePublication.DOI, Valid: employeePublication.DOI != ""},
+		PublicationType:   pgtype.Text{String: employeePublication.PublicationType, Valid: employeePublication.PublicationType != ""},
 	})
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to update employee publicaiton: %w", err))
@@ -58,54 +82,186 @@ func (r *pgEmployeePublicationRepository) Update(ctx context.Context, employeePu
 	employeePublication.CreatedAt = updateEmployeePublicationResult.CreatedAt.Time
 	employeePublication.UpdatedAt = updateEmployeePublicationResult.UpdatedAt.Time
 
+	if err := r.queries.SyncEmployeePublicationTranslations(ctx, employeePublication.ID); err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to sync translations of employee publication: %w", err))
+	}
+
 	return employeePublication, nil
 }
 
-func (r *pgEmployeePublicationRepository) Delete(ctx context.Context, id int64) error {
-	if err := r.queries.DeleteEmployeePublication(ctx, id); err != nil {
-		return custom_errors.InternalServerError(fmt.Errorf("failed to delete employee publicaiton: %w", err))
+func (r *pgEmployeePublicationRepository) Delete(ctx context.Context, id int64) ([]int64, error) {
+	publicationIDsResult, err := r.queries.DeleteEmployeePublication(ctx, id)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to delete employee publicaiton: %w", err))
 	}
 
-	return nil
+	publicationIDs := []int64{}
+	for _, publicationID := range publicationIDsResult {
+		if publicationID.Valid {
+			publicationIDs = append(publicationIDs, publicationID.Int64)
+		}
+	}
+
+	return publicationIDs, nil
 }
 
 func (r *pgEmployeePublicationRepository) GetByID(ctx context.Context, id int64) (*domain.EmployeePublication, error) {
 	employeePublicationResult, err := r.queries.GetEmployeePublicationByID(ctx, id)
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee publicaiton with give ID(%d): %w", id, err))
-	} 
+	}
 
-	return &domain.EmployeePublication{
-		ID:               employeePublicationResult.ID,
-		EmployeeID:       employeePublicationResult.EmployeeID,
-		LanguageCode:     employeePublicationResult.LanguageCode,
-		PublicationTitle: employeePublicationResult.PublicationTitle,
-		CreatedAt:        employeePublicationResult.CreatedAt.Time,
-		UpdatedAt:        employeePublicationResult.UpdatedAt.Time,
-	}, nil
+	return mapEmployeePublicationRow(employeePublicationResult), nil
 }
 
 func (r *pgEmployeePublicationRepository) GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeePublication, error) {
-	employeePublicationsResult, err := r.queries.GetEmployeePublicationsByEmployeeIDAndLanguageCode(ctx, sqlc.GetEmployeePublicationsByEmployeeIDAndLanguageCodeParams{
-		EmployeeID:   employeeID,
-		LanguageCode: langCode,
+	return r.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, []string{langCode})
+}
+
+func (r *pgEmployeePublicationRepository) GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeePublication, error) {
+	employeePublicationsResult, err := r.queries.GetEmployeePublicationsByEmployeeIDAndLanguageCodes(ctx, sqlc.GetEmployeePublicationsByEmployeeIDAndLanguageCodesParams{
+		EmployeeID:    employeeID,
+		LanguageCodes: langCodes,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee publicaitons with given EmployeeID(%d) and language_code(%s): %w", employeeID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee publicaitons with given EmployeeID(%d) and language_codes(%v): %w", employeeID, langCodes, err))
+	}
+
+	citationsResult, err := r.queries.GetPublicationCitationsByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive publication citations of employee(%d): %w", employeeID, err))
+	}
+
+	citations := make(map[int64]*domain.PublicationCitation, len(citationsResult))
+	for _, citationResult := range citationsResult {
+		citations[citationResult.PublicationID] = mapPublicationCitationRow(citationResult)
 	}
 
 	employeePublications := make([]*domain.EmployeePublication, len(employeePublicationsResult))
 	for index, publication := range employeePublicationsResult {
-		employeePublications[index] = &domain.EmployeePublication{
-			ID:                publication.ID,
-			EmployeeID:        publication.EmployeeID,
-			LanguageCode:      publication.LanguageCode,
-			PublicationTitle:  publication.PublicationTitle,
-			LinkToPublication: publication.LinkToPublication,
-			CreatedAt:         publication.CreatedAt.Time,
-			UpdatedAt:         publication.UpdatedAt.Time,
-		}
+		employeePublications[index] = mapEmployeePublicationRow(publication)
+		employeePublications[index].Citation = citations[employeePublications[index].PublicationID]
 	}
 
 	return employeePublications, nil
 }
+
+func (r *pgEmployeePublicationRepository) UpsertImported(ctx context.Context, employeePublication *domain.EmployeePublication) (*domain.EmployeePublication, error) {
+	authors := employeePublication.Authors
+	if authors == nil {
+		authors = []string{}
+	}
+
+	translationGroupID, err := translationGroupParam(employeePublication.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	employeePublicationResult, err := r.queries.UpsertImportedEmployeePublication(ctx, sqlc.UpsertImportedEmployeePublicationParams{
+		EmployeeID:         employeePublication.EmployeeID,
+		LanguageCode:       employeePublication.LanguageCode,
+		PublicationTitle:   employeePublication.PublicationTitle,
+		LinkToPublication:  employeePublication.LinkToPublication,
+		Authors:            authors,
+		PublicationYear:    pgtype.Int4{Int32: employeePublication.PublicationYear, Valid: employeePublication.PublicationYear != 0},
+		Venue:              pgtype.Text{String: employeePublication.Venue, Valid: employeePublication.Venue != ""},
+		Doi:                pgtype.Text{String: employeePublication.DOI, Valid: employeePublication.DOI != ""},
+		PublicationType:    pgtype.Text{String: employeePublication.PublicationType, Valid: employeePublication.PublicationType != ""},
+		Source:             employeePublication.Source,
+		ExternalID:         pgtype.Text{String: employeePublication.ExternalID, Valid: employeePublication.ExternalID != ""},
+		TranslationGroupID: translationGroupID,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to upsert imported employee publication(source - %s, external_id - %s): %w", employeePublication.Source, employeePublication.ExternalID, err))
+	}
+
+	employeePublication.ID = employeePublicationResult.ID
+	employeePublication.TranslationGroupID = employeePublicationResult.TranslationGroupID.String()
+	employeePublication.PublicationID = employeePublicationResult.PublicationID.Int64
+	employeePublication.CreatedAt = employeePublicationResult.CreatedAt.Time
+	employeePublication.UpdatedAt = employeePublicationResult.UpdatedAt.Time
+
+	return employeePublication, nil
+}
+
+func (r *pgEmployeePublicationRepository) DeleteStaleImported(ctx context.Context, employeeID int64, langCode string, source string, keepExternalIDs []string) ([]int64, error) {
+	if keepExternalIDs == nil {
+		keepExternalIDs = []string{}
+	}
+
+	publicationIDsResult, err := r.queries.DeleteStaleImportedEmployeePublications(ctx, sqlc.DeleteStaleImportedEmployeePublicationsParams{
+		EmployeeID:   employeeID,
+		LanguageCode: langCode,
+		Source:       source,
+		ExternalIds:  keepExternalIDs,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to delete stale imported employee publications of EmployeeID(%d) from source(%s): %w", employeeID, source, err))
+	}
+
+	publicationIDs := []int64{}
+	for _, publicationID := range publicationIDsResult {
+		if publicationID.Valid {
+			publicationIDs = append(publicationIDs, publicationID.Int64)
+		}
+	}
+
+	return publicationIDs, nil
+}
+
+func mapEmployeePublicationRow(publication sqlc.EmployeePublication) *domain.EmployeePublication {
+	return &domain.EmployeePublication{
+		ID:                 publication.ID,
+		EmployeeID:         publication.EmployeeID,
+		LanguageCode:       publication.LanguageCode,
+		TranslationGroupID: publication.TranslationGroupID.String(),
+		PublicationTitle:   publication.PublicationTitle,
+		LinkToPublication:  publication.LinkToPublication,
+		Authors:            publication.Authors,
+		PublicationYear:    publication.PublicationYear.Int32,
+		Venue:              publication.Venue.String,
+		DOI:                publication.Doi.String,
+		PublicationType:    publication.PublicationType.String,
+		Source:             publication.Source,
+		ExternalID:         publication.ExternalID.String,
+		PublicationID:      publication.PublicationID.Int64,
+		CreatedAt:          publication.CreatedAt.Time,
+		UpdatedAt:          publication.UpdatedAt.Time,
+	}
+}
+
+func (r *pgEmployeePublicationRepository) SetPublicationID(ctx context.Context, id int64, publicationID int64) error {
+	err := r.queries.SetEmployeePublicationPublicationID(ctx, sqlc.SetEmployeePublicationPublicationIDParams{
+		ID:            id,
+		PublicationID: pgtype.Int8{Int64: publicationID, Valid: publicationID != 0},
+	})
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to link employee publication(%d) to publication(%d): %w", id, publicationID, err))
+	}
+
+	return nil
+}
+
+func (r *pgEmployeePublicationRepository) CountByPublicationID(ctx context.Context, employeeID int64, publicationID int64) (int64, error) {
+	count, err := r.queries.CountEmployeePublicationsByPublicationID(ctx, sqlc.CountEmployeePublicationsByPublicationIDParams{
+		EmployeeID:    employeeID,
+		PublicationID: pgtype.Int8{Int64: publicationID, Valid: true},
+	})
+	if err != nil {
+		return 0, custom_errors.InternalServerError(fmt.Errorf("failed to count employee publications of EmployeeID(%d) linked to publication(%d): %w", employeeID, publicationID, err))
+	}
+
+	return count, nil
+}
+
+func (r *pgEmployeePublicationRepository) DeleteByPublicationID(ctx context.Context, employeeID int64, publicationID int64) error {
+	err := r.queries.DeleteEmployeePublicationsByPublicationID(ctx, sqlc.DeleteEmployeePublicationsByPublicationIDParams{
+		EmployeeID:    employeeID,
+		PublicationID: pgtype.Int8{Int64: publicationID, Valid: true},
+	})
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to delete employee publications of EmployeeID(%d) linked to publication(%d): %w", employeeID, publicationID, err))
+	}
+
+	return nil
+}
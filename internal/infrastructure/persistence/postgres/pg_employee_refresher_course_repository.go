@@ -30,6 +30,11 @@ func NewPgEmployeeRefresherCourseRepositoryWithQuery(q *sqlc.Queries) repositori
 }
 
 func (r *pgEmployeeRefresherCourseRepository) Create(ctx context.Context, employeeRefresherCourse *domain.EmployeeRefresherCourse) (*domain.EmployeeRefresherCourse, error) {
+	translationGroupID, err := translationGroupParam(employeeRefresherCourse.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	employeeRefresherCourseResult, err := r.queries.CreateEmployeeRefresherCourse(ctx, sqlc.CreateEmployeeRefresherCourseParams{
 		EmployeeID:   employeeRefresherCourse.EmployeeID,
 		LanguageCode: employeeRefresherCourse.LanguageCode,
@@ -42,12 +47,14 @@ func (r *pgEmployeeRefresherCourseRepository) Create(ctx context.Context, employ
 			Time:  employeeRefresherCourse.DateEnd,
 			Valid: !employeeRefresherCourse.DateEnd.IsZero(),
 		},
+		TranslationGroupID: translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create employee refresher course: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create employee refresher course: %w", err))
 	}
 
 	employeeRefresherCourse.ID = employeeRefresherCourseResult.ID
+	employeeRefresherCourse.TranslationGroupID = translationGroupID.String()
 	employeeRefresherCourse.CreatedAt = employeeRefresherCourseResult.CreatedAt.Time
 	employeeRefresherCourse.UpdatedAt = employeeRefresherCourseResult.UpdatedAt.Time
 
@@ -74,6 +81,10 @@ func (r *pgEmployeeRefresherCourseRepository) Update(ctx context.Context, employ
 	employeeRefresherCourse.CreatedAt = updateEmployeeRefresherCourseResult.CreatedAt.Time
 	employeeRefresherCourse.UpdatedAt = updateEmployeeRefresherCourseResult.UpdatedAt.Time
 
+	if err := r.queries.SyncEmployeeRefresherCourseTranslations(ctx, employeeRefresherCourse.ID); err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to sync translations of employee refresher course: %w", err))
+	}
+
 	return employeeRefresherCourse, nil
 }
 
@@ -89,40 +100,46 @@ func (r *pgEmployeeRefresherCourseRepository) GetByID(ctx context.Context, id in
 	employeeRefresherCourseResult, err := r.queries.GetEmployeeRefresherCourseByID(ctx, id)
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee refresher course with give ID(%d): %w", id, err))
-	} 
+	}
 
 	return &domain.EmployeeRefresherCourse{
-		ID:           employeeRefresherCourseResult.ID,
-		EmployeeID:   employeeRefresherCourseResult.EmployeeID,
-		LanguageCode: employeeRefresherCourseResult.LanguageCode,
-		CourseTitle:  employeeRefresherCourseResult.CourseTitle,
-		DateStart:    employeeRefresherCourseResult.DateStart.Time,
-		DateEnd:      employeeRefresherCourseResult.DateEnd.Time,
-		CreatedAt:    employeeRefresherCourseResult.CreatedAt.Time,
-		UpdatedAt:    employeeRefresherCourseResult.UpdatedAt.Time,
+		ID:                 employeeRefresherCourseResult.ID,
+		EmployeeID:         employeeRefresherCourseResult.EmployeeID,
+		LanguageCode:       employeeRefresherCourseResult.LanguageCode,
+		TranslationGroupID: employeeRefresherCourseResult.TranslationGroupID.String(),
+		CourseTitle:        employeeRefresherCourseResult.CourseTitle,
+		DateStart:          employeeRefresherCourseResult.DateStart.Time,
+		DateEnd:            employeeRefresherCourseResult.DateEnd.Time,
+		CreatedAt:          employeeRefresherCourseResult.CreatedAt.Time,
+		UpdatedAt:          employeeRefresherCourseResult.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgEmployeeRefresherCourseRepository) GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeRefresherCourse, error) {
-	employeeRefresherCoursesResult, err := r.queries.GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCode(ctx, sqlc.GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodeParams{
-		EmployeeID:   employeeID,
-		LanguageCode: langCode,
+	return r.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, []string{langCode})
+}
+
+func (r *pgEmployeeRefresherCourseRepository) GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeRefresherCourse, error) {
+	employeeRefresherCoursesResult, err := r.queries.GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodes(ctx, sqlc.GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodesParams{
+		EmployeeID:    employeeID,
+		LanguageCodes: langCodes,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee refresher courses with given EmployeeID(%d) and language_code(%s): %w", employeeID, langCode, err))
-  }
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee refresher courses with given EmployeeID(%d) and language_codes(%v): %w", employeeID, langCodes, err))
+	}
 
 	employeeRefresherCourses := make([]*domain.EmployeeRefresherCourse, len(employeeRefresherCoursesResult))
 	for index, degree := range employeeRefresherCoursesResult {
 		employeeRefresherCourses[index] = &domain.EmployeeRefresherCourse{
-			ID:           degree.ID,
-			EmployeeID:   degree.EmployeeID,
-			LanguageCode: degree.LanguageCode,
-			CourseTitle:  degree.CourseTitle,
-			DateStart:    degree.DateStart.Time,
-			DateEnd:      degree.DateEnd.Time,
-			CreatedAt:    degree.CreatedAt.Time,
-			UpdatedAt:    degree.UpdatedAt.Time,
+			ID:                 degree.ID,
+			EmployeeID:         degree.EmployeeID,
+			LanguageCode:       degree.LanguageCode,
+			TranslationGroupID: degree.TranslationGroupID.String(),
+			CourseTitle:        degree.CourseTitle,
+			DateStart:          degree.DateStart.Time,
+			DateEnd:            degree.DateEnd.Time,
+			CreatedAt:          degree.CreatedAt.Time,
+			UpdatedAt:          degree.UpdatedAt.Time,
 		}
 	}
 
@@ -73,12 +73,16 @@ func (r *pgEmployeeRepository) GetByID(ctx context.Context, id int64) (*domain.E
 	}
 
 	return &domain.Employee{
-		ID:        employeeResult.ID,
-		UniqueID:  employeeResult.UniqueID,
-		Gender:    employeeResult.Gender.String,
-		Tin:       employeeResult.Tin.String,
-		CreatedAt: employeeResult.CreatedAt.Time,
-		UpdatedAt: employeeResult.UpdatedAt.Time,
+		ID:                   employeeResult.ID,
+		UserID:               employeeResult.UserID.Int64,
+		UniqueID:             employeeResult.UniqueID,
+		Gender:               employeeResult.Gender.String,
+		Tin:                  employeeResult.Tin.String,
+		ORCID:                employeeResult.Orcid.String,
+		CurrentInstitutionID: employeeResult.CurrentInstitutionID.Int64,
+		CurrentOrgUnitID:     employeeResult.CurrentOrgUnitID.Int64,
+		CreatedAt:            employeeResult.CreatedAt.Time,
+		UpdatedAt:            employeeResult.UpdatedAt.Time,
 	}, nil
 }
 
@@ -89,12 +93,16 @@ func (r *pgEmployeeRepository) GetByUniqueID(ctx context.Context, uniqueIdentife
 	}
 
 	return &domain.Employee{
-		ID:        employeeResult.ID,
-		UniqueID:  employeeResult.UniqueID,
-		Gender:    employeeResult.Gender.String,
-		Tin:       employeeResult.Tin.String,
-		CreatedAt: employeeResult.CreatedAt.Time,
-		UpdatedAt: employeeResult.UpdatedAt.Time,
+		ID:                   employeeResult.ID,
+		UserID:               employeeResult.UserID.Int64,
+		UniqueID:             employeeResult.UniqueID,
+		Gender:               employeeResult.Gender.String,
+		Tin:                  employeeResult.Tin.String,
+		ORCID:                employeeResult.Orcid.String,
+		CurrentInstitutionID: employeeResult.CurrentInstitutionID.Int64,
+		CurrentOrgUnitID:     employeeResult.CurrentOrgUnitID.Int64,
+		CreatedAt:            employeeResult.CreatedAt.Time,
+		UpdatedAt:            employeeResult.UpdatedAt.Time,
 	}, nil
 }
 
@@ -108,12 +116,16 @@ func (r *pgEmployeeRepository) GetByUserID(ctx context.Context, userID int64) (*
 	}
 
 	return &domain.Employee{
-		ID:        employeeResult.ID,
-		UniqueID:  employeeResult.UniqueID,
-		Gender:    employeeResult.Gender.String,
-		Tin:       employeeResult.Tin.String,
-		CreatedAt: employeeResult.CreatedAt.Time,
-		UpdatedAt: employeeResult.UpdatedAt.Time,
+		ID:                   employeeResult.ID,
+		UserID:               employeeResult.UserID.Int64,
+		UniqueID:             employeeResult.UniqueID,
+		Gender:               employeeResult.Gender.String,
+		Tin:                  employeeResult.Tin.String,
+		ORCID:                employeeResult.Orcid.String,
+		CurrentInstitutionID: employeeResult.CurrentInstitutionID.Int64,
+		CurrentOrgUnitID:     employeeResult.CurrentOrgUnitID.Int64,
+		CreatedAt:            employeeResult.CreatedAt.Time,
+		UpdatedAt:            employeeResult.UpdatedAt.Time,
 	}, nil
 }
 
@@ -125,8 +137,16 @@ func (r *pgEmployeeRepository) GetPersonnelIDsPaginated(ctx context.Context, fil
 		Surname:        filter.Surname,
 		Middlename:     filter.Middlename,
 		Workplace:      filter.Workplace,
+		InstitutionID:  filter.InstitutionID,
+		OrgUnitID:      filter.OrgUnitID,
 		AcademicDegree: filter.AcademicDegree,
 		Speciality:     filter.Speciality,
+		SpecialityCode: filter.SpecialityCode,
+		Keyword:        filter.Keyword,
+		MinHIndex:      filter.MinHIndex,
+		MinI10Index:    filter.MinI10Index,
+		MinCitations:   filter.MinCitations,
+		SortBy:         filter.SortBy,
 		Page:           int32((filter.Page - 1) * filter.Limit),
 		Limit:          int32(filter.Limit),
 	})
@@ -142,9 +162,15 @@ func (r *pgEmployeeRepository) GetPersonnelIDsPaginated(ctx context.Context, fil
 			Name:                  personnelResult[index].Name,
 			Middlename:            personnelResult[index].Middlename.String,
 			Currentworkplace:      personnelResult[index].CurrentWorkplace.String,
-			Highestacademicdegree: personnelResult[index].HighestAcademicDegree.String,
+			CurrentInstitutionID:  personnelResult[index].CurrentInstitutionID.Int64,
+			CurrentOrgUnitID:      personnelResult[index].CurrentOrgUnitID.Int64,
+			Highestacademicdegree: personnelResult[index].HighestAcademicDegree,
 			Speciality:            personnelResult[index].Speciality.String,
 			UniqueID:              personnelResult[index].UniqueID,
+			PublicationCount:      personnelResult[index].PublicationCount,
+			HIndex:                personnelResult[index].HIndex,
+			I10Index:              personnelResult[index].I10Index,
+			TotalCitations:        personnelResult[index].TotalCitations,
 		}
 	}
 
@@ -159,8 +185,15 @@ func (r *pgEmployeeRepository) CountPersonnel(ctx context.Context, filter *dtos.
 		Surname:        filter.Surname,
 		Middlename:     filter.Middlename,
 		Workplace:      filter.Workplace,
+		InstitutionID:  filter.InstitutionID,
+		OrgUnitID:      filter.OrgUnitID,
 		AcademicDegree: filter.AcademicDegree,
 		Speciality:     filter.Speciality,
+		SpecialityCode: filter.SpecialityCode,
+		Keyword:        filter.Keyword,
+		MinHIndex:      filter.MinHIndex,
+		MinI10Index:    filter.MinI10Index,
+		MinCitations:   filter.MinCitations,
 	})
 	if err != nil {
 		return 0, custom_errors.InternalServerError(fmt.Errorf("could not count personnel: %w", err))
@@ -169,16 +202,17 @@ func (r *pgEmployeeRepository) CountPersonnel(ctx context.Context, filter *dtos.
 	return count, nil
 }
 
-func (r *pgEmployeeRepository) ListUniqueHighestAcademicDegrees(ctx context.Context) ([]string, error) {
-	degrees, err := r.queries.ListUniqueHighestAcademicDegrees(ctx)
+func (r *pgEmployeeRepository) ListUniqueHighestAcademicDegrees(ctx context.Context, langCode string) ([]*domain.HighestAcademicDegree, error) {
+	degrees, err := r.queries.ListUniqueHighestAcademicDegrees(ctx, langCode)
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrieve unique highest academic degrees: %w", err))
 	}
 
-	result := make([]string, 0, len(degrees))
+	result := make([]*domain.HighestAcademicDegree, len(degrees))
 	for i := range degrees {
-		if degrees[i].Valid {
-			result = append(result, degrees[i].String)
+		result[i] = &domain.HighestAcademicDegree{
+			Code: degrees[i].Code,
+			Name: degrees[i].Name,
 		}
 	}
 
@@ -200,3 +234,22 @@ func (r *pgEmployeeRepository) ListUniqueSpecialities(ctx context.Context) ([]st
 
 	return result, nil
 }
+
+func (r *pgEmployeeRepository) UpdateORCID(ctx context.Context, employeeID int64, orcid string) error {
+	err := r.queries.UpdateEmployeeOrcid(ctx, sqlc.UpdateEmployeeOrcidParams{
+		ID: employeeID,
+		Orcid: pgtype.Text{
+			String: orcid,
+			Valid:  orcid != "",
+		},
+	})
+	if err != nil {
+		if custom_errors.IsUniqueConstraintError(err) {
+			return custom_errors.BadRequest(fmt.Errorf("ORCID iD(%s) is already used by another employee", orcid))
+		}
+
+		return custom_errors.InternalServerError(fmt.Errorf("failed to update ORCID iD of employee with given ID(%d): %w", employeeID, err))
+	}
+
+	return nil
+}
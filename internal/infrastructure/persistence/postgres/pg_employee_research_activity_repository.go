@@ -28,17 +28,24 @@ func NewPgEmployeeResearchActivityRepositoryWithQueries(q *sqlc.Queries) reposit
 }
 
 func (r *pgEmployeeResearchActivityRepository) Create(ctx context.Context, employeeResearchActivity *domain.EmployeeResearchActivity) (*domain.EmployeeResearchActivity, error) {
+	translationGroupID, err := translationGroupParam(employeeResearchActivity.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	employeeResearchActivityResult, err := r.queries.CreateEmployeeResearchActivity(ctx, sqlc.CreateEmployeeResearchActivityParams{
 		EmployeeID:            employeeResearchActivity.EmployeeID,
 		LanguageCode:          employeeResearchActivity.LanguageCode,
 		ResearchActivityTitle: employeeResearchActivity.ResearchActivityTitle,
 		EmployeeRole:          employeeResearchActivity.EmployeeRole,
+		TranslationGroupID:    translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create employee research activity: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create employee research activity: %w", err))
 	}
 
 	employeeResearchActivity.ID = employeeResearchActivityResult.ID
+	employeeResearchActivity.TranslationGroupID = translationGroupID.String()
 	employeeResearchActivity.CreatedAt = employeeResearchActivityResult.CreatedAt.Time
 	employeeResearchActivity.UpdatedAt = employeeResearchActivityResult.UpdatedAt.Time
 
@@ -73,12 +80,13 @@ func (r *pgEmployeeResearchActivityRepository) GetByID(ctx context.Context, id i
 	employeeResearchActivityResult, err := r.queries.GetEmployeeResearchActivityByID(ctx, id)
 	if err != nil {
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee research activity with give ID(%d): %w", id, err))
-	} 
+	}
 
 	return &domain.EmployeeResearchActivity{
 		ID:                    employeeResearchActivityResult.ID,
 		EmployeeID:            employeeResearchActivityResult.EmployeeID,
 		LanguageCode:          employeeResearchActivityResult.LanguageCode,
+		TranslationGroupID:    employeeResearchActivityResult.TranslationGroupID.String(),
 		ResearchActivityTitle: employeeResearchActivityResult.ResearchActivityTitle,
 		EmployeeRole:          employeeResearchActivityResult.EmployeeRole,
 		CreatedAt:             employeeResearchActivityResult.CreatedAt.Time,
@@ -87,13 +95,17 @@ func (r *pgEmployeeResearchActivityRepository) GetByID(ctx context.Context, id i
 }
 
 func (r *pgEmployeeResearchActivityRepository) GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeResearchActivity, error) {
-	employeeResearchActivitysResult, err := r.queries.GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCode(ctx, sqlc.GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodeParams{
-		EmployeeID:   employeeID,
-		LanguageCode: langCode,
+	return r.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, []string{langCode})
+}
+
+func (r *pgEmployeeResearchActivityRepository) GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeResearchActivity, error) {
+	employeeResearchActivitysResult, err := r.queries.GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodes(ctx, sqlc.GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodesParams{
+		EmployeeID:    employeeID,
+		LanguageCodes: langCodes,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee research activitys with given EmployeeID(%d) and language_code(%s): %w", employeeID, langCode, err))
-	} 
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee research activitys with given EmployeeID(%d) and language_codes(%v): %w", employeeID, langCodes, err))
+	}
 
 	employeeResearchActivitys := make([]*domain.EmployeeResearchActivity, len(employeeResearchActivitysResult))
 	for index, researchActivity := range employeeResearchActivitysResult {
@@ -101,6 +113,7 @@ func (r *pgEmployeeResearchActivityRepository) GetByEmployeeIDAndLanguageCode(ct
 			ID:                    researchActivity.ID,
 			EmployeeID:            researchActivity.EmployeeID,
 			LanguageCode:          researchActivity.LanguageCode,
+			TranslationGroupID:    researchActivity.TranslationGroupID.String(),
 			ResearchActivityTitle: researchActivity.ResearchActivityTitle,
 			EmployeeRole:          researchActivity.EmployeeRole,
 			CreatedAt:             researchActivity.CreatedAt.Time,
@@ -28,17 +28,24 @@ func NewPgEmployeeScientificAwardRepositoryWithQuery(q *sqlc.Queries) repositori
 }
 
 func (r *pgEmployeeScientificAwardRepository) Create(ctx context.Context, employeeScientificAward *domain.EmployeeScientificAward) (*domain.EmployeeScientificAward, error) {
+	translationGroupID, err := translationGroupParam(employeeScientificAward.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	employeeScientificAwardResult, err := r.queries.CreateEmployeeScientificAward(ctx, sqlc.CreateEmployeeScientificAwardParams{
 		EmployeeID:           employeeScientificAward.EmployeeID,
 		LanguageCode:         employeeScientificAward.LanguageCode,
 		ScientificAwardTitle: employeeScientificAward.ScientificAwardTitle,
 		GivenBy:              employeeScientificAward.GivenBy,
+		TranslationGroupID:   translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create employee scientific award: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create employee scientific award: %w", err))
 	}
 
 	employeeScientificAward.ID = employeeScientificAwardResult.ID
+	employeeScientificAward.TranslationGroupID = translationGroupID.String()
 	employeeScientificAward.CreatedAt = employeeScientificAwardResult.CreatedAt.Time
 	employeeScientificAward.UpdatedAt = employeeScientificAwardResult.UpdatedAt.Time
 
@@ -79,6 +86,7 @@ func (r *pgEmployeeScientificAwardRepository) GetByID(ctx context.Context, id in
 		ID:                   employeeScientificAwardResult.ID,
 		EmployeeID:           employeeScientificAwardResult.EmployeeID,
 		LanguageCode:         employeeScientificAwardResult.LanguageCode,
+		TranslationGroupID:   employeeScientificAwardResult.TranslationGroupID.String(),
 		ScientificAwardTitle: employeeScientificAwardResult.ScientificAwardTitle,
 		GivenBy:              employeeScientificAwardResult.GivenBy,
 		CreatedAt:            employeeScientificAwardResult.CreatedAt.Time,
@@ -87,12 +95,16 @@ func (r *pgEmployeeScientificAwardRepository) GetByID(ctx context.Context, id in
 }
 
 func (r *pgEmployeeScientificAwardRepository) GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeScientificAward, error) {
-	employeeScientificAwardsResult, err := r.queries.GetEmployeeScientificAwardsByEmployeeIDAndLanguageCode(ctx, sqlc.GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodeParams{
-		EmployeeID:   employeeID,
-		LanguageCode: langCode,
+	return r.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, []string{langCode})
+}
+
+func (r *pgEmployeeScientificAwardRepository) GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeScientificAward, error) {
+	employeeScientificAwardsResult, err := r.queries.GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodes(ctx, sqlc.GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodesParams{
+		EmployeeID:    employeeID,
+		LanguageCodes: langCodes,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee scientific awards with given EmployeeID(%d) and language_code(%s): %w", employeeID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee scientific awards with given EmployeeID(%d) and language_codes(%v): %w", employeeID, langCodes, err))
 	}
 
 	employeeScientificAwards := make([]*domain.EmployeeScientificAward, len(employeeScientificAwardsResult))
@@ -101,6 +113,7 @@ func (r *pgEmployeeScientificAwardRepository) GetByEmployeeIDAndLanguageCode(ctx
 			ID:                   scientificAward.ID,
 			EmployeeID:           scientificAward.EmployeeID,
 			LanguageCode:         scientificAward.LanguageCode,
+			TranslationGroupID:   scientificAward.TranslationGroupID.String(),
 			ScientificAwardTitle: scientificAward.ScientificAwardTitle,
 			GivenBy:              scientificAward.GivenBy,
 			CreatedAt:            scientificAward.CreatedAt.Time,
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+)
+
+type pgEmployeeSimilarityRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgEmployeeSimilarityRepository(store *Store) repositories.EmployeeSimilarityRepository {
+	return &pgEmployeeSimilarityRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgEmployeeSimilarityRepositoryWithQuery(q *sqlc.Queries) repositories.EmployeeSimilarityRepository {
+	return &pgEmployeeSimilarityRepository{
+		queries: q,
+	}
+}
+
+// Refresh replaces the whole table, it is meant to run inside of a transaction so readers never see it empty
+func (r *pgEmployeeSimilarityRepository) Refresh(ctx context.Context, weights domain.EmployeeSimilarityWeights, maxPerEmployee int32) (int64, error) {
+	if err := r.queries.DeleteEmployeeSimilarities(ctx); err != nil {
+		return 0, custom_errors.InternalServerError(fmt.Errorf("failed to delete employee similarities: %w", err))
+	}
+
+	count, err := r.queries.InsertEmployeeSimilarities(ctx, sqlc.InsertEmployeeSimilaritiesParams{
+		ResearchAreaWeight: weights.ResearchArea,
+		KeywordWeight:      weights.Keyword,
+		EventWeight:        weights.Event,
+		InstitutionWeight:  weights.Institution,
+		MaxPerEmployee:     maxPerEmployee,
+	})
+	if err != nil {
+		return 0, custom_errors.InternalServerError(fmt.Errorf("failed to compute employee similarities: %w", err))
+	}
+
+	return count, nil
+}
+
+func (r *pgEmployeeSimilarityRepository) GetByUniqueID(ctx context.Context, uniqueID string, langCodes []string, limit int32) ([]*domain.EmployeeSimilarity, error) {
+	similaritiesResult, err := r.queries.GetSimilarEmployeesByUniqueID(ctx, sqlc.GetSimilarEmployeesByUniqueIDParams{
+		LanguageCodes: langCodes,
+		UniqueID:      uniqueID,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employees similar to employee(%s): %w", uniqueID, err))
+	}
+
+	similarities := make([]*domain.EmployeeSimilarity, len(similaritiesResult))
+	for index, similarity := range similaritiesResult {
+		similarities[index] = &domain.EmployeeSimilarity{
+			EmployeeID:            similarity.SimilarEmployeeID,
+			UniqueID:              similarity.UniqueID,
+			Surname:               similarity.Surname,
+			Name:                  similarity.Name,
+			Middlename:            similarity.Middlename.String,
+			HighestAcademicDegree: similarity.HighestAcademicDegree,
+			CurrentWorkplace:      similarity.CurrentWorkplace,
+			CurrentInstitutionID:  similarity.CurrentInstitutionID,
+			Score:                 similarity.Score,
+			SharedResearchAreas:   similarity.SharedResearchAreas,
+			SharedKeywords:        similarity.SharedKeywords,
+			SharedEvents:          similarity.SharedEvents,
+			SharedInstitutions:    similarity.SharedInstitutions,
+			ComputedAt:            similarity.ComputedAt.Time,
+		}
+	}
+
+	return similarities, nil
+}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+)
+
+type pgEmployeeVisibilityRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgEmployeeVisibilityRepository(store *Store) repositories.EmployeeVisibilityRepository {
+	return &pgEmployeeVisibilityRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgEmployeeVisibilityRepositoryWithQuery(q *sqlc.Queries) repositories.EmployeeVisibilityRepository {
+	return &pgEmployeeVisibilityRepository{
+		queries: q,
+	}
+}
+
+func (r *pgEmployeeVisibilityRepository) GetByEmployeeID(ctx context.Context, employeeID int64) (*domain.EmployeeVisibility, error) {
+	settingsResult, err := r.queries.GetEmployeeVisibilitySettingsByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive visibility settings of employee(%d): %w", employeeID, err))
+	}
+
+	visibility := &domain.EmployeeVisibility{
+		EmployeeID: employeeID,
+		Levels:     make(map[string]string, len(settingsResult)),
+	}
+	for _, setting := range settingsResult {
+		visibility.Levels[setting.Target] = setting.Level
+	}
+
+	return visibility, nil
+}
+
+func (r *pgEmployeeVisibilityRepository) GetByEmployeeIDs(ctx context.Context, employeeIDs []int64) (map[int64]*domain.EmployeeVisibility, error) {
+	settingsResult, err := r.queries.GetEmployeeVisibilitySettingsByEmployeeIDs(ctx, employeeIDs)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive visibility settings of employees: %w", err))
+	}
+
+	visibilities := make(map[int64]*domain.EmployeeVisibility, len(employeeIDs))
+	for _, setting := range settingsResult {
+		visibility, ok := visibilities[setting.EmployeeID]
+		if !ok {
+			visibility = &domain.EmployeeVisibility{
+				EmployeeID:    setting.EmployeeID,
+				InstitutionID: setting.CurrentInstitutionID,
+				Levels:        map[string]string{},
+			}
+			visibilities[setting.EmployeeID] = visibility
+		}
+
+		if setting.Target != "" {
+			visibility.Levels[setting.Target] = setting.Level
+		}
+	}
+
+	return visibilities, nil
+}
+
+func (r *pgEmployeeVisibilityRepository) Upsert(ctx context.Context, employeeID int64, target string, level string) error {
+	err := r.queries.UpsertEmployeeVisibilitySetting(ctx, sqlc.UpsertEmployeeVisibilitySettingParams{
+		EmployeeID: employeeID,
+		Target:     target,
+		Level:      level,
+	})
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to store visibility of %s for employee(%d): %w", target, employeeID, err))
+	}
+
+	return nil
+}
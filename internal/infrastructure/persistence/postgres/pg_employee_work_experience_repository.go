@@ -32,6 +32,11 @@ func NewPgEmployeeWorkExperienceRepositoryWithQuery(q *sqlc.Queries) repositorie
 }
 
 func (r *pgEmployeeWorkExperienceRepository) Create(ctx context.Context, employeeWorkExperience *domain.EmployeeWorkExperience) (*domain.EmployeeWorkExperience, error) {
+	translationGroupID, err := translationGroupParam(employeeWorkExperience.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	employeeWorkExperienceResult, err := r.queries.CreateEmployeeWorkExperience(ctx, sqlc.CreateEmployeeWorkExperienceParams{
 		EmployeeID:   employeeWorkExperience.EmployeeID,
 		LanguageCode: employeeWorkExperience.LanguageCode,
@@ -46,13 +51,27 @@ func (r *pgEmployeeWorkExperienceRepository) Create(ctx context.Context, employe
 			Time:  employeeWorkExperience.DateEnd,
 			Valid: !employeeWorkExperience.DateEnd.IsZero(),
 		},
-			OnGoing: employeeWorkExperience.Ongoing,
-		})
+		OnGoing:            employeeWorkExperience.Ongoing,
+		TranslationGroupID: translationGroupID,
+		InstitutionID: pgtype.Int8{
+			Int64: employeeWorkExperience.InstitutionID,
+			Valid: employeeWorkExperience.InstitutionID != 0,
+		},
+		OrgUnitID: pgtype.Int8{
+			Int64: employeeWorkExperience.OrgUnitID,
+			Valid: employeeWorkExperience.OrgUnitID != 0,
+		},
+	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create employee work experience: %w", err))
+		if custom_errors.IsForeignKeyViolationError(err) {
+			return nil, custom_errors.BadRequest(fmt.Errorf("institution(%d) or organizational unit(%d) of employee work experience does not exist: %w", employeeWorkExperience.InstitutionID, employeeWorkExperience.OrgUnitID, err))
+		}
+
+		return nil, translationGroupError(fmt.Errorf("failed to create employee work experience: %w", err))
 	}
 
 	employeeWorkExperience.ID = employeeWorkExperienceResult.ID
+	employeeWorkExperience.TranslationGroupID = translationGroupID.String()
 	employeeWorkExperience.CreatedAt = employeeWorkExperienceResult.CreatedAt.Time
 	employeeWorkExperience.UpdatedAt = employeeWorkExperienceResult.UpdatedAt.Time
 
@@ -73,15 +92,31 @@ func (r *pgEmployeeWorkExperienceRepository) Update(ctx context.Context, employe
 			Time:  employeeWorkExperience.DateEnd,
 			Valid: !employeeWorkExperience.DateEnd.IsZero(),
 		},
-			Column6: employeeWorkExperience.Ongoing,
-		})
+		Column6: employeeWorkExperience.Ongoing,
+		InstitutionID: pgtype.Int8{
+			Int64: employeeWorkExperience.InstitutionID,
+			Valid: employeeWorkExperience.InstitutionID != 0,
+		},
+		OrgUnitID: pgtype.Int8{
+			Int64: employeeWorkExperience.OrgUnitID,
+			Valid: employeeWorkExperience.OrgUnitID != 0,
+		},
+	})
 	if err != nil {
+		if custom_errors.IsForeignKeyViolationError(err) {
+			return nil, custom_errors.BadRequest(fmt.Errorf("institution(%d) or organizational unit(%d) of employee work experience does not exist: %w", employeeWorkExperience.InstitutionID, employeeWorkExperience.OrgUnitID, err))
+		}
+
 		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to update employee work experience: %w", err))
 	}
 
 	employeeWorkExperience.CreatedAt = updateEmployeeWorkExperienceResult.CreatedAt.Time
 	employeeWorkExperience.UpdatedAt = updateEmployeeWorkExperienceResult.UpdatedAt.Time
 
+	if err := r.queries.SyncEmployeeWorkExperienceTranslations(ctx, employeeWorkExperience.ID); err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to sync translations of employee work experience: %w", err))
+	}
+
 	return employeeWorkExperience, nil
 }
 
@@ -102,43 +137,57 @@ func (r *pgEmployeeWorkExperienceRepository) GetByID(ctx context.Context, id int
 	}
 
 	return &domain.EmployeeWorkExperience{
-		ID:           employeeWorkExperienceResult.ID,
-		EmployeeID:   employeeWorkExperienceResult.EmployeeID,
-		LanguageCode: employeeWorkExperienceResult.LanguageCode,
-		Workplace:    employeeWorkExperienceResult.Workplace,
-		Description:  employeeWorkExperienceResult.Description,
-		JobTitle:     employeeWorkExperienceResult.JobTitle,
-		DateStart:    employeeWorkExperienceResult.DateStart.Time,
-		DateEnd:      employeeWorkExperienceResult.DateEnd.Time,
-		Ongoing:      employeeWorkExperienceResult.OnGoing,
-		CreatedAt:    employeeWorkExperienceResult.CreatedAt.Time,
-		UpdatedAt:    employeeWorkExperienceResult.UpdatedAt.Time,
+		ID:                 employeeWorkExperienceResult.ID,
+		EmployeeID:         employeeWorkExperienceResult.EmployeeID,
+		LanguageCode:       employeeWorkExperienceResult.LanguageCode,
+		TranslationGroupID: employeeWorkExperienceResult.TranslationGroupID.String(),
+		InstitutionID:      employeeWorkExperienceResult.InstitutionID.Int64,
+		OrgUnitID:          employeeWorkExperienceResult.OrgUnitID.Int64,
+		Workplace:          employeeWorkExperienceResult.Workplace,
+		Description:        employeeWorkExperienceResult.Description,
+		JobTitle:           employeeWorkExperienceResult.JobTitle,
+		DateStart:          employeeWorkExperienceResult.DateStart.Time,
+		DateEnd:            employeeWorkExperienceResult.DateEnd.Time,
+		Ongoing:            employeeWorkExperienceResult.OnGoing,
+		Source:             employeeWorkExperienceResult.Source,
+		ExternalID:         employeeWorkExperienceResult.ExternalID.String,
+		CreatedAt:          employeeWorkExperienceResult.CreatedAt.Time,
+		UpdatedAt:          employeeWorkExperienceResult.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgEmployeeWorkExperienceRepository) GetByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64, langCode string) ([]*domain.EmployeeWorkExperience, error) {
-	employeeWorkExperiencesResult, err := r.queries.GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCode(ctx, sqlc.GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodeParams{
-		EmployeeID:   employeeID,
-		LanguageCode: langCode,
+	return r.GetByEmployeeIDAndLanguageCodes(ctx, employeeID, []string{langCode})
+}
+
+func (r *pgEmployeeWorkExperienceRepository) GetByEmployeeIDAndLanguageCodes(ctx context.Context, employeeID int64, langCodes []string) ([]*domain.EmployeeWorkExperience, error) {
+	employeeWorkExperiencesResult, err := r.queries.GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodes(ctx, sqlc.GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodesParams{
+		EmployeeID:    employeeID,
+		LanguageCodes: langCodes,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee work experiences with given EmployeeID(%d) and language_code(%s): %w", employeeID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive employee work experiences with given EmployeeID(%d) and language_codes(%v): %w", employeeID, langCodes, err))
 	}
 
 	employeeWorkExperiences := make([]*domain.EmployeeWorkExperience, len(employeeWorkExperiencesResult))
 	for index, workExperience := range employeeWorkExperiencesResult {
 		employeeWorkExperiences[index] = &domain.EmployeeWorkExperience{
-			ID:           workExperience.ID,
-			EmployeeID:   workExperience.EmployeeID,
-			LanguageCode: workExperience.LanguageCode,
-			Workplace:    workExperience.Workplace,
-			Description:  workExperience.Description,
-			JobTitle:     workExperience.JobTitle,
-			DateStart:    workExperience.DateStart.Time,
-			DateEnd:      workExperience.DateEnd.Time,
-			Ongoing:      workExperience.OnGoing,
-			CreatedAt:    workExperience.CreatedAt.Time,
-			UpdatedAt:    workExperience.UpdatedAt.Time,
+			ID:                 workExperience.ID,
+			EmployeeID:         workExperience.EmployeeID,
+			LanguageCode:       workExperience.LanguageCode,
+			TranslationGroupID: workExperience.TranslationGroupID.String(),
+			InstitutionID:      workExperience.InstitutionID.Int64,
+			OrgUnitID:          workExperience.OrgUnitID.Int64,
+			Workplace:          workExperience.Workplace,
+			Description:        workExperience.Description,
+			JobTitle:           workExperience.JobTitle,
+			DateStart:          workExperience.DateStart.Time,
+			DateEnd:            workExperience.DateEnd.Time,
+			Ongoing:            workExperience.OnGoing,
+			Source:             workExperience.Source,
+			ExternalID:         workExperience.ExternalID.String,
+			CreatedAt:          workExperience.CreatedAt.Time,
+			UpdatedAt:          workExperience.UpdatedAt.Time,
 		}
 	}
 
@@ -160,3 +209,61 @@ func (r *pgEmployeeWorkExperienceRepository) ListUniqueOngoingWorkplaces(ctx con
 
 	return result, nil
 }
+
+func (r *pgEmployeeWorkExperienceRepository) UpsertImported(ctx context.Context, employeeWorkExperience *domain.EmployeeWorkExperience) (*domain.EmployeeWorkExperience, error) {
+	translationGroupID, err := translationGroupParam(employeeWorkExperience.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	employeeWorkExperienceResult, err := r.queries.UpsertImportedEmployeeWorkExperience(ctx, sqlc.UpsertImportedEmployeeWorkExperienceParams{
+		EmployeeID:   employeeWorkExperience.EmployeeID,
+		LanguageCode: employeeWorkExperience.LanguageCode,
+		Workplace:    employeeWorkExperience.Workplace,
+		JobTitle:     employeeWorkExperience.JobTitle,
+		Description:  employeeWorkExperience.Description,
+		DateStart: pgtype.Date{
+			Time:  employeeWorkExperience.DateStart,
+			Valid: !employeeWorkExperience.DateStart.IsZero(),
+		},
+		DateEnd: pgtype.Date{
+			Time:  employeeWorkExperience.DateEnd,
+			Valid: !employeeWorkExperience.DateEnd.IsZero(),
+		},
+		OnGoing: employeeWorkExperience.Ongoing,
+		Source:  employeeWorkExperience.Source,
+		ExternalID: pgtype.Text{
+			String: employeeWorkExperience.ExternalID,
+			Valid:  employeeWorkExperience.ExternalID != "",
+		},
+		TranslationGroupID: translationGroupID,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to upsert imported employee work experience(source - %s, external_id - %s): %w", employeeWorkExperience.Source, employeeWorkExperience.ExternalID, err))
+	}
+
+	employeeWorkExperience.ID = employeeWorkExperienceResult.ID
+	employeeWorkExperience.TranslationGroupID = employeeWorkExperienceResult.TranslationGroupID.String()
+	employeeWorkExperience.CreatedAt = employeeWorkExperienceResult.CreatedAt.Time
+	employeeWorkExperience.UpdatedAt = employeeWorkExperienceResult.UpdatedAt.Time
+
+	return employeeWorkExperience, nil
+}
+
+func (r *pgEmployeeWorkExperienceRepository) DeleteStaleImported(ctx context.Context, employeeID int64, langCode string, source string, keepExternalIDs []string) error {
+	if keepExternalIDs == nil {
+		keepExternalIDs = []string{}
+	}
+
+	err := r.queries.DeleteStaleImportedEmployeeWorkExperiences(ctx, sqlc.DeleteStaleImportedEmployeeWorkExperiencesParams{
+		EmployeeID:   employeeID,
+		LanguageCode: langCode,
+		Source:       source,
+		ExternalIds:  keepExternalIDs,
+	})
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to delete stale imported employee work experiences of EmployeeID(%d) from source(%s): %w", employeeID, source, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+)
+
+type pgExpertRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgExpertRepository(store *Store) repositories.ExpertRepository {
+	return &pgExpertRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgExpertRepositoryWithQuery(q *sqlc.Queries) repositories.ExpertRepository {
+	return &pgExpertRepository{
+		queries: q,
+	}
+}
+
+func (r *pgExpertRepository) SearchMatches(ctx context.Context, filter *domain.ExpertSearchFilter) ([]*domain.ExpertMatch, error) {
+	matchesResult, err := r.queries.SearchExpertMatches(ctx, sqlc.SearchExpertMatchesParams{
+		Terms:           filter.Terms,
+		LanguageCode:    filter.LanguageCode,
+		InstitutionID:   filter.InstitutionID,
+		DegreeLevelCode: filter.DegreeLevelCode,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to search experts by terms(%v): %w", filter.Terms, err))
+	}
+
+	matches := make([]*domain.ExpertMatch, len(matchesResult))
+	for index, match := range matchesResult {
+		matches[index] = &domain.ExpertMatch{
+			EmployeeID:   match.EmployeeID,
+			Section:      match.Section,
+			ItemKey:      match.ItemKey,
+			Title:        match.Title,
+			MatchedTerms: match.MatchedTerms,
+		}
+	}
+
+	return matches, nil
+}
+
+func (r *pgExpertRepository) GetProfilesByIDs(ctx context.Context, ids []int64, langCodes []string) ([]*domain.ExpertProfile, error) {
+	profilesResult, err := r.queries.GetExpertProfilesByIDs(ctx, sqlc.GetExpertProfilesByIDsParams{
+		LanguageCodes: langCodes,
+		Ids:           ids,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive profiles of experts: %w", err))
+	}
+
+	profiles := make([]*domain.ExpertProfile, len(profilesResult))
+	for index, profile := range profilesResult {
+		profiles[index] = &domain.ExpertProfile{
+			EmployeeID:            profile.ID,
+			UniqueID:              profile.UniqueID,
+			Surname:               profile.Surname,
+			Name:                  profile.Name,
+			Middlename:            profile.Middlename.String,
+			HighestAcademicDegree: profile.HighestAcademicDegree,
+			CurrentWorkplace:      profile.CurrentWorkplace,
+			CurrentInstitutionID:  profile.CurrentInstitutionID,
+		}
+	}
+
+	return profiles, nil
+}
@@ -5,6 +5,7 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"database/sql"
 	"errors"
@@ -26,17 +27,24 @@ func (r *pgInstitutionAccreditationRepository) Create(ctx context.Context, insti
 		return nil, custom_errors.BadRequest(fmt.Errorf("institution accreditation requires to have institution_id"))
 	}
 
+	translationGroupID, err := translationGroupParam(institutionAccreditation.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	institutionAccreditationResult, err := r.store.Queries.CreateInstitutionAccreditation(ctx, sqlc.CreateInstitutionAccreditationParams{
-		InstitutionID:     institutionAccreditation.InstitutionID,
-		LanguageCode:      institutionAccreditation.LanguageCode,
-		AccreditationType: institutionAccreditation.AccreditationType,
-		GivenBy:           institutionAccreditation.GivenBy,
+		InstitutionID:      institutionAccreditation.InstitutionID,
+		LanguageCode:       institutionAccreditation.LanguageCode,
+		AccreditationType:  institutionAccreditation.AccreditationType,
+		GivenBy:            institutionAccreditation.GivenBy,
+		TranslationGroupID: translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create institution accreditation: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create institution accreditation: %w", err))
 	}
 
 	institutionAccreditation.ID = institutionAccreditationResult.ID
+	institutionAccreditation.TranslationGroupID = translationGroupID.String()
 	institutionAccreditation.CreatedAt = institutionAccreditationResult.CreatedAt.Time
 	institutionAccreditation.UpdatedAt = institutionAccreditationResult.UpdatedAt.Time
 
@@ -131,31 +139,36 @@ func (r *pgInstitutionAccreditationRepository) GetByID(ctx context.Context, id i
 	}
 
 	return &domain.InstitutionAccreditation{
-		ID:            institutionAccreditation.ID,
-		InstitutionID: institutionAccreditation.InstitutionID,
-		LanguageCode:  institutionAccreditation.LanguageCode,
-		AccreditationType:          institutionAccreditation.AccreditationType,
-		GivenBy:       institutionAccreditation.GivenBy,
-		CreatedAt:     institutionAccreditation.CreatedAt.Time,
-		UpdatedAt:     institutionAccreditation.UpdatedAt.Time,
+		ID:                 institutionAccreditation.ID,
+		InstitutionID:      institutionAccreditation.InstitutionID,
+		LanguageCode:       institutionAccreditation.LanguageCode,
+		TranslationGroupID: institutionAccreditation.TranslationGroupID.String(),
+		AccreditationType:  institutionAccreditation.AccreditationType,
+		GivenBy:            institutionAccreditation.GivenBy,
+		CreatedAt:          institutionAccreditation.CreatedAt.Time,
+		UpdatedAt:          institutionAccreditation.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgInstitutionAccreditationRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionAccreditation, error) {
-	if institutionID <= 0 {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution accreditation institution_id(%d) is provided", institutionID))
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution accreditation language_code(%s) is provided", langCode))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution accreditation language_code(%s) is provided", langCode))
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionAccreditationRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionAccreditation, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution accreditation institution_id(%d) is provided", institutionID))
 	}
 
-	institutionAccreditationsResult, err := r.store.Queries.GetInstitutionAccreditationsByInstitutionIDAndLanguageCode(ctx, sqlc.GetInstitutionAccreditationsByInstitutionIDAndLanguageCodeParams{
+	institutionAccreditationsResult, err := r.store.Queries.GetInstitutionAccreditationsByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionAccreditationsByInstitutionIDAndLanguageCodesParams{
 		InstitutionID: institutionID,
-		LanguageCode:  langCode,
+		LanguageCodes: langCodes,
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution accreditation with given institution_id(%d) and language_code(%s): %w", institutionID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution accreditation with given institution_id(%d) and language_codes(%v): %w", institutionID, langCodes, err))
 	} else if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -163,13 +176,14 @@ func (r *pgInstitutionAccreditationRepository) GetByInstitutionIDAndLanguageCode
 	institutionAccreditations := make([]*domain.InstitutionAccreditation, len(institutionAccreditationsResult))
 	for index, accreditation := range institutionAccreditationsResult {
 		institutionAccreditations[index] = &domain.InstitutionAccreditation{
-			ID:                accreditation.ID,
-			InstitutionID:     accreditation.InstitutionID,
-			LanguageCode:      accreditation.LanguageCode,
-			AccreditationType: accreditation.AccreditationType,
-			GivenBy:           accreditation.GivenBy,
-			CreatedAt:         accreditation.CreatedAt.Time,
-			UpdatedAt:         accreditation.UpdatedAt.Time,
+			ID:                 accreditation.ID,
+			InstitutionID:      accreditation.InstitutionID,
+			LanguageCode:       accreditation.LanguageCode,
+			TranslationGroupID: accreditation.TranslationGroupID.String(),
+			AccreditationType:  accreditation.AccreditationType,
+			GivenBy:            accreditation.GivenBy,
+			CreatedAt:          accreditation.CreatedAt.Time,
+			UpdatedAt:          accreditation.UpdatedAt.Time,
 		}
 	}
 
@@ -5,6 +5,7 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"database/sql"
 	"errors"
@@ -28,11 +29,16 @@ func (r *pgInstitutionAchievementRepository) Create(ctx context.Context, institu
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution achievement institutio_id(%d) is provided", institutionAchievement.InstitutionID))
 	}
 
+	translationGroupID, err := translationGroupParam(institutionAchievement.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	institutionAchievementResult, err := r.store.Queries.CreateInstitutionAchievement(ctx, sqlc.CreateInstitutionAchievementParams{
 		InstitutionID:    institutionAchievement.InstitutionID,
 		LanguageCode:     institutionAchievement.LanguageCode,
 		AchievementTitle: institutionAchievement.AchievementTitle,
-		AchievementType:             institutionAchievement.AchievementType,
+		AchievementType:  institutionAchievement.AchievementType,
 		DateRecieved: pgtype.Date{
 			Time:  institutionAchievement.DateReceived,
 			Valid: !institutionAchievement.DateReceived.IsZero(),
@@ -43,12 +49,14 @@ func (r *pgInstitutionAchievementRepository) Create(ctx context.Context, institu
 			String: institutionAchievement.Description,
 			Valid:  institutionAchievement.Description != "",
 		},
+		TranslationGroupID: translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create institution achievement: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create institution achievement: %w", err))
 	}
 
 	institutionAchievement.ID = institutionAchievementResult.ID
+	institutionAchievement.TranslationGroupID = translationGroupID.String()
 	institutionAchievement.CreatedAt = institutionAchievementResult.CreatedAt.Time
 	institutionAchievement.UpdatedAt = institutionAchievementResult.UpdatedAt.Time
 
@@ -73,7 +81,7 @@ func (r *pgInstitutionAchievementRepository) Update(ctx context.Context, institu
 		updateInstitutionAchievementParams := sqlc.UpdateInstitutionAchievementParams{
 			ID:               existingInstitutionAchievement.ID,
 			AchievementTitle: existingInstitutionAchievement.AchievementTitle,
-			AchievementType:             existingInstitutionAchievement.AchievementType,
+			AchievementType:  existingInstitutionAchievement.AchievementType,
 			DateRecieved:     existingInstitutionAchievement.DateRecieved,
 			GivenBy:          existingInstitutionAchievement.GivenBy,
 			LinkToFile:       existingInstitutionAchievement.LinkToFile,
@@ -169,34 +177,39 @@ func (r *pgInstitutionAchievementRepository) GetByID(ctx context.Context, id int
 	}
 
 	return &domain.InstitutionAchievement{
-		ID:               institutionAchiementResult.ID,
-		InstitutionID:    institutionAchiementResult.InstitutionID,
-		LanguageCode:     institutionAchiementResult.LanguageCode,
-		AchievementTitle: institutionAchiementResult.AchievementTitle,
-		AchievementType:             institutionAchiementResult.AchievementType,
-		DateReceived:     institutionAchiementResult.DateRecieved.Time,
-		GivenBy:          institutionAchiementResult.GivenBy,
-		Description:      institutionAchiementResult.Description.String,
-		CreatedAt:        institutionAchiementResult.CreatedAt.Time,
-		UpdatedAt:        institutionAchiementResult.UpdatedAt.Time,
+		ID:                 institutionAchiementResult.ID,
+		InstitutionID:      institutionAchiementResult.InstitutionID,
+		LanguageCode:       institutionAchiementResult.LanguageCode,
+		TranslationGroupID: institutionAchiementResult.TranslationGroupID.String(),
+		AchievementTitle:   institutionAchiementResult.AchievementTitle,
+		AchievementType:    institutionAchiementResult.AchievementType,
+		DateReceived:       institutionAchiementResult.DateRecieved.Time,
+		GivenBy:            institutionAchiementResult.GivenBy,
+		Description:        institutionAchiementResult.Description.String,
+		CreatedAt:          institutionAchiementResult.CreatedAt.Time,
+		UpdatedAt:          institutionAchiementResult.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgInstitutionAchievementRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionAchievement, error) {
-	if institutionID <= 0 {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution achievement institution_id(%d) is provided", institutionID))
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution achievement language_code(%s) is provided", langCode))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution achievement language_code(%s) is provided", langCode))
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionAchievementRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionAchievement, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution achievement institution_id(%d) is provided", institutionID))
 	}
 
-	institutionAchievementsResult, err := r.store.Queries.GetInstitutionAchievementsByInstitutionIDAndLanguageCode(ctx, sqlc.GetInstitutionAchievementsByInstitutionIDAndLanguageCodeParams{
+	institutionAchievementsResult, err := r.store.Queries.GetInstitutionAchievementsByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionAchievementsByInstitutionIDAndLanguageCodesParams{
 		InstitutionID: institutionID,
-		LanguageCode:  langCode,
+		LanguageCodes: langCodes,
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrieve institution achievement with given institutio_id(%d) and language_code(%s): %w", institutionID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrieve institution achievement with given institutio_id(%d) and language_codes(%v): %w", institutionID, langCodes, err))
 	} else if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -204,16 +217,17 @@ func (r *pgInstitutionAchievementRepository) GetByInstitutionIDAndLanguageCode(c
 	institutionAchievements := make([]*domain.InstitutionAchievement, len(institutionAchievementsResult))
 	for index, achievement := range institutionAchievementsResult {
 		institutionAchievements[index] = &domain.InstitutionAchievement{
-			ID:               achievement.ID,
-			LanguageCode:     achievement.LanguageCode,
-			AchievementTitle: achievement.AchievementTitle,
-			AchievementType:  achievement.AchievementType,
-			DateReceived:     achievement.DateRecieved.Time,
-			GivenBy:          achievement.GivenBy,
-			LinkToFile:       achievement.LinkToFile,
-			Description:      achievement.Description.String,
-			CreatedAt:        achievement.CreatedAt.Time,
-			UpdatedAt:        achievement.UpdatedAt.Time,
+			ID:                 achievement.ID,
+			LanguageCode:       achievement.LanguageCode,
+			TranslationGroupID: achievement.TranslationGroupID.String(),
+			AchievementTitle:   achievement.AchievementTitle,
+			AchievementType:    achievement.AchievementType,
+			DateReceived:       achievement.DateRecieved.Time,
+			GivenBy:            achievement.GivenBy,
+			LinkToFile:         achievement.LinkToFile,
+			Description:        achievement.Description.String,
+			CreatedAt:          achievement.CreatedAt.Time,
+			UpdatedAt:          achievement.UpdatedAt.Time,
 		}
 	}
 
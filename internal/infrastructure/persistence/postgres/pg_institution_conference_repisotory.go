@@ -5,6 +5,7 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"database/sql"
 	"errors"
@@ -28,10 +29,15 @@ func (r *pgInstitutionConferenceRepository) Create(ctx context.Context, institut
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution conference institution_id is provided"))
 	}
 
-	if institutionConference.LanguageCode != "en" && institutionConference.LanguageCode != "ru" && institutionConference.LanguageCode != "tg" {
+	if !languages.IsEnabled(institutionConference.LanguageCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution conference langauge_code is provided"))
 	}
 
+	translationGroupID, err := translationGroupParam(institutionConference.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	institutionConferenceResult, err := r.store.Queries.CreateInstitutionConference(ctx, sqlc.CreateInstitutionConferenceParams{
 		InstitutionID:   institutionConference.InstitutionID,
 		LanguageCode:    institutionConference.LanguageCode,
@@ -45,12 +51,14 @@ func (r *pgInstitutionConferenceRepository) Create(ctx context.Context, institut
 			Time:  institutionConference.DateOfConference,
 			Valid: !institutionConference.DateOfConference.IsZero(),
 		},
+		TranslationGroupID: translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create institution conference: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create institution conference: %w", err))
 	}
 
 	institutionConference.ID = institutionConferenceResult.ID
+	institutionConference.TranslationGroupID = translationGroupID.String()
 	institutionConference.CreatedAt = institutionConferenceResult.CreatedAt.Time
 	institutionConference.UpdatedAt = institutionConferenceResult.UpdatedAt.Time
 
@@ -161,51 +169,57 @@ func (r *pgInstitutionConferenceRepository) GetByID(ctx context.Context, id int6
 	}
 
 	return &domain.InstitutionConference{
-		ID:               institutionConferenceResult.ID,
-		InstitutionID:    institutionConferenceResult.InstitutionID,
-		LanguageCode:     institutionConferenceResult.LanguageCode,
-		ConferenceTitle:  institutionConferenceResult.ConferenceTitle,
-		Link:             institutionConferenceResult.Link,
-		LinkToRINC:       institutionConferenceResult.LinkToRinc.String,
-		DateOfConference: institutionConferenceResult.DateOfConference.Time,
-		CreatedAt:        institutionConferenceResult.CreatedAt.Time,
-		UpdatedAt:        institutionConferenceResult.UpdatedAt.Time,
+		ID:                 institutionConferenceResult.ID,
+		InstitutionID:      institutionConferenceResult.InstitutionID,
+		LanguageCode:       institutionConferenceResult.LanguageCode,
+		TranslationGroupID: institutionConferenceResult.TranslationGroupID.String(),
+		ConferenceTitle:    institutionConferenceResult.ConferenceTitle,
+		Link:               institutionConferenceResult.Link,
+		LinkToRINC:         institutionConferenceResult.LinkToRinc.String,
+		DateOfConference:   institutionConferenceResult.DateOfConference.Time,
+		CreatedAt:          institutionConferenceResult.CreatedAt.Time,
+		UpdatedAt:          institutionConferenceResult.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgInstitutionConferenceRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionConference, error) {
-	if institutionID <= 0 {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution conference instution_id is provided"))
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution conference langauge_code is provided"))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution conference langauge_code is provided"))
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionConferenceRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionConference, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution conference instution_id is provided"))
 	}
 
-	institutionConferencesResult, err := r.store.Queries.GetInstitutionConferencesByInstitutionIDAndLanguageCode(ctx, sqlc.GetInstitutionConferencesByInstitutionIDAndLanguageCodeParams{
+	institutionConferencesResult, err := r.store.Queries.GetInstitutionConferencesByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionConferencesByInstitutionIDAndLanguageCodesParams{
 		InstitutionID: institutionID,
-		LanguageCode:  langCode,
+		LanguageCodes: langCodes,
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution conference with given institutionID(%d) and languageCode(%s): %w", institutionID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution conference with given institutionID(%d) and languageCodes(%v): %w", institutionID, langCodes, err))
 	} else if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
 
-  institutionConferences := make([]*domain.InstitutionConference, len(institutionConferencesResult))
-  for index, conference := range institutionConferencesResult {
-    institutionConferences[index] = &domain.InstitutionConference{
-		ID:               conference.ID,
-		InstitutionID:    conference.InstitutionID,
-		LanguageCode:     conference.LanguageCode,
-		ConferenceTitle:  conference.ConferenceTitle,
-		Link:             conference.Link,
-		LinkToRINC:       conference.LinkToRinc.String,
-		DateOfConference: conference.DateOfConference.Time,
-		CreatedAt:        conference.CreatedAt.Time,
-		UpdatedAt:        conference.UpdatedAt.Time,
-	} 
-  }
+	institutionConferences := make([]*domain.InstitutionConference, len(institutionConferencesResult))
+	for index, conference := range institutionConferencesResult {
+		institutionConferences[index] = &domain.InstitutionConference{
+			ID:                 conference.ID,
+			InstitutionID:      conference.InstitutionID,
+			LanguageCode:       conference.LanguageCode,
+			TranslationGroupID: conference.TranslationGroupID.String(),
+			ConferenceTitle:    conference.ConferenceTitle,
+			Link:               conference.Link,
+			LinkToRINC:         conference.LinkToRinc.String,
+			DateOfConference:   conference.DateOfConference.Time,
+			CreatedAt:          conference.CreatedAt.Time,
+			UpdatedAt:          conference.UpdatedAt.Time,
+		}
+	}
 
 	return institutionConferences, nil
 }
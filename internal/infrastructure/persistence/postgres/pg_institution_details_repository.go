@@ -114,12 +114,16 @@ func (r *pgInstitutionDetailsRepository) Delete(ctx context.Context, id int64) e
 	return nil
 }
 func (r *pgInstitutionDetailsRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) (*domain.InstitutionDetails, error) {
-	institutionResult, err := r.queries.GetInstitutionDetailsByInstitutionIDAndLanguage(ctx, sqlc.GetInstitutionDetailsByInstitutionIDAndLanguageParams{
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionDetailsRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) (*domain.InstitutionDetails, error) {
+	institutionResult, err := r.queries.GetInstitutionDetailsByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionDetailsByInstitutionIDAndLanguageCodesParams{
 		InstitutionID: institutionID,
-		LanguageCode:  langCode,
+		LanguageCodes: langCodes,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution_details by ID(%d) and language_code(%s): %w", institutionID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution_details by ID(%d) and language_codes(%v): %w", institutionID, langCodes, err))
 	}
 
 	return &domain.InstitutionDetails{
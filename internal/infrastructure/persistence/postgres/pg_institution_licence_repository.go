@@ -5,6 +5,7 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"database/sql"
 	"errors"
@@ -28,10 +29,15 @@ func (r *pgInstitutionLicenceRepository) Create(ctx context.Context, institution
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution licence instituion_id is provided"))
 	}
 
-	if institutionLicence.LanguageCode != "en" && institutionLicence.LanguageCode != "ru" && institutionLicence.LanguageCode != "tg" {
+	if !languages.IsEnabled(institutionLicence.LanguageCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution licence language_Code is provided"))
 	}
 
+	translationGroupID, err := translationGroupParam(institutionLicence.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	institutionLicenceResult, err := r.store.Queries.CreateInstitutionLicence(ctx, sqlc.CreateInstitutionLicenceParams{
 		InstitutionID: institutionLicence.InstitutionID,
 		LanguageCode:  institutionLicence.LanguageCode,
@@ -47,12 +53,14 @@ func (r *pgInstitutionLicenceRepository) Create(ctx context.Context, institution
 			Time:  institutionLicence.DateEnd,
 			Valid: !institutionLicence.DateEnd.IsZero(),
 		},
+		TranslationGroupID: translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create institution licence: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create institution licence: %w", err))
 	}
 
 	institutionLicence.ID = institutionLicenceResult.ID
+	institutionLicence.TranslationGroupID = translationGroupID.String()
 	institutionLicence.CreatedAt = institutionLicenceResult.CreatedAt.Time
 	institutionLicence.UpdatedAt = institutionLicenceResult.UpdatedAt.Time
 
@@ -173,54 +181,60 @@ func (r *pgInstitutionLicenceRepository) GetByID(ctx context.Context, id int64)
 	}
 
 	return &domain.InstitutionLicence{
-		ID:            institutionLicenceResult.ID,
-		InstitutionID: institutionLicenceResult.InstitutionID,
-		LanguageCode:  institutionLicenceResult.LanguageCode,
-		LicenceTitle:  institutionLicenceResult.LicenceTitle,
-		LicenceType:   institutionLicenceResult.LicenceType,
-		GivenBy:       institutionLicenceResult.GivenBy,
-		LinkToFile:    institutionLicenceResult.LinkToFile,
-		DateStart:     institutionLicenceResult.DateStart.Time,
-		DateEnd:       institutionLicenceResult.DateEnd.Time,
-		CreatedAt:     institutionLicenceResult.CreatedAt.Time,
-		UpdatedAt:     institutionLicenceResult.UpdatedAt.Time,
+		ID:                 institutionLicenceResult.ID,
+		InstitutionID:      institutionLicenceResult.InstitutionID,
+		LanguageCode:       institutionLicenceResult.LanguageCode,
+		TranslationGroupID: institutionLicenceResult.TranslationGroupID.String(),
+		LicenceTitle:       institutionLicenceResult.LicenceTitle,
+		LicenceType:        institutionLicenceResult.LicenceType,
+		GivenBy:            institutionLicenceResult.GivenBy,
+		LinkToFile:         institutionLicenceResult.LinkToFile,
+		DateStart:          institutionLicenceResult.DateStart.Time,
+		DateEnd:            institutionLicenceResult.DateEnd.Time,
+		CreatedAt:          institutionLicenceResult.CreatedAt.Time,
+		UpdatedAt:          institutionLicenceResult.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgInstitutionLicenceRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionLicence, error) {
-	if institutionID <= 0 {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution licence institution_id is provided"))
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution licence language_code is provided"))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution licence language_code is provided"))
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionLicenceRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionLicence, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution licence institution_id is provided"))
 	}
 
-	institutionLicencesResult, err := r.store.Queries.GetInstitutionLicencesByInstitutionIDAndLanguageCode(ctx, sqlc.GetInstitutionLicencesByInstitutionIDAndLanguageCodeParams{
+	institutionLicencesResult, err := r.store.Queries.GetInstitutionLicencesByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionLicencesByInstitutionIDAndLanguageCodesParams{
 		InstitutionID: institutionID,
-		LanguageCode:  langCode,
+		LanguageCodes: langCodes,
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution licence with given institution_id(%d) and language_code(%s): %w", institutionID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution licence with given institution_id(%d) and language_codes(%v): %w", institutionID, langCodes, err))
 	} else if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
 
-  institutionLicences := make([]*domain.InstitutionLicence, len(institutionLicencesResult))
-  for index, licence := range institutionLicencesResult {
-    institutionLicences[index] = &domain.InstitutionLicence{
-		ID:           licence.ID,
-		LanguageCode: licence.LanguageCode,
-		LicenceTitle: licence.LicenceTitle,
-		LicenceType:  licence.LicenceTitle,
-		GivenBy:      licence.GivenBy,
-		LinkToFile:   licence.LinkToFile,
-		DateStart:    licence.DateStart.Time,
-		DateEnd:      licence.DateEnd.Time,
-		CreatedAt:    licence.CreatedAt.Time,
-		UpdatedAt:    licence.UpdatedAt.Time,
-	} 
-  }
+	institutionLicences := make([]*domain.InstitutionLicence, len(institutionLicencesResult))
+	for index, licence := range institutionLicencesResult {
+		institutionLicences[index] = &domain.InstitutionLicence{
+			ID:                 licence.ID,
+			LanguageCode:       licence.LanguageCode,
+			TranslationGroupID: licence.TranslationGroupID.String(),
+			LicenceTitle:       licence.LicenceTitle,
+			LicenceType:        licence.LicenceTitle,
+			GivenBy:            licence.GivenBy,
+			LinkToFile:         licence.LinkToFile,
+			DateStart:          licence.DateStart.Time,
+			DateEnd:            licence.DateEnd.Time,
+			CreatedAt:          licence.CreatedAt.Time,
+			UpdatedAt:          licence.UpdatedAt.Time,
+		}
+	}
 
 	return institutionLicences, nil
 }
@@ -5,6 +5,7 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"database/sql"
 	"errors"
@@ -28,10 +29,15 @@ func (r *pgInstitutionMagazineRepository) Create(ctx context.Context, institutio
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution magazine institution_id is provided"))
 	}
 
-	if institutionMagazine.LanguageCode != "en" && institutionMagazine.LanguageCode != "ru" && institutionMagazine.LanguageCode != "tg" {
+	if !languages.IsEnabled(institutionMagazine.LanguageCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution magazine langauge_code is provided"))
 	}
 
+	translationGroupID, err := translationGroupParam(institutionMagazine.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	institutionMagazineResult, err := r.store.Queries.CreateInstitutionMagazine(ctx, sqlc.CreateInstitutionMagazineParams{
 		InstitutionID: institutionMagazine.InstitutionID,
 		LanguageCode:  institutionMagazine.LanguageCode,
@@ -41,12 +47,14 @@ func (r *pgInstitutionMagazineRepository) Create(ctx context.Context, institutio
 			String: institutionMagazine.LinkToRINC,
 			Valid:  institutionMagazine.LinkToRINC != "",
 		},
+		TranslationGroupID: translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create institutio magazine: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create institutio magazine: %w", err))
 	}
 
 	institutionMagazine.ID = institutionMagazineResult.ID
+	institutionMagazine.TranslationGroupID = translationGroupID.String()
 	institutionMagazine.CreatedAt = institutionMagazineResult.CreatedAt.Time
 	institutionMagazine.UpdatedAt = institutionMagazineResult.UpdatedAt.Time
 
@@ -144,32 +152,37 @@ func (r *pgInstitutionMagazineRepository) GetByID(ctx context.Context, id int64)
 	}
 
 	return &domain.InstitutionMagazine{
-		ID:            institutionMagazineResult.ID,
-		InstitutionID: institutionMagazineResult.InstitutionID,
-		LanguageCode:  institutionMagazineResult.LanguageCode,
-		MagazineName:  institutionMagazineResult.MagazineName,
-		Link:          institutionMagazineResult.Link,
-		LinkToRINC:    institutionMagazineResult.LinkToRinc.String,
-		CreatedAt:     institutionMagazineResult.CreatedAt.Time,
-		UpdatedAt:     institutionMagazineResult.UpdatedAt.Time,
+		ID:                 institutionMagazineResult.ID,
+		InstitutionID:      institutionMagazineResult.InstitutionID,
+		LanguageCode:       institutionMagazineResult.LanguageCode,
+		TranslationGroupID: institutionMagazineResult.TranslationGroupID.String(),
+		MagazineName:       institutionMagazineResult.MagazineName,
+		Link:               institutionMagazineResult.Link,
+		LinkToRINC:         institutionMagazineResult.LinkToRinc.String,
+		CreatedAt:          institutionMagazineResult.CreatedAt.Time,
+		UpdatedAt:          institutionMagazineResult.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgInstitutionMagazineRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionMagazine, error) {
-	if institutionID <= 0 {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid instition magazine institution_id is provided"))
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution magazine language_code is provided"))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution magazine language_code is provided"))
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionMagazineRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionMagazine, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid instition magazine institution_id is provided"))
 	}
 
-	institutionMagazinesResult, err := r.store.Queries.GetInstitutionMagazinesByInstitutionIDAndLanguageCode(ctx, sqlc.GetInstitutionMagazinesByInstitutionIDAndLanguageCodeParams{
+	institutionMagazinesResult, err := r.store.Queries.GetInstitutionMagazinesByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionMagazinesByInstitutionIDAndLanguageCodesParams{
 		InstitutionID: institutionID,
-		LanguageCode:  langCode,
+		LanguageCodes: langCodes,
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution magazine with given instition_id(%d) and language_code(%s): %w", institutionID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution magazine with given instition_id(%d) and language_codes(%v): %w", institutionID, langCodes, err))
 	} else if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -177,14 +190,15 @@ func (r *pgInstitutionMagazineRepository) GetByInstitutionIDAndLanguageCode(ctx
 	institutionMagazines := make([]*domain.InstitutionMagazine, len(institutionMagazinesResult))
 	for index, magazine := range institutionMagazinesResult {
 		institutionMagazines[index] = &domain.InstitutionMagazine{
-			ID:            magazine.ID,
-			InstitutionID: magazine.InstitutionID,
-			LanguageCode:  magazine.LanguageCode,
-			MagazineName:  magazine.MagazineName,
-			Link:          magazine.Link,
-			LinkToRINC:    magazine.LinkToRinc.String,
-			CreatedAt:     magazine.CreatedAt.Time,
-			UpdatedAt:     magazine.UpdatedAt.Time,
+			ID:                 magazine.ID,
+			InstitutionID:      magazine.InstitutionID,
+			LanguageCode:       magazine.LanguageCode,
+			TranslationGroupID: magazine.TranslationGroupID.String(),
+			MagazineName:       magazine.MagazineName,
+			Link:               magazine.Link,
+			LinkToRINC:         magazine.LinkToRinc.String,
+			CreatedAt:          magazine.CreatedAt.Time,
+			UpdatedAt:          magazine.UpdatedAt.Time,
 		}
 	}
 
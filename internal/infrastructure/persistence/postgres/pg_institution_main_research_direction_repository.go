@@ -5,6 +5,7 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"database/sql"
 	"errors"
@@ -28,10 +29,15 @@ func (r *pgInstitutionMainResearchDirectionRepository) Create(ctx context.Contex
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution main research direction instituion_id is provided"))
 	}
 
-	if institutionMRD.LanguageCode != "en" && institutionMRD.LanguageCode != "ru" && institutionMRD.LanguageCode != "tg" {
+	if !languages.IsEnabled(institutionMRD.LanguageCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution main research direction language_Code is provided"))
 	}
 
+	translationGroupID, err := translationGroupParam(institutionMRD.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	institutionMRDResult, err := r.store.Queries.CreateInstitutionMainResearchDirection(ctx, sqlc.CreateInstitutionMainResearchDirectionParams{
 		InstitutionID:          institutionMRD.InstitutionID,
 		LanguageCode:           institutionMRD.LanguageCode,
@@ -41,12 +47,18 @@ func (r *pgInstitutionMainResearchDirectionRepository) Create(ctx context.Contex
 			String: institutionMRD.AreaOfResearch,
 			Valid:  institutionMRD.AreaOfResearch != "",
 		},
+		TranslationGroupID: translationGroupID,
+		ResearchFieldCode: pgtype.Text{
+			String: institutionMRD.ResearchFieldCode,
+			Valid:  institutionMRD.ResearchFieldCode != "",
+		},
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create main research direction: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create main research direction: %w", err))
 	}
 
 	institutionMRD.ID = institutionMRDResult.ID
+	institutionMRD.TranslationGroupID = translationGroupID.String()
 	institutionMRD.CreatedAt = institutionMRDResult.CreatedAt.Time
 	institutionMRD.UpdatedAt = institutionMRDResult.UpdatedAt.Time
 
@@ -74,6 +86,7 @@ func (r *pgInstitutionMainResearchDirectionRepository) Update(ctx context.Contex
 			ResearchDirectionTitle: existingInstitutionMRD.ResearchDirectionTitle,
 			Discipline:             existingInstitutionMRD.Discipline,
 			AreaOfResearch:         existingInstitutionMRD.AreaOfResearch,
+			ResearchFieldCode:      existingInstitutionMRD.ResearchFieldCode,
 		}
 
 		if institutionMRD.ResearchDirectionTitle != "" {
@@ -91,11 +104,22 @@ func (r *pgInstitutionMainResearchDirectionRepository) Update(ctx context.Contex
 			}
 		}
 
+		if institutionMRD.ResearchFieldCode != "" {
+			updateInstitutionMRDParams.ResearchFieldCode = pgtype.Text{
+				String: institutionMRD.ResearchFieldCode,
+				Valid:  true,
+			}
+		}
+
 		updateInstitutionMRDResult, err := q.UpdateInstitutionMainResearchDirection(ctx, updateInstitutionMRDParams)
 		if err != nil {
 			return custom_errors.InternalServerError(fmt.Errorf("failed to update institution main research direction: %w", err))
 		}
 
+		if err := q.SyncInstitutionMainResearchDirectionTranslations(ctx, institutionMRD.ID); err != nil {
+			return custom_errors.InternalServerError(fmt.Errorf("failed to sync translations of institution main research direction: %w", err))
+		}
+
 		institutionMRD.CreatedAt = updateInstitutionMRDResult.CreatedAt.Time
 		institutionMRD.UpdatedAt = updateInstitutionMRDResult.UpdatedAt.Time
 
@@ -153,29 +177,35 @@ func (r *pgInstitutionMainResearchDirectionRepository) GetByID(ctx context.Conte
 		ID:                     institutionMRDResult.ID,
 		InstitutionID:          institutionMRDResult.InstitutionID,
 		LanguageCode:           institutionMRDResult.LanguageCode,
+		TranslationGroupID:     institutionMRDResult.TranslationGroupID.String(),
 		ResearchDirectionTitle: institutionMRDResult.ResearchDirectionTitle,
 		Discipline:             institutionMRDResult.Discipline,
 		AreaOfResearch:         institutionMRDResult.AreaOfResearch.String,
+		ResearchFieldCode:      institutionMRDResult.ResearchFieldCode.String,
 		CreatedAt:              institutionMRDResult.CreatedAt.Time,
 		UpdatedAt:              institutionMRDResult.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgInstitutionMainResearchDirectionRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionMainResearchDirection, error) {
-	if institutionID <= 0 {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution main research direction institution_id is provided"))
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution main research direction language_code is provided"))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution main research direction language_code is provided"))
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionMainResearchDirectionRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionMainResearchDirection, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution main research direction institution_id is provided"))
 	}
 
-	institutionMRDsResult, err := r.store.Queries.GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguage(ctx, sqlc.GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageParams{
+	institutionMRDsResult, err := r.store.Queries.GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageCodesParams{
 		InstitutionID: institutionID,
-		LanguageCode:  langCode,
+		LanguageCodes: langCodes,
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution main research direction with given institution_id(%d) and language_code(%s): %w", institutionID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution main research direction with given institution_id(%d) and language_codes(%v): %w", institutionID, langCodes, err))
 	} else if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -186,9 +216,11 @@ func (r *pgInstitutionMainResearchDirectionRepository) GetByInstitutionIDAndLang
 			ID:                     mrd.ID,
 			InstitutionID:          mrd.InstitutionID,
 			LanguageCode:           mrd.LanguageCode,
+			TranslationGroupID:     mrd.TranslationGroupID.String(),
 			ResearchDirectionTitle: mrd.ResearchDirectionTitle,
 			Discipline:             mrd.Discipline,
 			AreaOfResearch:         mrd.AreaOfResearch.String,
+			ResearchFieldCode:      mrd.ResearchFieldCode.String,
 			CreatedAt:              mrd.CreatedAt.Time,
 			UpdatedAt:              mrd.UpdatedAt.Time,
 		}
@@ -5,6 +5,7 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"database/sql"
 	"errors"
@@ -28,10 +29,15 @@ func (r *pgInstitutionPartnershipRepository) Create(ctx context.Context, institu
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution partnership instituion_id is provided"))
 	}
 
-	if institutionPartnership.LanguageCode != "en" && institutionPartnership.LanguageCode != "ru" && institutionPartnership.LanguageCode != "tg" {
+	if !languages.IsEnabled(institutionPartnership.LanguageCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution partnership language_Code is provided"))
 	}
 
+	translationGroupID, err := translationGroupParam(institutionPartnership.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	institutionPartnershipResult, err := r.store.Queries.CreateInstitutionPartnership(ctx, sqlc.CreateInstitutionPartnershipParams{
 		InstitutionID: institutionPartnership.InstitutionID,
 		LanguageCode:  institutionPartnership.LanguageCode,
@@ -41,14 +47,16 @@ func (r *pgInstitutionPartnershipRepository) Create(ctx context.Context, institu
 			Time:  institutionPartnership.DateOfContract,
 			Valid: !institutionPartnership.DateOfContract.IsZero(),
 		},
-		LinkToPartner: institutionPartnership.LinkToPartner,
-		Goal:          institutionPartnership.Goal,
+		LinkToPartner:      institutionPartnership.LinkToPartner,
+		Goal:               institutionPartnership.Goal,
+		TranslationGroupID: translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create institution partnership: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create institution partnership: %w", err))
 	}
 
 	institutionPartnership.ID = institutionPartnershipResult.ID
+	institutionPartnership.TranslationGroupID = translationGroupID.String()
 	institutionPartnership.CreatedAt = institutionPartnershipResult.CreatedAt.Time
 	institutionPartnership.UpdatedAt = institutionPartnershipResult.UpdatedAt.Time
 
@@ -161,34 +169,39 @@ func (r *pgInstitutionPartnershipRepository) GetByID(ctx context.Context, id int
 	}
 
 	return &domain.InstitutionPartnership{
-		ID:             institutionPartnershipResult.ID,
-		InstitutionID:  institutionPartnershipResult.InstitutionID,
-		LanguageCode:   institutionPartnershipResult.LanguageCode,
-		PartnerName:    institutionPartnershipResult.PartnerName,
-		PartnerType:    institutionPartnershipResult.PartnerType,
-		Goal:           institutionPartnershipResult.Goal,
-		LinkToPartner:  institutionPartnershipResult.LinkToPartner,
-		DateOfContract: institutionPartnershipResult.DateOfContract.Time,
-		CreatedAt:      institutionPartnershipResult.CreatedAt.Time,
-		UpdatedAt:      institutionPartnershipResult.UpdatedAt.Time,
+		ID:                 institutionPartnershipResult.ID,
+		InstitutionID:      institutionPartnershipResult.InstitutionID,
+		LanguageCode:       institutionPartnershipResult.LanguageCode,
+		TranslationGroupID: institutionPartnershipResult.TranslationGroupID.String(),
+		PartnerName:        institutionPartnershipResult.PartnerName,
+		PartnerType:        institutionPartnershipResult.PartnerType,
+		Goal:               institutionPartnershipResult.Goal,
+		LinkToPartner:      institutionPartnershipResult.LinkToPartner,
+		DateOfContract:     institutionPartnershipResult.DateOfContract.Time,
+		CreatedAt:          institutionPartnershipResult.CreatedAt.Time,
+		UpdatedAt:          institutionPartnershipResult.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgInstitutionPartnershipRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionPartnership, error) {
-	if institutionID <= 0 {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution partnership institution_id is provided"))
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution partnership language_code is provided"))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution partnership language_code is provided"))
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionPartnershipRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionPartnership, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution partnership institution_id is provided"))
 	}
 
-	institutionPartnershipsResult, err := r.store.Queries.GetInstitutionPartnershipsByInstitutionIDAndLanguageCode(ctx, sqlc.GetInstitutionPartnershipsByInstitutionIDAndLanguageCodeParams{
+	institutionPartnershipsResult, err := r.store.Queries.GetInstitutionPartnershipsByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionPartnershipsByInstitutionIDAndLanguageCodesParams{
 		InstitutionID: institutionID,
-		LanguageCode:  langCode,
+		LanguageCodes: langCodes,
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution partnership with given institution_id(%d) and language_code(%s): %w", institutionID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution partnership with given institution_id(%d) and language_codes(%v): %w", institutionID, langCodes, err))
 	} else if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -196,16 +209,17 @@ func (r *pgInstitutionPartnershipRepository) GetByInstitutionIDAndLanguageCode(c
 	institutionPartnerships := make([]*domain.InstitutionPartnership, len(institutionPartnershipsResult))
 	for index, partnership := range institutionPartnershipsResult {
 		institutionPartnerships[index] = &domain.InstitutionPartnership{
-			ID:             partnership.ID,
-			InstitutionID:  partnership.InstitutionID,
-			LanguageCode:   partnership.LanguageCode,
-			PartnerName:    partnership.PartnerName,
-			PartnerType:    partnership.PartnerType,
-			Goal:           partnership.Goal,
-			LinkToPartner:  partnership.LinkToPartner,
-			DateOfContract: partnership.DateOfContract.Time,
-			CreatedAt:      partnership.CreatedAt.Time,
-			UpdatedAt:      partnership.UpdatedAt.Time,
+			ID:                 partnership.ID,
+			InstitutionID:      partnership.InstitutionID,
+			LanguageCode:       partnership.LanguageCode,
+			TranslationGroupID: partnership.TranslationGroupID.String(),
+			PartnerName:        partnership.PartnerName,
+			PartnerType:        partnership.PartnerType,
+			Goal:               partnership.Goal,
+			LinkToPartner:      partnership.LinkToPartner,
+			DateOfContract:     partnership.DateOfContract.Time,
+			CreatedAt:          partnership.CreatedAt.Time,
+			UpdatedAt:          partnership.UpdatedAt.Time,
 		}
 	}
 
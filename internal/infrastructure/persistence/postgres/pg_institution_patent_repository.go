@@ -5,6 +5,7 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"database/sql"
 	"errors"
@@ -26,24 +27,31 @@ func (r *pgInstitutionPatentRepository) Create(ctx context.Context, institutionP
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution patent instituion_id is provided"))
 	}
 
-	if institutionPatent.LanguageCode != "en" && institutionPatent.LanguageCode != "ru" && institutionPatent.LanguageCode != "tg" {
+	if !languages.IsEnabled(institutionPatent.LanguageCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution patent language_Code is provided"))
 	}
 
+	translationGroupID, err := translationGroupParam(institutionPatent.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	institutionPatentResult, err := r.store.Queries.CreateInstitutionPatent(ctx, sqlc.CreateInstitutionPatentParams{
-		InstitutionID:    institutionPatent.InstitutionID,
-		LanguageCode:     institutionPatent.LanguageCode,
-		PatentTitle:      institutionPatent.PatentTitle,
-		Discipline:       institutionPatent.Discipline,
-		Description:      institutionPatent.Description,
-		ImplementedIn:    institutionPatent.ImplementedIn,
-		LinkToPatentFile: institutionPatent.LinkToPatentFile,
+		InstitutionID:      institutionPatent.InstitutionID,
+		LanguageCode:       institutionPatent.LanguageCode,
+		PatentTitle:        institutionPatent.PatentTitle,
+		Discipline:         institutionPatent.Discipline,
+		Description:        institutionPatent.Description,
+		ImplementedIn:      institutionPatent.ImplementedIn,
+		LinkToPatentFile:   institutionPatent.LinkToPatentFile,
+		TranslationGroupID: translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create institution patent: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create institution patent: %w", err))
 	}
 
 	institutionPatent.ID = institutionPatentResult.ID
+	institutionPatent.TranslationGroupID = translationGroupID.String()
 	institutionPatent.CreatedAt = institutionPatentResult.CreatedAt.Time
 	institutionPatent.UpdatedAt = institutionPatentResult.UpdatedAt.Time
 
@@ -153,34 +161,39 @@ func (r *pgInstitutionPatentRepository) GetByID(ctx context.Context, id int64) (
 	}
 
 	return &domain.InstitutionPatent{
-		ID:               institutionPatentResult.ID,
-		InstitutionID:    institutionPatentResult.InstitutionID,
-		LanguageCode:     institutionPatentResult.LanguageCode,
-		PatentTitle:      institutionPatentResult.PatentTitle,
-		Discipline:       institutionPatentResult.Discipline,
-		Description:      institutionPatentResult.Discipline,
-		ImplementedIn:    institutionPatentResult.ImplementedIn,
-		LinkToPatentFile: institutionPatentResult.LinkToPatentFile,
-		CreatedAt:        institutionPatentResult.CreatedAt.Time,
-		UpdatedAt:        institutionPatentResult.UpdatedAt.Time,
+		ID:                 institutionPatentResult.ID,
+		InstitutionID:      institutionPatentResult.InstitutionID,
+		LanguageCode:       institutionPatentResult.LanguageCode,
+		TranslationGroupID: institutionPatentResult.TranslationGroupID.String(),
+		PatentTitle:        institutionPatentResult.PatentTitle,
+		Discipline:         institutionPatentResult.Discipline,
+		Description:        institutionPatentResult.Discipline,
+		ImplementedIn:      institutionPatentResult.ImplementedIn,
+		LinkToPatentFile:   institutionPatentResult.LinkToPatentFile,
+		CreatedAt:          institutionPatentResult.CreatedAt.Time,
+		UpdatedAt:          institutionPatentResult.UpdatedAt.Time,
 	}, nil
 }
 
 func (r *pgInstitutionPatentRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionPatent, error) {
-	if institutionID <= 0 {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution patent institution_id is provided"))
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution patent language_code is provided"))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution patent language_code is provided"))
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionPatentRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionPatent, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution patent institution_id is provided"))
 	}
 
-	institutionPatentsResult, err := r.store.Queries.GetInstitutionPatentsByInstitutionIDAndLanguageCode(ctx, sqlc.GetInstitutionPatentsByInstitutionIDAndLanguageCodeParams{
+	institutionPatentsResult, err := r.store.Queries.GetInstitutionPatentsByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionPatentsByInstitutionIDAndLanguageCodesParams{
 		InstitutionID: institutionID,
-		LanguageCode:  langCode,
+		LanguageCodes: langCodes,
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution patent with given institution_id(%d) and language_code(%s): %w", institutionID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution patent with given institution_id(%d) and language_codes(%v): %w", institutionID, langCodes, err))
 	} else if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -188,16 +201,17 @@ func (r *pgInstitutionPatentRepository) GetByInstitutionIDAndLanguageCode(ctx co
 	institutionPatents := make([]*domain.InstitutionPatent, len(institutionPatentsResult))
 	for index, patent := range institutionPatentsResult {
 		institutionPatents[index] = &domain.InstitutionPatent{
-			ID:               patent.ID,
-			InstitutionID:    patent.InstitutionID,
-			LanguageCode:     patent.LanguageCode,
-			PatentTitle:      patent.PatentTitle,
-			Discipline:       patent.Discipline,
-			Description:      patent.Discipline,
-			ImplementedIn:    patent.ImplementedIn,
-			LinkToPatentFile: patent.LinkToPatentFile,
-			CreatedAt:        patent.CreatedAt.Time,
-			UpdatedAt:        patent.UpdatedAt.Time,
+			ID:                 patent.ID,
+			InstitutionID:      patent.InstitutionID,
+			LanguageCode:       patent.LanguageCode,
+			TranslationGroupID: patent.TranslationGroupID.String(),
+			PatentTitle:        patent.PatentTitle,
+			Discipline:         patent.Discipline,
+			Description:        patent.Discipline,
+			ImplementedIn:      patent.ImplementedIn,
+			LinkToPatentFile:   patent.LinkToPatentFile,
+			CreatedAt:          patent.CreatedAt.Time,
+			UpdatedAt:          patent.UpdatedAt.Time,
 		}
 	}
 
@@ -5,6 +5,7 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"database/sql"
 	"errors"
@@ -28,7 +29,7 @@ func (r *pgInstitutionProjectRepository) Create(ctx context.Context, institution
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution project instituion_id is provided"))
 	}
 
-	if institutionProject.LanguageCode != "en" && institutionProject.LanguageCode != "ru" && institutionProject.LanguageCode != "tg" {
+	if !languages.IsEnabled(institutionProject.LanguageCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution project language_Code is provided"))
 	}
 
@@ -37,6 +38,11 @@ func (r *pgInstitutionProjectRepository) Create(ctx context.Context, institution
 	}
 
 	err := r.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		translationGroupID, err := translationGroupParam(institutionProject.TranslationGroupID)
+		if err != nil {
+			return err
+		}
+
 		institutionProjectResult, err := q.CreateInstitutionProject(ctx, sqlc.CreateInstitutionProjectParams{
 			InstitutionID: institutionProject.InstitutionID,
 			LanguageCode:  institutionProject.LanguageCode,
@@ -50,31 +56,40 @@ func (r *pgInstitutionProjectRepository) Create(ctx context.Context, institution
 				Time:  institutionProject.DateEnd,
 				Valid: !institutionProject.DateEnd.IsZero(),
 			},
-			Fund:            institutionProject.Fund,
-			InstitutionRole: institutionProject.InstitutionRole,
-			Coordinator:     institutionProject.Coordinator,
+			Fund:               institutionProject.Fund,
+			InstitutionRole:    institutionProject.InstitutionRole,
+			Coordinator:        institutionProject.Coordinator,
+			TranslationGroupID: translationGroupID,
 		})
 		if err != nil {
-			return custom_errors.InternalServerError(fmt.Errorf("failed to create institution project: %w", err))
+			return translationGroupError(fmt.Errorf("failed to create institution project: %w", err))
 		}
 
 		institutionProject.ID = institutionProjectResult.ID
+		institutionProject.TranslationGroupID = translationGroupID.String()
 		institutionProject.CreatedAt = institutionProjectResult.CreatedAt.Time
 		institutionProject.UpdatedAt = institutionProjectResult.UpdatedAt.Time
 
 		for index, projectPartner := range institutionProject.Partners {
+			translationGroupID, err := translationGroupParam(projectPartner.TranslationGroupID)
+			if err != nil {
+				return err
+			}
+
 			institutionProjectPartnerResult, err := q.CreateInstitutionProjectPartner(ctx, sqlc.CreateInstitutionProjectPartnerParams{
 				InstitutionProjectID: institutionProject.ID,
 				LanguageCode:         projectPartner.LanguageCode,
 				PartnerName:          projectPartner.PartnerName,
 				PartnerType:          projectPartner.PartnerType,
 				LinkToPartner:        projectPartner.LinkToPartner,
+				TranslationGroupID:   translationGroupID,
 			})
 			if err != nil {
-				return custom_errors.InternalServerError(fmt.Errorf("failed to create institution project partner: %w", err))
+				return translationGroupError(fmt.Errorf("failed to create institution project partner: %w", err))
 			}
 
 			institutionProject.Partners[index].ID = institutionProjectPartnerResult.ID
+			institutionProject.Partners[index].TranslationGroupID = translationGroupID.String()
 			institutionProject.Partners[index].CreatedAt = institutionProjectPartnerResult.CreatedAt.Time
 			institutionProject.Partners[index].UpdatedAt = institutionProjectPartnerResult.UpdatedAt.Time
 		}
@@ -270,6 +285,7 @@ func (r *pgInstitutionProjectRepository) GetByID(ctx context.Context, id int64)
 				ID:                   projectPartner.ID,
 				InstitutionProjectID: projectPartner.InstitutionProjectID,
 				LanguageCode:         projectPartner.LanguageCode,
+				TranslationGroupID:   projectPartner.TranslationGroupID.String(),
 				PartnerType:          projectPartner.PartnerType,
 				PartnerName:          projectPartner.PartnerName,
 				LinkToPartner:        projectPartner.LinkToPartner,
@@ -279,19 +295,20 @@ func (r *pgInstitutionProjectRepository) GetByID(ctx context.Context, id int64)
 		}
 
 		institutionProject = &domain.InstitutionProject{
-			ID:              institutionProjectResult.ID,
-			InstitutionID:   institutionProjectResult.InstitutionID,
-			LanguageCode:    institutionProjectResult.LanguageCode,
-			ProjectType:     institutionProjectResult.ProjectType,
-			ProjectTitle:    institutionProjectResult.ProjectTitle,
-			DateStart:       institutionProjectResult.DateStart.Time,
-			DateEnd:         institutionProjectResult.DateEnd.Time,
-			Fund:            institutionProjectResult.Fund,
-			InstitutionRole: institutionProjectResult.InstitutionRole,
-			Coordinator:     institutionProjectResult.Coordinator,
-			Partners:        institutionProjectPartners,
-			CreatedAt:       institutionProjectResult.CreatedAt.Time,
-			UpdatedAt:       institutionProjectResult.UpdatedAt.Time,
+			ID:                 institutionProjectResult.ID,
+			InstitutionID:      institutionProjectResult.InstitutionID,
+			LanguageCode:       institutionProjectResult.LanguageCode,
+			TranslationGroupID: institutionProjectResult.TranslationGroupID.String(),
+			ProjectType:        institutionProjectResult.ProjectType,
+			ProjectTitle:       institutionProjectResult.ProjectTitle,
+			DateStart:          institutionProjectResult.DateStart.Time,
+			DateEnd:            institutionProjectResult.DateEnd.Time,
+			Fund:               institutionProjectResult.Fund,
+			InstitutionRole:    institutionProjectResult.InstitutionRole,
+			Coordinator:        institutionProjectResult.Coordinator,
+			Partners:           institutionProjectPartners,
+			CreatedAt:          institutionProjectResult.CreatedAt.Time,
+			UpdatedAt:          institutionProjectResult.UpdatedAt.Time,
 		}
 
 		return nil
@@ -304,27 +321,31 @@ func (r *pgInstitutionProjectRepository) GetByID(ctx context.Context, id int64)
 }
 
 func (r *pgInstitutionProjectRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionProject, error) {
-	if institutionID <= 0 {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution project institution_id is provided"))
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution project language_code is provided"))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution project language_code is provided"))
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionProjectRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionProject, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution project institution_id is provided"))
 	}
 
 	var institutionProjects []*domain.InstitutionProject
 	err := r.store.ExecTx(ctx, func(q *sqlc.Queries) error {
-		institutionProjectsResult, err := r.store.Queries.GetInstitutionProjectsByInstitutionIDAndLanguageCode(ctx, sqlc.GetInstitutionProjectsByInstitutionIDAndLanguageCodeParams{
+		institutionProjectsResult, err := r.store.Queries.GetInstitutionProjectsByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionProjectsByInstitutionIDAndLanguageCodesParams{
 			InstitutionID: institutionID,
-			LanguageCode:  langCode,
+			LanguageCodes: langCodes,
 		})
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
-			return custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution project with given institution_id(%d) and language_code(%s): %w", institutionID, langCode, err))
+			return custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution project with given institution_id(%d) and language_codes(%v): %w", institutionID, langCodes, err))
 		} else if errors.Is(err, sql.ErrNoRows) {
 			return nil
 		}
 
-    institutionProjects = make([]*domain.InstitutionProject, len(institutionProjectsResult))
+		institutionProjects = make([]*domain.InstitutionProject, len(institutionProjectsResult))
 		for index, project := range institutionProjectsResult {
 			institutionProjectPartnersResult, err := q.GetInstitutionProjectPartnersByInstitutionProjectIDAndLanguageCode(ctx, sqlc.GetInstitutionProjectPartnersByInstitutionProjectIDAndLanguageCodeParams{
 				InstitutionProjectID: project.ID,
@@ -342,6 +363,7 @@ func (r *pgInstitutionProjectRepository) GetByInstitutionIDAndLanguageCode(ctx c
 					ID:                   projectPartner.ID,
 					InstitutionProjectID: projectPartner.InstitutionProjectID,
 					LanguageCode:         projectPartner.LanguageCode,
+					TranslationGroupID:   projectPartner.TranslationGroupID.String(),
 					PartnerType:          projectPartner.PartnerType,
 					PartnerName:          projectPartner.PartnerName,
 					LinkToPartner:        projectPartner.LinkToPartner,
@@ -351,19 +373,20 @@ func (r *pgInstitutionProjectRepository) GetByInstitutionIDAndLanguageCode(ctx c
 			}
 
 			institutionProjects[index] = &domain.InstitutionProject{
-				ID:              project.ID,
-				InstitutionID:   project.InstitutionID,
-				LanguageCode:    project.LanguageCode,
-				ProjectType:     project.ProjectType,
-				ProjectTitle:    project.ProjectTitle,
-				DateStart:       project.DateStart.Time,
-				DateEnd:         project.DateEnd.Time,
-				Fund:            project.Fund,
-				InstitutionRole: project.InstitutionRole,
-				Coordinator:     project.Coordinator,
-				Partners:        institutionProjectPartners,
-				CreatedAt:       project.CreatedAt.Time,
-				UpdatedAt:       project.UpdatedAt.Time,
+				ID:                 project.ID,
+				InstitutionID:      project.InstitutionID,
+				LanguageCode:       project.LanguageCode,
+				TranslationGroupID: project.TranslationGroupID.String(),
+				ProjectType:        project.ProjectType,
+				ProjectTitle:       project.ProjectTitle,
+				DateStart:          project.DateStart.Time,
+				DateEnd:            project.DateEnd.Time,
+				Fund:               project.Fund,
+				InstitutionRole:    project.InstitutionRole,
+				Coordinator:        project.Coordinator,
+				Partners:           institutionProjectPartners,
+				CreatedAt:          project.CreatedAt.Time,
+				UpdatedAt:          project.UpdatedAt.Time,
 			}
 		}
 
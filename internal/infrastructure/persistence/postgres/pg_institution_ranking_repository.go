@@ -5,6 +5,7 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"database/sql"
 	"errors"
@@ -28,10 +29,15 @@ func (r *pgInstitutionRankingRepository) Create(ctx context.Context, institution
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution ranking instituion_id is provided"))
 	}
 
-	if institutionRanking.LanguageCode != "en" && institutionRanking.LanguageCode != "ru" && institutionRanking.LanguageCode != "tg" {
+	if !languages.IsEnabled(institutionRanking.LanguageCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution ranking language_Code is provided"))
 	}
 
+	translationGroupID, err := translationGroupParam(institutionRanking.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	institutionRankingResult, err := r.store.Queries.CreateInstitutionRanking(ctx, sqlc.CreateInstitutionRankingParams{
 		InstitutionID: institutionRanking.InstitutionID,
 		LanguageCode:  institutionRanking.LanguageCode,
@@ -48,12 +54,14 @@ func (r *pgInstitutionRankingRepository) Create(ctx context.Context, institution
 			Valid:  institutionRanking.Description != "",
 		},
 		LinkToRankingAgency: institutionRanking.LinkToRankingAgency,
+		TranslationGroupID:  translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create institution ranking: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create institution ranking: %w", err))
 	}
 
 	institutionRanking.ID = institutionRankingResult.ID
+	institutionRanking.TranslationGroupID = translationGroupID.String()
 	institutionRanking.CreatedAt = institutionRankingResult.CreatedAt.Time
 	institutionRanking.UpdatedAt = institutionRankingResult.UpdatedAt.Time
 
@@ -181,6 +189,7 @@ func (r *pgInstitutionRankingRepository) GetByID(ctx context.Context, id int64)
 		ID:                  institutionRankingResult.ID,
 		InstitutionID:       institutionRankingResult.InstitutionID,
 		LanguageCode:        institutionRankingResult.LanguageCode,
+		TranslationGroupID:  institutionRankingResult.TranslationGroupID.String(),
 		RankingTitle:        institutionRankingResult.RankingTitle,
 		RankingType:         institutionRankingResult.RankingType,
 		DateReceived:        institutionRankingResult.DateRecieved.Time,
@@ -194,20 +203,24 @@ func (r *pgInstitutionRankingRepository) GetByID(ctx context.Context, id int64)
 }
 
 func (r *pgInstitutionRankingRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionRanking, error) {
-	if institutionID <= 0 {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution ranking institution_id is provided"))
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution ranking language_code is provided"))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution ranking language_code is provided"))
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionRankingRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionRanking, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution ranking institution_id is provided"))
 	}
 
-	institutionRankingsResult, err := r.store.Queries.GetInstitutionRankingsByInstitutionIDAndLanguageCode(ctx, sqlc.GetInstitutionRankingsByInstitutionIDAndLanguageCodeParams{
+	institutionRankingsResult, err := r.store.Queries.GetInstitutionRankingsByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionRankingsByInstitutionIDAndLanguageCodesParams{
 		InstitutionID: institutionID,
-		LanguageCode:  langCode,
+		LanguageCodes: langCodes,
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution ranking with given institution_id(%d) and language_code(%s): %w", institutionID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution ranking with given institution_id(%d) and language_codes(%v): %w", institutionID, langCodes, err))
 	} else if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -218,6 +231,7 @@ func (r *pgInstitutionRankingRepository) GetByInstitutionIDAndLanguageCode(ctx c
 			ID:                  ranking.ID,
 			InstitutionID:       ranking.InstitutionID,
 			LanguageCode:        ranking.LanguageCode,
+			TranslationGroupID:  ranking.TranslationGroupID.String(),
 			RankingTitle:        ranking.RankingTitle,
 			RankingType:         ranking.RankingType,
 			DateReceived:        ranking.DateRecieved.Time,
@@ -106,9 +106,9 @@ func (r *pgInstitutionRepository) Delete(ctx context.Context, id int64) error {
 }
 
 func (r *pgInstitutionRepository) GetByID(ctx context.Context, id int64, langCode string) (*domain.Institution, error) {
-	institutionResult, err := r.store.Queries.GetInstitutionByID(ctx, id)
+	institutionResult, err := r.queries.GetInstitutionByID(ctx, id)
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution with given ID(%d)", id))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution with given ID(%d): %w", id, err))
 	}
 
 	return &domain.Institution{
@@ -147,3 +147,15 @@ func (r *pgInstitutionRepository) GetAllInstitutions(ctx context.Context) ([]*do
 
 	return institutions, nil
 }
+
+func (r *pgInstitutionRepository) IsAdmin(ctx context.Context, institutionID int64, userID int64) (bool, error) {
+	isAdmin, err := r.queries.IsInstitutionAdmin(ctx, sqlc.IsInstitutionAdminParams{
+		InstitutionID: institutionID,
+		UserID:        userID,
+	})
+	if err != nil {
+		return false, custom_errors.InternalServerError(fmt.Errorf("failed to check whether user(%d) is admin of institution(%d): %w", userID, institutionID, err))
+	}
+
+	return isAdmin, nil
+}
@@ -5,6 +5,7 @@ import (
 	"backend/internal/domain"
 	"backend/internal/infrastructure/persistence/postgres/sqlc"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"context"
 	"database/sql"
 	"errors"
@@ -26,22 +27,29 @@ func (r *pgInstitutionResearchSupportInfrastructureRepository) Create(ctx contex
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution research support infrastructure instituion_id is provided"))
 	}
 
-	if institutionRSI.LanguageCode != "en" && institutionRSI.LanguageCode != "ru" && institutionRSI.LanguageCode != "tg" {
+	if !languages.IsEnabled(institutionRSI.LanguageCode) {
 		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution research support infrastructure language_Code is provided"))
 	}
 
+	translationGroupID, err := translationGroupParam(institutionRSI.TranslationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
 	institutionRSIResult, err := r.store.Queries.CreateInstitutionResearchSupportInfrastructure(ctx, sqlc.CreateInstitutionResearchSupportInfrastructureParams{
 		InstitutionID:                      institutionRSI.InstitutionID,
 		LanguageCode:                       institutionRSI.LanguageCode,
 		ResearchSupportInfrastructureTitle: institutionRSI.ResearchSupportInfrastructureTitle,
 		ResearchSupportInfrastructureType:  institutionRSI.ResearchSupportInfrastructureType,
 		TinOfLegalEntity:                   institutionRSI.TINOfLegalEntity,
+		TranslationGroupID:                 translationGroupID,
 	})
 	if err != nil {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create institution research support infrastructure: %w", err))
+		return nil, translationGroupError(fmt.Errorf("failed to create institution research support infrastructure: %w", err))
 	}
 
 	institutionRSI.ID = institutionRSIResult.ID
+	institutionRSI.TranslationGroupID = translationGroupID.String()
 	institutionRSI.CreatedAt = institutionRSIResult.CreatedAt.Time
 	institutionRSI.UpdatedAt = institutionRSIResult.UpdatedAt.Time
 
@@ -144,6 +152,7 @@ func (r *pgInstitutionResearchSupportInfrastructureRepository) GetByID(ctx conte
 		ID:                                 institutionRSIResult.ID,
 		InstitutionID:                      institutionRSIResult.InstitutionID,
 		LanguageCode:                       institutionRSIResult.LanguageCode,
+		TranslationGroupID:                 institutionRSIResult.TranslationGroupID.String(),
 		ResearchSupportInfrastructureTitle: institutionRSIResult.ResearchSupportInfrastructureTitle,
 		ResearchSupportInfrastructureType:  institutionRSIResult.ResearchSupportInfrastructureType,
 		TINOfLegalEntity:                   institutionRSIResult.TinOfLegalEntity,
@@ -153,20 +162,24 @@ func (r *pgInstitutionResearchSupportInfrastructureRepository) GetByID(ctx conte
 }
 
 func (r *pgInstitutionResearchSupportInfrastructureRepository) GetByInstitutionIDAndLanguageCode(ctx context.Context, institutionID int64, langCode string) ([]*domain.InstitutionResearchSupportInfrastructure, error) {
-	if institutionID <= 0 {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution research support infrastructure institution_id is provided"))
+	if !languages.IsEnabled(langCode) {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution research support infrastructure language_code is provided"))
 	}
 
-	if langCode != "en" && langCode != "ru" && langCode != "tg" {
-		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution research support infrastructure language_code is provided"))
+	return r.GetByInstitutionIDAndLanguageCodes(ctx, institutionID, []string{langCode})
+}
+
+func (r *pgInstitutionResearchSupportInfrastructureRepository) GetByInstitutionIDAndLanguageCodes(ctx context.Context, institutionID int64, langCodes []string) ([]*domain.InstitutionResearchSupportInfrastructure, error) {
+	if institutionID <= 0 {
+		return nil, custom_errors.BadRequest(fmt.Errorf("invalid institution research support infrastructure institution_id is provided"))
 	}
 
-	institutionRSIsResult, err := r.store.Queries.GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCode(ctx, sqlc.GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodeParams{
+	institutionRSIsResult, err := r.store.Queries.GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodes(ctx, sqlc.GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodesParams{
 		InstitutionID: institutionID,
-		LanguageCode:  langCode,
+		LanguageCodes: langCodes,
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution research support infrastructure with given institution_id(%d) and language_code(%s): %w", institutionID, langCode, err))
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution research support infrastructure with given institution_id(%d) and language_codes(%v): %w", institutionID, langCodes, err))
 	} else if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -177,6 +190,7 @@ func (r *pgInstitutionResearchSupportInfrastructureRepository) GetByInstitutionI
 			ID:                                 rsi.ID,
 			InstitutionID:                      rsi.InstitutionID,
 			LanguageCode:                       rsi.LanguageCode,
+			TranslationGroupID:                 rsi.TranslationGroupID.String(),
 			ResearchSupportInfrastructureTitle: rsi.ResearchSupportInfrastructureTitle,
 			ResearchSupportInfrastructureType:  rsi.ResearchSupportInfrastructureType,
 			TINOfLegalEntity:                   rsi.TinOfLegalEntity,
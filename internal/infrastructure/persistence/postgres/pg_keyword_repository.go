@@ -0,0 +1,172 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+	"strings"
+)
+
+type pgKeywordRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgKeywordRepository(store *Store) repositories.KeywordRepository {
+	return &pgKeywordRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgKeywordRepositoryWithQuery(q *sqlc.Queries) repositories.KeywordRepository {
+	return &pgKeywordRepository{
+		queries: q,
+	}
+}
+
+func (r *pgKeywordRepository) FindOrCreate(ctx context.Context, langCode string, label string) (int64, error) {
+	label = strings.TrimSpace(label)
+	keywordID, err := r.queries.GetKeywordIDByLabel(ctx, sqlc.GetKeywordIDByLabelParams{
+		LanguageCode: langCode,
+		Label:        label,
+	})
+	if err == nil {
+		return keywordID, nil
+	}
+	if !custom_errors.IsNotFound(err) {
+		return 0, custom_errors.InternalServerError(fmt.Errorf("failed to retrive keyword(%s) in language(%s): %w", label, langCode, err))
+	}
+
+	keywordID, err = r.queries.CreateKeyword(ctx)
+	if err != nil {
+		return 0, custom_errors.InternalServerError(fmt.Errorf("failed to create keyword(%s): %w", label, err))
+	}
+
+	if err := r.queries.CreateKeywordLabel(ctx, sqlc.CreateKeywordLabelParams{
+		KeywordID:    keywordID,
+		LanguageCode: langCode,
+		Label:        label,
+		IsPreferred:  true,
+	}); err != nil {
+		return 0, custom_errors.InternalServerError(fmt.Errorf("failed to create label(%s) of keyword(%d): %w", label, keywordID, err))
+	}
+
+	return keywordID, nil
+}
+
+func (r *pgKeywordRepository) GetByIDs(ctx context.Context, ids []int64) ([]*domain.Keyword, error) {
+	keywordsResult, err := r.queries.GetKeywordsByIDs(ctx, ids)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive keywords: %w", err))
+	}
+
+	keywords := make([]*domain.Keyword, len(keywordsResult))
+	for index, keyword := range keywordsResult {
+		keywords[index] = &domain.Keyword{
+			ID:            keyword.ID,
+			Labels:        map[string]string{},
+			Synonyms:      map[string][]string{},
+			EmployeeCount: keyword.EmployeeCount,
+			CreatedAt:     keyword.CreatedAt.Time,
+			UpdatedAt:     keyword.UpdatedAt.Time,
+		}
+	}
+
+	if err := r.loadLabels(ctx, keywords); err != nil {
+		return nil, err
+	}
+
+	return keywords, nil
+}
+
+func (r *pgKeywordRepository) SearchByPrefix(ctx context.Context, prefix string, limit int32) ([]*domain.Keyword, error) {
+	keywordsResult, err := r.queries.SearchKeywordsByPrefix(ctx, sqlc.SearchKeywordsByPrefixParams{
+		Prefix: prefix,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to search keywords by prefix(%s): %w", prefix, err))
+	}
+
+	keywords := make([]*domain.Keyword, len(keywordsResult))
+	for index, keyword := range keywordsResult {
+		keywords[index] = &domain.Keyword{
+			ID:            keyword.ID,
+			Labels:        map[string]string{},
+			Synonyms:      map[string][]string{},
+			EmployeeCount: keyword.EmployeeCount,
+			CreatedAt:     keyword.CreatedAt.Time,
+			UpdatedAt:     keyword.UpdatedAt.Time,
+		}
+	}
+
+	if err := r.loadLabels(ctx, keywords); err != nil {
+		return nil, err
+	}
+
+	return keywords, nil
+}
+
+func (r *pgKeywordRepository) Merge(ctx context.Context, targetID int64, sourceIDs []int64) error {
+	if err := r.queries.MoveKeywordLabels(ctx, sqlc.MoveKeywordLabelsParams{
+		TargetID:  targetID,
+		SourceIds: sourceIDs,
+	}); err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to move labels to keyword(%d): %w", targetID, err))
+	}
+
+	if err := r.queries.MoveKeywordTopics(ctx, sqlc.MoveKeywordTopicsParams{
+		TargetID:  targetID,
+		SourceIds: sourceIDs,
+	}); err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to move key topics to keyword(%d): %w", targetID, err))
+	}
+
+	if err := r.queries.DeleteKeywordsByIDs(ctx, sourceIDs); err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to delete merged keywords: %w", err))
+	}
+
+	if err := r.queries.PreferKeywordLabels(ctx, targetID); err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to pick preferred labels of keyword(%d): %w", targetID, err))
+	}
+
+	return nil
+}
+
+// loadLabels fills the preferred labels and synonyms of the keywords
+func (r *pgKeywordRepository) loadLabels(ctx context.Context, keywords []*domain.Keyword) error {
+	if len(keywords) == 0 {
+		return nil
+	}
+
+	keywordIDs := make([]int64, len(keywords))
+	keywordsByID := make(map[int64]*domain.Keyword, len(keywords))
+	for index, keyword := range keywords {
+		keywordIDs[index] = keyword.ID
+		keywordsByID[keyword.ID] = keyword
+	}
+
+	labels, err := r.queries.GetKeywordLabelsByKeywordIDs(ctx, keywordIDs)
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to retrive labels of keywords: %w", err))
+	}
+
+	for _, label := range labels {
+		keyword, ok := keywordsByID[label.KeywordID]
+		if !ok {
+			continue
+		}
+
+		if label.IsPreferred {
+			keyword.Labels[label.LanguageCode] = label.Label
+		} else {
+			keyword.Synonyms[label.LanguageCode] = append(keyword.Synonyms[label.LanguageCode], label.Label)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+)
+
+type pgLanguageRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgLanguageRepository(store *Store) repositories.LanguageRepository {
+	return &pgLanguageRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgLanguageRepositoryWithQuery(q *sqlc.Queries) repositories.LanguageRepository {
+	return &pgLanguageRepository{
+		queries: q,
+	}
+}
+
+func (r *pgLanguageRepository) GetAll(ctx context.Context) ([]*domain.Language, error) {
+	languagesResult, err := r.queries.GetAllLanguages(ctx)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive languages: %w", err))
+	}
+
+	languages := make([]*domain.Language, len(languagesResult))
+	for index, language := range languagesResult {
+		languages[index] = &domain.Language{
+			Code:       language.Code,
+			Name:       language.Name,
+			NativeName: language.NativeName,
+			Enabled:    language.Enabled,
+			IsDefault:  language.IsDefault,
+			SortOrder:  language.SortOrder,
+			CreatedAt:  language.CreatedAt.Time,
+			UpdatedAt:  language.UpdatedAt.Time,
+		}
+	}
+
+	return languages, nil
+}
@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type pgOrgUnitRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgOrgUnitRepository(store *Store) repositories.OrgUnitRepository {
+	return &pgOrgUnitRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgOrgUnitRepositoryWithQuery(q *sqlc.Queries) repositories.OrgUnitRepository {
+	return &pgOrgUnitRepository{
+		queries: q,
+	}
+}
+
+func (r *pgOrgUnitRepository) Create(ctx context.Context, orgUnit *domain.OrgUnit) (*domain.OrgUnit, error) {
+	orgUnitResult, err := r.queries.CreateOrgUnit(ctx, sqlc.CreateOrgUnitParams{
+		InstitutionID: orgUnit.InstitutionID,
+		ParentID: pgtype.Int8{
+			Int64: orgUnit.ParentID,
+			Valid: orgUnit.ParentID != 0,
+		},
+		UnitType:  orgUnit.UnitType,
+		SortOrder: orgUnit.SortOrder,
+	})
+	if err != nil {
+		if custom_errors.IsForeignKeyViolationError(err) {
+			return nil, custom_errors.BadRequest(fmt.Errorf("parent unit(%d) does not exist in institution(%d): %w", orgUnit.ParentID, orgUnit.InstitutionID, err))
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create organizational unit: %w", err))
+	}
+
+	orgUnit.ID = orgUnitResult.ID
+	orgUnit.CreatedAt = orgUnitResult.CreatedAt.Time
+	orgUnit.UpdatedAt = orgUnitResult.UpdatedAt.Time
+
+	if err := r.saveNames(ctx, orgUnit); err != nil {
+		return nil, err
+	}
+
+	return orgUnit, nil
+}
+
+func (r *pgOrgUnitRepository) Update(ctx context.Context, orgUnit *domain.OrgUnit) (*domain.OrgUnit, error) {
+	orgUnitResult, err := r.queries.UpdateOrgUnit(ctx, sqlc.UpdateOrgUnitParams{
+		ID: orgUnit.ID,
+		ParentID: pgtype.Int8{
+			Int64: orgUnit.ParentID,
+			Valid: orgUnit.ParentID != 0,
+		},
+		UnitType:  orgUnit.UnitType,
+		SortOrder: orgUnit.SortOrder,
+	})
+	if err != nil {
+		if custom_errors.IsForeignKeyViolationError(err) {
+			return nil, custom_errors.BadRequest(fmt.Errorf("parent unit(%d) does not exist in institution(%d): %w", orgUnit.ParentID, orgUnit.InstitutionID, err))
+		}
+		if custom_errors.IsNotFound(err) {
+			return nil, custom_errors.NotFound(fmt.Errorf("organizational unit with given ID(%d) does not exist: %w", orgUnit.ID, err))
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to update organizational unit(%d): %w", orgUnit.ID, err))
+	}
+
+	orgUnit.CreatedAt = orgUnitResult.CreatedAt.Time
+	orgUnit.UpdatedAt = orgUnitResult.UpdatedAt.Time
+
+	if err := r.saveNames(ctx, orgUnit); err != nil {
+		return nil, err
+	}
+
+	languageCodes := make([]string, 0, len(orgUnit.Names))
+	for languageCode := range orgUnit.Names {
+		languageCodes = append(languageCodes, languageCode)
+	}
+
+	err = r.queries.DeleteOrgUnitNamesExceptLanguages(ctx, sqlc.DeleteOrgUnitNamesExceptLanguagesParams{
+		OrgUnitID:     orgUnit.ID,
+		LanguageCodes: languageCodes,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to remove names of organizational unit(%d): %w", orgUnit.ID, err))
+	}
+
+	return orgUnit, nil
+}
+
+func (r *pgOrgUnitRepository) saveNames(ctx context.Context, orgUnit *domain.OrgUnit) error {
+	for languageCode, name := range orgUnit.Names {
+		err := r.queries.UpsertOrgUnitName(ctx, sqlc.UpsertOrgUnitNameParams{
+			OrgUnitID:    orgUnit.ID,
+			LanguageCode: languageCode,
+			Name:         name,
+		})
+		if err != nil {
+			if custom_errors.IsForeignKeyViolationError(err) {
+				return custom_errors.BadRequest(fmt.Errorf("language(%s) of organizational unit name does not exist: %w", languageCode, err))
+			}
+
+			return custom_errors.InternalServerError(fmt.Errorf("failed to save name of organizational unit(%d) in language(%s): %w", orgUnit.ID, languageCode, err))
+		}
+	}
+
+	return nil
+}
+
+func (r *pgOrgUnitRepository) Delete(ctx context.Context, id int64) error {
+	if err := r.queries.DeleteOrgUnit(ctx, id); err != nil {
+		if custom_errors.IsForeignKeyViolationError(err) {
+			return custom_errors.BadRequest(fmt.Errorf("organizational unit(%d) has sub-units, they must be removed or moved first: %w", id, err))
+		}
+
+		return custom_errors.InternalServerError(fmt.Errorf("failed to delete organizational unit(%d): %w", id, err))
+	}
+
+	return nil
+}
+
+func (r *pgOrgUnitRepository) GetByID(ctx context.Context, id int64) (*domain.OrgUnit, error) {
+	orgUnitResult, err := r.queries.GetOrgUnitByID(ctx, id)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, custom_errors.NotFound(fmt.Errorf("organizational unit with given ID(%d) does not exist: %w", id, err))
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive organizational unit with given ID(%d): %w", id, err))
+	}
+
+	namesResult, err := r.queries.GetOrgUnitNamesByOrgUnitID(ctx, id)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive names of organizational unit(%d): %w", id, err))
+	}
+
+	orgUnit := mapOrgUnit(orgUnitResult)
+	for _, name := range namesResult {
+		orgUnit.Names[name.LanguageCode] = name.Name
+	}
+
+	return orgUnit, nil
+}
+
+func (r *pgOrgUnitRepository) GetByInstitutionID(ctx context.Context, institutionID int64) ([]*domain.OrgUnit, error) {
+	orgUnitsResult, err := r.queries.GetOrgUnitsByInstitutionID(ctx, institutionID)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive organizational units of institution(%d): %w", institutionID, err))
+	}
+
+	namesResult, err := r.queries.GetOrgUnitNamesByInstitutionID(ctx, institutionID)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive names of organizational units of institution(%d): %w", institutionID, err))
+	}
+
+	orgUnits := make([]*domain.OrgUnit, len(orgUnitsResult))
+	orgUnitsByID := make(map[int64]*domain.OrgUnit, len(orgUnitsResult))
+	for index, orgUnitResult := range orgUnitsResult {
+		orgUnits[index] = mapOrgUnit(orgUnitResult)
+		orgUnitsByID[orgUnitResult.ID] = orgUnits[index]
+	}
+
+	for _, name := range namesResult {
+		if orgUnit, ok := orgUnitsByID[name.OrgUnitID]; ok {
+			orgUnit.Names[name.LanguageCode] = name.Name
+		}
+	}
+
+	return orgUnits, nil
+}
+
+func (r *pgOrgUnitRepository) IsInSubtree(ctx context.Context, rootID int64, id int64) (bool, error) {
+	inSubtree, err := r.queries.IsOrgUnitInSubtree(ctx, sqlc.IsOrgUnitInSubtreeParams{
+		RootID:    rootID,
+		OrgUnitID: id,
+	})
+	if err != nil {
+		return false, custom_errors.InternalServerError(fmt.Errorf("failed to check whether organizational unit(%d) lies below unit(%d): %w", id, rootID, err))
+	}
+
+	return inSubtree, nil
+}
+
+func mapOrgUnit(orgUnit sqlc.OrgUnit) *domain.OrgUnit {
+	return &domain.OrgUnit{
+		ID:            orgUnit.ID,
+		InstitutionID: orgUnit.InstitutionID,
+		ParentID:      orgUnit.ParentID.Int64,
+		UnitType:      orgUnit.UnitType,
+		SortOrder:     orgUnit.SortOrder,
+		Names:         map[string]string{},
+		CreatedAt:     orgUnit.CreatedAt.Time,
+		UpdatedAt:     orgUnit.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+)
+
+type pgPublicationCitationRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgPublicationCitationRepository(store *Store) repositories.PublicationCitationRepository {
+	return &pgPublicationCitationRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgPublicationCitationRepositoryWithQuery(q *sqlc.Queries) repositories.PublicationCitationRepository {
+	return &pgPublicationCitationRepository{
+		queries: q,
+	}
+}
+
+func (r *pgPublicationCitationRepository) Upsert(ctx context.Context, citation *domain.PublicationCitation) (*domain.PublicationCitation, error) {
+	citationResult, err := r.queries.UpsertPublicationCitation(ctx, sqlc.UpsertPublicationCitationParams{
+		PublicationID: citation.PublicationID,
+		CitationCount: citation.CitationCount,
+		Source:        citation.Source,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to set citation count of publication(%d): %w", citation.PublicationID, err))
+	}
+
+	return mapPublicationCitationRow(citationResult), nil
+}
+
+func (r *pgPublicationCitationRepository) GetByEmployeeID(ctx context.Context, employeeID int64) ([]*domain.PublicationCitation, error) {
+	citationsResult, err := r.queries.GetPublicationCitationsByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive publication citations of employee(%d): %w", employeeID, err))
+	}
+
+	citations := make([]*domain.PublicationCitation, len(citationsResult))
+	for index, citationResult := range citationsResult {
+		citations[index] = mapPublicationCitationRow(citationResult)
+	}
+
+	return citations, nil
+}
+
+func (r *pgPublicationCitationRepository) ListImportCandidates(ctx context.Context, employeeID int64) ([]*domain.Publication, error) {
+	candidatesResult, err := r.queries.ListCitationImportCandidatesByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive publications of employee(%d) to import citations for: %w", employeeID, err))
+	}
+
+	publications := make([]*domain.Publication, len(candidatesResult))
+	for index, candidate := range candidatesResult {
+		publications[index] = &domain.Publication{
+			ID:  candidate.ID,
+			DOI: candidate.Doi.String,
+		}
+	}
+
+	return publications, nil
+}
+
+func (r *pgPublicationCitationRepository) GetEmployeeMetrics(ctx context.Context, employeeID int64) (*domain.EmployeeCitationMetrics, error) {
+	metricsResult, err := r.queries.GetEmployeeCitationMetrics(ctx, employeeID)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return &domain.EmployeeCitationMetrics{EmployeeID: employeeID}, nil
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive citation metrics of employee(%d): %w", employeeID, err))
+	}
+
+	return &domain.EmployeeCitationMetrics{
+		EmployeeID:     metricsResult.EmployeeID,
+		HIndex:         metricsResult.HIndex,
+		I10Index:       metricsResult.I10Index,
+		TotalCitations: metricsResult.TotalCitations,
+		UpdatedAt:      metricsResult.UpdatedAt.Time,
+	}, nil
+}
+
+func (r *pgPublicationCitationRepository) RefreshEmployeeMetrics(ctx context.Context, employeeID int64) error {
+	if err := r.queries.RefreshEmployeeCitationMetrics(ctx, employeeID); err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to refresh citation metrics of employee(%d): %w", employeeID, err))
+	}
+
+	return nil
+}
+
+func (r *pgPublicationCitationRepository) RefreshMetricsByPublicationID(ctx context.Context, publicationID int64) error {
+	if err := r.queries.RefreshCitationMetricsByPublicationID(ctx, publicationID); err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to refresh citation metrics of authors of publication(%d): %w", publicationID, err))
+	}
+
+	return nil
+}
+
+func mapPublicationCitationRow(citationResult sqlc.PublicationCitation) *domain.PublicationCitation {
+	return &domain.PublicationCitation{
+		PublicationID: citationResult.PublicationID,
+		CitationCount: citationResult.CitationCount,
+		Source:        citationResult.Source,
+		UpdatedAt:     citationResult.UpdatedAt.Time,
+	}
+}
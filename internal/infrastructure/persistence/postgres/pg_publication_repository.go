@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/bibliography"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type pgPublicationRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgPublicationRepository(store *Store) repositories.PublicationRepository {
+	return &pgPublicationRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgPublicationRepositoryWithQuery(q *sqlc.Queries) repositories.PublicationRepository {
+	return &pgPublicationRepository{
+		queries: q,
+	}
+}
+
+func (r *pgPublicationRepository) Create(ctx context.Context, publication *domain.Publication) (*domain.Publication, error) {
+	doi := bibliography.NormalizeDOI(publication.DOI)
+	publicationResult, err := r.queries.CreatePublication(ctx, sqlc.CreatePublicationParams{
+		PublicationTitle:  publication.PublicationTitle,
+		NormalizedTitle:   bibliography.NormalizeTitle(publication.PublicationTitle),
+		LinkToPublication: publication.LinkToPublication,
+		PublicationYear:   pgtype.Int4{Int32: publication.PublicationYear, Valid: publication.PublicationYear != 0},
+		Venue:             publication.Venue,
+		Doi:               pgtype.Text{String: doi, Valid: doi != ""},
+		PublicationType:   publication.PublicationType,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create publication: %w", err))
+	}
+
+	createdPublication := mapPublicationRow(publicationResult)
+	for index, author := range publication.Authors {
+		authorResult, err := r.queries.CreatePublicationAuthor(ctx, sqlc.CreatePublicationAuthorParams{
+			PublicationID: createdPublication.ID,
+			Position:      int32(index + 1),
+			AuthorName:    author.AuthorName,
+			EmployeeID:    pgtype.Int8{Int64: author.EmployeeID, Valid: author.EmployeeID != 0},
+			ClaimedAt:     pgtype.Timestamptz{Time: author.ClaimedAt, Valid: author.EmployeeID != 0},
+		})
+		if err != nil {
+			return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create author(%s) of publication(%d): %w", author.AuthorName, createdPublication.ID, err))
+		}
+
+		createdPublication.Authors = append(createdPublication.Authors, mapPublicationAuthorRow(authorResult))
+	}
+
+	return createdPublication, nil
+}
+
+func (r *pgPublicationRepository) GetByID(ctx context.Context, id int64) (*domain.Publication, error) {
+	publicationResult, err := r.queries.GetPublicationByID(ctx, id)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, custom_errors.NotFound(fmt.Errorf("publication with given ID(%d) does not exist", id))
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive publication with given ID(%d): %w", id, err))
+	}
+
+	authorsResult, err := r.queries.GetPublicationAuthorsByPublicationID(ctx, id)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive authors of publication(%d): %w", id, err))
+	}
+
+	publication := mapPublicationRow(publicationResult)
+	publication.Authors = make([]*domain.PublicationAuthor, len(authorsResult))
+	for index, author := range authorsResult {
+		publication.Authors[index] = &domain.PublicationAuthor{
+			ID:               author.ID,
+			PublicationID:    author.PublicationID,
+			Position:         author.Position,
+			AuthorName:       author.AuthorName,
+			EmployeeID:       author.EmployeeID.Int64,
+			EmployeeUniqueID: author.EmployeeUniqueID.String,
+			ClaimedAt:        author.ClaimedAt.Time,
+			CreatedAt:        author.CreatedAt.Time,
+			UpdatedAt:        author.UpdatedAt.Time,
+		}
+	}
+
+	return publication, nil
+}
+
+func (r *pgPublicationRepository) FindByDOIOrTitle(ctx context.Context, doi string, title string) (*domain.Publication, error) {
+	normalizedDOI := bibliography.NormalizeDOI(doi)
+	if normalizedDOI != "" {
+		publicationResult, err := r.queries.GetPublicationByDOI(ctx, pgtype.Text{String: normalizedDOI, Valid: true})
+		if err == nil {
+			return mapPublicationRow(publicationResult), nil
+		}
+
+		if !custom_errors.IsNotFound(err) {
+			return nil, custom_errors.InternalServerError(fmt.Errorf("failed to find publication by DOI(%s): %w", normalizedDOI, err))
+		}
+	}
+
+	normalizedTitle := bibliography.NormalizeTitle(title)
+	if normalizedTitle == "" {
+		return nil, nil
+	}
+
+	publicationResult, err := r.queries.GetPublicationByNormalizedTitle(ctx, normalizedTitle)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to find publication by title(%s): %w", title, err))
+	}
+
+	// the same title with a different DOI is a different paper
+	if normalizedDOI != "" && publicationResult.Doi.Valid && publicationResult.Doi.String != normalizedDOI {
+		return nil, nil
+	}
+
+	return mapPublicationRow(publicationResult), nil
+}
+
+func (r *pgPublicationRepository) ListClaimSuggestions(ctx context.Context, employeeID int64, limit int32) ([]*domain.Publication, error) {
+	publicationsResult, err := r.queries.ListPublicationClaimSuggestions(ctx, sqlc.ListPublicationClaimSuggestionsParams{
+		EmployeeID: employeeID,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive publications to claim for EmployeeID(%d): %w", employeeID, err))
+	}
+
+	publications := make([]*domain.Publication, len(publicationsResult))
+	for index, publication := range publicationsResult {
+		publications[index] = mapPublicationRow(publication)
+	}
+
+	return publications, nil
+}
+
+func (r *pgPublicationRepository) GetAuthorByEmployeeID(ctx context.Context, publicationID int64, employeeID int64) (*domain.PublicationAuthor, error) {
+	authorResult, err := r.queries.GetPublicationAuthorByEmployeeID(ctx, sqlc.GetPublicationAuthorByEmployeeIDParams{
+		PublicationID: publicationID,
+		EmployeeID:    pgtype.Int8{Int64: employeeID, Valid: true},
+	})
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive author of publication(%d) claimed by EmployeeID(%d): %w", publicationID, employeeID, err))
+	}
+
+	return mapPublicationAuthorRow(authorResult), nil
+}
+
+func (r *pgPublicationRepository) FindMatchingUnclaimedAuthor(ctx context.Context, publicationID int64, employeeID int64) (*domain.PublicationAuthor, error) {
+	authorResult, err := r.queries.FindMatchingUnclaimedPublicationAuthor(ctx, sqlc.FindMatchingUnclaimedPublicationAuthorParams{
+		PublicationID: publicationID,
+		EmployeeID:    employeeID,
+	})
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to match author of publication(%d) to EmployeeID(%d): %w", publicationID, employeeID, err))
+	}
+
+	return mapPublicationAuthorRow(authorResult), nil
+}
+
+func (r *pgPublicationRepository) AddAuthor(ctx context.Context, author *domain.PublicationAuthor) (*domain.PublicationAuthor, error) {
+	position, err := r.queries.GetNextPublicationAuthorPosition(ctx, author.PublicationID)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive next author position of publication(%d): %w", author.PublicationID, err))
+	}
+
+	authorResult, err := r.queries.CreatePublicationAuthor(ctx, sqlc.CreatePublicationAuthorParams{
+		PublicationID: author.PublicationID,
+		Position:      position,
+		AuthorName:    author.AuthorName,
+		EmployeeID:    pgtype.Int8{Int64: author.EmployeeID, Valid: author.EmployeeID != 0},
+		ClaimedAt:     pgtype.Timestamptz{Time: author.ClaimedAt, Valid: author.EmployeeID != 0},
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to add author(%s) to publication(%d): %w", author.AuthorName, author.PublicationID, err))
+	}
+
+	return mapPublicationAuthorRow(authorResult), nil
+}
+
+func (r *pgPublicationRepository) ClaimAuthor(ctx context.Context, authorID int64, employeeID int64) (bool, error) {
+	affectedRows, err := r.queries.ClaimPublicationAuthor(ctx, sqlc.ClaimPublicationAuthorParams{
+		ID:         authorID,
+		EmployeeID: pgtype.Int8{Int64: employeeID, Valid: true},
+	})
+	if err != nil {
+		return false, custom_errors.InternalServerError(fmt.Errorf("failed to claim publication author(%d) by EmployeeID(%d): %w", authorID, employeeID, err))
+	}
+
+	return affectedRows > 0, nil
+}
+
+func (r *pgPublicationRepository) UnclaimAuthor(ctx context.Context, publicationID int64, employeeID int64) error {
+	err := r.queries.UnclaimPublicationAuthor(ctx, sqlc.UnclaimPublicationAuthorParams{
+		PublicationID: publicationID,
+		EmployeeID:    pgtype.Int8{Int64: employeeID, Valid: true},
+	})
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to unclaim author of publication(%d) by EmployeeID(%d): %w", publicationID, employeeID, err))
+	}
+
+	return nil
+}
+
+func mapPublicationRow(publication sqlc.Publication) *domain.Publication {
+	return &domain.Publication{
+		ID:                publication.ID,
+		PublicationTitle:  publication.PublicationTitle,
+		LinkToPublication: publication.LinkToPublication,
+		PublicationYear:   publication.PublicationYear.Int32,
+		Venue:             publication.Venue,
+		DOI:               publication.Doi.String,
+		PublicationType:   publication.PublicationType,
+		Authors:           []*domain.PublicationAuthor{},
+		CreatedAt:         publication.CreatedAt.Time,
+		UpdatedAt:         publication.UpdatedAt.Time,
+	}
+}
+
+func mapPublicationAuthorRow(author sqlc.PublicationAuthor) *domain.PublicationAuthor {
+	return &domain.PublicationAuthor{
+		ID:            author.ID,
+		PublicationID: author.PublicationID,
+		Position:      author.Position,
+		AuthorName:    author.AuthorName,
+		EmployeeID:    author.EmployeeID.Int64,
+		ClaimedAt:     author.ClaimedAt.Time,
+		CreatedAt:     author.CreatedAt.Time,
+		UpdatedAt:     author.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type pgResearchFieldRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgResearchFieldRepository(store *Store) repositories.ResearchFieldRepository {
+	return &pgResearchFieldRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgResearchFieldRepositoryWithQuery(q *sqlc.Queries) repositories.ResearchFieldRepository {
+	return &pgResearchFieldRepository{
+		queries: q,
+	}
+}
+
+func (r *pgResearchFieldRepository) GetAll(ctx context.Context) ([]*domain.ResearchField, error) {
+	researchFieldsResult, err := r.queries.GetAllResearchFields(ctx)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive research fields: %w", err))
+	}
+
+	namesResult, err := r.queries.GetAllResearchFieldNames(ctx)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive names of research fields: %w", err))
+	}
+
+	researchFields := make([]*domain.ResearchField, len(researchFieldsResult))
+	researchFieldsByCode := make(map[string]*domain.ResearchField, len(researchFieldsResult))
+	for index, researchField := range researchFieldsResult {
+		researchFields[index] = &domain.ResearchField{
+			Code:       researchField.Code,
+			ParentCode: researchField.ParentCode.String,
+			Level:      researchField.Level,
+			SortOrder:  researchField.SortOrder,
+			Names:      map[string]string{},
+			CreatedAt:  researchField.CreatedAt.Time,
+			UpdatedAt:  researchField.UpdatedAt.Time,
+		}
+		researchFieldsByCode[researchField.Code] = researchFields[index]
+	}
+
+	for _, name := range namesResult {
+		if researchField, ok := researchFieldsByCode[name.ResearchFieldCode]; ok {
+			researchField.Names[name.LanguageCode] = name.Name
+		}
+	}
+
+	return researchFields, nil
+}
+
+func (r *pgResearchFieldRepository) GetByCode(ctx context.Context, code string) (*domain.ResearchField, error) {
+	researchField, err := r.queries.GetResearchFieldByCode(ctx, code)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, custom_errors.NotFound(fmt.Errorf("research field(%s) does not exist: %w", code, err))
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive research field(%s): %w", code, err))
+	}
+
+	return &domain.ResearchField{
+		Code:       researchField.Code,
+		ParentCode: researchField.ParentCode.String,
+		Level:      researchField.Level,
+		SortOrder:  researchField.SortOrder,
+		CreatedAt:  researchField.CreatedAt.Time,
+		UpdatedAt:  researchField.UpdatedAt.Time,
+	}, nil
+}
+
+func (r *pgResearchFieldRepository) Exists(ctx context.Context, code string) (bool, error) {
+	exists, err := r.queries.IsResearchFieldExisting(ctx, code)
+	if err != nil {
+		return false, custom_errors.InternalServerError(fmt.Errorf("failed to check research field(%s): %w", code, err))
+	}
+
+	return exists, nil
+}
+
+func (r *pgResearchFieldRepository) Upsert(ctx context.Context, researchField *domain.ResearchField) (bool, error) {
+	isCreated, err := r.queries.UpsertResearchField(ctx, sqlc.UpsertResearchFieldParams{
+		Code:      researchField.Code,
+		Level:     researchField.Level,
+		SortOrder: researchField.SortOrder,
+		ParentCode: pgtype.Text{
+			String: researchField.ParentCode,
+			Valid:  researchField.ParentCode != "",
+		},
+	})
+	if err != nil {
+		if custom_errors.IsForeignKeyViolationError(err) {
+			return false, custom_errors.BadRequest(fmt.Errorf("parent(%s) of research field(%s) does not exist: %w", researchField.ParentCode, researchField.Code, err))
+		}
+
+		return false, custom_errors.InternalServerError(fmt.Errorf("failed to save research field(%s): %w", researchField.Code, err))
+	}
+
+	for languageCode, name := range researchField.Names {
+		if err := r.queries.UpsertResearchFieldName(ctx, sqlc.UpsertResearchFieldNameParams{
+			ResearchFieldCode: researchField.Code,
+			LanguageCode:      languageCode,
+			Name:              name,
+		}); err != nil {
+			if custom_errors.IsForeignKeyViolationError(err) {
+				return false, custom_errors.BadRequest(fmt.Errorf("language(%s) of research field(%s) does not exist: %w", languageCode, researchField.Code, err))
+			}
+
+			return false, custom_errors.InternalServerError(fmt.Errorf("failed to save name of research field(%s) in language(%s): %w", researchField.Code, languageCode, err))
+		}
+	}
+
+	return isCreated, nil
+}
+
+func (r *pgResearchFieldRepository) GetStats(ctx context.Context, level string, parentCode string) ([]*domain.ResearchFieldStats, error) {
+	statsResult, err := r.queries.GetResearchFieldStats(ctx, sqlc.GetResearchFieldStatsParams{
+		Level: pgtype.Text{
+			String: level,
+			Valid:  level != "",
+		},
+		ParentCode: pgtype.Text{
+			String: parentCode,
+			Valid:  parentCode != "",
+		},
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive research field stats for level(%s) and parent code(%s): %w", level, parentCode, err))
+	}
+
+	stats := make([]*domain.ResearchFieldStats, len(statsResult))
+	for index, stat := range statsResult {
+		stats[index] = &domain.ResearchFieldStats{
+			Code:             stat.Code,
+			ParentCode:       stat.ParentCode.String,
+			Level:            stat.Level,
+			ResearcherCount:  stat.ResearcherCount,
+			InstitutionCount: stat.InstitutionCount,
+		}
+	}
+
+	return stats, nil
+}
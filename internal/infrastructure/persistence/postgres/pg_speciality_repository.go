@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type pgSpecialityRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgSpecialityRepository(store *Store) repositories.SpecialityRepository {
+	return &pgSpecialityRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgSpecialityRepositoryWithQuery(q *sqlc.Queries) repositories.SpecialityRepository {
+	return &pgSpecialityRepository{
+		queries: q,
+	}
+}
+
+func (r *pgSpecialityRepository) GetAll(ctx context.Context) ([]*domain.Speciality, error) {
+	specialitiesResult, err := r.queries.GetAllSpecialities(ctx)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive specialities: %w", err))
+	}
+
+	specialities := make([]*domain.Speciality, len(specialitiesResult))
+	for index, speciality := range specialitiesResult {
+		specialities[index] = &domain.Speciality{
+			Code:       speciality.Code,
+			ParentCode: speciality.ParentCode.String,
+			CreatedAt:  speciality.CreatedAt.Time,
+			UpdatedAt:  speciality.UpdatedAt.Time,
+		}
+	}
+
+	return specialities, nil
+}
+
+func (r *pgSpecialityRepository) GetByParentCode(ctx context.Context, parentCode string) ([]*domain.Speciality, error) {
+	specialitiesResult, err := r.queries.GetSpecialitiesByParentCode(ctx, pgtype.Text{
+		String: parentCode,
+		Valid:  parentCode != "",
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive specialities with given parent code(%s): %w", parentCode, err))
+	}
+
+	specialities := make([]*domain.Speciality, len(specialitiesResult))
+	specialitiesByCode := make(map[string]*domain.Speciality, len(specialitiesResult))
+	codes := make([]string, len(specialitiesResult))
+	for index, speciality := range specialitiesResult {
+		specialities[index] = &domain.Speciality{
+			Code:        speciality.Code,
+			ParentCode:  speciality.ParentCode.String,
+			Names:       map[string]string{},
+			HasChildren: speciality.HasChildren,
+			CreatedAt:   speciality.CreatedAt.Time,
+			UpdatedAt:   speciality.UpdatedAt.Time,
+		}
+		specialitiesByCode[speciality.Code] = specialities[index]
+		codes[index] = speciality.Code
+	}
+
+	namesResult, err := r.queries.GetSpecialityNamesBySpecialityCodes(ctx, codes)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive names of specialities: %w", err))
+	}
+
+	for _, name := range namesResult {
+		if speciality, ok := specialitiesByCode[name.SpecialityCode]; ok {
+			speciality.Names[name.LanguageCode] = name.Name
+		}
+	}
+
+	return specialities, nil
+}
+
+func (r *pgSpecialityRepository) Exists(ctx context.Context, code string) (bool, error) {
+	exists, err := r.queries.IsSpecialityExisting(ctx, code)
+	if err != nil {
+		return false, custom_errors.InternalServerError(fmt.Errorf("failed to check speciality(%s): %w", code, err))
+	}
+
+	return exists, nil
+}
+
+func (r *pgSpecialityRepository) Upsert(ctx context.Context, speciality *domain.Speciality) (bool, error) {
+	isCreated, err := r.queries.UpsertSpeciality(ctx, sqlc.UpsertSpecialityParams{
+		Code: speciality.Code,
+		ParentCode: pgtype.Text{
+			String: speciality.ParentCode,
+			Valid:  speciality.ParentCode != "",
+		},
+	})
+	if err != nil {
+		if custom_errors.IsForeignKeyViolationError(err) {
+			return false, custom_errors.BadRequest(fmt.Errorf("parent(%s) of speciality(%s) does not exist: %w", speciality.ParentCode, speciality.Code, err))
+		}
+
+		return false, custom_errors.InternalServerError(fmt.Errorf("failed to save speciality(%s): %w", speciality.Code, err))
+	}
+
+	for languageCode, name := range speciality.Names {
+		if err := r.queries.UpsertSpecialityName(ctx, sqlc.UpsertSpecialityNameParams{
+			SpecialityCode: speciality.Code,
+			LanguageCode:   languageCode,
+			Name:           name,
+		}); err != nil {
+			if custom_errors.IsForeignKeyViolationError(err) {
+				return false, custom_errors.BadRequest(fmt.Errorf("language(%s) of speciality(%s) does not exist: %w", languageCode, speciality.Code, err))
+			}
+
+			return false, custom_errors.InternalServerError(fmt.Errorf("failed to save name of speciality(%s) in language(%s): %w", speciality.Code, languageCode, err))
+		}
+	}
+
+	return isCreated, nil
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+)
+
+type pgTranslationGroupRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgTranslationGroupRepository(store *Store) repositories.TranslationGroupRepository {
+	return &pgTranslationGroupRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgTranslationGroupRepositoryWithQuery(q *sqlc.Queries) repositories.TranslationGroupRepository {
+	return &pgTranslationGroupRepository{
+		queries: q,
+	}
+}
+
+func (r *pgTranslationGroupRepository) GetByEmployeeID(ctx context.Context, employeeID int64) ([]*domain.TranslationGroup, error) {
+	translationGroupsResult, err := r.queries.GetEmployeeTranslationGroups(ctx, employeeID)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive translation groups of EmployeeID(%d): %w", employeeID, err))
+	}
+
+	translationGroups := make([]*domain.TranslationGroup, len(translationGroupsResult))
+	for index, translationGroup := range translationGroupsResult {
+		translationGroups[index] = &domain.TranslationGroup{
+			ID:            translationGroup.TranslationGroupID.String(),
+			Resource:      translationGroup.Resource,
+			RecordID:      translationGroup.RecordID,
+			Title:         translationGroup.Title,
+			LanguageCodes: translationGroup.LanguageCodes,
+		}
+	}
+
+	return translationGroups, nil
+}
+
+func (r *pgTranslationGroupRepository) GetByInstitutionID(ctx context.Context, institutionID int64) ([]*domain.TranslationGroup, error) {
+	translationGroupsResult, err := r.queries.GetInstitutionTranslationGroups(ctx, institutionID)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive translation groups of InstitutionID(%d): %w", institutionID, err))
+	}
+
+	translationGroups := make([]*domain.TranslationGroup, len(translationGroupsResult))
+	for index, translationGroup := range translationGroupsResult {
+		translationGroups[index] = &domain.TranslationGroup{
+			ID:            translationGroup.TranslationGroupID.String(),
+			Resource:      translationGroup.Resource,
+			RecordID:      translationGroup.RecordID,
+			Title:         translationGroup.Title,
+			LanguageCodes: translationGroup.LanguageCodes,
+		}
+	}
+
+	return translationGroups, nil
+}
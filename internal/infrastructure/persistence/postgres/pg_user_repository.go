@@ -7,6 +7,8 @@ import (
 	"backend/internal/shared/custom_errors"
 	"context"
 	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type pgUserRepository struct {
@@ -29,8 +31,9 @@ func NewPgUserRepositoryWithQueries(q *sqlc.Queries) repositories.UserRepository
 
 func (r *pgUserRepository) CreateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
 	createdUser, err := r.queries.CreateUser(ctx, sqlc.CreateUserParams{
-		Email:        user.Email,
-		PasswordHash: user.PasswordHash,
+		Email:             user.Email,
+		PasswordHash:      user.PasswordHash,
+		PreferredLanguage: pgtype.Text{String: user.PreferredLanguage, Valid: user.PreferredLanguage != ""},
 	})
 	if err != nil {
 
@@ -51,11 +54,13 @@ func (r *pgUserRepository) GetByEmail(ctx context.Context, email string) (*domai
 	}
 
 	return &domain.User{
-		ID:           userResult.ID,
-		Email:        userResult.Email,
-		PasswordHash: userResult.PasswordHash,
-		CreatedAt:    userResult.CreatedAt.Time,
-		UpdatedAt:    userResult.UpdatedAt.Time,
+		ID:                userResult.ID,
+		Email:             userResult.Email,
+		PasswordHash:      userResult.PasswordHash,
+		PreferredLanguage: userResult.PreferredLanguage.String,
+		Role:              userResult.Role,
+		CreatedAt:         userResult.CreatedAt.Time,
+		UpdatedAt:         userResult.UpdatedAt.Time,
 	}, nil
 }
 
@@ -66,10 +71,24 @@ func (r *pgUserRepository) GetByID(ctx context.Context, id int64) (*domain.User,
 	}
 
 	return &domain.User{
-		ID:           userResult.ID,
-		Email:        userResult.Email,
-		PasswordHash: userResult.PasswordHash,
-		CreatedAt:    userResult.CreatedAt.Time,
-		UpdatedAt:    userResult.UpdatedAt.Time,
+		ID:                userResult.ID,
+		Email:             userResult.Email,
+		PasswordHash:      userResult.PasswordHash,
+		PreferredLanguage: userResult.PreferredLanguage.String,
+		Role:              userResult.Role,
+		CreatedAt:         userResult.CreatedAt.Time,
+		UpdatedAt:         userResult.UpdatedAt.Time,
 	}, nil
 }
+
+func (r *pgUserRepository) UpdatePreferredLanguage(ctx context.Context, id int64, langCode string) error {
+	err := r.queries.UpdateUserPreferredLanguage(ctx, sqlc.UpdateUserPreferredLanguageParams{
+		ID:                id,
+		PreferredLanguage: pgtype.Text{String: langCode, Valid: langCode != ""},
+	})
+	if err != nil {
+		return custom_errors.InternalServerError(fmt.Errorf("failed to update preferred language(%s) of user(%d): %w", langCode, id, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"backend/internal/application/repositories"
+	"backend/internal/domain"
+	"backend/internal/infrastructure/persistence/postgres/sqlc"
+	"backend/internal/shared/custom_errors"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type pgWorkplaceMappingRepository struct {
+	store   *Store
+	queries *sqlc.Queries
+}
+
+func NewPgWorkplaceMappingRepository(store *Store) repositories.WorkplaceMappingRepository {
+	return &pgWorkplaceMappingRepository{
+		store:   store,
+		queries: store.Queries,
+	}
+}
+
+func NewPgWorkplaceMappingRepositoryWithQuery(q *sqlc.Queries) repositories.WorkplaceMappingRepository {
+	return &pgWorkplaceMappingRepository{
+		queries: q,
+	}
+}
+
+func (r *pgWorkplaceMappingRepository) ListWorkplaceVariants(ctx context.Context) ([]*domain.WorkplaceVariant, error) {
+	variantsResult, err := r.queries.ListWorkplaceVariants(ctx)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive workplace variants: %w", err))
+	}
+
+	variants := make([]*domain.WorkplaceVariant, len(variantsResult))
+	for index, variant := range variantsResult {
+		variants[index] = &domain.WorkplaceVariant{
+			Workplace:       variant.Workplace,
+			LanguageCode:    variant.LanguageCode,
+			ExperienceCount: variant.ExperienceCount,
+			LinkedCount:     variant.LinkedCount,
+			InstitutionIDs:  variant.InstitutionIds,
+		}
+	}
+
+	return variants, nil
+}
+
+func (r *pgWorkplaceMappingRepository) ListInstitutionTitles(ctx context.Context) ([]*domain.InstitutionDetails, error) {
+	titlesResult, err := r.queries.ListInstitutionTitles(ctx)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive institution titles: %w", err))
+	}
+
+	titles := make([]*domain.InstitutionDetails, len(titlesResult))
+	for index, title := range titlesResult {
+		titles[index] = &domain.InstitutionDetails{
+			InstitutionID:         title.InstitutionID,
+			LanguageCode:          title.LanguageCode,
+			InstitutionTitleShort: title.InstitutionTitleShort,
+			InstitutionTitleLong:  title.InstitutionTitleLong,
+		}
+	}
+
+	return titles, nil
+}
+
+func (r *pgWorkplaceMappingRepository) GetCandidates(ctx context.Context, workplaces []string, institutionID int64, overwrite bool) ([]*domain.WorkplaceMappingChange, error) {
+	candidatesResult, err := r.queries.GetWorkplaceMappingCandidates(ctx, sqlc.GetWorkplaceMappingCandidatesParams{
+		Workplaces:    workplaces,
+		InstitutionID: institutionID,
+		Overwrite:     overwrite,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive work experiences to map to institution(%d): %w", institutionID, err))
+	}
+
+	changes := make([]*domain.WorkplaceMappingChange, len(candidatesResult))
+	for index, candidate := range candidatesResult {
+		changes[index] = &domain.WorkplaceMappingChange{
+			WorkExperienceID: candidate.ID,
+			EmployeeID:       candidate.EmployeeID,
+			LanguageCode:     candidate.LanguageCode,
+			Workplace:        candidate.Workplace,
+			OldInstitutionID: candidate.InstitutionID.Int64,
+			NewInstitutionID: institutionID,
+		}
+	}
+
+	return changes, nil
+}
+
+func (r *pgWorkplaceMappingRepository) Apply(ctx context.Context, batch *domain.WorkplaceMappingBatch, changes []*domain.WorkplaceMappingChange) (*domain.WorkplaceMappingBatch, error) {
+	batchResult, err := r.queries.CreateWorkplaceMappingBatch(ctx, sqlc.CreateWorkplaceMappingBatchParams{
+		InstitutionID: pgtype.Int8{Int64: batch.InstitutionID, Valid: batch.InstitutionID != 0},
+		Workplaces:    batch.Workplaces,
+		Overwrite:     batch.Overwrite,
+		CreatedBy:     pgtype.Int8{Int64: batch.CreatedBy, Valid: batch.CreatedBy != 0},
+	})
+	if err != nil {
+		if custom_errors.IsForeignKeyViolationError(err) {
+			return nil, custom_errors.BadRequest(fmt.Errorf("institution(%d) to map workplaces to does not exist: %w", batch.InstitutionID, err))
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to create workplace mapping batch: %w", err))
+	}
+
+	workExperienceIDs := make([]int64, len(changes))
+	for index, change := range changes {
+		workExperienceIDs[index] = change.WorkExperienceID
+	}
+
+	err = r.queries.CreateWorkplaceMappingChanges(ctx, sqlc.CreateWorkplaceMappingChangesParams{
+		BatchID:           batchResult.ID,
+		InstitutionID:     batch.InstitutionID,
+		WorkExperienceIds: workExperienceIDs,
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to record changes of workplace mapping batch(%d): %w", batchResult.ID, err))
+	}
+
+	changeCount, err := r.queries.ApplyWorkplaceMappingBatch(ctx, batchResult.ID)
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to apply workplace mapping batch(%d): %w", batchResult.ID, err))
+	}
+
+	batch.ID = batchResult.ID
+	batch.CreatedAt = batchResult.CreatedAt.Time
+	batch.ChangeCount = changeCount
+
+	return batch, nil
+}
+
+func (r *pgWorkplaceMappingRepository) GetBatchByIDForUpdate(ctx context.Context, id int64) (*domain.WorkplaceMappingBatch, error) {
+	batchResult, err := r.queries.GetWorkplaceMappingBatchByIDForUpdate(ctx, id)
+	if err != nil {
+		if custom_errors.IsNotFound(err) {
+			return nil, custom_errors.NotFound(fmt.Errorf("workplace mapping batch with given ID(%d) does not exist", id))
+		}
+
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive workplace mapping batch with given ID(%d): %w", id, err))
+	}
+
+	return &domain.WorkplaceMappingBatch{
+		ID:            batchResult.ID,
+		InstitutionID: batchResult.InstitutionID.Int64,
+		Workplaces:    batchResult.Workplaces,
+		Overwrite:     batchResult.Overwrite,
+		CreatedBy:     batchResult.CreatedBy.Int64,
+		CreatedAt:     batchResult.CreatedAt.Time,
+		UndoneBy:      batchResult.UndoneBy.Int64,
+		UndoneAt:      batchResult.UndoneAt.Time,
+		ChangeCount:   batchResult.ChangeCount,
+	}, nil
+}
+
+func (r *pgWorkplaceMappingRepository) Undo(ctx context.Context, id int64, undoneBy int64) (int64, error) {
+	restoredCount, err := r.queries.UndoWorkplaceMappingBatch(ctx, id)
+	if err != nil {
+		return 0, custom_errors.InternalServerError(fmt.Errorf("failed to undo workplace mapping batch(%d): %w", id, err))
+	}
+
+	err = r.queries.MarkWorkplaceMappingBatchUndone(ctx, sqlc.MarkWorkplaceMappingBatchUndoneParams{
+		ID:       id,
+		UndoneBy: pgtype.Int8{Int64: undoneBy, Valid: undoneBy != 0},
+	})
+	if err != nil {
+		return 0, custom_errors.InternalServerError(fmt.Errorf("failed to mark workplace mapping batch(%d) as undone: %w", id, err))
+	}
+
+	return restoredCount, nil
+}
+
+func (r *pgWorkplaceMappingRepository) ListBatches(ctx context.Context, page int64, limit int64) ([]*domain.WorkplaceMappingBatch, error) {
+	batchesResult, err := r.queries.ListWorkplaceMappingBatches(ctx, sqlc.ListWorkplaceMappingBatchesParams{
+		Page:  int32((page - 1) * limit),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return nil, custom_errors.InternalServerError(fmt.Errorf("failed to retrive workplace mapping batches (page - %d, limit - %d): %w", page, limit, err))
+	}
+
+	batches := make([]*domain.WorkplaceMappingBatch, len(batchesResult))
+	for index, batch := range batchesResult {
+		batches[index] = &domain.WorkplaceMappingBatch{
+			ID:            batch.ID,
+			InstitutionID: batch.InstitutionID.Int64,
+			Workplaces:    batch.Workplaces,
+			Overwrite:     batch.Overwrite,
+			CreatedBy:     batch.CreatedBy.Int64,
+			CreatedAt:     batch.CreatedAt.Time,
+			UndoneBy:      batch.UndoneBy.Int64,
+			UndoneAt:      batch.UndoneAt.Time,
+			ChangeCount:   batch.ChangeCount,
+		}
+	}
+
+	return batches, nil
+}
@@ -0,0 +1,93 @@
+-- name: GetCollaborationEdges :many
+-- pairs of employees sharing publications, projects, events or professional communities with the number
+-- of shared items of each kind. The employee is one of employee_ids, the collaborator too unless include_external is set.
+-- publications are shared records, projects (research activities), events and communities are free text matched by normalized title.
+-- an item is counted once however many languages it is entered in, titles shared by more than max_token_employees
+-- employees (a whole association, a congress) say nothing about collaboration and are left out.
+-- employees without consent to the latest terms are not linked
+with items as (
+    select ep.employee_id, 'publication'::text as kind, ep.publication_id::text as token, ep.publication_id::text as item
+    from employee_publications ep
+    where ep.publication_id is not null
+    union
+    select pa.employee_id, 'publication'::text, pa.publication_id::text, pa.publication_id::text
+    from publication_authors pa
+    where pa.employee_id is not null
+    union
+    select era.employee_id, 'project'::text, lower(regexp_replace(btrim(era.research_activity_title), '\s+', ' ', 'g')),
+        era.translation_group_id::text
+    from employee_research_activities era
+    where btrim(era.research_activity_title) <> ''
+    union
+    select pe.employee_id, 'event'::text,
+        lower(regexp_replace(btrim(pe.event_title), '\s+', ' ', 'g')) || '@' || coalesce(pe.event_date::text, ''),
+        pe.translation_group_id::text
+    from employee_participation_in_events pe
+    where btrim(pe.event_title) <> ''
+    union
+    select pc.employee_id, 'community'::text, lower(regexp_replace(btrim(pc.professional_community_title), '\s+', ' ', 'g')),
+        pc.translation_group_id::text
+    from employee_participation_in_professional_communities pc
+    where btrim(pc.professional_community_title) <> ''
+),
+common_tokens as (
+    select kind, token
+    from items
+    group by kind, token
+    having count(distinct employee_id) > sqlc.arg(max_token_employees)::int
+)
+select
+    a.employee_id,
+    b.employee_id as collaborator_id,
+    a.kind,
+    count(distinct a.item)::int as weight
+from items a
+join items b on b.kind = a.kind and b.token = a.token and b.employee_id <> a.employee_id
+join employees ea on ea.id = a.employee_id
+join employees eb on eb.id = b.employee_id
+where
+    a.employee_id = any(sqlc.arg(employee_ids)::bigint[])
+    and has_latest_consent(ea.user_id)
+    and has_latest_consent(eb.user_id)
+    and (sqlc.arg(include_external)::bool or b.employee_id = any(sqlc.arg(employee_ids)::bigint[]))
+    and not exists (select 1 from common_tokens ct where ct.kind = a.kind and ct.token = a.token)
+group by a.employee_id, b.employee_id, a.kind
+order by a.employee_id, b.employee_id, a.kind
+;
+
+-- name: GetCollaborationNodesByIDs :many
+-- names are taken in the first of the language codes the employee has details in, they are empty when there is none.
+-- employees without consent to the latest terms are left out
+select
+    e.id,
+    e.unique_id,
+    coalesce(p.surname, '')::text as surname,
+    coalesce(p.name, '')::text as name,
+    coalesce(p.middlename, '')::text as middlename,
+    coalesce(e.current_workplace, '')::text as current_workplace,
+    coalesce(e.current_institution_id, 0)::bigint as current_institution_id
+from employees e
+left join lateral (
+    select ed.surname, ed.name, ed.middlename
+    from employee_details ed
+    where ed.employee_id = e.id
+      and ed.is_employee_details_new is true
+      and ed.language_code = any(sqlc.arg(language_codes)::text[])
+    order by array_position(sqlc.arg(language_codes)::text[], ed.language_code::text)
+    limit 1
+) p on true
+where
+    e.id = any(sqlc.arg(ids)::bigint[])
+    and has_latest_consent(e.user_id)
+order by e.id
+;
+
+-- name: GetEmployeeIDsByCurrentInstitutionID :many
+-- employees without consent to the latest terms are left out
+select id
+from employees
+where
+    current_institution_id = $1
+    and has_latest_consent(user_id)
+order by id
+;
@@ -0,0 +1,57 @@
+-- name: CreateConsentDocument :one
+INSERT INTO consent_documents (
+  version,
+  language_code,
+  title,
+  body
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: CreateUserConsent :exec
+-- accepting the same version again keeps the first acceptance
+INSERT INTO user_consents (
+  user_id,
+  document_version,
+  language_code,
+  ip_address
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (user_id, document_version) DO NOTHING;
+
+-- name: GetAllUserConsents :many
+SELECT
+  uc.id,
+  uc.user_id,
+  u.email,
+  coalesce(e.unique_id, '')::text AS employee_unique_id,
+  uc.document_version,
+  uc.language_code,
+  uc.ip_address,
+  uc.accepted_at
+FROM user_consents uc
+JOIN users u ON u.id = uc.user_id
+LEFT JOIN employees e ON e.user_id = uc.user_id
+ORDER BY uc.accepted_at, uc.id;
+
+-- name: GetConsentDocumentsByVersion :many
+SELECT *
+FROM consent_documents
+WHERE version = $1
+ORDER BY language_code;
+
+-- name: GetLatestConsentDocumentVersion :one
+-- zero while no terms are published
+SELECT coalesce(max(version), 0)::int AS version
+FROM consent_documents;
+
+-- name: GetLatestUserConsentVersion :one
+SELECT coalesce(max(document_version), 0)::int AS document_version
+FROM user_consents
+WHERE user_id = $1;
+
+-- name: HasLatestUserConsent :one
+-- the condition public listings and profiles apply, the user accepted the latest terms
+SELECT has_latest_consent(sqlc.arg(user_id)::bigint)::boolean AS has_latest_consent;
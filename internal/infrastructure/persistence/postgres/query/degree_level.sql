@@ -0,0 +1,15 @@
+-- name: GetAllDegreeLevelNames :many
+SELECT *
+FROM degree_level_names;
+
+-- name: GetAllDegreeLevels :many
+SELECT *
+FROM degree_levels
+ORDER BY rank, code;
+
+-- name: IsDegreeLevelExisting :one
+SELECT EXISTS (
+  SELECT 1
+  FROM degree_levels
+  WHERE code = $1
+)::boolean AS is_existing;
@@ -37,53 +37,177 @@ select
     e.unique_id,
     e.gender,
     e.tin,
-    e.highest_academic_degree,
+    coalesce(dln.name, e.highest_academic_degree)::text as highest_academic_degree,
     e.speciality,
     e.current_workplace,
+    e.current_institution_id,
+    e.current_org_unit_id,
     ed.surname,
     ed.name,
-    ed.middlename
+    ed.middlename,
+    -- a paper entered in several languages or shared with colleagues is counted once
+    (
+        select count(distinct coalesce(ep.publication_id::text, ep.translation_group_id::text, ep.id::text))
+        from employee_publications ep
+        where ep.employee_id = e.id
+    )::bigint as publication_count,
+    coalesce(cm.h_index, 0)::int as h_index,
+    coalesce(cm.i10_index, 0)::int as i10_index,
+    coalesce(cm.total_citations, 0)::int as total_citations
 from employees e
 join
     employee_details ed
     on ed.employee_id = e.id
     and ed.is_employee_details_new is true
     and ed.language_code = sqlc.arg(language_code)
+left join employee_citation_metrics cm on cm.employee_id = e.id
+-- the highest degree is a degree level code, or free text for degrees entered before the vocabulary existed
+left join
+    degree_level_names dln
+    on dln.degree_level_code = e.highest_academic_degree
+    and dln.language_code = sqlc.arg(language_code)
 where
+    -- the conditions below are explained to employees by the completeness checklist, keep them in sync
     -- must exist in employee_socials
     exists (select 1 from employee_socials es where es.employee_id = e.id)
     -- required non-null denormalized fields
     and e.highest_academic_degree is not null
     and e.speciality is not null
     and e.current_workplace is not null
+    -- the user of the employee accepted the latest terms
+    and has_latest_consent(e.user_id)
 
-    -- optional filters (pass NULL to ignore)
+    -- optional filters (pass NULL to ignore), a filter on a section of the profile matches only employees
+    -- who share the section with the public, so the directory does not reveal what they hide
     and (nullif(sqlc.arg(uid)::text, '') is null or e.unique_id = sqlc.arg(uid))
+    -- names are matched against the whole name history in any language, so researchers are found by previous surnames too
     and (
-        nullif(sqlc.arg(name)::text, '') is null
-        or ed.name ilike '%' || sqlc.arg(name) || '%'
+        (
+            nullif(sqlc.arg(name)::text, '') is null
+            and nullif(sqlc.arg(surname)::text, '') is null
+            and nullif(sqlc.arg(middlename)::text, '') is null
+        )
+        or (
+            employee_section_is_public(e.id, 'details')
+            and exists (
+                select 1
+                from employee_details hd
+                where hd.employee_id = e.id
+                  and (nullif(sqlc.arg(name)::text, '') is null or hd.name ilike '%' || sqlc.arg(name) || '%')
+                  and (nullif(sqlc.arg(surname)::text, '') is null or hd.surname ilike '%' || sqlc.arg(surname) || '%')
+                  and (nullif(sqlc.arg(middlename)::text, '') is null or hd.middlename ilike '%' || sqlc.arg(middlename) || '%')
+            )
+        )
     )
     and (
-        nullif(sqlc.arg(surname)::text, '') is null
-        or ed.surname ilike '%' || sqlc.arg(surname) || '%'
+        nullif(sqlc.arg(workplace)::text, '') is null
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_workplace = sqlc.arg(workplace)
+        )
     )
     and (
-        nullif(sqlc.arg(middlename)::text, '') is null
-        or ed.middlename ilike '%' || sqlc.arg(middlename) || '%'
+        sqlc.arg(institution_id)::bigint = 0
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_institution_id = sqlc.arg(institution_id)
+        )
     )
+    -- employees of the unit or of any unit below it
     and (
-        nullif(sqlc.arg(workplace)::text, '') is null
-        or e.current_workplace = sqlc.arg(workplace)
+        sqlc.arg(org_unit_id)::bigint = 0
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_org_unit_id in (
+                with recursive unit_tree as (
+                    select ou.id
+                    from org_units ou
+                    where ou.id = sqlc.arg(org_unit_id)
+                    union all
+                    select child.id
+                    from org_units child
+                    join unit_tree on child.parent_id = unit_tree.id
+                )
+                select unit_tree.id from unit_tree
+            )
+        )
     )
     and (
         nullif(btrim(sqlc.arg(academic_degree)::text), '') is null
-        or btrim(e.highest_academic_degree) ilike btrim(sqlc.arg(academic_degree)::text)
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and (
+                btrim(e.highest_academic_degree) ilike btrim(sqlc.arg(academic_degree)::text)
+                -- a degree level is found by its code or by its name in any language
+                or exists (
+                    select 1
+                    from degree_level_names an
+                    where an.degree_level_code = e.highest_academic_degree
+                      and btrim(an.name) ilike btrim(sqlc.arg(academic_degree)::text)
+                )
+            )
+        )
     )
     and (
         nullif(btrim(sqlc.arg(speciality)::text), '') is null
-        or btrim(e.speciality) ilike btrim(sqlc.arg(speciality)::text)
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and btrim(e.speciality) ilike btrim(sqlc.arg(speciality)::text)
+        )
+    )
+    -- employees whose speciality is the classifier node or any node below it
+    and (
+        nullif(sqlc.arg(speciality_code)::text, '') is null
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and e.speciality_code in (
+                with recursive speciality_tree as (
+                    select s.code
+                    from specialities s
+                    where s.code = sqlc.arg(speciality_code)
+                    union all
+                    select child.code
+                    from specialities child
+                    join speciality_tree on child.parent_code = speciality_tree.code
+                )
+                select speciality_tree.code from speciality_tree
+            )
+        )
+    )
+    -- employees with a key topic of the keyword, whichever of its labels or synonyms is given
+    and (
+        nullif(btrim(sqlc.arg(keyword)::text), '') is null
+        or (
+            employee_section_is_public(e.id, 'main_research_areas')
+            and exists (
+                select 1
+                from employee_main_research_areas mra
+                join employee_main_research_area_key_topics kt on kt.employee_main_research_area_id = mra.id
+                join keyword_labels kl on kl.keyword_id = kt.keyword_id
+                where mra.employee_id = e.id
+                  and kl.normalized_label = normalize_keyword_label(sqlc.arg(keyword)::text)
+            )
+        )
     )
-order by e.id
+    and (
+        (sqlc.arg(min_h_index)::int <= 0 and sqlc.arg(min_i10_index)::int <= 0 and sqlc.arg(min_citations)::int <= 0)
+        or (
+            employee_section_is_public(e.id, 'publications')
+            and coalesce(cm.h_index, 0) >= sqlc.arg(min_h_index)::int
+            and coalesce(cm.i10_index, 0) >= sqlc.arg(min_i10_index)::int
+            and coalesce(cm.total_citations, 0) >= sqlc.arg(min_citations)::int
+        )
+    )
+order by
+    -- directory can be ranked by bibliometric indicators, otherwise it keeps the insertion order,
+    -- indicators hidden with the publications are ranked last
+    case
+        when not employee_section_is_public(e.id, 'publications') then null
+        when sqlc.arg(sort_by)::text = 'h_index' then coalesce(cm.h_index, 0)
+        when sqlc.arg(sort_by)::text = 'i10_index' then coalesce(cm.i10_index, 0)
+        when sqlc.arg(sort_by)::text = 'citations' then coalesce(cm.total_citations, 0)
+    end desc nulls last,
+    e.id
 limit sqlc.arg('limit')
 offset sqlc.arg(page)
 ;
@@ -96,35 +220,132 @@ join
     on ed.employee_id = e.id
     and ed.is_employee_details_new is true
     and ed.language_code = sqlc.arg(language_code)
+left join employee_citation_metrics cm on cm.employee_id = e.id
 where
     exists (select 1 from employee_socials es where es.employee_id = e.id)
     and e.highest_academic_degree is not null
     and e.speciality is not null
     and e.current_workplace is not null
+    -- the user of the employee accepted the latest terms
+    and has_latest_consent(e.user_id)
     and (nullif(sqlc.arg(uid)::text, '') is null or e.unique_id = sqlc.arg(uid))
+    -- names are matched against the whole name history in any language, so researchers are found by previous surnames too
     and (
-        nullif(sqlc.arg(name)::text, '') is null
-        or ed.name ilike '%' || sqlc.arg(name) || '%'
+        (
+            nullif(sqlc.arg(name)::text, '') is null
+            and nullif(sqlc.arg(surname)::text, '') is null
+            and nullif(sqlc.arg(middlename)::text, '') is null
+        )
+        or (
+            employee_section_is_public(e.id, 'details')
+            and exists (
+                select 1
+                from employee_details hd
+                where hd.employee_id = e.id
+                  and (nullif(sqlc.arg(name)::text, '') is null or hd.name ilike '%' || sqlc.arg(name) || '%')
+                  and (nullif(sqlc.arg(surname)::text, '') is null or hd.surname ilike '%' || sqlc.arg(surname) || '%')
+                  and (nullif(sqlc.arg(middlename)::text, '') is null or hd.middlename ilike '%' || sqlc.arg(middlename) || '%')
+            )
+        )
     )
     and (
-        nullif(sqlc.arg(surname)::text, '') is null
-        or ed.surname ilike '%' || sqlc.arg(surname) || '%'
+        nullif(sqlc.arg(workplace)::text, '') is null
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_workplace = sqlc.arg(workplace)
+        )
     )
     and (
-        nullif(sqlc.arg(middlename)::text, '') is null
-        or ed.middlename ilike '%' || sqlc.arg(middlename) || '%'
+        sqlc.arg(institution_id)::bigint = 0
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_institution_id = sqlc.arg(institution_id)
+        )
     )
+    -- employees of the unit or of any unit below it
     and (
-        nullif(sqlc.arg(workplace)::text, '') is null
-        or e.current_workplace = sqlc.arg(workplace)
+        sqlc.arg(org_unit_id)::bigint = 0
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_org_unit_id in (
+                with recursive unit_tree as (
+                    select ou.id
+                    from org_units ou
+                    where ou.id = sqlc.arg(org_unit_id)
+                    union all
+                    select child.id
+                    from org_units child
+                    join unit_tree on child.parent_id = unit_tree.id
+                )
+                select unit_tree.id from unit_tree
+            )
+        )
     )
     and (
         nullif(btrim(sqlc.arg(academic_degree)::text), '') is null
-        or btrim(e.highest_academic_degree) ilike btrim(sqlc.arg(academic_degree)::text)
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and (
+                btrim(e.highest_academic_degree) ilike btrim(sqlc.arg(academic_degree)::text)
+                -- a degree level is found by its code or by its name in any language
+                or exists (
+                    select 1
+                    from degree_level_names an
+                    where an.degree_level_code = e.highest_academic_degree
+                      and btrim(an.name) ilike btrim(sqlc.arg(academic_degree)::text)
+                )
+            )
+        )
     )
     and (
         nullif(btrim(sqlc.arg(speciality)::text), '') is null
-        or btrim(e.speciality) ilike btrim(sqlc.arg(speciality)::text)
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and btrim(e.speciality) ilike btrim(sqlc.arg(speciality)::text)
+        )
+    )
+    -- employees whose speciality is the classifier node or any node below it
+    and (
+        nullif(sqlc.arg(speciality_code)::text, '') is null
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and e.speciality_code in (
+                with recursive speciality_tree as (
+                    select s.code
+                    from specialities s
+                    where s.code = sqlc.arg(speciality_code)
+                    union all
+                    select child.code
+                    from specialities child
+                    join speciality_tree on child.parent_code = speciality_tree.code
+                )
+                select speciality_tree.code from speciality_tree
+            )
+        )
+    )
+    -- employees with a key topic of the keyword, whichever of its labels or synonyms is given
+    and (
+        nullif(btrim(sqlc.arg(keyword)::text), '') is null
+        or (
+            employee_section_is_public(e.id, 'main_research_areas')
+            and exists (
+                select 1
+                from employee_main_research_areas mra
+                join employee_main_research_area_key_topics kt on kt.employee_main_research_area_id = mra.id
+                join keyword_labels kl on kl.keyword_id = kt.keyword_id
+                where mra.employee_id = e.id
+                  and kl.normalized_label = normalize_keyword_label(sqlc.arg(keyword)::text)
+            )
+        )
+    )
+    and (
+        (sqlc.arg(min_h_index)::int <= 0 and sqlc.arg(min_i10_index)::int <= 0 and sqlc.arg(min_citations)::int <= 0)
+        or (
+            employee_section_is_public(e.id, 'publications')
+            and coalesce(cm.h_index, 0) >= sqlc.arg(min_h_index)::int
+            and coalesce(cm.i10_index, 0) >= sqlc.arg(min_i10_index)::int
+            and coalesce(cm.total_citations, 0) >= sqlc.arg(min_citations)::int
+        )
     )
 ;
 
@@ -146,12 +367,30 @@ order by e.current_workplace asc
 ;
 
 -- name: ListUniqueHighestAcademicDegrees :many
-select distinct e.highest_academic_degree
-from employees e
-where
-    e.highest_academic_degree is not null
-    and e.highest_academic_degree <> ''
-order by e.highest_academic_degree asc
+-- degree level codes are named in language_code or in the first language of the registry having a name,
+-- free text entered before the vocabulary existed is returned as its own code and name
+select
+    hd.highest_academic_degree::text as code,
+    coalesce(
+        (
+            select dln.name
+            from degree_level_names dln
+            join languages l on l.code = dln.language_code
+            where dln.degree_level_code = hd.highest_academic_degree
+            order by (dln.language_code = sqlc.arg(language_code)) desc, l.sort_order, l.code
+            limit 1
+        ),
+        hd.highest_academic_degree
+    )::text as name
+from (
+    select distinct e.highest_academic_degree
+    from employees e
+    where
+        e.highest_academic_degree is not null
+        and e.highest_academic_degree <> ''
+) hd
+left join degree_levels dl on dl.code = hd.highest_academic_degree
+order by dl.rank asc nulls last, name asc
 ;
 
 -- name: ListUniqueSpecialities :many
@@ -162,3 +401,11 @@ where
     and e.speciality <> ''
 order by e.speciality asc
 ;
+
+-- name: UpdateEmployeeOrcid :exec
+update employees
+set
+    orcid = $1,
+    updated_at = now()
+where id = $2
+;
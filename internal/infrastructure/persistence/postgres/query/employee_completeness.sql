@@ -0,0 +1,87 @@
+-- name: GetEmployeeCompletenessCounts :many
+-- one row per employee and enabled language, sections written per language are counted in that language only,
+-- pass an empty unique_id, a zero institution_id or an empty language_code to ignore the filter
+select
+    e.id,
+    e.unique_id,
+    l.code::text as language_code,
+    coalesce(ed.surname, '')::text as surname,
+    coalesce(ed.name, '')::text as name,
+    coalesce(ed.middlename, '')::text as middlename,
+    (ed.id is not null)::boolean as has_details,
+    (
+        select count(*) from employee_degrees d
+        where d.employee_id = e.id and d.language_code = l.code
+    )::bigint as degree_count,
+    (
+        select count(*) from employee_work_experiences we
+        where we.employee_id = e.id and we.language_code = l.code
+    )::bigint as work_experience_count,
+    (
+        select count(*) from employee_work_experiences we
+        where we.employee_id = e.id and we.language_code = l.code and we.on_going is true
+    )::bigint as ongoing_work_experience_count,
+    (
+        select count(*) from employee_main_research_areas mra
+        where mra.employee_id = e.id and mra.language_code = l.code
+    )::bigint as main_research_area_count,
+    (
+        select count(*)
+        from employee_main_research_area_key_topics kt
+        join employee_main_research_areas mra on mra.id = kt.employee_main_research_area_id
+        where mra.employee_id = e.id and mra.language_code = l.code
+    )::bigint as key_topic_count,
+    (
+        select count(*) from employee_publications ep
+        where ep.employee_id = e.id and ep.language_code = l.code
+    )::bigint as publication_count,
+    (
+        select count(*) from employee_scientific_awards sa
+        where sa.employee_id = e.id and sa.language_code = l.code
+    )::bigint as scientific_award_count,
+    (
+        select count(*) from employee_patents p
+        where p.employee_id = e.id and p.language_code = l.code
+    )::bigint as patent_count,
+    (
+        select count(*) from employee_participation_in_professional_communities pc
+        where pc.employee_id = e.id and pc.language_code = l.code
+    )::bigint as professional_community_count,
+    (
+        select count(*) from employee_refresher_courses rc
+        where rc.employee_id = e.id and rc.language_code = l.code
+    )::bigint as refresher_course_count,
+    (
+        select count(*) from employee_participation_in_events pe
+        where pe.employee_id = e.id and pe.language_code = l.code
+    )::bigint as event_count,
+    (
+        select count(*) from employee_research_activities ra
+        where ra.employee_id = e.id and ra.language_code = l.code
+    )::bigint as research_activity_count,
+    (
+        select count(*) from employee_socials es
+        where es.employee_id = e.id
+    )::bigint as social_count,
+    (coalesce(e.gender, '') <> '')::boolean as has_gender,
+    (coalesce(e.orcid, '') <> '')::boolean as has_orcid,
+    (e.highest_academic_degree is not null)::boolean as has_highest_academic_degree,
+    (e.speciality is not null)::boolean as has_speciality,
+    (e.current_workplace is not null)::boolean as has_current_workplace,
+    has_latest_consent(e.user_id)::boolean as has_consent
+from employees e
+cross join languages l
+left join
+    employee_details ed
+    on ed.employee_id = e.id
+    and ed.is_employee_details_new is true
+    and ed.language_code = l.code
+where
+    l.enabled is true
+    and (nullif(sqlc.arg(language_code)::text, '') is null or l.code = sqlc.arg(language_code))
+    and (nullif(sqlc.arg(unique_id)::text, '') is null or e.unique_id = sqlc.arg(unique_id))
+    and (
+        sqlc.arg(institution_id)::bigint = 0
+        or e.current_institution_id = sqlc.arg(institution_id)
+    )
+order by e.id, l.sort_order, l.code;
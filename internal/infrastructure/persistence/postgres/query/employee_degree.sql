@@ -2,42 +2,79 @@
 INSERT INTO employee_degrees(
   employee_id,
   language_code,
-  degree_level,
+  degree_level_code,
   university_name,
   speciality,
   date_start,
   date_end,
   given_by,
-  date_degree_recieved
+  date_degree_recieved,
+  translation_group_id,
+  speciality_code
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateEmployeeDegree :one
+-- speciality_code is written as given, a NULL unlinks the degree from the classifier
 UPDATE employee_degrees 
 SET 
-  degree_level = COALESCE($1, degree_level),
+  degree_level_code = COALESCE($1, degree_level_code),
   university_name = COALESCE($2, university_name),
   speciality = COALESCE($3, speciality),
   date_start = COALESCE($4, date_start),
   date_end = COALESCE($5, date_end),
   given_by = COALESCE($6, given_by),
   date_degree_recieved = COALESCE($7, date_degree_recieved),
+  speciality_code = $8,
   updated_at = now()
-WHERE id = $8
+WHERE id = $9
 RETURNING id, created_at, updated_at;
 
+-- name: SyncEmployeeDegreeTranslations :exec
+UPDATE employee_degrees
+SET
+  degree_level_code = source.degree_level_code,
+  speciality_code = source.speciality_code,
+  date_start = source.date_start,
+  date_end = source.date_end,
+  date_degree_recieved = source.date_degree_recieved,
+  updated_at = now()
+FROM employee_degrees source
+WHERE source.id = $1
+  AND employee_degrees.translation_group_id = source.translation_group_id
+  AND employee_degrees.id <> source.id;
+
 -- name: DeleteEmployeeDegree :exec
 DELETE FROM employee_degrees
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_degrees WHERE id = $1
+);
 
 -- name: GetEmployeeDegreeByID :one
-SELECT *
+-- degree_level_name is the name of the level in the language of the entry,
+-- the free text of an entry made before the vocabulary existed when it has no level
+SELECT
+  employee_degrees.*,
+  coalesce(dln.name, employee_degrees.degree_level_code, employee_degrees.degree_level)::text AS degree_level_name
 FROM employee_degrees
-WHERE id = $1;
+LEFT JOIN degree_level_names dln
+  ON dln.degree_level_code = employee_degrees.degree_level_code
+  AND dln.language_code = employee_degrees.language_code
+WHERE employee_degrees.id = $1;
 
--- name: GetEmployeeDegreesByEmployeeIDAndLanguageCode :many
-SELECT *
+-- name: GetEmployeeDegreesByEmployeeIDAndLanguageCodes :many
+SELECT
+  employee_degrees.*,
+  coalesce(dln.name, employee_degrees.degree_level_code, employee_degrees.degree_level)::text AS degree_level_name
 FROM employee_degrees
-WHERE employee_id = $1 AND language_code = $2
+LEFT JOIN degree_level_names dln
+  ON dln.degree_level_code = employee_degrees.degree_level_code
+  AND dln.language_code = employee_degrees.language_code
+WHERE employee_degrees.id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_degrees
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 ORDER BY employee_degrees.date_degree_recieved DESC;
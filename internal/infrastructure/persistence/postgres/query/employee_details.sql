@@ -5,9 +5,11 @@ INSERT INTO employee_details (
   surname,
   name,
   middlename,
-  is_employee_details_new
+  is_employee_details_new,
+  effective_from,
+  effective_to
 ) VALUES(
-  $1, $2, $3, $4, $5, $6
+  $1, $2, $3, $4, $5, $6, $7, $8
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateEmployeeDetails :one
@@ -17,8 +19,10 @@ SET
   name = COALESCE($2, name),
   middlename = COALESCE($3, middlename),
   is_employee_details_new = COALESCE($4, is_employee_details_new),
+  effective_from = $5,
+  effective_to = $6,
   updated_at = now()
-WHERE id = $5
+WHERE id = $7
 RETURNING id, created_at, updated_at;
 
 -- name: DeleteEmployeeDetails :exec
@@ -33,7 +37,8 @@ WHERE id = $1;
 -- name: GetEmployeeDetailsByEmployeeID :many
 SELECT *
 FROM employee_details
-WHERE employee_id = $1;
+WHERE employee_id = $1
+ORDER BY language_code, is_employee_details_new DESC, effective_from DESC NULLS LAST, id DESC;
 
 -- name: GetCurrentEmployeeDetailsByEmployeeIDAndLanguageCode :one
 SELECT *
@@ -41,4 +46,17 @@ FROM employee_details
 WHERE 
   employee_id = $1
   AND language_code = $2
-  AND is_employee_details_new = 'true';
\ No newline at end of file
+  AND is_employee_details_new = 'true';
+
+-- name: ArchiveCurrentEmployeeDetails :exec
+-- the current name of the language becomes a previous one, its period is closed where the new name takes effect
+UPDATE employee_details
+SET
+  is_employee_details_new = false,
+  effective_to = COALESCE(effective_to, GREATEST(COALESCE(sqlc.narg(effective_to)::date, CURRENT_DATE), effective_from)),
+  updated_at = now()
+WHERE
+  employee_id = sqlc.arg(employee_id)
+  AND language_code = sqlc.arg(language_code)
+  AND id <> sqlc.arg(except_id)
+  AND is_employee_details_new IS TRUE;
@@ -3,17 +3,21 @@ INSERT INTO employee_main_research_areas(
   employee_id,
   language_code,
   area,
-  discipline
+  discipline,
+  translation_group_id,
+  research_field_code
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5, $6
 ) RETURNING id, created_at, updated_at;
 
 -- name: CreateEmployeeMainResearchAreaKeyTopic :one
 INSERT INTO employee_main_research_area_key_topics(
   employee_main_research_area_id, 
-  key_topic_title
+  key_topic_title,
+  language_code,
+  keyword_id
 ) VALUES (
-  $1, $2
+  $1, $2, $3, $4
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateEmployeeMainResearchArea :one
@@ -21,21 +25,35 @@ UPDATE employee_main_research_areas
 SET 
   area = COALESCE($1, area),
   discipline = COALESCE($2, discipline),
+  research_field_code = COALESCE($3, research_field_code),
   updated_at = now()
-WHERE id = $3
+WHERE id = $4
 RETURNING id, created_at, updated_at;
 
+-- name: SyncEmployeeMainResearchAreaTranslations :exec
+UPDATE employee_main_research_areas
+SET
+  research_field_code = source.research_field_code,
+  updated_at = now()
+FROM employee_main_research_areas source
+WHERE source.id = $1
+  AND employee_main_research_areas.translation_group_id = source.translation_group_id
+  AND employee_main_research_areas.id <> source.id;
+
 -- name: UpdateEmployeeMainResearchAreaKeyTopic :one
 UPDATE employee_main_research_area_key_topics
 SET
   key_topic_title = COALESCE($1, key_topic_title),
+  keyword_id = COALESCE($2, keyword_id),
   updated_at = now()
-WHERE id = $2
+WHERE id = $3
 RETURNING id, created_at, updated_at;
 
 -- name: DeleteEmployeeMainResearchArea :exec
 DELETE FROM employee_main_research_areas
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_main_research_areas WHERE id = $1
+);
 
 -- name: DeleteEmployeeMainResearchAreaKeyTopic :exec
 DELETE FROM employee_main_research_area_key_topics
@@ -50,10 +68,15 @@ SELECT *
 FROM employee_main_research_areas
 WHERE id = $1;
 
--- name: GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCode :many
+-- name: GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodes :many
 SELECT *
 FROM employee_main_research_areas
-WHERE employee_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_main_research_areas
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
 
 -- name: GetEmployeeMainResearchAreaKeyTopicByID :one
 SELECT *
@@ -0,0 +1,64 @@
+-- name: CreateOrcidOAuthState :exec
+INSERT INTO orcid_oauth_states (
+  state,
+  employee_id
+) VALUES (
+  $1, $2
+);
+
+-- name: ConsumeOrcidOAuthState :one
+DELETE FROM orcid_oauth_states
+WHERE state = $1
+RETURNING *;
+
+-- name: DeleteExpiredOrcidOAuthStates :exec
+DELETE FROM orcid_oauth_states
+WHERE created_at < $1;
+
+-- name: UpsertEmployeeOrcidAccount :one
+INSERT INTO employee_orcid_accounts (
+  employee_id,
+  orcid,
+  access_token,
+  refresh_token,
+  scope,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (employee_id) DO UPDATE
+SET
+  orcid = EXCLUDED.orcid,
+  access_token = EXCLUDED.access_token,
+  refresh_token = EXCLUDED.refresh_token,
+  scope = EXCLUDED.scope,
+  expires_at = EXCLUDED.expires_at,
+  updated_at = now()
+RETURNING id, created_at, updated_at;
+
+-- name: GetEmployeeOrcidAccountByEmployeeID :one
+SELECT *
+FROM employee_orcid_accounts
+WHERE employee_id = $1;
+
+-- name: GetEmployeeOrcidAccountAccessTokenByEmployeeID :one
+-- the encrypted access token is read on its own, only to call ORCID
+SELECT access_token
+FROM employee_orcid_accounts
+WHERE employee_id = $1;
+
+-- name: DeleteEmployeeOrcidAccountByEmployeeID :exec
+DELETE FROM employee_orcid_accounts
+WHERE employee_id = $1;
+
+-- name: ListEmployeeOrcidAccountsDueForSync :many
+SELECT *
+FROM employee_orcid_accounts
+WHERE last_synced_at IS NULL OR last_synced_at < sqlc.arg(synced_before)
+ORDER BY last_synced_at NULLS FIRST, id
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateEmployeeOrcidAccountLastSyncedAt :exec
+UPDATE employee_orcid_accounts
+SET last_synced_at = now()
+WHERE employee_id = $1;
@@ -3,9 +3,10 @@ INSERT INTO employee_participation_in_events(
   employee_id,
   language_code,
   event_title,
-  event_date
+  event_date,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateEmployeeParticipationInEvent :one
@@ -17,16 +18,33 @@ SET
 WHERE id = $3
 RETURNING id, created_at, updated_at;
 
+-- name: SyncEmployeeParticipationInEventTranslations :exec
+UPDATE employee_participation_in_events
+SET
+  event_date = source.event_date,
+  updated_at = now()
+FROM employee_participation_in_events source
+WHERE source.id = $1
+  AND employee_participation_in_events.translation_group_id = source.translation_group_id
+  AND employee_participation_in_events.id <> source.id;
+
 -- name: DeleteEmployeeParticipationInEvent :exec
 DELETE FROM employee_participation_in_events
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_participation_in_events WHERE id = $1
+);
 
 -- name: GetEmployeeParticipationInEventByID :one
 SELECT *
 FROM employee_participation_in_events
 WHERE id = $1;
 
--- name: GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCode :many
+-- name: GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodes :many
 SELECT *
 FROM employee_participation_in_events
-WHERE employee_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_participation_in_events
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -3,9 +3,10 @@ INSERT INTO employee_participation_in_professional_communities(
   employee_id,
   language_code,
   professional_community_title,
-  role_in_professional_community
+  role_in_professional_community,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateEmployeeParticipationInProfessionalCommunity :one
@@ -19,14 +20,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteEmployeeParticipationInProfessionalCommunity :exec
 DELETE FROM employee_participation_in_professional_communities
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_participation_in_professional_communities WHERE id = $1
+);
 
 -- name: GetEmployeeParticipationInProfessionalCommunityByID :one
 SELECT *
 FROM employee_participation_in_professional_communities
 WHERE id = $1;
 
--- name: GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCode :many
+-- name: GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodes :many
 SELECT *
 FROM employee_participation_in_professional_communities
-WHERE employee_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_participation_in_professional_communities
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -3,9 +3,10 @@ INSERT INTO employee_patents(
   employee_id,
   language_code,
   patent_title,
-  description
+  description,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateEmployeePatent :one
@@ -19,14 +20,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteEmployeePatent :exec
 DELETE FROM employee_patents
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_patents WHERE id = $1
+);
 
 -- name: GetEmployeePatentByID :one
 SELECT *
 FROM employee_patents
 WHERE id = $1;
 
--- name: GetEmployeePatentsByEmployeeIDAndLanguageCode :many
+-- name: GetEmployeePatentsByEmployeeIDAndLanguageCodes :many
 SELECT *
 FROM employee_patents
-WHERE employee_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_patents
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -3,30 +3,121 @@ INSERT INTO employee_publications(
   employee_id,
   language_code,
   publication_title,
-  link_to_publication
+  link_to_publication,
+  authors,
+  publication_year,
+  venue,
+  doi,
+  publication_type,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateEmployeePublication :one
+-- the optional bibliographic fields are written as given, a NULL clears them
 UPDATE employee_publications 
 SET 
   publication_title = COALESCE($1, publication_title),
   link_to_publication = COALESCE($2, link_to_publication),
+  authors = COALESCE($4, authors),
+  publication_year = $5,
+  venue = $6,
+  doi = $7,
+  publication_type = $8,
   updated_at = now()
 WHERE id = $3
 RETURNING id, created_at, updated_at;
 
--- name: DeleteEmployeePublication :exec
+-- name: SyncEmployeePublicationTranslations :exec
+UPDATE employee_publications
+SET
+  link_to_publication = source.link_to_publication,
+  publication_year = source.publication_year,
+  doi = source.doi,
+  publication_type = source.publication_type,
+  updated_at = now()
+FROM employee_publications source
+WHERE source.id = $1
+  AND employee_publications.translation_group_id = source.translation_group_id
+  AND employee_publications.id <> source.id;
+
+-- name: DeleteEmployeePublication :many
 DELETE FROM employee_publications
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_publications WHERE id = $1
+)
+RETURNING publication_id;
 
 -- name: GetEmployeePublicationByID :one
 SELECT *
 FROM employee_publications
 WHERE id = $1;
 
--- name: GetEmployeePublicationsByEmployeeIDAndLanguageCode :many
+-- name: GetEmployeePublicationsByEmployeeIDAndLanguageCodes :many
 SELECT *
 FROM employee_publications
-WHERE employee_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_publications
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
+
+-- name: UpsertImportedEmployeePublication :one
+INSERT INTO employee_publications(
+  employee_id,
+  language_code,
+  publication_title,
+  link_to_publication,
+  authors,
+  publication_year,
+  venue,
+  doi,
+  publication_type,
+  source,
+  external_id,
+  translation_group_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+)
+ON CONFLICT (employee_id, language_code, source, external_id) DO UPDATE
+SET
+  publication_title = EXCLUDED.publication_title,
+  link_to_publication = EXCLUDED.link_to_publication,
+  authors = CASE
+    WHEN cardinality(EXCLUDED.authors) > 0 THEN EXCLUDED.authors
+    ELSE employee_publications.authors
+  END,
+  publication_year = EXCLUDED.publication_year,
+  venue = EXCLUDED.venue,
+  doi = EXCLUDED.doi,
+  publication_type = EXCLUDED.publication_type,
+  updated_at = now()
+RETURNING id, created_at, updated_at, publication_id, translation_group_id;
+
+-- name: DeleteStaleImportedEmployeePublications :many
+DELETE FROM employee_publications
+WHERE translation_group_id IN (
+  SELECT translation_group_id
+  FROM employee_publications
+  WHERE employee_id = sqlc.arg(employee_id)
+    AND language_code = sqlc.arg(language_code)
+    AND source = sqlc.arg(source)
+    AND NOT (external_id = ANY(sqlc.arg(external_ids)::text[]))
+)
+RETURNING publication_id;
+
+-- name: SetEmployeePublicationPublicationID :exec
+UPDATE employee_publications
+SET publication_id = sqlc.arg(publication_id)
+WHERE id = sqlc.arg(id);
+
+-- name: CountEmployeePublicationsByPublicationID :one
+SELECT count(*)::bigint
+FROM employee_publications
+WHERE employee_id = sqlc.arg(employee_id) AND publication_id = sqlc.arg(publication_id);
+
+-- name: DeleteEmployeePublicationsByPublicationID :exec
+DELETE FROM employee_publications
+WHERE employee_id = sqlc.arg(employee_id) AND publication_id = sqlc.arg(publication_id);
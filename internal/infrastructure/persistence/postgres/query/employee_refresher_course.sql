@@ -4,9 +4,10 @@ INSERT INTO employee_refresher_courses(
   language_code,
   course_title,
   date_start,
-  date_end
+  date_end,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateEmployeeRefresherCourse :one
@@ -19,16 +20,34 @@ SET
 WHERE id = $4
 RETURNING id, created_at, updated_at;
 
+-- name: SyncEmployeeRefresherCourseTranslations :exec
+UPDATE employee_refresher_courses
+SET
+  date_start = source.date_start,
+  date_end = source.date_end,
+  updated_at = now()
+FROM employee_refresher_courses source
+WHERE source.id = $1
+  AND employee_refresher_courses.translation_group_id = source.translation_group_id
+  AND employee_refresher_courses.id <> source.id;
+
 -- name: DeleteEmployeeRefresherCourse :exec
 DELETE FROM employee_refresher_courses
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_refresher_courses WHERE id = $1
+);
 
 -- name: GetEmployeeRefresherCourseByID :one
 SELECT *
 FROM employee_refresher_courses
 WHERE id = $1;
 
--- name: GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCode :many
+-- name: GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodes :many
 SELECT *
 FROM employee_refresher_courses
-WHERE employee_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_refresher_courses
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
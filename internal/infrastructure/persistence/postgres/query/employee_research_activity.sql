@@ -3,9 +3,10 @@ INSERT INTO employee_research_activities(
   employee_id,
   language_code,
   research_activity_title,
-  employee_role
+  employee_role,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateEmployeeResearchActivity :one
@@ -19,14 +20,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteEmployeeResearchActivity :exec
 DELETE FROM employee_research_activities
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_research_activities WHERE id = $1
+);
 
 -- name: GetEmployeeResearchActivityByID :one
 SELECT *
 FROM employee_research_activities
 WHERE id = $1;
 
--- name: GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCode :many
+-- name: GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodes :many
 SELECT *
 FROM employee_research_activities
-WHERE employee_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_research_activities
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -3,9 +3,10 @@ INSERT INTO employee_scientific_awards(
   employee_id,
   language_code,
   scientific_award_title,
-  given_by
+  given_by,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateEmployeeScientificAward :one
@@ -19,14 +20,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteEmployeeScientificAward :exec
 DELETE FROM employee_scientific_awards
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_scientific_awards WHERE id = $1
+);
 
 -- name: GetEmployeeScientificAwardByID :one
 SELECT *
 FROM employee_scientific_awards
 WHERE id = $1;
 
--- name: GetEmployeeScientificAwardsByEmployeeIDAndLanguageCode :many
+-- name: GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodes :many
 SELECT *
 FROM employee_scientific_awards
-WHERE employee_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_scientific_awards
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -0,0 +1,160 @@
+-- name: DeleteEmployeeSimilarities :exec
+delete from employee_similarities;
+
+-- name: GetSimilarEmployeesByUniqueID :many
+-- names are taken in the first of the language codes the similar employee has details in,
+-- employees without details in any of them or without consent to the latest terms are skipped
+select
+    s.similar_employee_id,
+    e.unique_id,
+    s.score,
+    s.shared_research_areas,
+    s.shared_keywords,
+    s.shared_events,
+    s.shared_institutions,
+    s.computed_at,
+    p.surname,
+    p.name,
+    p.middlename,
+    coalesce(dln.name, e.highest_academic_degree, '')::text as highest_academic_degree,
+    coalesce(e.current_workplace, '')::text as current_workplace,
+    coalesce(e.current_institution_id, 0)::bigint as current_institution_id
+from employee_similarities s
+join employees owner on owner.id = s.employee_id
+join employees e on e.id = s.similar_employee_id
+join lateral (
+    select ed.surname, ed.name, ed.middlename, ed.language_code
+    from employee_details ed
+    where ed.employee_id = e.id
+      and ed.is_employee_details_new is true
+      and ed.language_code = any(sqlc.arg(language_codes)::text[])
+    order by array_position(sqlc.arg(language_codes)::text[], ed.language_code::text)
+    limit 1
+) p on true
+left join
+    degree_level_names dln
+    on dln.degree_level_code = e.highest_academic_degree
+    and dln.language_code = p.language_code
+where
+    owner.unique_id = sqlc.arg(unique_id)
+    and has_latest_consent(owner.user_id)
+    and has_latest_consent(e.user_id)
+order by s.score desc, s.similar_employee_id
+limit sqlc.arg('limit')
+;
+
+-- name: InsertEmployeeSimilarities :execrows
+-- pairs come from shared research areas, keywords and events, a shared institution only adds to the score
+-- so that large institutions do not pair everyone with everyone. Every kind scores the cosine of the two sets
+-- (shared / sqrt(size * size)), weighted and summed; the best max_per_employee pairs of each employee are kept.
+-- employees without consent to the latest terms are left out on both sides
+with consenting as (
+    select e.id
+    from employees e
+    where has_latest_consent(e.user_id)
+),
+features as (
+    select distinct mra.employee_id, 'research_area'::text as kind,
+        coalesce('field:' || mra.research_field_code, 'area:' || lower(regexp_replace(btrim(mra.area), '\s+', ' ', 'g')))::text as token
+    from employee_main_research_areas mra
+    union
+    select distinct mra.employee_id, 'keyword'::text, kt.keyword_id::text
+    from employee_main_research_area_key_topics kt
+    join employee_main_research_areas mra on mra.id = kt.employee_main_research_area_id
+    where kt.keyword_id is not null
+    union
+    select distinct pe.employee_id, 'event'::text,
+        (lower(regexp_replace(btrim(pe.event_title), '\s+', ' ', 'g')) || '@' || coalesce(pe.event_date::text, ''))::text
+    from employee_participation_in_events pe
+),
+institutions as (
+    select distinct we.employee_id, we.institution_id
+    from employee_work_experiences we
+    where we.institution_id is not null
+),
+feature_sizes as (
+    select employee_id, kind, count(*)::float8 as size
+    from features
+    group by employee_id, kind
+),
+institution_sizes as (
+    select employee_id, count(*)::float8 as size
+    from institutions
+    group by employee_id
+),
+pairs as (
+    select
+        a.employee_id,
+        b.employee_id as similar_employee_id,
+        count(*) filter (where a.kind = 'research_area')::int as shared_research_areas,
+        count(*) filter (where a.kind = 'keyword')::int as shared_keywords,
+        count(*) filter (where a.kind = 'event')::int as shared_events
+    from features a
+    join features b on b.kind = a.kind and b.token = a.token and b.employee_id <> a.employee_id
+    where
+        a.employee_id in (select id from consenting)
+        and b.employee_id in (select id from consenting)
+    group by a.employee_id, b.employee_id
+),
+scored as (
+    select
+        pairs.*,
+        (
+            select count(*)
+            from institutions ia
+            join institutions ib on ib.institution_id = ia.institution_id
+            where ia.employee_id = pairs.employee_id and ib.employee_id = pairs.similar_employee_id
+        )::int as shared_institutions,
+        (
+            select coalesce(sum(
+                case fa.kind
+                    when 'research_area' then sqlc.arg(research_area_weight)::float8 * pairs.shared_research_areas
+                    when 'keyword' then sqlc.arg(keyword_weight)::float8 * pairs.shared_keywords
+                    else sqlc.arg(event_weight)::float8 * pairs.shared_events
+                end / sqrt(fa.size * fb.size)
+            ), 0)
+            from feature_sizes fa
+            join feature_sizes fb on fb.kind = fa.kind and fb.employee_id = pairs.similar_employee_id
+            where fa.employee_id = pairs.employee_id
+        )::float8 as topic_score
+    from pairs
+),
+ranked as (
+    select
+        scored.*,
+        scored.topic_score + coalesce(
+            sqlc.arg(institution_weight)::float8 * scored.shared_institutions / sqrt(ia.size * ib.size),
+            0
+        ) as score
+    from scored
+    left join institution_sizes ia on ia.employee_id = scored.employee_id
+    left join institution_sizes ib on ib.employee_id = scored.similar_employee_id
+),
+limited as (
+    select
+        ranked.*,
+        row_number() over (partition by ranked.employee_id order by ranked.score desc, ranked.similar_employee_id) as position
+    from ranked
+)
+insert into employee_similarities (
+    employee_id,
+    similar_employee_id,
+    score,
+    shared_research_areas,
+    shared_keywords,
+    shared_events,
+    shared_institutions,
+    computed_at
+)
+select
+    employee_id,
+    similar_employee_id,
+    score,
+    shared_research_areas,
+    shared_keywords,
+    shared_events,
+    shared_institutions,
+    now()
+from limited
+where position <= sqlc.arg(max_per_employee)::int
+;
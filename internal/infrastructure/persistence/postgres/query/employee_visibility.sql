@@ -0,0 +1,30 @@
+-- name: GetEmployeeVisibilitySettingsByEmployeeID :many
+SELECT *
+FROM employee_visibility_settings
+WHERE employee_id = $1
+ORDER BY target;
+
+-- name: GetEmployeeVisibilitySettingsByEmployeeIDs :many
+-- one row per setting along with the current institution of the employee,
+-- an employee without settings has a single row with empty target and level
+SELECT
+  e.id AS employee_id,
+  coalesce(e.current_institution_id, 0)::bigint AS current_institution_id,
+  coalesce(evs.target, '')::text AS target,
+  coalesce(evs.level, '')::text AS level
+FROM employees e
+LEFT JOIN employee_visibility_settings evs ON evs.employee_id = e.id
+WHERE e.id = any(sqlc.arg(ids)::bigint[])
+ORDER BY e.id, evs.target;
+
+-- name: UpsertEmployeeVisibilitySetting :exec
+INSERT INTO employee_visibility_settings (
+  employee_id,
+  target,
+  level
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (employee_id, target) DO UPDATE
+SET level = EXCLUDED.level,
+    updated_at = now();
@@ -7,9 +7,12 @@ INSERT INTO employee_work_experiences(
   description,
   date_start,
   date_end,
-  on_going
+  on_going,
+  translation_group_id,
+  institution_id,
+  org_unit_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateEmployeeWorkExperience :one
@@ -24,14 +27,31 @@ SET
     ELSE COALESCE($5, date_end)
   END,
   on_going = COALESCE($6::boolean, on_going),
+  institution_id = $8,
+  org_unit_id = $9,
   updated_at = now()
 WHERE id = $7
 RETURNING id, created_at, updated_at;
 
+-- name: SyncEmployeeWorkExperienceTranslations :exec
+UPDATE employee_work_experiences
+SET
+  date_start = source.date_start,
+  date_end = source.date_end,
+  on_going = source.on_going,
+  institution_id = source.institution_id,
+  org_unit_id = source.org_unit_id,
+  updated_at = now()
+FROM employee_work_experiences source
+WHERE source.id = $1
+  AND employee_work_experiences.translation_group_id = source.translation_group_id
+  AND employee_work_experiences.id <> source.id;
+
 -- name: DeleteEmployeeWorkExperience :exec
-delete from employee_work_experiences
-where id = $1
-;
+DELETE FROM employee_work_experiences
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_work_experiences WHERE id = $1
+);
 
 -- name: GetEmployeeWorkExperienceByID :one
 select *
@@ -39,10 +59,53 @@ from employee_work_experiences
 where id = $1
 ;
 
--- name: GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCode :many
+-- name: GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodes :many
 select *
 from employee_work_experiences
-where employee_id = $1 and language_code = $2
+where id in (
+    select distinct on (translation_group_id) id
+    from employee_work_experiences
+    where employee_id = $1 and language_code = any($2::text[])
+    order by translation_group_id, array_position($2::text[], language_code::text)
+)
 order by
     employee_work_experiences.on_going desc, employee_work_experiences.date_end desc
 ;
+
+-- name: UpsertImportedEmployeeWorkExperience :one
+INSERT INTO employee_work_experiences(
+  employee_id,
+  language_code,
+  workplace,
+  job_title,
+  description,
+  date_start,
+  date_end,
+  on_going,
+  source,
+  external_id,
+  translation_group_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+ON CONFLICT (employee_id, language_code, source, external_id) DO UPDATE
+SET
+  workplace = EXCLUDED.workplace,
+  job_title = EXCLUDED.job_title,
+  description = EXCLUDED.description,
+  date_start = EXCLUDED.date_start,
+  date_end = EXCLUDED.date_end,
+  on_going = EXCLUDED.on_going,
+  updated_at = now()
+RETURNING id, created_at, updated_at, translation_group_id;
+
+-- name: DeleteStaleImportedEmployeeWorkExperiences :exec
+DELETE FROM employee_work_experiences
+WHERE translation_group_id IN (
+  SELECT translation_group_id
+  FROM employee_work_experiences
+  WHERE employee_id = sqlc.arg(employee_id)
+    AND language_code = sqlc.arg(language_code)
+    AND source = sqlc.arg(source)
+    AND NOT (external_id = ANY(sqlc.arg(external_ids)::text[]))
+);
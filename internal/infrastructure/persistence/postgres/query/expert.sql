@@ -0,0 +1,137 @@
+-- name: GetExpertProfilesByIDs :many
+-- names are taken in the first of the language codes the employee has details in
+select distinct on (e.id)
+    e.id,
+    e.unique_id,
+    coalesce(dln.name, e.highest_academic_degree, '')::text as highest_academic_degree,
+    coalesce(e.current_workplace, '')::text as current_workplace,
+    coalesce(e.current_institution_id, 0)::bigint as current_institution_id,
+    ed.surname,
+    ed.name,
+    ed.middlename
+from employees e
+join
+    employee_details ed
+    on ed.employee_id = e.id
+    and ed.is_employee_details_new is true
+    and ed.language_code = any(sqlc.arg(language_codes)::text[])
+left join
+    degree_level_names dln
+    on dln.degree_level_code = e.highest_academic_degree
+    and dln.language_code = ed.language_code
+where e.id = any(sqlc.arg(ids)::bigint[])
+order by e.id, array_position(sqlc.arg(language_codes)::text[], ed.language_code::text)
+;
+
+-- name: SearchExpertMatches :many
+-- every profile item containing at least one of the terms, terms are lowercase words.
+-- item_key identifies the item across its translations so a translated item is counted once,
+-- key topics also match by the labels of their keyword in every language
+with items as (
+    select
+        mra.employee_id,
+        'research_area'::text as section,
+        mra.language_code,
+        coalesce(mra.translation_group_id::text, 'research_area:' || mra.id)::text as item_key,
+        concat_ws(' / ', mra.discipline, mra.area)::text as title,
+        concat_ws(' ', mra.discipline, mra.area)::text as content
+    from employee_main_research_areas mra
+    union all
+    select
+        mra.employee_id,
+        'key_topic'::text,
+        kt.language_code,
+        coalesce('keyword:' || kt.keyword_id, 'key_topic:' || kt.id)::text,
+        kt.key_topic_title::text,
+        concat_ws(
+            ' ',
+            kt.key_topic_title,
+            (select string_agg(kl.label, ' ') from keyword_labels kl where kl.keyword_id = kt.keyword_id)
+        )::text
+    from employee_main_research_area_key_topics kt
+    join employee_main_research_areas mra on mra.id = kt.employee_main_research_area_id
+    union all
+    select
+        ep.employee_id,
+        'publication'::text,
+        ep.language_code,
+        coalesce(ep.translation_group_id::text, 'publication:' || ep.id)::text,
+        ep.publication_title::text,
+        ep.publication_title::text
+    from employee_publications ep
+    union all
+    select
+        ept.employee_id,
+        'patent'::text,
+        ept.language_code,
+        coalesce(ept.translation_group_id::text, 'patent:' || ept.id)::text,
+        ept.patent_title::text,
+        concat_ws(' ', ept.patent_title, ept.description)::text
+    from employee_patents ept
+    union all
+    select
+        era.employee_id,
+        'project'::text,
+        era.language_code,
+        coalesce(era.translation_group_id::text, 'project:' || era.id)::text,
+        era.research_activity_title::text,
+        era.research_activity_title::text
+    from employee_research_activities era
+    union all
+    select
+        edg.employee_id,
+        'degree'::text,
+        edg.language_code,
+        coalesce(edg.translation_group_id::text, 'degree:' || edg.id)::text,
+        edg.speciality::text,
+        edg.speciality::text
+    from employee_degrees edg
+)
+select
+    items.employee_id,
+    items.section,
+    items.item_key,
+    items.title,
+    array(
+        select term
+        from unnest(sqlc.arg(terms)::text[]) term
+        where strpos(lower(items.content), term) > 0
+    )::text[] as matched_terms
+from items
+join employees e on e.id = items.employee_id
+where
+    -- only employees who accepted the latest terms are published
+    has_latest_consent(e.user_id)
+    and exists (
+        select 1
+        from unnest(sqlc.arg(terms)::text[]) term
+        where strpos(lower(items.content), term) > 0
+    )
+    -- optional filters (pass empty values to ignore)
+    and (
+        sqlc.arg(language_code)::text = ''
+        or items.language_code = sqlc.arg(language_code)
+    )
+    -- employees without details in the profile language cannot be shown
+    and exists (
+        select 1
+        from employee_details ed
+        where ed.employee_id = e.id
+          and ed.is_employee_details_new is true
+          and (sqlc.arg(language_code)::text = '' or ed.language_code = sqlc.arg(language_code))
+    )
+    and (
+        sqlc.arg(institution_id)::bigint = 0
+        or e.current_institution_id = sqlc.arg(institution_id)
+    )
+    and (
+        sqlc.arg(degree_level_code)::text = ''
+        or exists (
+            select 1
+            from employee_degrees dg
+            where dg.employee_id = e.id
+              and dg.degree_level_code = sqlc.arg(degree_level_code)
+        )
+    )
+order by items.employee_id, items.section, items.item_key
+;
@@ -36,3 +36,11 @@ WHERE
 -- name: GetAllInstitutions :many
 SELECT *
 FROM institutions;
+
+-- name: IsInstitutionAdmin :one
+SELECT EXISTS (
+  SELECT 1
+  FROM institution_admins
+  WHERE institution_id = $1
+    AND user_id = $2
+)::boolean AS is_admin;
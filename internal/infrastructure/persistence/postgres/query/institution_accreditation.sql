@@ -3,9 +3,10 @@ INSERT INTO institution_accreditations(
   institution_id,
   language_code,
   accreditation_type,
-  given_by
+  given_by,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateInstitutionAccreditation :one
@@ -19,14 +20,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteInstitutionAccreditation :exec
 DELETE FROM institution_accreditations
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_accreditations WHERE id = $1
+);
 
 -- name: GetInstitutionAccreditationByID :one
 SELECT *
 FROM institution_accreditations
 WHERE id = $1;
 
--- name: GetInstitutionAccreditationsByInstitutionIDAndLanguageCode :many
+-- name: GetInstitutionAccreditationsByInstitutionIDAndLanguageCodes :many
 SELECT *
 FROM institution_accreditations
-WHERE institution_id = $1 and language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_accreditations
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -7,9 +7,10 @@ INSERT INTO institution_achievements(
   date_recieved,
   given_by,
   link_to_file,
-  description
+  description,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8  
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateInstitutionAchievement :one
@@ -27,14 +28,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteInstitutionAchivement :exec
 DELETE FROM institution_achievements
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_achievements WHERE id = $1
+);
 
 -- name: GetInstitutionAchievementByID :one
 SELECT *
 FROM institution_achievements
 WHERE id = $1;
 
--- name: GetInstitutionAchievementsByInstitutionIDAndLanguageCode :many
+-- name: GetInstitutionAchievementsByInstitutionIDAndLanguageCodes :many
 SELECT *
 FROM institution_achievements
-WHERE institution_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_achievements
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -5,9 +5,10 @@ INSERT INTO institution_conferences (
   conference_title,
   link,
   link_to_rinc,
-  date_of_conference
+  date_of_conference,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6
+  $1, $2, $3, $4, $5, $6, $7
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateInstitutionConference :one
@@ -23,14 +24,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteInstitutionConference :exec
 DELETE FROM institution_conferences
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_conferences WHERE id = $1
+);
 
 -- name: GetInstitutionConferenceByID :one
 SELECT *
 FROM institution_conferences
 WHERE id = $1;
 
--- name: GetInstitutionConferencesByInstitutionIDAndLanguageCode :many
+-- name: GetInstitutionConferencesByInstitutionIDAndLanguageCodes :many
 SELECT *
 FROM institution_conferences
-WHERE institution_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_conferences
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
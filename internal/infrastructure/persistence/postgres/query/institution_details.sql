@@ -41,10 +41,12 @@ SELECT *
 FROM institution_details
 WHERE id = $1;
 
--- name: GetInstitutionDetailsByInstitutionIDAndLanguage :one
+-- name: GetInstitutionDetailsByInstitutionIDAndLanguageCodes :one
 SELECT *
 FROM institution_details
-WHERE institution_id = $1 AND language_code = $2;
+WHERE institution_id = $1 AND language_code = ANY($2::text[])
+ORDER BY array_position($2::text[], language_code::text)
+LIMIT 1;
 
 -- name: GetInstitutionNamesByLanguageCode :many
 SELECT institution_title_long
@@ -4,9 +4,10 @@ INSERT INTO institution_magazines(
   language_code,
   magazine_name,
   link,
-  link_to_rinc
+  link_to_rinc,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateInstitutionMagazine :one
@@ -21,14 +22,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteInstitutionMagazine :exec
 DELETE FROM institution_magazines
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_magazines WHERE id = $1
+);
 
 -- name: GetInstitutionMagazineByID :one
 SELECT *
 FROM institution_magazines
 WHERE id = $1;
 
--- name: GetInstitutionMagazinesByInstitutionIDAndLanguageCode :many
+-- name: GetInstitutionMagazinesByInstitutionIDAndLanguageCodes :many
 SELECT *
 FROM institution_magazines
-WHERE institution_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_magazines
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -4,9 +4,11 @@ INSERT INTO institution_main_research_directions(
   language_code,
   research_direction_title,
   discipline,
-  area_of_research
+  area_of_research,
+  translation_group_id,
+  research_field_code
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6, $7
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateInstitutionMainResearchDirection :one
@@ -15,20 +17,38 @@ SET
   research_direction_title = COALESCE($1, research_direction_title),
   discipline = COALESCE($2, discipline),
   area_of_research = COALESCE($3, area_of_research),
+  research_field_code = COALESCE($4, research_field_code),
   updated_at = now()
-WHERE id = $4
+WHERE id = $5
 RETURNING id, created_at, updated_at;
 
+-- name: SyncInstitutionMainResearchDirectionTranslations :exec
+UPDATE institution_main_research_directions
+SET
+  research_field_code = source.research_field_code,
+  updated_at = now()
+FROM institution_main_research_directions source
+WHERE source.id = $1
+  AND institution_main_research_directions.translation_group_id = source.translation_group_id
+  AND institution_main_research_directions.id <> source.id;
+
 -- name: DeleteInstitutionMainResearchDirection :exec
 DELETE FROM institution_main_research_directions
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_main_research_directions WHERE id = $1
+);
 
 -- name: GetInstitutionMainResearchDirectionByID :one
 SELECT *
 FROM institution_main_research_directions
 WHERE id = $1;
 
--- name: GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguage :many
+-- name: GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageCodes :many
 SELECT *
 FROM institution_main_research_directions
-WHERE institution_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_main_research_directions
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -6,9 +6,10 @@ INSERT INTO institution_partnerships(
   partner_type,
   date_of_contract,
   link_to_partner,
-  goal
+  goal,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7
+  $1, $2, $3, $4, $5, $6, $7, $8
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateInstitutionPartnership :one
@@ -25,14 +26,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteInstitutionPartnership :exec
 DELETE FROM institution_partnerships
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_partnerships WHERE id = $1
+);
 
 -- name: GetInstitutionPartnershipByID :one
 SELECT *
 FROM institution_partnerships
 WHERE id = $1;
 
--- name: GetInstitutionPartnershipsByInstitutionIDAndLanguageCode :many
+-- name: GetInstitutionPartnershipsByInstitutionIDAndLanguageCodes :many
 SELECT *
 FROM institution_partnerships
-WHERE institution_id = $1 and language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_partnerships
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
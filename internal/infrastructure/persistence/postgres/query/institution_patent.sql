@@ -6,9 +6,10 @@ INSERT INTO institution_patents(
   discipline,
   description,
   implemented_in,
-  link_to_patent_file
+  link_to_patent_file,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7
+  $1, $2, $3, $4, $5, $6, $7, $8
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateInstitutionPatent :one
@@ -25,14 +26,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteInstitutionPatent :exec
 DELETE FROM institution_patents
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_patents WHERE id = $1
+);
 
 -- name: GetInstitutionPatentByID :one
 SELECT *
 FROM institution_patents
 WHERE id = $1;
 
--- name: GetInstitutionPatentsByInstitutionIDAndLanguageCode :many
+-- name: GetInstitutionPatentsByInstitutionIDAndLanguageCodes :many
 SELECT *
 FROM institution_patents
-WHERE institution_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_patents
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -8,9 +8,10 @@ INSERT INTO institution_projects(
   date_end,
   fund,
   institution_role,
-  coordinator
+  coordinator,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateInstitutionProject :one
@@ -29,14 +30,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteInstitutionProject :exec
 DELETE FROM institution_projects
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_projects WHERE id = $1
+);
 
 -- name: GetInstitutionProjectByID :one
 SELECT *
 FROM institution_projects
 WHERE id = $1;
 
--- name: GetInstitutionProjectsByInstitutionIDAndLanguageCode :many
+-- name: GetInstitutionProjectsByInstitutionIDAndLanguageCodes :many
 SELECT *
 FROM institution_projects
-WHERE institution_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_projects
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -4,9 +4,10 @@ INSERT INTO institution_project_partners(
   language_code,
   partner_name,
   partner_type,
-  link_to_partner
+  link_to_partner,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateInstitutionProjectPartner :one
@@ -21,7 +22,9 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteInstitutionProjectPartner :exec
 DELETE FROM institution_project_partners
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_project_partners WHERE id = $1
+);
 
 -- name: GetInstitutionProjectPartnerByID :one
 SELECT *
@@ -8,9 +8,10 @@ INSERT INTO institution_rankings(
   ranking_agency,
   link_to_ranking_file,
   description,
-  link_to_ranking_agency
+  link_to_ranking_agency,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateInstitutionRanking :one
@@ -29,14 +30,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteInstitutionRanking :exec
 DELETE FROM institution_rankings
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_rankings WHERE id = $1
+);
 
 -- name: GetInstitutionRankingByID :one
 SELECT *
 FROM institution_rankings
 WHERE id = $1;
 
--- name: GetInstitutionRankingsByInstitutionIDAndLanguageCode :many
+-- name: GetInstitutionRankingsByInstitutionIDAndLanguageCodes :many
 SELECT *
 FROM institution_rankings
-WHERE institution_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_rankings
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
@@ -4,9 +4,10 @@ INSERT INTO institution_research_support_infrastructures(
   language_code,
   research_support_infrastructure_title,
   research_support_infrastructure_type,
-  tin_of_legal_entity
+  tin_of_legal_entity,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6
 ) RETURNING id, created_at, updated_at;
 
 -- name: UpdateInstitutionResearchSupportInfrastructure :one
@@ -21,14 +22,21 @@ RETURNING id, created_at, updated_at;
 
 -- name: DeleteInstitutionResearchSupportInfrastructure :exec
 DELETE FROM institution_research_support_infrastructures
-WHERE id = $1;
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_research_support_infrastructures WHERE id = $1
+);
 
 -- name: GetInstitutionResearchSupportInfrastructureByID :one
 SELECT *
 FROM institution_research_support_infrastructures
 WHERE id = $1;
 
--- name: GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCode :many
+-- name: GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodes :many
 SELECT *
 FROM institution_research_support_infrastructures
-WHERE institution_id = $1 AND language_code = $2;
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_research_support_infrastructures
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+);
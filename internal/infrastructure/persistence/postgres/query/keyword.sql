@@ -0,0 +1,100 @@
+-- name: CreateKeyword :one
+INSERT INTO keywords DEFAULT VALUES
+RETURNING id;
+
+-- name: CreateKeywordLabel :exec
+INSERT INTO keyword_labels (
+  keyword_id,
+  language_code,
+  label,
+  is_preferred
+) VALUES (
+  $1, $2, $3, $4
+);
+
+-- name: DeleteKeywordsByIDs :exec
+DELETE FROM keywords
+WHERE id = ANY(sqlc.arg(ids)::bigint[]);
+
+-- name: GetKeywordIDByLabel :one
+-- synonyms left by merges resolve to the keyword they were merged into
+SELECT keyword_id
+FROM keyword_labels
+WHERE language_code = sqlc.arg(language_code)
+  AND normalized_label = normalize_keyword_label(sqlc.arg(label)::text);
+
+-- name: GetKeywordLabelsByKeywordIDs :many
+SELECT *
+FROM keyword_labels
+WHERE keyword_id = ANY(sqlc.arg(keyword_ids)::bigint[])
+ORDER BY keyword_id, language_code, is_preferred DESC, label;
+
+-- name: GetKeywordsByIDs :many
+SELECT
+  keywords.*,
+  (
+    SELECT count(DISTINCT mra.employee_id)
+    FROM employee_main_research_area_key_topics kt
+    JOIN employee_main_research_areas mra ON mra.id = kt.employee_main_research_area_id
+    WHERE kt.keyword_id = keywords.id
+  )::bigint AS employee_count
+FROM keywords
+WHERE keywords.id = ANY(sqlc.arg(ids)::bigint[])
+ORDER BY keywords.id;
+
+-- name: MoveKeywordLabels :exec
+-- moved labels become synonyms, the target keeps its preferred labels
+UPDATE keyword_labels
+SET
+  keyword_id = sqlc.arg(target_id)::bigint,
+  is_preferred = false,
+  updated_at = now()
+WHERE keyword_id = ANY(sqlc.arg(source_ids)::bigint[]);
+
+-- name: MoveKeywordTopics :exec
+UPDATE employee_main_research_area_key_topics
+SET
+  keyword_id = sqlc.arg(target_id)::bigint,
+  updated_at = now()
+WHERE keyword_id = ANY(sqlc.arg(source_ids)::bigint[]);
+
+-- name: PreferKeywordLabels :exec
+-- a language of the keyword without a preferred label gets its oldest label preferred
+UPDATE keyword_labels
+SET
+  is_preferred = true,
+  updated_at = now()
+WHERE id IN (
+  SELECT DISTINCT ON (kl.language_code) kl.id
+  FROM keyword_labels kl
+  WHERE kl.keyword_id = $1
+    AND NOT EXISTS (
+      SELECT 1
+      FROM keyword_labels preferred
+      WHERE preferred.keyword_id = kl.keyword_id
+        AND preferred.language_code = kl.language_code
+        AND preferred.is_preferred
+    )
+  ORDER BY kl.language_code, kl.id
+);
+
+-- name: SearchKeywordsByPrefix :many
+-- a keyword matches when any of its labels in any language starts with the prefix,
+-- the prefix is normalized like labels and has LIKE wildcards escaped
+SELECT
+  keywords.*,
+  (
+    SELECT count(DISTINCT mra.employee_id)
+    FROM employee_main_research_area_key_topics kt
+    JOIN employee_main_research_areas mra ON mra.id = kt.employee_main_research_area_id
+    WHERE kt.keyword_id = keywords.id
+  )::bigint AS employee_count
+FROM keywords
+WHERE EXISTS (
+  SELECT 1
+  FROM keyword_labels kl
+  WHERE kl.keyword_id = keywords.id
+    AND kl.normalized_label LIKE normalize_keyword_label(sqlc.arg(prefix)::text) || '%'
+)
+ORDER BY employee_count DESC, keywords.id
+LIMIT sqlc.arg('limit');
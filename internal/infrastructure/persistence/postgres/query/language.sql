@@ -0,0 +1,4 @@
+-- name: GetAllLanguages :many
+SELECT *
+FROM languages
+ORDER BY sort_order, code;
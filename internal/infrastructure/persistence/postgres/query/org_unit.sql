@@ -0,0 +1,80 @@
+-- name: CreateOrgUnit :one
+INSERT INTO org_units (
+  institution_id,
+  parent_id,
+  unit_type,
+  sort_order
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING id, created_at, updated_at;
+
+-- name: UpdateOrgUnit :one
+UPDATE org_units
+SET
+  parent_id = $2,
+  unit_type = $3,
+  sort_order = $4,
+  updated_at = now()
+WHERE id = $1
+RETURNING id, created_at, updated_at;
+
+-- name: DeleteOrgUnit :exec
+DELETE FROM org_units
+WHERE id = $1;
+
+-- name: GetOrgUnitByID :one
+SELECT *
+FROM org_units
+WHERE id = $1;
+
+-- name: GetOrgUnitsByInstitutionID :many
+SELECT *
+FROM org_units
+WHERE institution_id = $1
+ORDER BY sort_order, id;
+
+-- name: IsOrgUnitInSubtree :one
+-- Whether the unit is the root of the subtree or lies anywhere below it.
+WITH RECURSIVE subtree AS (
+  SELECT ou.id
+  FROM org_units ou
+  WHERE ou.id = sqlc.arg(root_id)
+  UNION ALL
+  SELECT child.id
+  FROM org_units child
+  JOIN subtree ON child.parent_id = subtree.id
+)
+SELECT EXISTS (
+  SELECT 1 FROM subtree WHERE subtree.id = sqlc.arg(org_unit_id)
+)::boolean AS in_subtree;
+
+-- name: GetOrgUnitNamesByInstitutionID :many
+SELECT n.*
+FROM org_unit_names n
+JOIN org_units ou ON ou.id = n.org_unit_id
+WHERE ou.institution_id = $1
+ORDER BY n.org_unit_id, n.language_code;
+
+-- name: GetOrgUnitNamesByOrgUnitID :many
+SELECT *
+FROM org_unit_names
+WHERE org_unit_id = $1
+ORDER BY language_code;
+
+-- name: UpsertOrgUnitName :exec
+INSERT INTO org_unit_names (
+  org_unit_id,
+  language_code,
+  name
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (org_unit_id, language_code) DO UPDATE
+SET
+  name = EXCLUDED.name,
+  updated_at = now();
+
+-- name: DeleteOrgUnitNamesExceptLanguages :exec
+DELETE FROM org_unit_names
+WHERE org_unit_id = sqlc.arg(org_unit_id)
+  AND NOT (language_code = ANY(sqlc.arg(language_codes)::text[]));
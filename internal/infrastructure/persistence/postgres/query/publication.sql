@@ -0,0 +1,122 @@
+-- name: CreatePublication :one
+INSERT INTO publications (
+  publication_title,
+  normalized_title,
+  link_to_publication,
+  publication_year,
+  venue,
+  doi,
+  publication_type
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetPublicationByID :one
+SELECT *
+FROM publications
+WHERE id = $1;
+
+-- name: GetPublicationByDOI :one
+SELECT *
+FROM publications
+WHERE doi = $1;
+
+-- name: GetPublicationByNormalizedTitle :one
+SELECT *
+FROM publications
+WHERE normalized_title = $1
+ORDER BY id
+LIMIT 1;
+
+-- name: ListPublicationClaimSuggestions :many
+-- publications having an unclaimed author whose name contains the surname of the employee
+SELECT p.*
+FROM publications p
+WHERE EXISTS (
+    SELECT 1
+    FROM publication_authors pa
+    JOIN employee_details ed
+      ON ed.employee_id = sqlc.arg(employee_id)
+      AND ed.is_employee_details_new IS TRUE
+      AND btrim(ed.surname) <> ''
+    WHERE pa.publication_id = p.id
+      AND pa.employee_id IS NULL
+      AND pa.author_name ILIKE '%' || btrim(ed.surname) || '%'
+  )
+  AND NOT EXISTS (
+    SELECT 1
+    FROM publication_authors pa
+    WHERE pa.publication_id = p.id AND pa.employee_id = sqlc.arg(employee_id)
+  )
+ORDER BY p.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CreatePublicationAuthor :one
+INSERT INTO publication_authors (
+  publication_id,
+  position,
+  author_name,
+  employee_id,
+  claimed_at
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetPublicationAuthorsByPublicationID :many
+SELECT
+  pa.id,
+  pa.publication_id,
+  pa.position,
+  pa.author_name,
+  pa.employee_id,
+  pa.claimed_at,
+  pa.created_at,
+  pa.updated_at,
+  e.unique_id AS employee_unique_id
+FROM publication_authors pa
+LEFT JOIN employees e ON e.id = pa.employee_id
+WHERE pa.publication_id = $1
+ORDER BY pa.position;
+
+-- name: GetPublicationAuthorByEmployeeID :one
+SELECT *
+FROM publication_authors
+WHERE publication_id = $1 AND employee_id = $2;
+
+-- name: FindMatchingUnclaimedPublicationAuthor :one
+-- first unclaimed author whose name contains the surname of the employee in any language
+SELECT pa.*
+FROM publication_authors pa
+WHERE pa.publication_id = sqlc.arg(publication_id)
+  AND pa.employee_id IS NULL
+  AND EXISTS (
+    SELECT 1
+    FROM employee_details ed
+    WHERE ed.employee_id = sqlc.arg(employee_id)
+      AND ed.is_employee_details_new IS TRUE
+      AND btrim(ed.surname) <> ''
+      AND pa.author_name ILIKE '%' || btrim(ed.surname) || '%'
+  )
+ORDER BY pa.position
+LIMIT 1;
+
+-- name: GetNextPublicationAuthorPosition :one
+SELECT (COALESCE(max(position), 0) + 1)::int AS next_position
+FROM publication_authors
+WHERE publication_id = $1;
+
+-- name: ClaimPublicationAuthor :execrows
+UPDATE publication_authors
+SET
+  employee_id = sqlc.arg(employee_id),
+  claimed_at = now(),
+  updated_at = now()
+WHERE id = sqlc.arg(id) AND employee_id IS NULL;
+
+-- name: UnclaimPublicationAuthor :exec
+UPDATE publication_authors
+SET
+  employee_id = NULL,
+  claimed_at = NULL,
+  updated_at = now()
+WHERE publication_id = $1 AND employee_id = $2;
@@ -0,0 +1,109 @@
+-- name: UpsertPublicationCitation :one
+INSERT INTO publication_citations (
+  publication_id,
+  citation_count,
+  source
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (publication_id) DO UPDATE
+SET
+  citation_count = EXCLUDED.citation_count,
+  source = EXCLUDED.source,
+  updated_at = now()
+RETURNING *;
+
+-- name: GetPublicationCitationsByEmployeeID :many
+SELECT pc.*
+FROM publication_citations pc
+WHERE pc.publication_id IN (
+  SELECT ep.publication_id
+  FROM employee_publications ep
+  WHERE ep.employee_id = $1
+);
+
+-- name: ListCitationImportCandidatesByEmployeeID :many
+-- shared publications of the employee that have a DOI to look the citation count up by
+SELECT DISTINCT p.id, p.doi
+FROM publications p
+JOIN employee_publications ep ON ep.publication_id = p.id
+WHERE ep.employee_id = $1 AND p.doi IS NOT NULL
+ORDER BY p.id;
+
+-- name: GetEmployeeCitationMetrics :one
+SELECT *
+FROM employee_citation_metrics
+WHERE employee_id = $1;
+
+-- name: RefreshEmployeeCitationMetrics :exec
+-- h-index is the largest h such that h publications have at least h citations each,
+-- i10-index is the number of publications with at least 10 citations.
+-- A publication entered in several languages is counted once.
+WITH ranked AS (
+  SELECT
+    pc.citation_count,
+    row_number() OVER (ORDER BY pc.citation_count DESC) AS citation_rank
+  FROM publication_citations pc
+  WHERE pc.publication_id IN (
+    SELECT ep.publication_id
+    FROM employee_publications ep
+    WHERE ep.employee_id = sqlc.arg(employee_id)::bigint
+  )
+)
+INSERT INTO employee_citation_metrics (
+  employee_id,
+  h_index,
+  i10_index,
+  total_citations
+)
+SELECT
+  sqlc.arg(employee_id)::bigint,
+  count(*) FILTER (WHERE ranked.citation_count >= ranked.citation_rank),
+  count(*) FILTER (WHERE ranked.citation_count >= 10),
+  coalesce(sum(ranked.citation_count), 0)
+FROM ranked
+ON CONFLICT (employee_id) DO UPDATE
+SET
+  h_index = EXCLUDED.h_index,
+  i10_index = EXCLUDED.i10_index,
+  total_citations = EXCLUDED.total_citations,
+  updated_at = now();
+
+-- name: RefreshCitationMetricsByPublicationID :exec
+-- recomputes the indicators of every employee linked to the publication, see RefreshEmployeeCitationMetrics
+WITH linked AS (
+  SELECT DISTINCT ep.employee_id, ep.publication_id
+  FROM employee_publications ep
+  WHERE ep.employee_id IN (
+    SELECT affected.employee_id
+    FROM employee_publications affected
+    WHERE affected.publication_id = sqlc.arg(publication_id)::bigint
+  )
+),
+ranked AS (
+  SELECT
+    linked.employee_id,
+    pc.citation_count,
+    row_number() OVER (PARTITION BY linked.employee_id ORDER BY pc.citation_count DESC) AS citation_rank
+  FROM linked
+  JOIN publication_citations pc ON pc.publication_id = linked.publication_id
+)
+INSERT INTO employee_citation_metrics (
+  employee_id,
+  h_index,
+  i10_index,
+  total_citations
+)
+SELECT
+  ranked.employee_id,
+  count(*) FILTER (WHERE ranked.citation_count >= ranked.citation_rank),
+  count(*) FILTER (WHERE ranked.citation_count >= 10),
+  coalesce(sum(ranked.citation_count), 0)
+FROM ranked
+GROUP BY ranked.employee_id
+ON CONFLICT (employee_id) DO UPDATE
+SET
+  h_index = EXCLUDED.h_index,
+  i10_index = EXCLUDED.i10_index,
+  total_citations = EXCLUDED.total_citations,
+  updated_at = now();
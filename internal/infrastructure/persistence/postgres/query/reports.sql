@@ -1,10 +1,33 @@
+-- name: GetPublicationCountsByWorkplace :many
+-- A paper shared by several employees of the same workplace or entered in several languages is counted once.
+-- Workplaces linked to an institution are grouped by the institution and named by its title.
+SELECT
+	latest_experience.institution_id,
+	coalesce(latest_institution.institution_title_long, latest_experience.workplace)::text AS workplace,
+	count(DISTINCT coalesce(ep.publication_id::text, ep.translation_group_id::text, ep.id::text))::bigint AS publication_count
+FROM employee_publications AS ep
+JOIN (
+	SELECT DISTINCT ON (employee_id)
+		employee_id,
+		institution_id,
+		workplace
+	FROM employee_work_experiences
+	WHERE employee_work_experiences.language_code = sqlc.arg(language_code)
+	ORDER BY employee_work_experiences.employee_id, employee_work_experiences.date_end DESC NULLS FIRST
+) AS latest_experience ON ep.employee_id = latest_experience.employee_id
+LEFT JOIN institution_details AS latest_institution
+	ON latest_institution.institution_id = latest_experience.institution_id
+	AND latest_institution.language_code = sqlc.arg(language_code)
+GROUP BY latest_experience.institution_id, coalesce(latest_institution.institution_title_long, latest_experience.workplace);
+
 -- name: GetSummaryData :many
 SELECT 
 	e.id,
 	ed.surname,
 	ed."name",
-	latest_experience.workplace,
-	latest_degree.degree_level
+	latest_experience.institution_id,
+	coalesce(latest_institution.institution_title_long, latest_experience.workplace)::text AS workplace,
+	highest_degree.degree_level_code
 FROM employees AS e
 JOIN employee_details AS ed ON e.id = ed.employee_id
 JOIN (
@@ -13,20 +36,26 @@ JOIN (
 JOIN (
 	SELECT DISTINCT ON (employee_id)
 		employee_id,
+		institution_id,
 		workplace
 	FROM employee_work_experiences
 	WHERE employee_work_experiences.language_code = sqlc.arg(language_code)
 	ORDER BY employee_work_experiences.employee_id, employee_work_experiences.date_end DESC NULLS FIRST
 ) AS latest_experience ON e.id = latest_experience.employee_id
+LEFT JOIN institution_details AS latest_institution
+	ON latest_institution.institution_id = latest_experience.institution_id
+	AND latest_institution.language_code = sqlc.arg(language_code)
+-- the highest degree by the rank of its level, the latest one among degrees of the same level
 JOIN (
-	SELECT DISTINCT ON (employee_id)
-		employee_id,
-		degree_level,
-		speciality
+	SELECT DISTINCT ON (employee_degrees.employee_id)
+		employee_degrees.employee_id,
+		employee_degrees.degree_level_code,
+		employee_degrees.speciality
 	FROM employee_degrees
+	LEFT JOIN degree_levels AS dl ON dl.code = employee_degrees.degree_level_code
 	WHERE employee_degrees.language_code = sqlc.arg(language_code)
-	ORDER BY employee_id, date_end DESC
-) AS latest_degree ON e.id = latest_degree.employee_id
+	ORDER BY employee_degrees.employee_id, dl.rank DESC NULLS LAST, employee_degrees.date_end DESC NULLS LAST, employee_degrees.id DESC
+) AS highest_degree ON e.id = highest_degree.employee_id
 WHERE
 	ed.language_code = sqlc.arg(language_code)
 	AND ed.is_employee_details_new = true
@@ -0,0 +1,81 @@
+-- name: GetAllResearchFieldNames :many
+SELECT *
+FROM research_field_names;
+
+-- name: GetAllResearchFields :many
+SELECT *
+FROM research_fields
+ORDER BY sort_order, code;
+
+-- name: GetResearchFieldByCode :one
+SELECT *
+FROM research_fields
+WHERE code = $1;
+
+-- name: GetResearchFieldStats :many
+-- a node counts the records linked to it and to its descendants, each researcher and institution once
+WITH RECURSIVE research_field_subtrees AS (
+  SELECT code AS root_code, code
+  FROM research_fields
+  UNION ALL
+  SELECT research_field_subtrees.root_code, child.code
+  FROM research_fields child
+  JOIN research_field_subtrees ON child.parent_code = research_field_subtrees.code
+)
+SELECT
+  research_fields.code,
+  research_fields.parent_code,
+  research_fields.level,
+  (
+    SELECT count(DISTINCT mra.employee_id)
+    FROM employee_main_research_areas mra
+    JOIN research_field_subtrees rfs ON rfs.code = mra.research_field_code
+    WHERE rfs.root_code = research_fields.code
+  )::bigint AS researcher_count,
+  (
+    SELECT count(DISTINCT mrd.institution_id)
+    FROM institution_main_research_directions mrd
+    JOIN research_field_subtrees rfs ON rfs.code = mrd.research_field_code
+    WHERE rfs.root_code = research_fields.code
+  )::bigint AS institution_count
+FROM research_fields
+WHERE (sqlc.narg(level)::text IS NULL OR research_fields.level = sqlc.narg(level)::text)
+  AND (sqlc.narg(parent_code)::text IS NULL OR research_fields.parent_code = sqlc.narg(parent_code)::text)
+ORDER BY research_fields.sort_order, research_fields.code;
+
+-- name: IsResearchFieldExisting :one
+SELECT EXISTS (
+  SELECT 1
+  FROM research_fields
+  WHERE code = $1
+)::boolean AS is_existing;
+
+-- name: UpsertResearchField :one
+INSERT INTO research_fields (
+  code,
+  parent_code,
+  level,
+  sort_order
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (code) DO UPDATE
+SET
+  parent_code = EXCLUDED.parent_code,
+  level = EXCLUDED.level,
+  sort_order = EXCLUDED.sort_order,
+  updated_at = now()
+RETURNING (xmax = 0)::boolean AS is_created;
+
+-- name: UpsertResearchFieldName :exec
+INSERT INTO research_field_names (
+  research_field_code,
+  language_code,
+  name
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (research_field_code, language_code) DO UPDATE
+SET
+  name = EXCLUDED.name,
+  updated_at = now();
@@ -0,0 +1,55 @@
+-- name: GetAllSpecialities :many
+SELECT *
+FROM specialities
+ORDER BY code;
+
+-- name: GetSpecialitiesByParentCode :many
+-- top level specialities when parent_code is NULL
+SELECT
+  specialities.*,
+  EXISTS (
+    SELECT 1
+    FROM specialities child
+    WHERE child.parent_code = specialities.code
+  )::boolean AS has_children
+FROM specialities
+WHERE specialities.parent_code IS NOT DISTINCT FROM sqlc.narg(parent_code)
+ORDER BY specialities.code;
+
+-- name: GetSpecialityNamesBySpecialityCodes :many
+SELECT *
+FROM speciality_names
+WHERE speciality_code = ANY(sqlc.arg(speciality_codes)::text[]);
+
+-- name: IsSpecialityExisting :one
+SELECT EXISTS (
+  SELECT 1
+  FROM specialities
+  WHERE code = $1
+)::boolean AS is_existing;
+
+-- name: UpsertSpeciality :one
+INSERT INTO specialities (
+  code,
+  parent_code
+) VALUES (
+  $1, $2
+)
+ON CONFLICT (code) DO UPDATE
+SET
+  parent_code = EXCLUDED.parent_code,
+  updated_at = now()
+RETURNING (xmax = 0)::boolean AS is_created;
+
+-- name: UpsertSpecialityName :exec
+INSERT INTO speciality_names (
+  speciality_code,
+  language_code,
+  name
+) VALUES (
+  $1, $2, $3
+)
+ON CONFLICT (speciality_code, language_code) DO UPDATE
+SET
+  name = EXCLUDED.name,
+  updated_at = now();
@@ -0,0 +1,112 @@
+-- name: GetEmployeeTranslationGroups :many
+WITH entries AS (
+  SELECT 'degree' AS resource, translation_group_id, id, language_code, university_name AS title
+  FROM employee_degrees
+  WHERE employee_id = $1
+  UNION ALL
+  SELECT 'work-experience' AS resource, translation_group_id, id, language_code, job_title AS title
+  FROM employee_work_experiences
+  WHERE employee_id = $1
+  UNION ALL
+  SELECT 'mra' AS resource, translation_group_id, id, language_code, area AS title
+  FROM employee_main_research_areas
+  WHERE employee_id = $1
+  UNION ALL
+  SELECT 'publication' AS resource, translation_group_id, id, language_code, publication_title AS title
+  FROM employee_publications
+  WHERE employee_id = $1
+  UNION ALL
+  SELECT 'scientific-award' AS resource, translation_group_id, id, language_code, scientific_award_title AS title
+  FROM employee_scientific_awards
+  WHERE employee_id = $1
+  UNION ALL
+  SELECT 'patent' AS resource, translation_group_id, id, language_code, patent_title AS title
+  FROM employee_patents
+  WHERE employee_id = $1
+  UNION ALL
+  SELECT 'pipc' AS resource, translation_group_id, id, language_code, professional_community_title AS title
+  FROM employee_participation_in_professional_communities
+  WHERE employee_id = $1
+  UNION ALL
+  SELECT 'refresher-course' AS resource, translation_group_id, id, language_code, course_title AS title
+  FROM employee_refresher_courses
+  WHERE employee_id = $1
+  UNION ALL
+  SELECT 'pie' AS resource, translation_group_id, id, language_code, event_title AS title
+  FROM employee_participation_in_events
+  WHERE employee_id = $1
+  UNION ALL
+  SELECT 'research-activity' AS resource, translation_group_id, id, language_code, research_activity_title AS title
+  FROM employee_research_activities
+  WHERE employee_id = $1
+)
+SELECT
+  entries.resource::text AS resource,
+  entries.translation_group_id,
+  min(entries.id)::bigint AS record_id,
+  (array_agg(entries.title ORDER BY entries.id))[1]::text AS title,
+  array_agg(DISTINCT entries.language_code)::text[] AS language_codes
+FROM entries
+GROUP BY entries.resource, entries.translation_group_id
+ORDER BY entries.resource, record_id;
+
+-- name: GetInstitutionTranslationGroups :many
+WITH entries AS (
+  SELECT 'accreditation' AS resource, translation_group_id, id, language_code, accreditation_type AS title
+  FROM institution_accreditations
+  WHERE institution_id = $1
+  UNION ALL
+  SELECT 'licence' AS resource, translation_group_id, id, language_code, licence_title AS title
+  FROM institution_licences
+  WHERE institution_id = $1
+  UNION ALL
+  SELECT 'ranking' AS resource, translation_group_id, id, language_code, ranking_title AS title
+  FROM institution_rankings
+  WHERE institution_id = $1
+  UNION ALL
+  SELECT 'patent' AS resource, translation_group_id, id, language_code, patent_title AS title
+  FROM institution_patents
+  WHERE institution_id = $1
+  UNION ALL
+  SELECT 'partnership' AS resource, translation_group_id, id, language_code, partner_name AS title
+  FROM institution_partnerships
+  WHERE institution_id = $1
+  UNION ALL
+  SELECT 'achievement' AS resource, translation_group_id, id, language_code, achievement_title AS title
+  FROM institution_achievements
+  WHERE institution_id = $1
+  UNION ALL
+  SELECT 'magazine' AS resource, translation_group_id, id, language_code, magazine_name AS title
+  FROM institution_magazines
+  WHERE institution_id = $1
+  UNION ALL
+  SELECT 'main-research-direction' AS resource, translation_group_id, id, language_code, research_direction_title AS title
+  FROM institution_main_research_directions
+  WHERE institution_id = $1
+  UNION ALL
+  SELECT 'research-support-infrastructure' AS resource, translation_group_id, id, language_code, research_support_infrastructure_title AS title
+  FROM institution_research_support_infrastructures
+  WHERE institution_id = $1
+  UNION ALL
+  SELECT 'conference' AS resource, translation_group_id, id, language_code, conference_title AS title
+  FROM institution_conferences
+  WHERE institution_id = $1
+  UNION ALL
+  SELECT 'project' AS resource, translation_group_id, id, language_code, project_title AS title
+  FROM institution_projects
+  WHERE institution_id = $1
+  UNION ALL
+  SELECT 'project-partner' AS resource, institution_project_partners.translation_group_id, institution_project_partners.id, institution_project_partners.language_code, institution_project_partners.partner_name AS title
+  FROM institution_project_partners
+  JOIN institution_projects ON institution_projects.id = institution_project_partners.institution_project_id
+  WHERE institution_projects.institution_id = $1
+)
+SELECT
+  entries.resource::text AS resource,
+  entries.translation_group_id,
+  min(entries.id)::bigint AS record_id,
+  (array_agg(entries.title ORDER BY entries.id))[1]::text AS title,
+  array_agg(DISTINCT entries.language_code)::text[] AS language_codes
+FROM entries
+GROUP BY entries.resource, entries.translation_group_id
+ORDER BY entries.resource, record_id;
@@ -1,9 +1,10 @@
 -- name: CreateUser :one
 INSERT INTO users(
   email,
-  password_hash
+  password_hash,
+  preferred_language
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 ) RETURNING id, created_at, updated_at;
 
 -- name: GetUserByEmail :one
@@ -15,3 +16,10 @@ WHERE email = $1;
 SELECT *
 FROM users
 WHERE id = $1;
+
+-- name: UpdateUserPreferredLanguage :exec
+UPDATE users
+SET
+  preferred_language = $2,
+  updated_at = now()
+WHERE id = $1;
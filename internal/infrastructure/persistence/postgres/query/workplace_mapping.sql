@@ -0,0 +1,118 @@
+-- name: ListWorkplaceVariants :many
+-- Distinct spellings of workplaces per language with the institutions they are already linked to.
+SELECT
+  btrim(workplace)::text AS workplace,
+  language_code,
+  count(*)::bigint AS experience_count,
+  count(institution_id)::bigint AS linked_count,
+  coalesce(array_agg(DISTINCT institution_id) FILTER (WHERE institution_id IS NOT NULL), '{}')::bigint[] AS institution_ids
+FROM employee_work_experiences
+WHERE btrim(workplace) <> ''
+GROUP BY btrim(workplace), language_code
+ORDER BY btrim(workplace), language_code;
+
+-- name: ListInstitutionTitles :many
+SELECT
+  institution_id,
+  language_code,
+  institution_title_short,
+  institution_title_long
+FROM institution_details
+ORDER BY institution_id, language_code;
+
+-- name: GetWorkplaceMappingCandidates :many
+-- Work experiences spelled as one of the workplaces, together with their translations, that a mapping would change.
+-- Without overwrite translation groups already linked to another institution are left alone.
+SELECT
+  we.id,
+  we.employee_id,
+  we.language_code,
+  we.workplace,
+  we.institution_id
+FROM employee_work_experiences we
+WHERE we.translation_group_id IN (
+    SELECT matched.translation_group_id
+    FROM employee_work_experiences matched
+    WHERE btrim(matched.workplace) = ANY(sqlc.arg(workplaces)::text[])
+  )
+  AND we.institution_id IS DISTINCT FROM sqlc.arg(institution_id)::bigint
+  AND (
+    sqlc.arg(overwrite)::boolean
+    OR NOT EXISTS (
+      SELECT 1
+      FROM employee_work_experiences linked
+      WHERE linked.translation_group_id = we.translation_group_id
+        AND linked.institution_id IS NOT NULL
+        AND linked.institution_id <> sqlc.arg(institution_id)::bigint
+    )
+  )
+ORDER BY we.employee_id, we.id;
+
+-- name: CreateWorkplaceMappingBatch :one
+INSERT INTO workplace_mapping_batches (
+  institution_id,
+  workplaces,
+  overwrite,
+  created_by
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING id, created_at;
+
+-- name: CreateWorkplaceMappingChanges :exec
+INSERT INTO workplace_mapping_changes (
+  batch_id,
+  work_experience_id,
+  old_institution_id,
+  new_institution_id
+)
+SELECT
+  sqlc.arg(batch_id)::bigint,
+  we.id,
+  we.institution_id,
+  sqlc.arg(institution_id)::bigint
+FROM employee_work_experiences we
+WHERE we.id = ANY(sqlc.arg(work_experience_ids)::bigint[]);
+
+-- name: ApplyWorkplaceMappingBatch :execrows
+UPDATE employee_work_experiences we
+SET
+  institution_id = c.new_institution_id,
+  updated_at = now()
+FROM workplace_mapping_changes c
+WHERE c.batch_id = $1
+  AND c.work_experience_id = we.id;
+
+-- name: UndoWorkplaceMappingBatch :execrows
+-- Work experiences relinked after the batch keep their current institution.
+UPDATE employee_work_experiences we
+SET
+  institution_id = c.old_institution_id,
+  updated_at = now()
+FROM workplace_mapping_changes c
+WHERE c.batch_id = $1
+  AND c.work_experience_id = we.id
+  AND we.institution_id IS NOT DISTINCT FROM c.new_institution_id;
+
+-- name: MarkWorkplaceMappingBatchUndone :exec
+UPDATE workplace_mapping_batches
+SET
+  undone_by = $2,
+  undone_at = now()
+WHERE id = $1;
+
+-- name: GetWorkplaceMappingBatchByIDForUpdate :one
+SELECT
+  b.*,
+  (SELECT count(*) FROM workplace_mapping_changes c WHERE c.batch_id = b.id)::bigint AS change_count
+FROM workplace_mapping_batches b
+WHERE b.id = $1
+FOR UPDATE OF b;
+
+-- name: ListWorkplaceMappingBatches :many
+SELECT
+  b.*,
+  (SELECT count(*) FROM workplace_mapping_changes c WHERE c.batch_id = b.id)::bigint AS change_count
+FROM workplace_mapping_batches b
+ORDER BY b.created_at DESC, b.id DESC
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg(page);
@@ -19,35 +19,132 @@ join
     on ed.employee_id = e.id
     and ed.is_employee_details_new is true
     and ed.language_code = $1
+left join employee_citation_metrics cm on cm.employee_id = e.id
 where
     exists (select 1 from employee_socials es where es.employee_id = e.id)
     and e.highest_academic_degree is not null
     and e.speciality is not null
     and e.current_workplace is not null
+    -- the user of the employee accepted the latest terms
+    and has_latest_consent(e.user_id)
     and (nullif($2::text, '') is null or e.unique_id = $2)
+    -- names are matched against the whole name history in any language, so researchers are found by previous surnames too
     and (
-        nullif($3::text, '') is null
-        or ed.name ilike '%' || $3 || '%'
+        (
+            nullif($3::text, '') is null
+            and nullif($4::text, '') is null
+            and nullif($5::text, '') is null
+        )
+        or (
+            employee_section_is_public(e.id, 'details')
+            and exists (
+                select 1
+                from employee_details hd
+                where hd.employee_id = e.id
+                  and (nullif($3::text, '') is null or hd.name ilike '%' || $3 || '%')
+                  and (nullif($4::text, '') is null or hd.surname ilike '%' || $4 || '%')
+                  and (nullif($5::text, '') is null or hd.middlename ilike '%' || $5 || '%')
+            )
+        )
     )
     and (
-        nullif($4::text, '') is null
-        or ed.surname ilike '%' || $4 || '%'
+        nullif($6::text, '') is null
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_workplace = $6
+        )
     )
     and (
-        nullif($5::text, '') is null
-        or ed.middlename ilike '%' || $5 || '%'
+        $7::bigint = 0
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_institution_id = $7
+        )
     )
+    -- employees of the unit or of any unit below it
     and (
-        nullif($6::text, '') is null
-        or e.current_workplace = $6
+        $8::bigint = 0
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_org_unit_id in (
+                with recursive unit_tree as (
+                    select ou.id
+                    from org_units ou
+                    where ou.id = $8
+                    union all
+                    select child.id
+                    from org_units child
+                    join unit_tree on child.parent_id = unit_tree.id
+                )
+                select unit_tree.id from unit_tree
+            )
+        )
+    )
+    and (
+        nullif(btrim($9::text), '') is null
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and (
+                btrim(e.highest_academic_degree) ilike btrim($9::text)
+                -- a degree level is found by its code or by its name in any language
+                or exists (
+                    select 1
+                    from degree_level_names an
+                    where an.degree_level_code = e.highest_academic_degree
+                      and btrim(an.name) ilike btrim($9::text)
+                )
+            )
+        )
+    )
+    and (
+        nullif(btrim($10::text), '') is null
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and btrim(e.speciality) ilike btrim($10::text)
+        )
+    )
+    -- employees whose speciality is the classifier node or any node below it
+    and (
+        nullif($11::text, '') is null
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and e.speciality_code in (
+                with recursive speciality_tree as (
+                    select s.code
+                    from specialities s
+                    where s.code = $11
+                    union all
+                    select child.code
+                    from specialities child
+                    join speciality_tree on child.parent_code = speciality_tree.code
+                )
+                select speciality_tree.code from speciality_tree
+            )
+        )
     )
+    -- employees with a key topic of the keyword, whichever of its labels or synonyms is given
     and (
-        nullif(btrim($7::text), '') is null
-        or btrim(e.highest_academic_degree) ilike btrim($7::text)
+        nullif(btrim($12::text), '') is null
+        or (
+            employee_section_is_public(e.id, 'main_research_areas')
+            and exists (
+                select 1
+                from employee_main_research_areas mra
+                join employee_main_research_area_key_topics kt on kt.employee_main_research_area_id = mra.id
+                join keyword_labels kl on kl.keyword_id = kt.keyword_id
+                where mra.employee_id = e.id
+                  and kl.normalized_label = normalize_keyword_label($12::text)
+            )
+        )
     )
     and (
-        nullif(btrim($8::text), '') is null
-        or btrim(e.speciality) ilike btrim($8::text)
+        ($13::int <= 0 and $14::int <= 0 and $15::int <= 0)
+        or (
+            employee_section_is_public(e.id, 'publications')
+            and coalesce(cm.h_index, 0) >= $13::int
+            and coalesce(cm.i10_index, 0) >= $14::int
+            and coalesce(cm.total_citations, 0) >= $15::int
+        )
     )
 `
 
@@ -58,8 +155,15 @@ type CountPersonnelParams struct {
 	Surname        string `json:"surname"`
 	Middlename     string `json:"middlename"`
 	Workplace      string `json:"workplace"`
+	InstitutionID  int64  `json:"institution_id"`
+	OrgUnitID      int64  `json:"org_unit_id"`
 	AcademicDegree string `json:"academic_degree"`
 	Speciality     string `json:"speciality"`
+	SpecialityCode string `json:"speciality_code"`
+	Keyword        string `json:"keyword"`
+	MinHIndex      int32  `json:"min_h_index"`
+	MinI10Index    int32  `json:"min_i10_index"`
+	MinCitations   int32  `json:"min_citations"`
 }
 
 func (q *Queries) CountPersonnel(ctx context.Context, arg CountPersonnelParams) (int64, error) {
@@ -70,8 +174,15 @@ func (q *Queries) CountPersonnel(ctx context.Context, arg CountPersonnelParams)
 		arg.Surname,
 		arg.Middlename,
 		arg.Workplace,
+		arg.InstitutionID,
+		arg.OrgUnitID,
 		arg.AcademicDegree,
 		arg.Speciality,
+		arg.SpecialityCode,
+		arg.Keyword,
+		arg.MinHIndex,
+		arg.MinI10Index,
+		arg.MinCitations,
 	)
 	var total int64
 	err := row.Scan(&total)
@@ -125,7 +236,7 @@ func (q *Queries) DeleteEmployee(ctx context.Context, id int64) error {
 }
 
 const getEmployeeByID = `-- name: GetEmployeeByID :one
-select id, unique_id, created_at, updated_at, user_id, gender, tin, highest_academic_degree, speciality, current_workplace
+select id, unique_id, created_at, updated_at, user_id, gender, tin, highest_academic_degree, speciality, current_workplace, orcid, current_institution_id, current_org_unit_id, speciality_code
 from employees
 where id = $1
 `
@@ -144,12 +255,16 @@ func (q *Queries) GetEmployeeByID(ctx context.Context, id int64) (Employee, erro
 		&i.HighestAcademicDegree,
 		&i.Speciality,
 		&i.CurrentWorkplace,
+		&i.Orcid,
+		&i.CurrentInstitutionID,
+		&i.CurrentOrgUnitID,
+		&i.SpecialityCode,
 	)
 	return i, err
 }
 
 const getEmployeeByUniqueIdentifier = `-- name: GetEmployeeByUniqueIdentifier :one
-select id, unique_id, created_at, updated_at, user_id, gender, tin, highest_academic_degree, speciality, current_workplace
+select id, unique_id, created_at, updated_at, user_id, gender, tin, highest_academic_degree, speciality, current_workplace, orcid, current_institution_id, current_org_unit_id, speciality_code
 from employees
 where unique_id = $1
 `
@@ -168,12 +283,16 @@ func (q *Queries) GetEmployeeByUniqueIdentifier(ctx context.Context, uniqueID st
 		&i.HighestAcademicDegree,
 		&i.Speciality,
 		&i.CurrentWorkplace,
+		&i.Orcid,
+		&i.CurrentInstitutionID,
+		&i.CurrentOrgUnitID,
+		&i.SpecialityCode,
 	)
 	return i, err
 }
 
 const getEmployeeByUserID = `-- name: GetEmployeeByUserID :one
-select id, unique_id, created_at, updated_at, user_id, gender, tin, highest_academic_degree, speciality, current_workplace
+select id, unique_id, created_at, updated_at, user_id, gender, tin, highest_academic_degree, speciality, current_workplace, orcid, current_institution_id, current_org_unit_id, speciality_code
 from employees
 where user_id = $1
 `
@@ -192,6 +311,10 @@ func (q *Queries) GetEmployeeByUserID(ctx context.Context, userID pgtype.Int8) (
 		&i.HighestAcademicDegree,
 		&i.Speciality,
 		&i.CurrentWorkplace,
+		&i.Orcid,
+		&i.CurrentInstitutionID,
+		&i.CurrentOrgUnitID,
+		&i.SpecialityCode,
 	)
 	return i, err
 }
@@ -202,55 +325,179 @@ select
     e.unique_id,
     e.gender,
     e.tin,
-    e.highest_academic_degree,
+    coalesce(dln.name, e.highest_academic_degree)::text as highest_academic_degree,
     e.speciality,
     e.current_workplace,
+    e.current_institution_id,
+    e.current_org_unit_id,
     ed.surname,
     ed.name,
-    ed.middlename
+    ed.middlename,
+    -- a paper entered in several languages or shared with colleagues is counted once
+    (
+        select count(distinct coalesce(ep.publication_id::text, ep.translation_group_id::text, ep.id::text))
+        from employee_publications ep
+        where ep.employee_id = e.id
+    )::bigint as publication_count,
+    coalesce(cm.h_index, 0)::int as h_index,
+    coalesce(cm.i10_index, 0)::int as i10_index,
+    coalesce(cm.total_citations, 0)::int as total_citations
 from employees e
 join
     employee_details ed
     on ed.employee_id = e.id
     and ed.is_employee_details_new is true
     and ed.language_code = $1
+left join employee_citation_metrics cm on cm.employee_id = e.id
+-- the highest degree is a degree level code, or free text for degrees entered before the vocabulary existed
+left join
+    degree_level_names dln
+    on dln.degree_level_code = e.highest_academic_degree
+    and dln.language_code = $1
 where
+    -- the conditions below are explained to employees by the completeness checklist, keep them in sync
     -- must exist in employee_socials
     exists (select 1 from employee_socials es where es.employee_id = e.id)
     -- required non-null denormalized fields
     and e.highest_academic_degree is not null
     and e.speciality is not null
     and e.current_workplace is not null
+    -- the user of the employee accepted the latest terms
+    and has_latest_consent(e.user_id)
 
-    -- optional filters (pass NULL to ignore)
+    -- optional filters (pass NULL to ignore), a filter on a section of the profile matches only employees
+    -- who share the section with the public, so the directory does not reveal what they hide
     and (nullif($2::text, '') is null or e.unique_id = $2)
+    -- names are matched against the whole name history in any language, so researchers are found by previous surnames too
     and (
-        nullif($3::text, '') is null
-        or ed.name ilike '%' || $3 || '%'
+        (
+            nullif($3::text, '') is null
+            and nullif($4::text, '') is null
+            and nullif($5::text, '') is null
+        )
+        or (
+            employee_section_is_public(e.id, 'details')
+            and exists (
+                select 1
+                from employee_details hd
+                where hd.employee_id = e.id
+                  and (nullif($3::text, '') is null or hd.name ilike '%' || $3 || '%')
+                  and (nullif($4::text, '') is null or hd.surname ilike '%' || $4 || '%')
+                  and (nullif($5::text, '') is null or hd.middlename ilike '%' || $5 || '%')
+            )
+        )
     )
     and (
-        nullif($4::text, '') is null
-        or ed.surname ilike '%' || $4 || '%'
+        nullif($6::text, '') is null
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_workplace = $6
+        )
     )
     and (
-        nullif($5::text, '') is null
-        or ed.middlename ilike '%' || $5 || '%'
+        $7::bigint = 0
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_institution_id = $7
+        )
     )
+    -- employees of the unit or of any unit below it
     and (
-        nullif($6::text, '') is null
-        or e.current_workplace = $6
+        $8::bigint = 0
+        or (
+            employee_section_is_public(e.id, 'work_experiences')
+            and e.current_org_unit_id in (
+                with recursive unit_tree as (
+                    select ou.id
+                    from org_units ou
+                    where ou.id = $8
+                    union all
+                    select child.id
+                    from org_units child
+                    join unit_tree on child.parent_id = unit_tree.id
+                )
+                select unit_tree.id from unit_tree
+            )
+        )
+    )
+    and (
+        nullif(btrim($9::text), '') is null
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and (
+                btrim(e.highest_academic_degree) ilike btrim($9::text)
+                -- a degree level is found by its code or by its name in any language
+                or exists (
+                    select 1
+                    from degree_level_names an
+                    where an.degree_level_code = e.highest_academic_degree
+                      and btrim(an.name) ilike btrim($9::text)
+                )
+            )
+        )
+    )
+    and (
+        nullif(btrim($10::text), '') is null
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and btrim(e.speciality) ilike btrim($10::text)
+        )
+    )
+    -- employees whose speciality is the classifier node or any node below it
+    and (
+        nullif($11::text, '') is null
+        or (
+            employee_section_is_public(e.id, 'degrees')
+            and e.speciality_code in (
+                with recursive speciality_tree as (
+                    select s.code
+                    from specialities s
+                    where s.code = $11
+                    union all
+                    select child.code
+                    from specialities child
+                    join speciality_tree on child.parent_code = speciality_tree.code
+                )
+                select speciality_tree.code from speciality_tree
+            )
+        )
     )
+    -- employees with a key topic of the keyword, whichever of its labels or synonyms is given
     and (
-        nullif(btrim($7::text), '') is null
-        or btrim(e.highest_academic_degree) ilike btrim($7::text)
+        nullif(btrim($12::text), '') is null
+        or (
+            employee_section_is_public(e.id, 'main_research_areas')
+            and exists (
+                select 1
+                from employee_main_research_areas mra
+                join employee_main_research_area_key_topics kt on kt.employee_main_research_area_id = mra.id
+                join keyword_labels kl on kl.keyword_id = kt.keyword_id
+                where mra.employee_id = e.id
+                  and kl.normalized_label = normalize_keyword_label($12::text)
+            )
+        )
     )
     and (
-        nullif(btrim($8::text), '') is null
-        or btrim(e.speciality) ilike btrim($8::text)
+        ($13::int <= 0 and $14::int <= 0 and $15::int <= 0)
+        or (
+            employee_section_is_public(e.id, 'publications')
+            and coalesce(cm.h_index, 0) >= $13::int
+            and coalesce(cm.i10_index, 0) >= $14::int
+            and coalesce(cm.total_citations, 0) >= $15::int
+        )
     )
-order by e.id
-limit $10
-offset $9
+order by
+    -- directory can be ranked by bibliometric indicators, otherwise it keeps the insertion order,
+    -- indicators hidden with the publications are ranked last
+    case
+        when not employee_section_is_public(e.id, 'publications') then null
+        when $16::text = 'h_index' then coalesce(cm.h_index, 0)
+        when $16::text = 'i10_index' then coalesce(cm.i10_index, 0)
+        when $16::text = 'citations' then coalesce(cm.total_citations, 0)
+    end desc nulls last,
+    e.id
+limit $18
+offset $17
 `
 
 type GetPersonnelPaginatedParams struct {
@@ -260,8 +507,16 @@ type GetPersonnelPaginatedParams struct {
 	Surname        string `json:"surname"`
 	Middlename     string `json:"middlename"`
 	Workplace      string `json:"workplace"`
+	InstitutionID  int64  `json:"institution_id"`
+	OrgUnitID      int64  `json:"org_unit_id"`
 	AcademicDegree string `json:"academic_degree"`
 	Speciality     string `json:"speciality"`
+	SpecialityCode string `json:"speciality_code"`
+	Keyword        string `json:"keyword"`
+	MinHIndex      int32  `json:"min_h_index"`
+	MinI10Index    int32  `json:"min_i10_index"`
+	MinCitations   int32  `json:"min_citations"`
+	SortBy         string `json:"sort_by"`
 	Page           int32  `json:"page"`
 	Limit          int32  `json:"limit"`
 }
@@ -271,12 +526,18 @@ type GetPersonnelPaginatedRow struct {
 	UniqueID              string      `json:"unique_id"`
 	Gender                pgtype.Text `json:"gender"`
 	Tin                   pgtype.Text `json:"tin"`
-	HighestAcademicDegree pgtype.Text `json:"highest_academic_degree"`
+	HighestAcademicDegree string      `json:"highest_academic_degree"`
 	Speciality            pgtype.Text `json:"speciality"`
 	CurrentWorkplace      pgtype.Text `json:"current_workplace"`
+	CurrentInstitutionID  pgtype.Int8 `json:"current_institution_id"`
+	CurrentOrgUnitID      pgtype.Int8 `json:"current_org_unit_id"`
 	Surname               string      `json:"surname"`
 	Name                  string      `json:"name"`
 	Middlename            pgtype.Text `json:"middlename"`
+	PublicationCount      int64       `json:"publication_count"`
+	HIndex                int32       `json:"h_index"`
+	I10Index              int32       `json:"i10_index"`
+	TotalCitations        int32       `json:"total_citations"`
 }
 
 func (q *Queries) GetPersonnelPaginated(ctx context.Context, arg GetPersonnelPaginatedParams) ([]GetPersonnelPaginatedRow, error) {
@@ -287,8 +548,16 @@ func (q *Queries) GetPersonnelPaginated(ctx context.Context, arg GetPersonnelPag
 		arg.Surname,
 		arg.Middlename,
 		arg.Workplace,
+		arg.InstitutionID,
+		arg.OrgUnitID,
 		arg.AcademicDegree,
 		arg.Speciality,
+		arg.SpecialityCode,
+		arg.Keyword,
+		arg.MinHIndex,
+		arg.MinI10Index,
+		arg.MinCitations,
+		arg.SortBy,
 		arg.Page,
 		arg.Limit,
 	)
@@ -307,9 +576,15 @@ func (q *Queries) GetPersonnelPaginated(ctx context.Context, arg GetPersonnelPag
 			&i.HighestAcademicDegree,
 			&i.Speciality,
 			&i.CurrentWorkplace,
+			&i.CurrentInstitutionID,
+			&i.CurrentOrgUnitID,
 			&i.Surname,
 			&i.Name,
 			&i.Middlename,
+			&i.PublicationCount,
+			&i.HIndex,
+			&i.I10Index,
+			&i.TotalCitations,
 		); err != nil {
 			return nil, err
 		}
@@ -322,27 +597,50 @@ func (q *Queries) GetPersonnelPaginated(ctx context.Context, arg GetPersonnelPag
 }
 
 const listUniqueHighestAcademicDegrees = `-- name: ListUniqueHighestAcademicDegrees :many
-select distinct e.highest_academic_degree
-from employees e
-where
-    e.highest_academic_degree is not null
-    and e.highest_academic_degree <> ''
-order by e.highest_academic_degree asc
+select
+    hd.highest_academic_degree::text as code,
+    coalesce(
+        (
+            select dln.name
+            from degree_level_names dln
+            join languages l on l.code = dln.language_code
+            where dln.degree_level_code = hd.highest_academic_degree
+            order by (dln.language_code = $1) desc, l.sort_order, l.code
+            limit 1
+        ),
+        hd.highest_academic_degree
+    )::text as name
+from (
+    select distinct e.highest_academic_degree
+    from employees e
+    where
+        e.highest_academic_degree is not null
+        and e.highest_academic_degree <> ''
+) hd
+left join degree_levels dl on dl.code = hd.highest_academic_degree
+order by dl.rank asc nulls last, name asc
 `
 
-func (q *Queries) ListUniqueHighestAcademicDegrees(ctx context.Context) ([]pgtype.Text, error) {
-	rows, err := q.db.Query(ctx, listUniqueHighestAcademicDegrees)
+type ListUniqueHighestAcademicDegreesRow struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// degree level codes are named in language_code or in the first language of the registry having a name,
+// free text entered before the vocabulary existed is returned as its own code and name
+func (q *Queries) ListUniqueHighestAcademicDegrees(ctx context.Context, languageCode string) ([]ListUniqueHighestAcademicDegreesRow, error) {
+	rows, err := q.db.Query(ctx, listUniqueHighestAcademicDegrees, languageCode)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []pgtype.Text{}
+	items := []ListUniqueHighestAcademicDegreesRow{}
 	for rows.Next() {
-		var highest_academic_degree pgtype.Text
-		if err := rows.Scan(&highest_academic_degree); err != nil {
+		var i ListUniqueHighestAcademicDegreesRow
+		if err := rows.Scan(&i.Code, &i.Name); err != nil {
 			return nil, err
 		}
-		items = append(items, highest_academic_degree)
+		items = append(items, i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -415,3 +713,21 @@ func (q *Queries) ListUniqueWorkplaces(ctx context.Context, languageCode string)
 	}
 	return items, nil
 }
+
+const updateEmployeeOrcid = `-- name: UpdateEmployeeOrcid :exec
+update employees
+set
+    orcid = $1,
+    updated_at = now()
+where id = $2
+`
+
+type UpdateEmployeeOrcidParams struct {
+	Orcid pgtype.Text `json:"orcid"`
+	ID    int64       `json:"id"`
+}
+
+func (q *Queries) UpdateEmployeeOrcid(ctx context.Context, arg UpdateEmployeeOrcidParams) error {
+	_, err := q.db.Exec(ctx, updateEmployeeOrcid, arg.Orcid, arg.ID)
+	return err
+}
@@ -15,28 +15,32 @@ const createEmployeeDegree = `-- name: CreateEmployeeDegree :one
 INSERT INTO employee_degrees(
   employee_id,
   language_code,
-  degree_level,
+  degree_level_code,
   university_name,
   speciality,
   date_start,
   date_end,
   given_by,
-  date_degree_recieved
+  date_degree_recieved,
+  translation_group_id,
+  speciality_code
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateEmployeeDegreeParams struct {
 	EmployeeID         int64       `json:"employee_id"`
 	LanguageCode       string      `json:"language_code"`
-	DegreeLevel        string      `json:"degree_level"`
+	DegreeLevelCode    pgtype.Text `json:"degree_level_code"`
 	UniversityName     string      `json:"university_name"`
 	Speciality         string      `json:"speciality"`
 	DateStart          pgtype.Date `json:"date_start"`
 	DateEnd            pgtype.Date `json:"date_end"`
 	GivenBy            pgtype.Text `json:"given_by"`
 	DateDegreeRecieved pgtype.Date `json:"date_degree_recieved"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
+	SpecialityCode     pgtype.Text `json:"speciality_code"`
 }
 
 type CreateEmployeeDegreeRow struct {
@@ -49,13 +53,15 @@ func (q *Queries) CreateEmployeeDegree(ctx context.Context, arg CreateEmployeeDe
 	row := q.db.QueryRow(ctx, createEmployeeDegree,
 		arg.EmployeeID,
 		arg.LanguageCode,
-		arg.DegreeLevel,
+		arg.DegreeLevelCode,
 		arg.UniversityName,
 		arg.Speciality,
 		arg.DateStart,
 		arg.DateEnd,
 		arg.GivenBy,
 		arg.DateDegreeRecieved,
+		arg.TranslationGroupID,
+		arg.SpecialityCode,
 	)
 	var i CreateEmployeeDegreeRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -64,7 +70,9 @@ func (q *Queries) CreateEmployeeDegree(ctx context.Context, arg CreateEmployeeDe
 
 const deleteEmployeeDegree = `-- name: DeleteEmployeeDegree :exec
 DELETE FROM employee_degrees
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_degrees WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteEmployeeDegree(ctx context.Context, id int64) error {
@@ -73,14 +81,40 @@ func (q *Queries) DeleteEmployeeDegree(ctx context.Context, id int64) error {
 }
 
 const getEmployeeDegreeByID = `-- name: GetEmployeeDegreeByID :one
-SELECT id, employee_id, language_code, university_name, degree_level, speciality, date_start, date_end, given_by, date_degree_recieved, created_at, updated_at
+SELECT
+  employee_degrees.id, employee_degrees.employee_id, employee_degrees.language_code, employee_degrees.university_name, employee_degrees.degree_level, employee_degrees.speciality, employee_degrees.date_start, employee_degrees.date_end, employee_degrees.given_by, employee_degrees.date_degree_recieved, employee_degrees.created_at, employee_degrees.updated_at, employee_degrees.translation_group_id, employee_degrees.degree_level_code, employee_degrees.speciality_code,
+  coalesce(dln.name, employee_degrees.degree_level_code, employee_degrees.degree_level)::text AS degree_level_name
 FROM employee_degrees
-WHERE id = $1
+LEFT JOIN degree_level_names dln
+  ON dln.degree_level_code = employee_degrees.degree_level_code
+  AND dln.language_code = employee_degrees.language_code
+WHERE employee_degrees.id = $1
 `
 
-func (q *Queries) GetEmployeeDegreeByID(ctx context.Context, id int64) (EmployeeDegree, error) {
+type GetEmployeeDegreeByIDRow struct {
+	ID                 int64              `json:"id"`
+	EmployeeID         int64              `json:"employee_id"`
+	LanguageCode       string             `json:"language_code"`
+	UniversityName     string             `json:"university_name"`
+	DegreeLevel        pgtype.Text        `json:"degree_level"`
+	Speciality         string             `json:"speciality"`
+	DateStart          pgtype.Date        `json:"date_start"`
+	DateEnd            pgtype.Date        `json:"date_end"`
+	GivenBy            pgtype.Text        `json:"given_by"`
+	DateDegreeRecieved pgtype.Date        `json:"date_degree_recieved"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
+	DegreeLevelCode    pgtype.Text        `json:"degree_level_code"`
+	SpecialityCode     pgtype.Text        `json:"speciality_code"`
+	DegreeLevelName    string             `json:"degree_level_name"`
+}
+
+// degree_level_name is the name of the level in the language of the entry,
+// the free text of an entry made before the vocabulary existed when it has no level
+func (q *Queries) GetEmployeeDegreeByID(ctx context.Context, id int64) (GetEmployeeDegreeByIDRow, error) {
 	row := q.db.QueryRow(ctx, getEmployeeDegreeByID, id)
-	var i EmployeeDegree
+	var i GetEmployeeDegreeByIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.EmployeeID,
@@ -94,31 +128,64 @@ func (q *Queries) GetEmployeeDegreeByID(ctx context.Context, id int64) (Employee
 		&i.DateDegreeRecieved,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
+		&i.DegreeLevelCode,
+		&i.SpecialityCode,
+		&i.DegreeLevelName,
 	)
 	return i, err
 }
 
-const getEmployeeDegreesByEmployeeIDAndLanguageCode = `-- name: GetEmployeeDegreesByEmployeeIDAndLanguageCode :many
-SELECT id, employee_id, language_code, university_name, degree_level, speciality, date_start, date_end, given_by, date_degree_recieved, created_at, updated_at
+const getEmployeeDegreesByEmployeeIDAndLanguageCodes = `-- name: GetEmployeeDegreesByEmployeeIDAndLanguageCodes :many
+SELECT
+  employee_degrees.id, employee_degrees.employee_id, employee_degrees.language_code, employee_degrees.university_name, employee_degrees.degree_level, employee_degrees.speciality, employee_degrees.date_start, employee_degrees.date_end, employee_degrees.given_by, employee_degrees.date_degree_recieved, employee_degrees.created_at, employee_degrees.updated_at, employee_degrees.translation_group_id, employee_degrees.degree_level_code, employee_degrees.speciality_code,
+  coalesce(dln.name, employee_degrees.degree_level_code, employee_degrees.degree_level)::text AS degree_level_name
 FROM employee_degrees
-WHERE employee_id = $1 AND language_code = $2
+LEFT JOIN degree_level_names dln
+  ON dln.degree_level_code = employee_degrees.degree_level_code
+  AND dln.language_code = employee_degrees.language_code
+WHERE employee_degrees.id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_degrees
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 ORDER BY employee_degrees.date_degree_recieved DESC
 `
 
-type GetEmployeeDegreesByEmployeeIDAndLanguageCodeParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
+type GetEmployeeDegreesByEmployeeIDAndLanguageCodesParams struct {
+	EmployeeID    int64    `json:"employee_id"`
+	LanguageCodes []string `json:"language_codes"`
+}
+
+type GetEmployeeDegreesByEmployeeIDAndLanguageCodesRow struct {
+	ID                 int64              `json:"id"`
+	EmployeeID         int64              `json:"employee_id"`
+	LanguageCode       string             `json:"language_code"`
+	UniversityName     string             `json:"university_name"`
+	DegreeLevel        pgtype.Text        `json:"degree_level"`
+	Speciality         string             `json:"speciality"`
+	DateStart          pgtype.Date        `json:"date_start"`
+	DateEnd            pgtype.Date        `json:"date_end"`
+	GivenBy            pgtype.Text        `json:"given_by"`
+	DateDegreeRecieved pgtype.Date        `json:"date_degree_recieved"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
+	DegreeLevelCode    pgtype.Text        `json:"degree_level_code"`
+	SpecialityCode     pgtype.Text        `json:"speciality_code"`
+	DegreeLevelName    string             `json:"degree_level_name"`
 }
 
-func (q *Queries) GetEmployeeDegreesByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeDegreesByEmployeeIDAndLanguageCodeParams) ([]EmployeeDegree, error) {
-	rows, err := q.db.Query(ctx, getEmployeeDegreesByEmployeeIDAndLanguageCode, arg.EmployeeID, arg.LanguageCode)
+func (q *Queries) GetEmployeeDegreesByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeDegreesByEmployeeIDAndLanguageCodesParams) ([]GetEmployeeDegreesByEmployeeIDAndLanguageCodesRow, error) {
+	rows, err := q.db.Query(ctx, getEmployeeDegreesByEmployeeIDAndLanguageCodes, arg.EmployeeID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []EmployeeDegree{}
+	items := []GetEmployeeDegreesByEmployeeIDAndLanguageCodesRow{}
 	for rows.Next() {
-		var i EmployeeDegree
+		var i GetEmployeeDegreesByEmployeeIDAndLanguageCodesRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.EmployeeID,
@@ -132,6 +199,10 @@ func (q *Queries) GetEmployeeDegreesByEmployeeIDAndLanguageCode(ctx context.Cont
 			&i.DateDegreeRecieved,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
+			&i.DegreeLevelCode,
+			&i.SpecialityCode,
+			&i.DegreeLevelName,
 		); err != nil {
 			return nil, err
 		}
@@ -146,26 +217,28 @@ func (q *Queries) GetEmployeeDegreesByEmployeeIDAndLanguageCode(ctx context.Cont
 const updateEmployeeDegree = `-- name: UpdateEmployeeDegree :one
 UPDATE employee_degrees 
 SET 
-  degree_level = COALESCE($1, degree_level),
+  degree_level_code = COALESCE($1, degree_level_code),
   university_name = COALESCE($2, university_name),
   speciality = COALESCE($3, speciality),
   date_start = COALESCE($4, date_start),
   date_end = COALESCE($5, date_end),
   given_by = COALESCE($6, given_by),
   date_degree_recieved = COALESCE($7, date_degree_recieved),
+  speciality_code = $8,
   updated_at = now()
-WHERE id = $8
+WHERE id = $9
 RETURNING id, created_at, updated_at
 `
 
 type UpdateEmployeeDegreeParams struct {
-	DegreeLevel        string      `json:"degree_level"`
+	DegreeLevelCode    pgtype.Text `json:"degree_level_code"`
 	UniversityName     string      `json:"university_name"`
 	Speciality         string      `json:"speciality"`
 	DateStart          pgtype.Date `json:"date_start"`
 	DateEnd            pgtype.Date `json:"date_end"`
 	GivenBy            pgtype.Text `json:"given_by"`
 	DateDegreeRecieved pgtype.Date `json:"date_degree_recieved"`
+	SpecialityCode     pgtype.Text `json:"speciality_code"`
 	ID                 int64       `json:"id"`
 }
 
@@ -175,18 +248,40 @@ type UpdateEmployeeDegreeRow struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+// speciality_code is written as given, a NULL unlinks the degree from the classifier
 func (q *Queries) UpdateEmployeeDegree(ctx context.Context, arg UpdateEmployeeDegreeParams) (UpdateEmployeeDegreeRow, error) {
 	row := q.db.QueryRow(ctx, updateEmployeeDegree,
-		arg.DegreeLevel,
+		arg.DegreeLevelCode,
 		arg.UniversityName,
 		arg.Speciality,
 		arg.DateStart,
 		arg.DateEnd,
 		arg.GivenBy,
 		arg.DateDegreeRecieved,
+		arg.SpecialityCode,
 		arg.ID,
 	)
 	var i UpdateEmployeeDegreeRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
 	return i, err
 }
+
+const syncEmployeeDegreeTranslations = `-- name: SyncEmployeeDegreeTranslations :exec
+UPDATE employee_degrees
+SET
+  degree_level_code = source.degree_level_code,
+  speciality_code = source.speciality_code,
+  date_start = source.date_start,
+  date_end = source.date_end,
+  date_degree_recieved = source.date_degree_recieved,
+  updated_at = now()
+FROM employee_degrees source
+WHERE source.id = $1
+  AND employee_degrees.translation_group_id = source.translation_group_id
+  AND employee_degrees.id <> source.id
+`
+
+func (q *Queries) SyncEmployeeDegreeTranslations(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, syncEmployeeDegreeTranslations, id)
+	return err
+}
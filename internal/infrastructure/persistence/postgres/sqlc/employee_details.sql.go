@@ -11,6 +11,37 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const archiveCurrentEmployeeDetails = `-- name: ArchiveCurrentEmployeeDetails :exec
+UPDATE employee_details
+SET
+  is_employee_details_new = false,
+  effective_to = COALESCE(effective_to, GREATEST(COALESCE($1::date, CURRENT_DATE), effective_from)),
+  updated_at = now()
+WHERE
+  employee_id = $2
+  AND language_code = $3
+  AND id <> $4
+  AND is_employee_details_new IS TRUE
+`
+
+type ArchiveCurrentEmployeeDetailsParams struct {
+	EffectiveTo  pgtype.Date `json:"effective_to"`
+	EmployeeID   int64       `json:"employee_id"`
+	LanguageCode string      `json:"language_code"`
+	ExceptID     int64       `json:"except_id"`
+}
+
+// the current name of the language becomes a previous one, its period is closed where the new name takes effect
+func (q *Queries) ArchiveCurrentEmployeeDetails(ctx context.Context, arg ArchiveCurrentEmployeeDetailsParams) error {
+	_, err := q.db.Exec(ctx, archiveCurrentEmployeeDetails,
+		arg.EffectiveTo,
+		arg.EmployeeID,
+		arg.LanguageCode,
+		arg.ExceptID,
+	)
+	return err
+}
+
 const createEmployeeDetails = `-- name: CreateEmployeeDetails :one
 INSERT INTO employee_details (
   employee_id,
@@ -18,9 +49,11 @@ INSERT INTO employee_details (
   surname,
   name,
   middlename,
-  is_employee_details_new
+  is_employee_details_new,
+  effective_from,
+  effective_to
 ) VALUES(
-  $1, $2, $3, $4, $5, $6
+  $1, $2, $3, $4, $5, $6, $7, $8
 ) RETURNING id, created_at, updated_at
 `
 
@@ -31,6 +64,8 @@ type CreateEmployeeDetailsParams struct {
 	Name                 string      `json:"name"`
 	Middlename           pgtype.Text `json:"middlename"`
 	IsEmployeeDetailsNew bool        `json:"is_employee_details_new"`
+	EffectiveFrom        pgtype.Date `json:"effective_from"`
+	EffectiveTo          pgtype.Date `json:"effective_to"`
 }
 
 type CreateEmployeeDetailsRow struct {
@@ -47,6 +82,8 @@ func (q *Queries) CreateEmployeeDetails(ctx context.Context, arg CreateEmployeeD
 		arg.Name,
 		arg.Middlename,
 		arg.IsEmployeeDetailsNew,
+		arg.EffectiveFrom,
+		arg.EffectiveTo,
 	)
 	var i CreateEmployeeDetailsRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -64,7 +101,7 @@ func (q *Queries) DeleteEmployeeDetails(ctx context.Context, id int64) error {
 }
 
 const getCurrentEmployeeDetailsByEmployeeIDAndLanguageCode = `-- name: GetCurrentEmployeeDetailsByEmployeeIDAndLanguageCode :one
-SELECT id, employee_id, language_code, surname, name, middlename, is_employee_details_new, created_at, updated_at
+SELECT id, employee_id, language_code, surname, name, middlename, is_employee_details_new, created_at, updated_at, effective_from, effective_to
 FROM employee_details
 WHERE 
   employee_id = $1
@@ -90,14 +127,17 @@ func (q *Queries) GetCurrentEmployeeDetailsByEmployeeIDAndLanguageCode(ctx conte
 		&i.IsEmployeeDetailsNew,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EffectiveFrom,
+		&i.EffectiveTo,
 	)
 	return i, err
 }
 
 const getEmployeeDetailsByEmployeeID = `-- name: GetEmployeeDetailsByEmployeeID :many
-SELECT id, employee_id, language_code, surname, name, middlename, is_employee_details_new, created_at, updated_at
+SELECT id, employee_id, language_code, surname, name, middlename, is_employee_details_new, created_at, updated_at, effective_from, effective_to
 FROM employee_details
 WHERE employee_id = $1
+ORDER BY language_code, is_employee_details_new DESC, effective_from DESC NULLS LAST, id DESC
 `
 
 func (q *Queries) GetEmployeeDetailsByEmployeeID(ctx context.Context, employeeID int64) ([]EmployeeDetail, error) {
@@ -119,6 +159,8 @@ func (q *Queries) GetEmployeeDetailsByEmployeeID(ctx context.Context, employeeID
 			&i.IsEmployeeDetailsNew,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.EffectiveFrom,
+			&i.EffectiveTo,
 		); err != nil {
 			return nil, err
 		}
@@ -131,7 +173,7 @@ func (q *Queries) GetEmployeeDetailsByEmployeeID(ctx context.Context, employeeID
 }
 
 const getEmployeeDetailsByID = `-- name: GetEmployeeDetailsByID :one
-SELECT id, employee_id, language_code, surname, name, middlename, is_employee_details_new, created_at, updated_at
+SELECT id, employee_id, language_code, surname, name, middlename, is_employee_details_new, created_at, updated_at, effective_from, effective_to
 FROM employee_details
 WHERE id = $1
 `
@@ -149,6 +191,8 @@ func (q *Queries) GetEmployeeDetailsByID(ctx context.Context, id int64) (Employe
 		&i.IsEmployeeDetailsNew,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EffectiveFrom,
+		&i.EffectiveTo,
 	)
 	return i, err
 }
@@ -160,8 +204,10 @@ SET
   name = COALESCE($2, name),
   middlename = COALESCE($3, middlename),
   is_employee_details_new = COALESCE($4, is_employee_details_new),
+  effective_from = $5,
+  effective_to = $6,
   updated_at = now()
-WHERE id = $5
+WHERE id = $7
 RETURNING id, created_at, updated_at
 `
 
@@ -170,6 +216,8 @@ type UpdateEmployeeDetailsParams struct {
 	Name                 string      `json:"name"`
 	Middlename           pgtype.Text `json:"middlename"`
 	IsEmployeeDetailsNew bool        `json:"is_employee_details_new"`
+	EffectiveFrom        pgtype.Date `json:"effective_from"`
+	EffectiveTo          pgtype.Date `json:"effective_to"`
 	ID                   int64       `json:"id"`
 }
 
@@ -185,6 +233,8 @@ func (q *Queries) UpdateEmployeeDetails(ctx context.Context, arg UpdateEmployeeD
 		arg.Name,
 		arg.Middlename,
 		arg.IsEmployeeDetailsNew,
+		arg.EffectiveFrom,
+		arg.EffectiveTo,
 		arg.ID,
 	)
 	var i UpdateEmployeeDetailsRow
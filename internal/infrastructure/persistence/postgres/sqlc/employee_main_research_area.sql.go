@@ -16,17 +16,21 @@ INSERT INTO employee_main_research_areas(
   employee_id,
   language_code,
   area,
-  discipline
+  discipline,
+  translation_group_id,
+  research_field_code
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5, $6
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateEmployeeMainResearchAreaParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
-	Area         string `json:"area"`
-	Discipline   string `json:"discipline"`
+	EmployeeID         int64       `json:"employee_id"`
+	LanguageCode       string      `json:"language_code"`
+	Area               string      `json:"area"`
+	Discipline         string      `json:"discipline"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
+	ResearchFieldCode  pgtype.Text `json:"research_field_code"`
 }
 
 type CreateEmployeeMainResearchAreaRow struct {
@@ -41,6 +45,8 @@ func (q *Queries) CreateEmployeeMainResearchArea(ctx context.Context, arg Create
 		arg.LanguageCode,
 		arg.Area,
 		arg.Discipline,
+		arg.TranslationGroupID,
+		arg.ResearchFieldCode,
 	)
 	var i CreateEmployeeMainResearchAreaRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -50,15 +56,19 @@ func (q *Queries) CreateEmployeeMainResearchArea(ctx context.Context, arg Create
 const createEmployeeMainResearchAreaKeyTopic = `-- name: CreateEmployeeMainResearchAreaKeyTopic :one
 INSERT INTO employee_main_research_area_key_topics(
   employee_main_research_area_id, 
-  key_topic_title
+  key_topic_title,
+  language_code,
+  keyword_id
 ) VALUES (
-  $1, $2
+  $1, $2, $3, $4
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateEmployeeMainResearchAreaKeyTopicParams struct {
-	EmployeeMainResearchAreaID int64  `json:"employee_main_research_area_id"`
-	KeyTopicTitle              string `json:"key_topic_title"`
+	EmployeeMainResearchAreaID int64       `json:"employee_main_research_area_id"`
+	KeyTopicTitle              string      `json:"key_topic_title"`
+	LanguageCode               string      `json:"language_code"`
+	KeywordID                  pgtype.Int8 `json:"keyword_id"`
 }
 
 type CreateEmployeeMainResearchAreaKeyTopicRow struct {
@@ -68,7 +78,12 @@ type CreateEmployeeMainResearchAreaKeyTopicRow struct {
 }
 
 func (q *Queries) CreateEmployeeMainResearchAreaKeyTopic(ctx context.Context, arg CreateEmployeeMainResearchAreaKeyTopicParams) (CreateEmployeeMainResearchAreaKeyTopicRow, error) {
-	row := q.db.QueryRow(ctx, createEmployeeMainResearchAreaKeyTopic, arg.EmployeeMainResearchAreaID, arg.KeyTopicTitle)
+	row := q.db.QueryRow(ctx, createEmployeeMainResearchAreaKeyTopic,
+		arg.EmployeeMainResearchAreaID,
+		arg.KeyTopicTitle,
+		arg.LanguageCode,
+		arg.KeywordID,
+	)
 	var i CreateEmployeeMainResearchAreaKeyTopicRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
 	return i, err
@@ -76,7 +91,9 @@ func (q *Queries) CreateEmployeeMainResearchAreaKeyTopic(ctx context.Context, ar
 
 const deleteEmployeeMainResearchArea = `-- name: DeleteEmployeeMainResearchArea :exec
 DELETE FROM employee_main_research_areas
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_main_research_areas WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteEmployeeMainResearchArea(ctx context.Context, id int64) error {
@@ -105,7 +122,7 @@ func (q *Queries) DeleteEmployeeMainResearchAreaKeyTopicsByEmployeeMainResearchA
 }
 
 const getEmployeeMainResearchAreaByID = `-- name: GetEmployeeMainResearchAreaByID :one
-SELECT id, employee_id, language_code, area, discipline, created_at, updated_at
+SELECT id, employee_id, language_code, area, discipline, created_at, updated_at, translation_group_id, research_field_code
 FROM employee_main_research_areas
 WHERE id = $1
 `
@@ -121,12 +138,14 @@ func (q *Queries) GetEmployeeMainResearchAreaByID(ctx context.Context, id int64)
 		&i.Discipline,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
+		&i.ResearchFieldCode,
 	)
 	return i, err
 }
 
 const getEmployeeMainResearchAreaKeyTopicByID = `-- name: GetEmployeeMainResearchAreaKeyTopicByID :one
-SELECT id, employee_main_research_area_id, key_topic_title, created_at, updated_at
+SELECT id, employee_main_research_area_id, key_topic_title, created_at, updated_at, language_code, keyword_id
 FROM employee_main_research_area_key_topics 
 WHERE id = $1
 `
@@ -140,12 +159,14 @@ func (q *Queries) GetEmployeeMainResearchAreaKeyTopicByID(ctx context.Context, i
 		&i.KeyTopicTitle,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LanguageCode,
+		&i.KeywordID,
 	)
 	return i, err
 }
 
 const getEmployeeMainResearchAreaKeyTopicsByEmployeeMainResearchAreaIDAndLanguageCode = `-- name: GetEmployeeMainResearchAreaKeyTopicsByEmployeeMainResearchAreaIDAndLanguageCode :many
-SELECT id, employee_main_research_area_id, key_topic_title, created_at, updated_at
+SELECT id, employee_main_research_area_id, key_topic_title, created_at, updated_at, language_code, keyword_id
 FROM employee_main_research_area_key_topics
 WHERE employee_main_research_area_id = $1
 `
@@ -165,6 +186,8 @@ func (q *Queries) GetEmployeeMainResearchAreaKeyTopicsByEmployeeMainResearchArea
 			&i.KeyTopicTitle,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LanguageCode,
+			&i.KeywordID,
 		); err != nil {
 			return nil, err
 		}
@@ -176,19 +199,24 @@ func (q *Queries) GetEmployeeMainResearchAreaKeyTopicsByEmployeeMainResearchArea
 	return items, nil
 }
 
-const getEmployeeMainResearchAreasByEmployeeIDAndLanguageCode = `-- name: GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCode :many
-SELECT id, employee_id, language_code, area, discipline, created_at, updated_at
+const getEmployeeMainResearchAreasByEmployeeIDAndLanguageCodes = `-- name: GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodes :many
+SELECT id, employee_id, language_code, area, discipline, created_at, updated_at, translation_group_id, research_field_code
 FROM employee_main_research_areas
-WHERE employee_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_main_research_areas
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodeParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
+type GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodesParams struct {
+	EmployeeID    int64    `json:"employee_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodeParams) ([]EmployeeMainResearchArea, error) {
-	rows, err := q.db.Query(ctx, getEmployeeMainResearchAreasByEmployeeIDAndLanguageCode, arg.EmployeeID, arg.LanguageCode)
+func (q *Queries) GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodesParams) ([]EmployeeMainResearchArea, error) {
+	rows, err := q.db.Query(ctx, getEmployeeMainResearchAreasByEmployeeIDAndLanguageCodes, arg.EmployeeID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -204,6 +232,8 @@ func (q *Queries) GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCode(ctx co
 			&i.Discipline,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
+			&i.ResearchFieldCode,
 		); err != nil {
 			return nil, err
 		}
@@ -215,20 +245,38 @@ func (q *Queries) GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCode(ctx co
 	return items, nil
 }
 
+const syncEmployeeMainResearchAreaTranslations = `-- name: SyncEmployeeMainResearchAreaTranslations :exec
+UPDATE employee_main_research_areas
+SET
+  research_field_code = source.research_field_code,
+  updated_at = now()
+FROM employee_main_research_areas source
+WHERE source.id = $1
+  AND employee_main_research_areas.translation_group_id = source.translation_group_id
+  AND employee_main_research_areas.id <> source.id
+`
+
+func (q *Queries) SyncEmployeeMainResearchAreaTranslations(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, syncEmployeeMainResearchAreaTranslations, id)
+	return err
+}
+
 const updateEmployeeMainResearchArea = `-- name: UpdateEmployeeMainResearchArea :one
 UPDATE employee_main_research_areas 
 SET 
   area = COALESCE($1, area),
   discipline = COALESCE($2, discipline),
+  research_field_code = COALESCE($3, research_field_code),
   updated_at = now()
-WHERE id = $3
+WHERE id = $4
 RETURNING id, created_at, updated_at
 `
 
 type UpdateEmployeeMainResearchAreaParams struct {
-	Area       string `json:"area"`
-	Discipline string `json:"discipline"`
-	ID         int64  `json:"id"`
+	Area              string      `json:"area"`
+	Discipline        string      `json:"discipline"`
+	ResearchFieldCode pgtype.Text `json:"research_field_code"`
+	ID                int64       `json:"id"`
 }
 
 type UpdateEmployeeMainResearchAreaRow struct {
@@ -238,7 +286,12 @@ type UpdateEmployeeMainResearchAreaRow struct {
 }
 
 func (q *Queries) UpdateEmployeeMainResearchArea(ctx context.Context, arg UpdateEmployeeMainResearchAreaParams) (UpdateEmployeeMainResearchAreaRow, error) {
-	row := q.db.QueryRow(ctx, updateEmployeeMainResearchArea, arg.Area, arg.Discipline, arg.ID)
+	row := q.db.QueryRow(ctx, updateEmployeeMainResearchArea,
+		arg.Area,
+		arg.Discipline,
+		arg.ResearchFieldCode,
+		arg.ID,
+	)
 	var i UpdateEmployeeMainResearchAreaRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
 	return i, err
@@ -248,14 +301,16 @@ const updateEmployeeMainResearchAreaKeyTopic = `-- name: UpdateEmployeeMainResea
 UPDATE employee_main_research_area_key_topics
 SET
   key_topic_title = COALESCE($1, key_topic_title),
+  keyword_id = COALESCE($2, keyword_id),
   updated_at = now()
-WHERE id = $2
+WHERE id = $3
 RETURNING id, created_at, updated_at
 `
 
 type UpdateEmployeeMainResearchAreaKeyTopicParams struct {
-	KeyTopicTitle string `json:"key_topic_title"`
-	ID            int64  `json:"id"`
+	KeyTopicTitle string      `json:"key_topic_title"`
+	KeywordID     pgtype.Int8 `json:"keyword_id"`
+	ID            int64       `json:"id"`
 }
 
 type UpdateEmployeeMainResearchAreaKeyTopicRow struct {
@@ -265,7 +320,7 @@ type UpdateEmployeeMainResearchAreaKeyTopicRow struct {
 }
 
 func (q *Queries) UpdateEmployeeMainResearchAreaKeyTopic(ctx context.Context, arg UpdateEmployeeMainResearchAreaKeyTopicParams) (UpdateEmployeeMainResearchAreaKeyTopicRow, error) {
-	row := q.db.QueryRow(ctx, updateEmployeeMainResearchAreaKeyTopic, arg.KeyTopicTitle, arg.ID)
+	row := q.db.QueryRow(ctx, updateEmployeeMainResearchAreaKeyTopic, arg.KeyTopicTitle, arg.KeywordID, arg.ID)
 	var i UpdateEmployeeMainResearchAreaKeyTopicRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
 	return i, err
@@ -16,17 +16,19 @@ INSERT INTO employee_participation_in_events(
   employee_id,
   language_code,
   event_title,
-  event_date
+  event_date,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateEmployeeParticipationInEventParams struct {
-	EmployeeID   int64       `json:"employee_id"`
-	LanguageCode string      `json:"language_code"`
-	EventTitle   string      `json:"event_title"`
-	EventDate    pgtype.Date `json:"event_date"`
+	EmployeeID         int64       `json:"employee_id"`
+	LanguageCode       string      `json:"language_code"`
+	EventTitle         string      `json:"event_title"`
+	EventDate          pgtype.Date `json:"event_date"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateEmployeeParticipationInEventRow struct {
@@ -41,6 +43,7 @@ func (q *Queries) CreateEmployeeParticipationInEvent(ctx context.Context, arg Cr
 		arg.LanguageCode,
 		arg.EventTitle,
 		arg.EventDate,
+		arg.TranslationGroupID,
 	)
 	var i CreateEmployeeParticipationInEventRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -49,7 +52,9 @@ func (q *Queries) CreateEmployeeParticipationInEvent(ctx context.Context, arg Cr
 
 const deleteEmployeeParticipationInEvent = `-- name: DeleteEmployeeParticipationInEvent :exec
 DELETE FROM employee_participation_in_events
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_participation_in_events WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteEmployeeParticipationInEvent(ctx context.Context, id int64) error {
@@ -58,7 +63,7 @@ func (q *Queries) DeleteEmployeeParticipationInEvent(ctx context.Context, id int
 }
 
 const getEmployeeParticipationInEventByID = `-- name: GetEmployeeParticipationInEventByID :one
-SELECT id, employee_id, language_code, event_title, event_date, created_at, updated_at
+SELECT id, employee_id, language_code, event_title, event_date, created_at, updated_at, translation_group_id
 FROM employee_participation_in_events
 WHERE id = $1
 `
@@ -74,23 +79,29 @@ func (q *Queries) GetEmployeeParticipationInEventByID(ctx context.Context, id in
 		&i.EventDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getEmployeeParticipationInEventsByEmployeeIDAndLanguageCode = `-- name: GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCode :many
-SELECT id, employee_id, language_code, event_title, event_date, created_at, updated_at
+const getEmployeeParticipationInEventsByEmployeeIDAndLanguageCodes = `-- name: GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodes :many
+SELECT id, employee_id, language_code, event_title, event_date, created_at, updated_at, translation_group_id
 FROM employee_participation_in_events
-WHERE employee_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_participation_in_events
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodeParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
+type GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodesParams struct {
+	EmployeeID    int64    `json:"employee_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodeParams) ([]EmployeeParticipationInEvent, error) {
-	rows, err := q.db.Query(ctx, getEmployeeParticipationInEventsByEmployeeIDAndLanguageCode, arg.EmployeeID, arg.LanguageCode)
+func (q *Queries) GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodesParams) ([]EmployeeParticipationInEvent, error) {
+	rows, err := q.db.Query(ctx, getEmployeeParticipationInEventsByEmployeeIDAndLanguageCodes, arg.EmployeeID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +117,7 @@ func (q *Queries) GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCode(ct
 			&i.EventDate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
@@ -145,3 +157,19 @@ func (q *Queries) UpdateEmployeeParticipationInEvent(ctx context.Context, arg Up
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
 	return i, err
 }
+
+const syncEmployeeParticipationInEventTranslations = `-- name: SyncEmployeeParticipationInEventTranslations :exec
+UPDATE employee_participation_in_events
+SET
+  event_date = source.event_date,
+  updated_at = now()
+FROM employee_participation_in_events source
+WHERE source.id = $1
+  AND employee_participation_in_events.translation_group_id = source.translation_group_id
+  AND employee_participation_in_events.id <> source.id
+`
+
+func (q *Queries) SyncEmployeeParticipationInEventTranslations(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, syncEmployeeParticipationInEventTranslations, id)
+	return err
+}
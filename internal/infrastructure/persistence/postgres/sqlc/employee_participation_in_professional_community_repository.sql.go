@@ -16,17 +16,19 @@ INSERT INTO employee_participation_in_professional_communities(
   employee_id,
   language_code,
   professional_community_title,
-  role_in_professional_community
+  role_in_professional_community,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateEmployeeParticipationInProfessionalCommunityParams struct {
-	EmployeeID                  int64  `json:"employee_id"`
-	LanguageCode                string `json:"language_code"`
-	ProfessionalCommunityTitle  string `json:"professional_community_title"`
-	RoleInProfessionalCommunity string `json:"role_in_professional_community"`
+	EmployeeID                  int64       `json:"employee_id"`
+	LanguageCode                string      `json:"language_code"`
+	ProfessionalCommunityTitle  string      `json:"professional_community_title"`
+	RoleInProfessionalCommunity string      `json:"role_in_professional_community"`
+	TranslationGroupID          pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateEmployeeParticipationInProfessionalCommunityRow struct {
@@ -41,6 +43,7 @@ func (q *Queries) CreateEmployeeParticipationInProfessionalCommunity(ctx context
 		arg.LanguageCode,
 		arg.ProfessionalCommunityTitle,
 		arg.RoleInProfessionalCommunity,
+		arg.TranslationGroupID,
 	)
 	var i CreateEmployeeParticipationInProfessionalCommunityRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -49,7 +52,9 @@ func (q *Queries) CreateEmployeeParticipationInProfessionalCommunity(ctx context
 
 const deleteEmployeeParticipationInProfessionalCommunity = `-- name: DeleteEmployeeParticipationInProfessionalCommunity :exec
 DELETE FROM employee_participation_in_professional_communities
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_participation_in_professional_communities WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteEmployeeParticipationInProfessionalCommunity(ctx context.Context, id int64) error {
@@ -58,7 +63,7 @@ func (q *Queries) DeleteEmployeeParticipationInProfessionalCommunity(ctx context
 }
 
 const getEmployeeParticipationInProfessionalCommunityByID = `-- name: GetEmployeeParticipationInProfessionalCommunityByID :one
-SELECT id, employee_id, professional_community_title, language_code, role_in_professional_community, created_at, updated_at
+SELECT id, employee_id, professional_community_title, language_code, role_in_professional_community, created_at, updated_at, translation_group_id
 FROM employee_participation_in_professional_communities
 WHERE id = $1
 `
@@ -74,23 +79,29 @@ func (q *Queries) GetEmployeeParticipationInProfessionalCommunityByID(ctx contex
 		&i.RoleInProfessionalCommunity,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCode = `-- name: GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCode :many
-SELECT id, employee_id, professional_community_title, language_code, role_in_professional_community, created_at, updated_at
+const getEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodes = `-- name: GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodes :many
+SELECT id, employee_id, professional_community_title, language_code, role_in_professional_community, created_at, updated_at, translation_group_id
 FROM employee_participation_in_professional_communities
-WHERE employee_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_participation_in_professional_communities
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodeParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
+type GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodesParams struct {
+	EmployeeID    int64    `json:"employee_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodeParams) ([]EmployeeParticipationInProfessionalCommunity, error) {
-	rows, err := q.db.Query(ctx, getEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCode, arg.EmployeeID, arg.LanguageCode)
+func (q *Queries) GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodesParams) ([]EmployeeParticipationInProfessionalCommunity, error) {
+	rows, err := q.db.Query(ctx, getEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodes, arg.EmployeeID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +117,7 @@ func (q *Queries) GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAn
 			&i.RoleInProfessionalCommunity,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
@@ -16,17 +16,19 @@ INSERT INTO employee_patents(
   employee_id,
   language_code,
   patent_title,
-  description
+  description,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateEmployeePatentParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
-	PatentTitle  string `json:"patent_title"`
-	Description  string `json:"description"`
+	EmployeeID         int64       `json:"employee_id"`
+	LanguageCode       string      `json:"language_code"`
+	PatentTitle        string      `json:"patent_title"`
+	Description        string      `json:"description"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateEmployeePatentRow struct {
@@ -41,6 +43,7 @@ func (q *Queries) CreateEmployeePatent(ctx context.Context, arg CreateEmployeePa
 		arg.LanguageCode,
 		arg.PatentTitle,
 		arg.Description,
+		arg.TranslationGroupID,
 	)
 	var i CreateEmployeePatentRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -49,7 +52,9 @@ func (q *Queries) CreateEmployeePatent(ctx context.Context, arg CreateEmployeePa
 
 const deleteEmployeePatent = `-- name: DeleteEmployeePatent :exec
 DELETE FROM employee_patents
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_patents WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteEmployeePatent(ctx context.Context, id int64) error {
@@ -58,7 +63,7 @@ func (q *Queries) DeleteEmployeePatent(ctx context.Context, id int64) error {
 }
 
 const getEmployeePatentByID = `-- name: GetEmployeePatentByID :one
-SELECT id, employee_id, language_code, patent_title, description, created_at, updated_at
+SELECT id, employee_id, language_code, patent_title, description, created_at, updated_at, translation_group_id
 FROM employee_patents
 WHERE id = $1
 `
@@ -74,23 +79,29 @@ func (q *Queries) GetEmployeePatentByID(ctx context.Context, id int64) (Employee
 		&i.Description,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getEmployeePatentsByEmployeeIDAndLanguageCode = `-- name: GetEmployeePatentsByEmployeeIDAndLanguageCode :many
-SELECT id, employee_id, language_code, patent_title, description, created_at, updated_at
+const getEmployeePatentsByEmployeeIDAndLanguageCodes = `-- name: GetEmployeePatentsByEmployeeIDAndLanguageCodes :many
+SELECT id, employee_id, language_code, patent_title, description, created_at, updated_at, translation_group_id
 FROM employee_patents
-WHERE employee_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_patents
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetEmployeePatentsByEmployeeIDAndLanguageCodeParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
+type GetEmployeePatentsByEmployeeIDAndLanguageCodesParams struct {
+	EmployeeID    int64    `json:"employee_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetEmployeePatentsByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeePatentsByEmployeeIDAndLanguageCodeParams) ([]EmployeePatent, error) {
-	rows, err := q.db.Query(ctx, getEmployeePatentsByEmployeeIDAndLanguageCode, arg.EmployeeID, arg.LanguageCode)
+func (q *Queries) GetEmployeePatentsByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeePatentsByEmployeeIDAndLanguageCodesParams) ([]EmployeePatent, error) {
+	rows, err := q.db.Query(ctx, getEmployeePatentsByEmployeeIDAndLanguageCodes, arg.EmployeeID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +117,7 @@ func (q *Queries) GetEmployeePatentsByEmployeeIDAndLanguageCode(ctx context.Cont
 			&i.Description,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
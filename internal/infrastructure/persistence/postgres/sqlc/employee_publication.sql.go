@@ -11,22 +11,52 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countEmployeePublicationsByPublicationID = `-- name: CountEmployeePublicationsByPublicationID :one
+SELECT count(*)::bigint
+FROM employee_publications
+WHERE employee_id = $1 AND publication_id = $2
+`
+
+type CountEmployeePublicationsByPublicationIDParams struct {
+	EmployeeID    int64       `json:"employee_id"`
+	PublicationID pgtype.Int8 `json:"publication_id"`
+}
+
+func (q *Queries) CountEmployeePublicationsByPublicationID(ctx context.Context, arg CountEmployeePublicationsByPublicationIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countEmployeePublicationsByPublicationID, arg.EmployeeID, arg.PublicationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createEmployeePublication = `-- name: CreateEmployeePublication :one
 INSERT INTO employee_publications(
   employee_id,
   language_code,
   publication_title,
-  link_to_publication
+  link_to_publication,
+  authors,
+  publication_year,
+  venue,
+  doi,
+  publication_type,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateEmployeePublicationParams struct {
-	EmployeeID        int64  `json:"employee_id"`
-	LanguageCode      string `json:"language_code"`
-	PublicationTitle  string `json:"publication_title"`
-	LinkToPublication string `json:"link_to_publication"`
+	EmployeeID         int64       `json:"employee_id"`
+	LanguageCode       string      `json:"language_code"`
+	PublicationTitle   string      `json:"publication_title"`
+	LinkToPublication  string      `json:"link_to_publication"`
+	Authors            []string    `json:"authors"`
+	PublicationYear    pgtype.Int4 `json:"publication_year"`
+	Venue              pgtype.Text `json:"venue"`
+	Doi                pgtype.Text `json:"doi"`
+	PublicationType    pgtype.Text `json:"publication_type"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateEmployeePublicationRow struct {
@@ -41,24 +71,108 @@ func (q *Queries) CreateEmployeePublication(ctx context.Context, arg CreateEmplo
 		arg.LanguageCode,
 		arg.PublicationTitle,
 		arg.LinkToPublication,
+		arg.Authors,
+		arg.PublicationYear,
+		arg.Venue,
+		arg.Doi,
+		arg.PublicationType,
+		arg.TranslationGroupID,
 	)
 	var i CreateEmployeePublicationRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
 	return i, err
 }
 
-const deleteEmployeePublication = `-- name: DeleteEmployeePublication :exec
+const deleteEmployeePublication = `-- name: DeleteEmployeePublication :many
 DELETE FROM employee_publications
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_publications WHERE id = $1
+)
+RETURNING publication_id
 `
 
-func (q *Queries) DeleteEmployeePublication(ctx context.Context, id int64) error {
-	_, err := q.db.Exec(ctx, deleteEmployeePublication, id)
+func (q *Queries) DeleteEmployeePublication(ctx context.Context, id int64) ([]pgtype.Int8, error) {
+	rows, err := q.db.Query(ctx, deleteEmployeePublication, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.Int8{}
+	for rows.Next() {
+		var publication_id pgtype.Int8
+		if err := rows.Scan(&publication_id); err != nil {
+			return nil, err
+		}
+		items = append(items, publication_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteEmployeePublicationsByPublicationID = `-- name: DeleteEmployeePublicationsByPublicationID :exec
+DELETE FROM employee_publications
+WHERE employee_id = $1 AND publication_id = $2
+`
+
+type DeleteEmployeePublicationsByPublicationIDParams struct {
+	EmployeeID    int64       `json:"employee_id"`
+	PublicationID pgtype.Int8 `json:"publication_id"`
+}
+
+func (q *Queries) DeleteEmployeePublicationsByPublicationID(ctx context.Context, arg DeleteEmployeePublicationsByPublicationIDParams) error {
+	_, err := q.db.Exec(ctx, deleteEmployeePublicationsByPublicationID, arg.EmployeeID, arg.PublicationID)
 	return err
 }
 
+const deleteStaleImportedEmployeePublications = `-- name: DeleteStaleImportedEmployeePublications :many
+DELETE FROM employee_publications
+WHERE translation_group_id IN (
+  SELECT translation_group_id
+  FROM employee_publications
+  WHERE employee_id = $1
+    AND language_code = $2
+    AND source = $3
+    AND NOT (external_id = ANY($4::text[]))
+)
+RETURNING publication_id
+`
+
+type DeleteStaleImportedEmployeePublicationsParams struct {
+	EmployeeID   int64    `json:"employee_id"`
+	LanguageCode string   `json:"language_code"`
+	Source       string   `json:"source"`
+	ExternalIds  []string `json:"external_ids"`
+}
+
+func (q *Queries) DeleteStaleImportedEmployeePublications(ctx context.Context, arg DeleteStaleImportedEmployeePublicationsParams) ([]pgtype.Int8, error) {
+	rows, err := q.db.Query(ctx, deleteStaleImportedEmployeePublications,
+		arg.EmployeeID,
+		arg.LanguageCode,
+		arg.Source,
+		arg.ExternalIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.Int8{}
+	for rows.Next() {
+		var publication_id pgtype.Int8
+		if err := rows.Scan(&publication_id); err != nil {
+			return nil, err
+		}
+		items = append(items, publication_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getEmployeePublicationByID = `-- name: GetEmployeePublicationByID :one
-SELECT id, employee_id, language_code, publication_title, link_to_publication, created_at, updated_at
+SELECT id, employee_id, language_code, publication_title, link_to_publication, created_at, updated_at, authors, publication_year, venue, doi, publication_type, source, external_id, publication_id, translation_group_id
 FROM employee_publications
 WHERE id = $1
 `
@@ -74,23 +188,37 @@ func (q *Queries) GetEmployeePublicationByID(ctx context.Context, id int64) (Emp
 		&i.LinkToPublication,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Authors,
+		&i.PublicationYear,
+		&i.Venue,
+		&i.Doi,
+		&i.PublicationType,
+		&i.Source,
+		&i.ExternalID,
+		&i.PublicationID,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getEmployeePublicationsByEmployeeIDAndLanguageCode = `-- name: GetEmployeePublicationsByEmployeeIDAndLanguageCode :many
-SELECT id, employee_id, language_code, publication_title, link_to_publication, created_at, updated_at
+const getEmployeePublicationsByEmployeeIDAndLanguageCodes = `-- name: GetEmployeePublicationsByEmployeeIDAndLanguageCodes :many
+SELECT id, employee_id, language_code, publication_title, link_to_publication, created_at, updated_at, authors, publication_year, venue, doi, publication_type, source, external_id, publication_id, translation_group_id
 FROM employee_publications
-WHERE employee_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_publications
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetEmployeePublicationsByEmployeeIDAndLanguageCodeParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
+type GetEmployeePublicationsByEmployeeIDAndLanguageCodesParams struct {
+	EmployeeID    int64    `json:"employee_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetEmployeePublicationsByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeePublicationsByEmployeeIDAndLanguageCodeParams) ([]EmployeePublication, error) {
-	rows, err := q.db.Query(ctx, getEmployeePublicationsByEmployeeIDAndLanguageCode, arg.EmployeeID, arg.LanguageCode)
+func (q *Queries) GetEmployeePublicationsByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeePublicationsByEmployeeIDAndLanguageCodesParams) ([]EmployeePublication, error) {
+	rows, err := q.db.Query(ctx, getEmployeePublicationsByEmployeeIDAndLanguageCodes, arg.EmployeeID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +234,15 @@ func (q *Queries) GetEmployeePublicationsByEmployeeIDAndLanguageCode(ctx context
 			&i.LinkToPublication,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Authors,
+			&i.PublicationYear,
+			&i.Venue,
+			&i.Doi,
+			&i.PublicationType,
+			&i.Source,
+			&i.ExternalID,
+			&i.PublicationID,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
@@ -117,20 +254,46 @@ func (q *Queries) GetEmployeePublicationsByEmployeeIDAndLanguageCode(ctx context
 	return items, nil
 }
 
+const setEmployeePublicationPublicationID = `-- name: SetEmployeePublicationPublicationID :exec
+UPDATE employee_publications
+SET publication_id = $1
+WHERE id = $2
+`
+
+type SetEmployeePublicationPublicationIDParams struct {
+	PublicationID pgtype.Int8 `json:"publication_id"`
+	ID            int64       `json:"id"`
+}
+
+func (q *Queries) SetEmployeePublicationPublicationID(ctx context.Context, arg SetEmployeePublicationPublicationIDParams) error {
+	_, err := q.db.Exec(ctx, setEmployeePublicationPublicationID, arg.PublicationID, arg.ID)
+	return err
+}
+
 const updateEmployeePublication = `-- name: UpdateEmployeePublication :one
 UPDATE employee_publications 
 SET 
   publication_title = COALESCE($1, publication_title),
   link_to_publication = COALESCE($2, link_to_publication),
+  authors = COALESCE($4, authors),
+  publication_year = $5,
+  venue = $6,
+  doi = $7,
+  publication_type = $8,
   updated_at = now()
 WHERE id = $3
 RETURNING id, created_at, updated_at
 `
 
 type UpdateEmployeePublicationParams struct {
-	PublicationTitle  string `json:"publication_title"`
-	LinkToPublication string `json:"link_to_publication"`
-	ID                int64  `json:"id"`
+	PublicationTitle  string      `json:"publication_title"`
+	LinkToPublication string      `json:"link_to_publication"`
+	ID                int64       `json:"id"`
+	Authors           []string    `json:"authors"`
+	PublicationYear   pgtype.Int4 `json:"publication_year"`
+	Venue             pgtype.Text `json:"venue"`
+	Doi               pgtype.Text `json:"doi"`
+	PublicationType   pgtype.Text `json:"publication_type"`
 }
 
 type UpdateEmployeePublicationRow struct {
@@ -139,9 +302,120 @@ type UpdateEmployeePublicationRow struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+// the optional bibliographic fields are written as given, a NULL clears them
 func (q *Queries) UpdateEmployeePublication(ctx context.Context, arg UpdateEmployeePublicationParams) (UpdateEmployeePublicationRow, error) {
-	row := q.db.QueryRow(ctx, updateEmployeePublication, arg.PublicationTitle, arg.LinkToPublication, arg.ID)
+	row := q.db.QueryRow(ctx, updateEmployeePublication,
+		arg.PublicationTitle,
+		arg.LinkToPublication,
+		arg.ID,
+		arg.Authors,
+		arg.PublicationYear,
+		arg.Venue,
+		arg.Doi,
+		arg.PublicationType,
+	)
 	var i UpdateEmployeePublicationRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
 	return i, err
 }
+
+const upsertImportedEmployeePublication = `-- name: UpsertImportedEmployeePublication :one
+INSERT INTO employee_publications(
+  employee_id,
+  language_code,
+  publication_title,
+  link_to_publication,
+  authors,
+  publication_year,
+  venue,
+  doi,
+  publication_type,
+  source,
+  external_id,
+  translation_group_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+)
+ON CONFLICT (employee_id, language_code, source, external_id) DO UPDATE
+SET
+  publication_title = EXCLUDED.publication_title,
+  link_to_publication = EXCLUDED.link_to_publication,
+  authors = CASE
+    WHEN cardinality(EXCLUDED.authors) > 0 THEN EXCLUDED.authors
+    ELSE employee_publications.authors
+  END,
+  publication_year = EXCLUDED.publication_year,
+  venue = EXCLUDED.venue,
+  doi = EXCLUDED.doi,
+  publication_type = EXCLUDED.publication_type,
+  updated_at = now()
+RETURNING id, created_at, updated_at, publication_id, translation_group_id
+`
+
+type UpsertImportedEmployeePublicationParams struct {
+	EmployeeID         int64       `json:"employee_id"`
+	LanguageCode       string      `json:"language_code"`
+	PublicationTitle   string      `json:"publication_title"`
+	LinkToPublication  string      `json:"link_to_publication"`
+	Authors            []string    `json:"authors"`
+	PublicationYear    pgtype.Int4 `json:"publication_year"`
+	Venue              pgtype.Text `json:"venue"`
+	Doi                pgtype.Text `json:"doi"`
+	PublicationType    pgtype.Text `json:"publication_type"`
+	Source             string      `json:"source"`
+	ExternalID         pgtype.Text `json:"external_id"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
+}
+
+type UpsertImportedEmployeePublicationRow struct {
+	ID                 int64              `json:"id"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	PublicationID      pgtype.Int8        `json:"publication_id"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
+}
+
+func (q *Queries) UpsertImportedEmployeePublication(ctx context.Context, arg UpsertImportedEmployeePublicationParams) (UpsertImportedEmployeePublicationRow, error) {
+	row := q.db.QueryRow(ctx, upsertImportedEmployeePublication,
+		arg.EmployeeID,
+		arg.LanguageCode,
+		arg.PublicationTitle,
+		arg.LinkToPublication,
+		arg.Authors,
+		arg.PublicationYear,
+		arg.Venue,
+		arg.Doi,
+		arg.PublicationType,
+		arg.Source,
+		arg.ExternalID,
+		arg.TranslationGroupID,
+	)
+	var i UpsertImportedEmployeePublicationRow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PublicationID,
+		&i.TranslationGroupID,
+	)
+	return i, err
+}
+
+const syncEmployeePublicationTranslations = `-- name: SyncEmployeePublicationTranslations :exec
+UPDATE employee_publications
+SET
+  link_to_publication = source.link_to_publication,
+  publication_year = source.publication_year,
+  doi = source.doi,
+  publication_type = source.publication_type,
+  updated_at = now()
+FROM employee_publications source
+WHERE source.id = $1
+  AND employee_publications.translation_group_id = source.translation_group_id
+  AND employee_publications.id <> source.id
+`
+
+func (q *Queries) SyncEmployeePublicationTranslations(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, syncEmployeePublicationTranslations, id)
+	return err
+}
@@ -17,18 +17,20 @@ INSERT INTO employee_refresher_courses(
   language_code,
   course_title,
   date_start,
-  date_end
+  date_end,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateEmployeeRefresherCourseParams struct {
-	EmployeeID   int64       `json:"employee_id"`
-	LanguageCode string      `json:"language_code"`
-	CourseTitle  string      `json:"course_title"`
-	DateStart    pgtype.Date `json:"date_start"`
-	DateEnd      pgtype.Date `json:"date_end"`
+	EmployeeID         int64       `json:"employee_id"`
+	LanguageCode       string      `json:"language_code"`
+	CourseTitle        string      `json:"course_title"`
+	DateStart          pgtype.Date `json:"date_start"`
+	DateEnd            pgtype.Date `json:"date_end"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateEmployeeRefresherCourseRow struct {
@@ -44,6 +46,7 @@ func (q *Queries) CreateEmployeeRefresherCourse(ctx context.Context, arg CreateE
 		arg.CourseTitle,
 		arg.DateStart,
 		arg.DateEnd,
+		arg.TranslationGroupID,
 	)
 	var i CreateEmployeeRefresherCourseRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -52,7 +55,9 @@ func (q *Queries) CreateEmployeeRefresherCourse(ctx context.Context, arg CreateE
 
 const deleteEmployeeRefresherCourse = `-- name: DeleteEmployeeRefresherCourse :exec
 DELETE FROM employee_refresher_courses
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_refresher_courses WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteEmployeeRefresherCourse(ctx context.Context, id int64) error {
@@ -61,7 +66,7 @@ func (q *Queries) DeleteEmployeeRefresherCourse(ctx context.Context, id int64) e
 }
 
 const getEmployeeRefresherCourseByID = `-- name: GetEmployeeRefresherCourseByID :one
-SELECT id, employee_id, language_code, course_title, date_start, date_end, created_at, updated_at
+SELECT id, employee_id, language_code, course_title, date_start, date_end, created_at, updated_at, translation_group_id
 FROM employee_refresher_courses
 WHERE id = $1
 `
@@ -78,23 +83,29 @@ func (q *Queries) GetEmployeeRefresherCourseByID(ctx context.Context, id int64)
 		&i.DateEnd,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getEmployeeRefresherCoursesByEmployeeIDAndLanguageCode = `-- name: GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCode :many
-SELECT id, employee_id, language_code, course_title, date_start, date_end, created_at, updated_at
+const getEmployeeRefresherCoursesByEmployeeIDAndLanguageCodes = `-- name: GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodes :many
+SELECT id, employee_id, language_code, course_title, date_start, date_end, created_at, updated_at, translation_group_id
 FROM employee_refresher_courses
-WHERE employee_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_refresher_courses
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodeParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
+type GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodesParams struct {
+	EmployeeID    int64    `json:"employee_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodeParams) ([]EmployeeRefresherCourse, error) {
-	rows, err := q.db.Query(ctx, getEmployeeRefresherCoursesByEmployeeIDAndLanguageCode, arg.EmployeeID, arg.LanguageCode)
+func (q *Queries) GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodesParams) ([]EmployeeRefresherCourse, error) {
+	rows, err := q.db.Query(ctx, getEmployeeRefresherCoursesByEmployeeIDAndLanguageCodes, arg.EmployeeID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +122,7 @@ func (q *Queries) GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCode(ctx con
 			&i.DateEnd,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
@@ -157,3 +169,20 @@ func (q *Queries) UpdateEmployeeRefresherCourse(ctx context.Context, arg UpdateE
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
 	return i, err
 }
+
+const syncEmployeeRefresherCourseTranslations = `-- name: SyncEmployeeRefresherCourseTranslations :exec
+UPDATE employee_refresher_courses
+SET
+  date_start = source.date_start,
+  date_end = source.date_end,
+  updated_at = now()
+FROM employee_refresher_courses source
+WHERE source.id = $1
+  AND employee_refresher_courses.translation_group_id = source.translation_group_id
+  AND employee_refresher_courses.id <> source.id
+`
+
+func (q *Queries) SyncEmployeeRefresherCourseTranslations(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, syncEmployeeRefresherCourseTranslations, id)
+	return err
+}
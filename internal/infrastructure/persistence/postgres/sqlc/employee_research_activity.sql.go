@@ -16,17 +16,19 @@ INSERT INTO employee_research_activities(
   employee_id,
   language_code,
   research_activity_title,
-  employee_role
+  employee_role,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateEmployeeResearchActivityParams struct {
-	EmployeeID            int64  `json:"employee_id"`
-	LanguageCode          string `json:"language_code"`
-	ResearchActivityTitle string `json:"research_activity_title"`
-	EmployeeRole          string `json:"employee_role"`
+	EmployeeID            int64       `json:"employee_id"`
+	LanguageCode          string      `json:"language_code"`
+	ResearchActivityTitle string      `json:"research_activity_title"`
+	EmployeeRole          string      `json:"employee_role"`
+	TranslationGroupID    pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateEmployeeResearchActivityRow struct {
@@ -41,6 +43,7 @@ func (q *Queries) CreateEmployeeResearchActivity(ctx context.Context, arg Create
 		arg.LanguageCode,
 		arg.ResearchActivityTitle,
 		arg.EmployeeRole,
+		arg.TranslationGroupID,
 	)
 	var i CreateEmployeeResearchActivityRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -49,7 +52,9 @@ func (q *Queries) CreateEmployeeResearchActivity(ctx context.Context, arg Create
 
 const deleteEmployeeResearchActivity = `-- name: DeleteEmployeeResearchActivity :exec
 DELETE FROM employee_research_activities
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_research_activities WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteEmployeeResearchActivity(ctx context.Context, id int64) error {
@@ -57,19 +62,24 @@ func (q *Queries) DeleteEmployeeResearchActivity(ctx context.Context, id int64)
 	return err
 }
 
-const getEmployeeResearchActivitiesByEmployeeIDAndLanguageCode = `-- name: GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCode :many
-SELECT id, employee_id, language_code, research_activity_title, employee_role, created_at, updated_at
+const getEmployeeResearchActivitiesByEmployeeIDAndLanguageCodes = `-- name: GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodes :many
+SELECT id, employee_id, language_code, research_activity_title, employee_role, created_at, updated_at, translation_group_id
 FROM employee_research_activities
-WHERE employee_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_research_activities
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodeParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
+type GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodesParams struct {
+	EmployeeID    int64    `json:"employee_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodeParams) ([]EmployeeResearchActivity, error) {
-	rows, err := q.db.Query(ctx, getEmployeeResearchActivitiesByEmployeeIDAndLanguageCode, arg.EmployeeID, arg.LanguageCode)
+func (q *Queries) GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodesParams) ([]EmployeeResearchActivity, error) {
+	rows, err := q.db.Query(ctx, getEmployeeResearchActivitiesByEmployeeIDAndLanguageCodes, arg.EmployeeID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +95,7 @@ func (q *Queries) GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCode(ctx c
 			&i.EmployeeRole,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
@@ -97,7 +108,7 @@ func (q *Queries) GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCode(ctx c
 }
 
 const getEmployeeResearchActivityByID = `-- name: GetEmployeeResearchActivityByID :one
-SELECT id, employee_id, language_code, research_activity_title, employee_role, created_at, updated_at
+SELECT id, employee_id, language_code, research_activity_title, employee_role, created_at, updated_at, translation_group_id
 FROM employee_research_activities
 WHERE id = $1
 `
@@ -113,6 +124,7 @@ func (q *Queries) GetEmployeeResearchActivityByID(ctx context.Context, id int64)
 		&i.EmployeeRole,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
@@ -16,17 +16,19 @@ INSERT INTO employee_scientific_awards(
   employee_id,
   language_code,
   scientific_award_title,
-  given_by
+  given_by,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateEmployeeScientificAwardParams struct {
-	EmployeeID           int64  `json:"employee_id"`
-	LanguageCode         string `json:"language_code"`
-	ScientificAwardTitle string `json:"scientific_award_title"`
-	GivenBy              string `json:"given_by"`
+	EmployeeID           int64       `json:"employee_id"`
+	LanguageCode         string      `json:"language_code"`
+	ScientificAwardTitle string      `json:"scientific_award_title"`
+	GivenBy              string      `json:"given_by"`
+	TranslationGroupID   pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateEmployeeScientificAwardRow struct {
@@ -41,6 +43,7 @@ func (q *Queries) CreateEmployeeScientificAward(ctx context.Context, arg CreateE
 		arg.LanguageCode,
 		arg.ScientificAwardTitle,
 		arg.GivenBy,
+		arg.TranslationGroupID,
 	)
 	var i CreateEmployeeScientificAwardRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -49,7 +52,9 @@ func (q *Queries) CreateEmployeeScientificAward(ctx context.Context, arg CreateE
 
 const deleteEmployeeScientificAward = `-- name: DeleteEmployeeScientificAward :exec
 DELETE FROM employee_scientific_awards
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_scientific_awards WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteEmployeeScientificAward(ctx context.Context, id int64) error {
@@ -58,7 +63,7 @@ func (q *Queries) DeleteEmployeeScientificAward(ctx context.Context, id int64) e
 }
 
 const getEmployeeScientificAwardByID = `-- name: GetEmployeeScientificAwardByID :one
-SELECT id, employee_id, language_code, scientific_award_title, given_by, created_at, updated_at
+SELECT id, employee_id, language_code, scientific_award_title, given_by, created_at, updated_at, translation_group_id
 FROM employee_scientific_awards
 WHERE id = $1
 `
@@ -74,23 +79,29 @@ func (q *Queries) GetEmployeeScientificAwardByID(ctx context.Context, id int64)
 		&i.GivenBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getEmployeeScientificAwardsByEmployeeIDAndLanguageCode = `-- name: GetEmployeeScientificAwardsByEmployeeIDAndLanguageCode :many
-SELECT id, employee_id, language_code, scientific_award_title, given_by, created_at, updated_at
+const getEmployeeScientificAwardsByEmployeeIDAndLanguageCodes = `-- name: GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodes :many
+SELECT id, employee_id, language_code, scientific_award_title, given_by, created_at, updated_at, translation_group_id
 FROM employee_scientific_awards
-WHERE employee_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM employee_scientific_awards
+  WHERE employee_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodeParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
+type GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodesParams struct {
+	EmployeeID    int64    `json:"employee_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetEmployeeScientificAwardsByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodeParams) ([]EmployeeScientificAward, error) {
-	rows, err := q.db.Query(ctx, getEmployeeScientificAwardsByEmployeeIDAndLanguageCode, arg.EmployeeID, arg.LanguageCode)
+func (q *Queries) GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodesParams) ([]EmployeeScientificAward, error) {
+	rows, err := q.db.Query(ctx, getEmployeeScientificAwardsByEmployeeIDAndLanguageCodes, arg.EmployeeID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +117,7 @@ func (q *Queries) GetEmployeeScientificAwardsByEmployeeIDAndLanguageCode(ctx con
 			&i.GivenBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
@@ -20,21 +20,27 @@ INSERT INTO employee_work_experiences(
   description,
   date_start,
   date_end,
-  on_going
+  on_going,
+  translation_group_id,
+  institution_id,
+  org_unit_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateEmployeeWorkExperienceParams struct {
-	EmployeeID   int64       `json:"employee_id"`
-	LanguageCode string      `json:"language_code"`
-	Workplace    string      `json:"workplace"`
-	JobTitle     string      `json:"job_title"`
-	Description  string      `json:"description"`
-	DateStart    pgtype.Date `json:"date_start"`
-	DateEnd      pgtype.Date `json:"date_end"`
-	OnGoing      bool        `json:"on_going"`
+	EmployeeID         int64       `json:"employee_id"`
+	LanguageCode       string      `json:"language_code"`
+	Workplace          string      `json:"workplace"`
+	JobTitle           string      `json:"job_title"`
+	Description        string      `json:"description"`
+	DateStart          pgtype.Date `json:"date_start"`
+	DateEnd            pgtype.Date `json:"date_end"`
+	OnGoing            bool        `json:"on_going"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
+	InstitutionID      pgtype.Int8 `json:"institution_id"`
+	OrgUnitID          pgtype.Int8 `json:"org_unit_id"`
 }
 
 type CreateEmployeeWorkExperienceRow struct {
@@ -53,6 +59,9 @@ func (q *Queries) CreateEmployeeWorkExperience(ctx context.Context, arg CreateEm
 		arg.DateStart,
 		arg.DateEnd,
 		arg.OnGoing,
+		arg.TranslationGroupID,
+		arg.InstitutionID,
+		arg.OrgUnitID,
 	)
 	var i CreateEmployeeWorkExperienceRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -60,8 +69,10 @@ func (q *Queries) CreateEmployeeWorkExperience(ctx context.Context, arg CreateEm
 }
 
 const deleteEmployeeWorkExperience = `-- name: DeleteEmployeeWorkExperience :exec
-delete from employee_work_experiences
-where id = $1
+DELETE FROM employee_work_experiences
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM employee_work_experiences WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteEmployeeWorkExperience(ctx context.Context, id int64) error {
@@ -69,8 +80,37 @@ func (q *Queries) DeleteEmployeeWorkExperience(ctx context.Context, id int64) er
 	return err
 }
 
+const deleteStaleImportedEmployeeWorkExperiences = `-- name: DeleteStaleImportedEmployeeWorkExperiences :exec
+DELETE FROM employee_work_experiences
+WHERE translation_group_id IN (
+  SELECT translation_group_id
+  FROM employee_work_experiences
+  WHERE employee_id = $1
+    AND language_code = $2
+    AND source = $3
+    AND NOT (external_id = ANY($4::text[]))
+)
+`
+
+type DeleteStaleImportedEmployeeWorkExperiencesParams struct {
+	EmployeeID   int64    `json:"employee_id"`
+	LanguageCode string   `json:"language_code"`
+	Source       string   `json:"source"`
+	ExternalIds  []string `json:"external_ids"`
+}
+
+func (q *Queries) DeleteStaleImportedEmployeeWorkExperiences(ctx context.Context, arg DeleteStaleImportedEmployeeWorkExperiencesParams) error {
+	_, err := q.db.Exec(ctx, deleteStaleImportedEmployeeWorkExperiences,
+		arg.EmployeeID,
+		arg.LanguageCode,
+		arg.Source,
+		arg.ExternalIds,
+	)
+	return err
+}
+
 const getEmployeeWorkExperienceByID = `-- name: GetEmployeeWorkExperienceByID :one
-select id, employee_id, language_code, workplace, job_title, description, date_start, date_end, created_at, updated_at, on_going
+select id, employee_id, language_code, workplace, job_title, description, date_start, date_end, created_at, updated_at, on_going, source, external_id, translation_group_id, institution_id, org_unit_id
 from employee_work_experiences
 where id = $1
 `
@@ -90,25 +130,35 @@ func (q *Queries) GetEmployeeWorkExperienceByID(ctx context.Context, id int64) (
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.OnGoing,
+		&i.Source,
+		&i.ExternalID,
+		&i.TranslationGroupID,
+		&i.InstitutionID,
+		&i.OrgUnitID,
 	)
 	return i, err
 }
 
-const getEmployeeWorkExperiencesByEmployeeIDAndLanguageCode = `-- name: GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCode :many
-select id, employee_id, language_code, workplace, job_title, description, date_start, date_end, created_at, updated_at, on_going
+const getEmployeeWorkExperiencesByEmployeeIDAndLanguageCodes = `-- name: GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodes :many
+select id, employee_id, language_code, workplace, job_title, description, date_start, date_end, created_at, updated_at, on_going, source, external_id, translation_group_id, institution_id, org_unit_id
 from employee_work_experiences
-where employee_id = $1 and language_code = $2
+where id in (
+    select distinct on (translation_group_id) id
+    from employee_work_experiences
+    where employee_id = $1 and language_code = any($2::text[])
+    order by translation_group_id, array_position($2::text[], language_code::text)
+)
 order by
     employee_work_experiences.on_going desc, employee_work_experiences.date_end desc
 `
 
-type GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodeParams struct {
-	EmployeeID   int64  `json:"employee_id"`
-	LanguageCode string `json:"language_code"`
+type GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodesParams struct {
+	EmployeeID    int64    `json:"employee_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodeParams) ([]EmployeeWorkExperience, error) {
-	rows, err := q.db.Query(ctx, getEmployeeWorkExperiencesByEmployeeIDAndLanguageCode, arg.EmployeeID, arg.LanguageCode)
+func (q *Queries) GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodesParams) ([]EmployeeWorkExperience, error) {
+	rows, err := q.db.Query(ctx, getEmployeeWorkExperiencesByEmployeeIDAndLanguageCodes, arg.EmployeeID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -128,6 +178,11 @@ func (q *Queries) GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCode(ctx cont
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.OnGoing,
+			&i.Source,
+			&i.ExternalID,
+			&i.TranslationGroupID,
+			&i.InstitutionID,
+			&i.OrgUnitID,
 		); err != nil {
 			return nil, err
 		}
@@ -151,19 +206,23 @@ SET
     ELSE COALESCE($5, date_end)
   END,
   on_going = COALESCE($6::boolean, on_going),
+  institution_id = $8,
+  org_unit_id = $9,
   updated_at = now()
 WHERE id = $7
 RETURNING id, created_at, updated_at
 `
 
 type UpdateEmployeeWorkExperienceParams struct {
-	Workplace   string      `json:"workplace"`
-	JobTitle    string      `json:"job_title"`
-	Description string      `json:"description"`
-	DateStart   pgtype.Date `json:"date_start"`
-	DateEnd     pgtype.Date `json:"date_end"`
-	Column6     bool        `json:"column_6"`
-	ID          int64       `json:"id"`
+	Workplace     string      `json:"workplace"`
+	JobTitle      string      `json:"job_title"`
+	Description   string      `json:"description"`
+	DateStart     pgtype.Date `json:"date_start"`
+	DateEnd       pgtype.Date `json:"date_end"`
+	Column6       bool        `json:"column_6"`
+	ID            int64       `json:"id"`
+	InstitutionID pgtype.Int8 `json:"institution_id"`
+	OrgUnitID     pgtype.Int8 `json:"org_unit_id"`
 }
 
 type UpdateEmployeeWorkExperienceRow struct {
@@ -181,8 +240,103 @@ func (q *Queries) UpdateEmployeeWorkExperience(ctx context.Context, arg UpdateEm
 		arg.DateEnd,
 		arg.Column6,
 		arg.ID,
+		arg.InstitutionID,
+		arg.OrgUnitID,
 	)
 	var i UpdateEmployeeWorkExperienceRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
 	return i, err
 }
+
+const upsertImportedEmployeeWorkExperience = `-- name: UpsertImportedEmployeeWorkExperience :one
+INSERT INTO employee_work_experiences(
+  employee_id,
+  language_code,
+  workplace,
+  job_title,
+  description,
+  date_start,
+  date_end,
+  on_going,
+  source,
+  external_id,
+  translation_group_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+ON CONFLICT (employee_id, language_code, source, external_id) DO UPDATE
+SET
+  workplace = EXCLUDED.workplace,
+  job_title = EXCLUDED.job_title,
+  description = EXCLUDED.description,
+  date_start = EXCLUDED.date_start,
+  date_end = EXCLUDED.date_end,
+  on_going = EXCLUDED.on_going,
+  updated_at = now()
+RETURNING id, created_at, updated_at, translation_group_id
+`
+
+type UpsertImportedEmployeeWorkExperienceParams struct {
+	EmployeeID         int64       `json:"employee_id"`
+	LanguageCode       string      `json:"language_code"`
+	Workplace          string      `json:"workplace"`
+	JobTitle           string      `json:"job_title"`
+	Description        string      `json:"description"`
+	DateStart          pgtype.Date `json:"date_start"`
+	DateEnd            pgtype.Date `json:"date_end"`
+	OnGoing            bool        `json:"on_going"`
+	Source             string      `json:"source"`
+	ExternalID         pgtype.Text `json:"external_id"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
+}
+
+type UpsertImportedEmployeeWorkExperienceRow struct {
+	ID                 int64              `json:"id"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
+}
+
+func (q *Queries) UpsertImportedEmployeeWorkExperience(ctx context.Context, arg UpsertImportedEmployeeWorkExperienceParams) (UpsertImportedEmployeeWorkExperienceRow, error) {
+	row := q.db.QueryRow(ctx, upsertImportedEmployeeWorkExperience,
+		arg.EmployeeID,
+		arg.LanguageCode,
+		arg.Workplace,
+		arg.JobTitle,
+		arg.Description,
+		arg.DateStart,
+		arg.DateEnd,
+		arg.OnGoing,
+		arg.Source,
+		arg.ExternalID,
+		arg.TranslationGroupID,
+	)
+	var i UpsertImportedEmployeeWorkExperienceRow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TranslationGroupID,
+	)
+	return i, err
+}
+
+const syncEmployeeWorkExperienceTranslations = `-- name: SyncEmployeeWorkExperienceTranslations :exec
+UPDATE employee_work_experiences
+SET
+  date_start = source.date_start,
+  date_end = source.date_end,
+  on_going = source.on_going,
+  institution_id = source.institution_id,
+  org_unit_id = source.org_unit_id,
+  updated_at = now()
+FROM employee_work_experiences source
+WHERE source.id = $1
+  AND employee_work_experiences.translation_group_id = source.translation_group_id
+  AND employee_work_experiences.id <> source.id
+`
+
+func (q *Queries) SyncEmployeeWorkExperienceTranslations(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, syncEmployeeWorkExperienceTranslations, id)
+	return err
+}
@@ -122,6 +122,27 @@ func (q *Queries) GetInstitutionByID(ctx context.Context, id int64) (Institution
 	return i, err
 }
 
+const isInstitutionAdmin = `-- name: IsInstitutionAdmin :one
+SELECT EXISTS (
+  SELECT 1
+  FROM institution_admins
+  WHERE institution_id = $1
+    AND user_id = $2
+)::boolean AS is_admin
+`
+
+type IsInstitutionAdminParams struct {
+	InstitutionID int64 `json:"institution_id"`
+	UserID        int64 `json:"user_id"`
+}
+
+func (q *Queries) IsInstitutionAdmin(ctx context.Context, arg IsInstitutionAdminParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isInstitutionAdmin, arg.InstitutionID, arg.UserID)
+	var is_admin bool
+	err := row.Scan(&is_admin)
+	return is_admin, err
+}
+
 const updateInstitution = `-- name: UpdateInstitution :one
 UPDATE institutions
 SET 
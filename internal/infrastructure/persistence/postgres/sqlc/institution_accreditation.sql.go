@@ -16,17 +16,19 @@ INSERT INTO institution_accreditations(
   institution_id,
   language_code,
   accreditation_type,
-  given_by
+  given_by,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateInstitutionAccreditationParams struct {
-	InstitutionID     int64  `json:"institution_id"`
-	LanguageCode      string `json:"language_code"`
-	AccreditationType string `json:"accreditation_type"`
-	GivenBy           string `json:"given_by"`
+	InstitutionID      int64       `json:"institution_id"`
+	LanguageCode       string      `json:"language_code"`
+	AccreditationType  string      `json:"accreditation_type"`
+	GivenBy            string      `json:"given_by"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateInstitutionAccreditationRow struct {
@@ -41,6 +43,7 @@ func (q *Queries) CreateInstitutionAccreditation(ctx context.Context, arg Create
 		arg.LanguageCode,
 		arg.AccreditationType,
 		arg.GivenBy,
+		arg.TranslationGroupID,
 	)
 	var i CreateInstitutionAccreditationRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -49,7 +52,9 @@ func (q *Queries) CreateInstitutionAccreditation(ctx context.Context, arg Create
 
 const deleteInstitutionAccreditation = `-- name: DeleteInstitutionAccreditation :exec
 DELETE FROM institution_accreditations
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_accreditations WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionAccreditation(ctx context.Context, id int64) error {
@@ -58,7 +63,7 @@ func (q *Queries) DeleteInstitutionAccreditation(ctx context.Context, id int64)
 }
 
 const getInstitutionAccreditationByID = `-- name: GetInstitutionAccreditationByID :one
-SELECT id, institution_id, language_code, accreditation_type, given_by, created_at, updated_at
+SELECT id, institution_id, language_code, accreditation_type, given_by, created_at, updated_at, translation_group_id
 FROM institution_accreditations
 WHERE id = $1
 `
@@ -74,23 +79,29 @@ func (q *Queries) GetInstitutionAccreditationByID(ctx context.Context, id int64)
 		&i.GivenBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getInstitutionAccreditationsByInstitutionIDAndLanguageCode = `-- name: GetInstitutionAccreditationsByInstitutionIDAndLanguageCode :many
-SELECT id, institution_id, language_code, accreditation_type, given_by, created_at, updated_at
+const getInstitutionAccreditationsByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionAccreditationsByInstitutionIDAndLanguageCodes :many
+SELECT id, institution_id, language_code, accreditation_type, given_by, created_at, updated_at, translation_group_id
 FROM institution_accreditations
-WHERE institution_id = $1 and language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_accreditations
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetInstitutionAccreditationsByInstitutionIDAndLanguageCodeParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionAccreditationsByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionAccreditationsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionAccreditationsByInstitutionIDAndLanguageCodeParams) ([]InstitutionAccreditation, error) {
-	rows, err := q.db.Query(ctx, getInstitutionAccreditationsByInstitutionIDAndLanguageCode, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionAccreditationsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionAccreditationsByInstitutionIDAndLanguageCodesParams) ([]InstitutionAccreditation, error) {
+	rows, err := q.db.Query(ctx, getInstitutionAccreditationsByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +117,7 @@ func (q *Queries) GetInstitutionAccreditationsByInstitutionIDAndLanguageCode(ctx
 			&i.GivenBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
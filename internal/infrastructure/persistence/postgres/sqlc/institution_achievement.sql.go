@@ -20,21 +20,23 @@ INSERT INTO institution_achievements(
   date_recieved,
   given_by,
   link_to_file,
-  description
+  description,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8  
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateInstitutionAchievementParams struct {
-	InstitutionID    int64       `json:"institution_id"`
-	LanguageCode     string      `json:"language_code"`
-	AchievementTitle string      `json:"achievement_title"`
-	AchievementType  string      `json:"achievement_type"`
-	DateRecieved     pgtype.Date `json:"date_recieved"`
-	GivenBy          string      `json:"given_by"`
-	LinkToFile       string      `json:"link_to_file"`
-	Description      pgtype.Text `json:"description"`
+	InstitutionID      int64       `json:"institution_id"`
+	LanguageCode       string      `json:"language_code"`
+	AchievementTitle   string      `json:"achievement_title"`
+	AchievementType    string      `json:"achievement_type"`
+	DateRecieved       pgtype.Date `json:"date_recieved"`
+	GivenBy            string      `json:"given_by"`
+	LinkToFile         string      `json:"link_to_file"`
+	Description        pgtype.Text `json:"description"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateInstitutionAchievementRow struct {
@@ -53,6 +55,7 @@ func (q *Queries) CreateInstitutionAchievement(ctx context.Context, arg CreateIn
 		arg.GivenBy,
 		arg.LinkToFile,
 		arg.Description,
+		arg.TranslationGroupID,
 	)
 	var i CreateInstitutionAchievementRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -61,7 +64,9 @@ func (q *Queries) CreateInstitutionAchievement(ctx context.Context, arg CreateIn
 
 const deleteInstitutionAchivement = `-- name: DeleteInstitutionAchivement :exec
 DELETE FROM institution_achievements
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_achievements WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionAchivement(ctx context.Context, id int64) error {
@@ -70,7 +75,7 @@ func (q *Queries) DeleteInstitutionAchivement(ctx context.Context, id int64) err
 }
 
 const getInstitutionAchievementByID = `-- name: GetInstitutionAchievementByID :one
-SELECT id, institution_id, language_code, achievement_title, achievement_type, date_recieved, given_by, link_to_file, description, created_at, updated_at
+SELECT id, institution_id, language_code, achievement_title, achievement_type, date_recieved, given_by, link_to_file, description, created_at, updated_at, translation_group_id
 FROM institution_achievements
 WHERE id = $1
 `
@@ -90,23 +95,29 @@ func (q *Queries) GetInstitutionAchievementByID(ctx context.Context, id int64) (
 		&i.Description,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getInstitutionAchievementsByInstitutionIDAndLanguageCode = `-- name: GetInstitutionAchievementsByInstitutionIDAndLanguageCode :many
-SELECT id, institution_id, language_code, achievement_title, achievement_type, date_recieved, given_by, link_to_file, description, created_at, updated_at
+const getInstitutionAchievementsByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionAchievementsByInstitutionIDAndLanguageCodes :many
+SELECT id, institution_id, language_code, achievement_title, achievement_type, date_recieved, given_by, link_to_file, description, created_at, updated_at, translation_group_id
 FROM institution_achievements
-WHERE institution_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_achievements
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetInstitutionAchievementsByInstitutionIDAndLanguageCodeParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionAchievementsByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionAchievementsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionAchievementsByInstitutionIDAndLanguageCodeParams) ([]InstitutionAchievement, error) {
-	rows, err := q.db.Query(ctx, getInstitutionAchievementsByInstitutionIDAndLanguageCode, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionAchievementsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionAchievementsByInstitutionIDAndLanguageCodesParams) ([]InstitutionAchievement, error) {
+	rows, err := q.db.Query(ctx, getInstitutionAchievementsByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +137,7 @@ func (q *Queries) GetInstitutionAchievementsByInstitutionIDAndLanguageCode(ctx c
 			&i.Description,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
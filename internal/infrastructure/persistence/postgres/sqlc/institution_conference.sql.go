@@ -18,19 +18,21 @@ INSERT INTO institution_conferences (
   conference_title,
   link,
   link_to_rinc,
-  date_of_conference
+  date_of_conference,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6
+  $1, $2, $3, $4, $5, $6, $7
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateInstitutionConferenceParams struct {
-	InstitutionID    int64       `json:"institution_id"`
-	LanguageCode     string      `json:"language_code"`
-	ConferenceTitle  string      `json:"conference_title"`
-	Link             string      `json:"link"`
-	LinkToRinc       pgtype.Text `json:"link_to_rinc"`
-	DateOfConference pgtype.Date `json:"date_of_conference"`
+	InstitutionID      int64       `json:"institution_id"`
+	LanguageCode       string      `json:"language_code"`
+	ConferenceTitle    string      `json:"conference_title"`
+	Link               string      `json:"link"`
+	LinkToRinc         pgtype.Text `json:"link_to_rinc"`
+	DateOfConference   pgtype.Date `json:"date_of_conference"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateInstitutionConferenceRow struct {
@@ -47,6 +49,7 @@ func (q *Queries) CreateInstitutionConference(ctx context.Context, arg CreateIns
 		arg.Link,
 		arg.LinkToRinc,
 		arg.DateOfConference,
+		arg.TranslationGroupID,
 	)
 	var i CreateInstitutionConferenceRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -55,7 +58,9 @@ func (q *Queries) CreateInstitutionConference(ctx context.Context, arg CreateIns
 
 const deleteInstitutionConference = `-- name: DeleteInstitutionConference :exec
 DELETE FROM institution_conferences
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_conferences WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionConference(ctx context.Context, id int64) error {
@@ -64,7 +69,7 @@ func (q *Queries) DeleteInstitutionConference(ctx context.Context, id int64) err
 }
 
 const getInstitutionConferenceByID = `-- name: GetInstitutionConferenceByID :one
-SELECT id, institution_id, language_code, conference_title, link, link_to_rinc, date_of_conference, created_at, updated_at
+SELECT id, institution_id, language_code, conference_title, link, link_to_rinc, date_of_conference, created_at, updated_at, translation_group_id
 FROM institution_conferences
 WHERE id = $1
 `
@@ -82,23 +87,29 @@ func (q *Queries) GetInstitutionConferenceByID(ctx context.Context, id int64) (I
 		&i.DateOfConference,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getInstitutionConferencesByInstitutionIDAndLanguageCode = `-- name: GetInstitutionConferencesByInstitutionIDAndLanguageCode :many
-SELECT id, institution_id, language_code, conference_title, link, link_to_rinc, date_of_conference, created_at, updated_at
+const getInstitutionConferencesByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionConferencesByInstitutionIDAndLanguageCodes :many
+SELECT id, institution_id, language_code, conference_title, link, link_to_rinc, date_of_conference, created_at, updated_at, translation_group_id
 FROM institution_conferences
-WHERE institution_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_conferences
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetInstitutionConferencesByInstitutionIDAndLanguageCodeParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionConferencesByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionConferencesByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionConferencesByInstitutionIDAndLanguageCodeParams) ([]InstitutionConference, error) {
-	rows, err := q.db.Query(ctx, getInstitutionConferencesByInstitutionIDAndLanguageCode, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionConferencesByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionConferencesByInstitutionIDAndLanguageCodesParams) ([]InstitutionConference, error) {
+	rows, err := q.db.Query(ctx, getInstitutionConferencesByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +127,7 @@ func (q *Queries) GetInstitutionConferencesByInstitutionIDAndLanguageCode(ctx co
 			&i.DateOfConference,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
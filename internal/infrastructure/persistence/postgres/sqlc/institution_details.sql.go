@@ -107,19 +107,21 @@ func (q *Queries) GetInstitutionDetailsByID(ctx context.Context, id int64) (Inst
 	return i, err
 }
 
-const getInstitutionDetailsByInstitutionIDAndLanguage = `-- name: GetInstitutionDetailsByInstitutionIDAndLanguage :one
+const getInstitutionDetailsByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionDetailsByInstitutionIDAndLanguageCodes :one
 SELECT id, institution_id, language_code, institution_type, legal_status, mission, founder, legal_address, factual_address, created_at, updated_at, institution_title_short, institution_title_long, city
 FROM institution_details
-WHERE institution_id = $1 AND language_code = $2
+WHERE institution_id = $1 AND language_code = ANY($2::text[])
+ORDER BY array_position($2::text[], language_code::text)
+LIMIT 1
 `
 
-type GetInstitutionDetailsByInstitutionIDAndLanguageParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionDetailsByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionDetailsByInstitutionIDAndLanguage(ctx context.Context, arg GetInstitutionDetailsByInstitutionIDAndLanguageParams) (InstitutionDetail, error) {
-	row := q.db.QueryRow(ctx, getInstitutionDetailsByInstitutionIDAndLanguage, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionDetailsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionDetailsByInstitutionIDAndLanguageCodesParams) (InstitutionDetail, error) {
+	row := q.db.QueryRow(ctx, getInstitutionDetailsByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	var i InstitutionDetail
 	err := row.Scan(
 		&i.ID,
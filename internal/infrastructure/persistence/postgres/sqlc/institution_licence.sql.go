@@ -20,21 +20,23 @@ INSERT INTO institution_licences(
   link_to_file,
   given_by,
   date_start,
-  date_end
+  date_end,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateInstitutionLicenceParams struct {
-	InstitutionID int64       `json:"institution_id"`
-	LanguageCode  string      `json:"language_code"`
-	LicenceTitle  string      `json:"licence_title"`
-	LicenceType   string      `json:"licence_type"`
-	LinkToFile    string      `json:"link_to_file"`
-	GivenBy       string      `json:"given_by"`
-	DateStart     pgtype.Date `json:"date_start"`
-	DateEnd       pgtype.Date `json:"date_end"`
+	InstitutionID      int64       `json:"institution_id"`
+	LanguageCode       string      `json:"language_code"`
+	LicenceTitle       string      `json:"licence_title"`
+	LicenceType        string      `json:"licence_type"`
+	LinkToFile         string      `json:"link_to_file"`
+	GivenBy            string      `json:"given_by"`
+	DateStart          pgtype.Date `json:"date_start"`
+	DateEnd            pgtype.Date `json:"date_end"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateInstitutionLicenceRow struct {
@@ -53,6 +55,7 @@ func (q *Queries) CreateInstitutionLicence(ctx context.Context, arg CreateInstit
 		arg.GivenBy,
 		arg.DateStart,
 		arg.DateEnd,
+		arg.TranslationGroupID,
 	)
 	var i CreateInstitutionLicenceRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -61,7 +64,9 @@ func (q *Queries) CreateInstitutionLicence(ctx context.Context, arg CreateInstit
 
 const deleteInstitutionLicence = `-- name: DeleteInstitutionLicence :exec
 DELETE FROM institution_licences
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_licences WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionLicence(ctx context.Context, id int64) error {
@@ -70,7 +75,7 @@ func (q *Queries) DeleteInstitutionLicence(ctx context.Context, id int64) error
 }
 
 const getInstitutionLicenceByID = `-- name: GetInstitutionLicenceByID :one
-SELECT id, institution_id, language_code, licence_title, licence_type, link_to_file, given_by, date_start, date_end, created_at, updated_at
+SELECT id, institution_id, language_code, licence_title, licence_type, link_to_file, given_by, date_start, date_end, created_at, updated_at, translation_group_id
 FROM institution_licences
 WHERE id = $1
 `
@@ -90,23 +95,29 @@ func (q *Queries) GetInstitutionLicenceByID(ctx context.Context, id int64) (Inst
 		&i.DateEnd,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getInstitutionLicencesByInstitutionIDAndLanguageCode = `-- name: GetInstitutionLicencesByInstitutionIDAndLanguageCode :many
-SELECT id, institution_id, language_code, licence_title, licence_type, link_to_file, given_by, date_start, date_end, created_at, updated_at
+const getInstitutionLicencesByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionLicencesByInstitutionIDAndLanguageCodes :many
+SELECT id, institution_id, language_code, licence_title, licence_type, link_to_file, given_by, date_start, date_end, created_at, updated_at, translation_group_id
 FROM institution_licences
-WHERE institution_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_licences
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetInstitutionLicencesByInstitutionIDAndLanguageCodeParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionLicencesByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionLicencesByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionLicencesByInstitutionIDAndLanguageCodeParams) ([]InstitutionLicence, error) {
-	rows, err := q.db.Query(ctx, getInstitutionLicencesByInstitutionIDAndLanguageCode, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionLicencesByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionLicencesByInstitutionIDAndLanguageCodesParams) ([]InstitutionLicence, error) {
+	rows, err := q.db.Query(ctx, getInstitutionLicencesByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +137,7 @@ func (q *Queries) GetInstitutionLicencesByInstitutionIDAndLanguageCode(ctx conte
 			&i.DateEnd,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
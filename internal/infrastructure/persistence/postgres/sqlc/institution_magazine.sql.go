@@ -17,18 +17,20 @@ INSERT INTO institution_magazines(
   language_code,
   magazine_name,
   link,
-  link_to_rinc
+  link_to_rinc,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateInstitutionMagazineParams struct {
-	InstitutionID int64       `json:"institution_id"`
-	LanguageCode  string      `json:"language_code"`
-	MagazineName  string      `json:"magazine_name"`
-	Link          string      `json:"link"`
-	LinkToRinc    pgtype.Text `json:"link_to_rinc"`
+	InstitutionID      int64       `json:"institution_id"`
+	LanguageCode       string      `json:"language_code"`
+	MagazineName       string      `json:"magazine_name"`
+	Link               string      `json:"link"`
+	LinkToRinc         pgtype.Text `json:"link_to_rinc"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateInstitutionMagazineRow struct {
@@ -44,6 +46,7 @@ func (q *Queries) CreateInstitutionMagazine(ctx context.Context, arg CreateInsti
 		arg.MagazineName,
 		arg.Link,
 		arg.LinkToRinc,
+		arg.TranslationGroupID,
 	)
 	var i CreateInstitutionMagazineRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -52,7 +55,9 @@ func (q *Queries) CreateInstitutionMagazine(ctx context.Context, arg CreateInsti
 
 const deleteInstitutionMagazine = `-- name: DeleteInstitutionMagazine :exec
 DELETE FROM institution_magazines
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_magazines WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionMagazine(ctx context.Context, id int64) error {
@@ -61,7 +66,7 @@ func (q *Queries) DeleteInstitutionMagazine(ctx context.Context, id int64) error
 }
 
 const getInstitutionMagazineByID = `-- name: GetInstitutionMagazineByID :one
-SELECT id, institution_id, language_code, magazine_name, link, link_to_rinc, created_at, updated_at
+SELECT id, institution_id, language_code, magazine_name, link, link_to_rinc, created_at, updated_at, translation_group_id
 FROM institution_magazines
 WHERE id = $1
 `
@@ -78,23 +83,29 @@ func (q *Queries) GetInstitutionMagazineByID(ctx context.Context, id int64) (Ins
 		&i.LinkToRinc,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getInstitutionMagazinesByInstitutionIDAndLanguageCode = `-- name: GetInstitutionMagazinesByInstitutionIDAndLanguageCode :many
-SELECT id, institution_id, language_code, magazine_name, link, link_to_rinc, created_at, updated_at
+const getInstitutionMagazinesByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionMagazinesByInstitutionIDAndLanguageCodes :many
+SELECT id, institution_id, language_code, magazine_name, link, link_to_rinc, created_at, updated_at, translation_group_id
 FROM institution_magazines
-WHERE institution_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_magazines
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetInstitutionMagazinesByInstitutionIDAndLanguageCodeParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionMagazinesByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionMagazinesByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionMagazinesByInstitutionIDAndLanguageCodeParams) ([]InstitutionMagazine, error) {
-	rows, err := q.db.Query(ctx, getInstitutionMagazinesByInstitutionIDAndLanguageCode, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionMagazinesByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionMagazinesByInstitutionIDAndLanguageCodesParams) ([]InstitutionMagazine, error) {
+	rows, err := q.db.Query(ctx, getInstitutionMagazinesByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +122,7 @@ func (q *Queries) GetInstitutionMagazinesByInstitutionIDAndLanguageCode(ctx cont
 			&i.LinkToRinc,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
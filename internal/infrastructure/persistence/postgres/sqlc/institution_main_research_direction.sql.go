@@ -17,9 +17,11 @@ INSERT INTO institution_main_research_directions(
   language_code,
   research_direction_title,
   discipline,
-  area_of_research
+  area_of_research,
+  translation_group_id,
+  research_field_code
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6, $7
 ) RETURNING id, created_at, updated_at
 `
 
@@ -29,6 +31,8 @@ type CreateInstitutionMainResearchDirectionParams struct {
 	ResearchDirectionTitle string      `json:"research_direction_title"`
 	Discipline             string      `json:"discipline"`
 	AreaOfResearch         pgtype.Text `json:"area_of_research"`
+	TranslationGroupID     pgtype.UUID `json:"translation_group_id"`
+	ResearchFieldCode      pgtype.Text `json:"research_field_code"`
 }
 
 type CreateInstitutionMainResearchDirectionRow struct {
@@ -44,6 +48,8 @@ func (q *Queries) CreateInstitutionMainResearchDirection(ctx context.Context, ar
 		arg.ResearchDirectionTitle,
 		arg.Discipline,
 		arg.AreaOfResearch,
+		arg.TranslationGroupID,
+		arg.ResearchFieldCode,
 	)
 	var i CreateInstitutionMainResearchDirectionRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -52,7 +58,9 @@ func (q *Queries) CreateInstitutionMainResearchDirection(ctx context.Context, ar
 
 const deleteInstitutionMainResearchDirection = `-- name: DeleteInstitutionMainResearchDirection :exec
 DELETE FROM institution_main_research_directions
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_main_research_directions WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionMainResearchDirection(ctx context.Context, id int64) error {
@@ -61,7 +69,7 @@ func (q *Queries) DeleteInstitutionMainResearchDirection(ctx context.Context, id
 }
 
 const getInstitutionMainResearchDirectionByID = `-- name: GetInstitutionMainResearchDirectionByID :one
-SELECT id, institution_id, language_code, research_direction_title, discipline, area_of_research, created_at, updated_at
+SELECT id, institution_id, language_code, research_direction_title, discipline, area_of_research, created_at, updated_at, translation_group_id, research_field_code
 FROM institution_main_research_directions
 WHERE id = $1
 `
@@ -78,23 +86,30 @@ func (q *Queries) GetInstitutionMainResearchDirectionByID(ctx context.Context, i
 		&i.AreaOfResearch,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
+		&i.ResearchFieldCode,
 	)
 	return i, err
 }
 
-const getInstitutionMainResearchDirectionsByInstitutionIDAndLanguage = `-- name: GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguage :many
-SELECT id, institution_id, language_code, research_direction_title, discipline, area_of_research, created_at, updated_at
+const getInstitutionMainResearchDirectionsByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageCodes :many
+SELECT id, institution_id, language_code, research_direction_title, discipline, area_of_research, created_at, updated_at, translation_group_id, research_field_code
 FROM institution_main_research_directions
-WHERE institution_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_main_research_directions
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguage(ctx context.Context, arg GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageParams) ([]InstitutionMainResearchDirection, error) {
-	rows, err := q.db.Query(ctx, getInstitutionMainResearchDirectionsByInstitutionIDAndLanguage, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageCodesParams) ([]InstitutionMainResearchDirection, error) {
+	rows, err := q.db.Query(ctx, getInstitutionMainResearchDirectionsByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +126,8 @@ func (q *Queries) GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguage
 			&i.AreaOfResearch,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
+			&i.ResearchFieldCode,
 		); err != nil {
 			return nil, err
 		}
@@ -122,14 +139,31 @@ func (q *Queries) GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguage
 	return items, nil
 }
 
+const syncInstitutionMainResearchDirectionTranslations = `-- name: SyncInstitutionMainResearchDirectionTranslations :exec
+UPDATE institution_main_research_directions
+SET
+  research_field_code = source.research_field_code,
+  updated_at = now()
+FROM institution_main_research_directions source
+WHERE source.id = $1
+  AND institution_main_research_directions.translation_group_id = source.translation_group_id
+  AND institution_main_research_directions.id <> source.id
+`
+
+func (q *Queries) SyncInstitutionMainResearchDirectionTranslations(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, syncInstitutionMainResearchDirectionTranslations, id)
+	return err
+}
+
 const updateInstitutionMainResearchDirection = `-- name: UpdateInstitutionMainResearchDirection :one
 UPDATE institution_main_research_directions
 SET 
   research_direction_title = COALESCE($1, research_direction_title),
   discipline = COALESCE($2, discipline),
   area_of_research = COALESCE($3, area_of_research),
+  research_field_code = COALESCE($4, research_field_code),
   updated_at = now()
-WHERE id = $4
+WHERE id = $5
 RETURNING id, created_at, updated_at
 `
 
@@ -137,6 +171,7 @@ type UpdateInstitutionMainResearchDirectionParams struct {
 	ResearchDirectionTitle string      `json:"research_direction_title"`
 	Discipline             string      `json:"discipline"`
 	AreaOfResearch         pgtype.Text `json:"area_of_research"`
+	ResearchFieldCode      pgtype.Text `json:"research_field_code"`
 	ID                     int64       `json:"id"`
 }
 
@@ -151,6 +186,7 @@ func (q *Queries) UpdateInstitutionMainResearchDirection(ctx context.Context, ar
 		arg.ResearchDirectionTitle,
 		arg.Discipline,
 		arg.AreaOfResearch,
+		arg.ResearchFieldCode,
 		arg.ID,
 	)
 	var i UpdateInstitutionMainResearchDirectionRow
@@ -19,20 +19,22 @@ INSERT INTO institution_partnerships(
   partner_type,
   date_of_contract,
   link_to_partner,
-  goal
+  goal,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7
+  $1, $2, $3, $4, $5, $6, $7, $8
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateInstitutionPartnershipParams struct {
-	InstitutionID  int64       `json:"institution_id"`
-	LanguageCode   string      `json:"language_code"`
-	PartnerName    string      `json:"partner_name"`
-	PartnerType    string      `json:"partner_type"`
-	DateOfContract pgtype.Date `json:"date_of_contract"`
-	LinkToPartner  string      `json:"link_to_partner"`
-	Goal           string      `json:"goal"`
+	InstitutionID      int64       `json:"institution_id"`
+	LanguageCode       string      `json:"language_code"`
+	PartnerName        string      `json:"partner_name"`
+	PartnerType        string      `json:"partner_type"`
+	DateOfContract     pgtype.Date `json:"date_of_contract"`
+	LinkToPartner      string      `json:"link_to_partner"`
+	Goal               string      `json:"goal"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateInstitutionPartnershipRow struct {
@@ -50,6 +52,7 @@ func (q *Queries) CreateInstitutionPartnership(ctx context.Context, arg CreateIn
 		arg.DateOfContract,
 		arg.LinkToPartner,
 		arg.Goal,
+		arg.TranslationGroupID,
 	)
 	var i CreateInstitutionPartnershipRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -58,7 +61,9 @@ func (q *Queries) CreateInstitutionPartnership(ctx context.Context, arg CreateIn
 
 const deleteInstitutionPartnership = `-- name: DeleteInstitutionPartnership :exec
 DELETE FROM institution_partnerships
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_partnerships WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionPartnership(ctx context.Context, id int64) error {
@@ -67,7 +72,7 @@ func (q *Queries) DeleteInstitutionPartnership(ctx context.Context, id int64) er
 }
 
 const getInstitutionPartnershipByID = `-- name: GetInstitutionPartnershipByID :one
-SELECT id, institution_id, language_code, partner_name, partner_type, date_of_contract, link_to_partner, goal, created_at, updated_at
+SELECT id, institution_id, language_code, partner_name, partner_type, date_of_contract, link_to_partner, goal, created_at, updated_at, translation_group_id
 FROM institution_partnerships
 WHERE id = $1
 `
@@ -86,23 +91,29 @@ func (q *Queries) GetInstitutionPartnershipByID(ctx context.Context, id int64) (
 		&i.Goal,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getInstitutionPartnershipsByInstitutionIDAndLanguageCode = `-- name: GetInstitutionPartnershipsByInstitutionIDAndLanguageCode :many
-SELECT id, institution_id, language_code, partner_name, partner_type, date_of_contract, link_to_partner, goal, created_at, updated_at
+const getInstitutionPartnershipsByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionPartnershipsByInstitutionIDAndLanguageCodes :many
+SELECT id, institution_id, language_code, partner_name, partner_type, date_of_contract, link_to_partner, goal, created_at, updated_at, translation_group_id
 FROM institution_partnerships
-WHERE institution_id = $1 and language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_partnerships
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetInstitutionPartnershipsByInstitutionIDAndLanguageCodeParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionPartnershipsByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionPartnershipsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionPartnershipsByInstitutionIDAndLanguageCodeParams) ([]InstitutionPartnership, error) {
-	rows, err := q.db.Query(ctx, getInstitutionPartnershipsByInstitutionIDAndLanguageCode, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionPartnershipsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionPartnershipsByInstitutionIDAndLanguageCodesParams) ([]InstitutionPartnership, error) {
+	rows, err := q.db.Query(ctx, getInstitutionPartnershipsByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +132,7 @@ func (q *Queries) GetInstitutionPartnershipsByInstitutionIDAndLanguageCode(ctx c
 			&i.Goal,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
@@ -19,20 +19,22 @@ INSERT INTO institution_patents(
   discipline,
   description,
   implemented_in,
-  link_to_patent_file
+  link_to_patent_file,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7
+  $1, $2, $3, $4, $5, $6, $7, $8
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateInstitutionPatentParams struct {
-	InstitutionID    int64  `json:"institution_id"`
-	LanguageCode     string `json:"language_code"`
-	PatentTitle      string `json:"patent_title"`
-	Discipline       string `json:"discipline"`
-	Description      string `json:"description"`
-	ImplementedIn    string `json:"implemented_in"`
-	LinkToPatentFile string `json:"link_to_patent_file"`
+	InstitutionID      int64       `json:"institution_id"`
+	LanguageCode       string      `json:"language_code"`
+	PatentTitle        string      `json:"patent_title"`
+	Discipline         string      `json:"discipline"`
+	Description        string      `json:"description"`
+	ImplementedIn      string      `json:"implemented_in"`
+	LinkToPatentFile   string      `json:"link_to_patent_file"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateInstitutionPatentRow struct {
@@ -50,6 +52,7 @@ func (q *Queries) CreateInstitutionPatent(ctx context.Context, arg CreateInstitu
 		arg.Description,
 		arg.ImplementedIn,
 		arg.LinkToPatentFile,
+		arg.TranslationGroupID,
 	)
 	var i CreateInstitutionPatentRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -58,7 +61,9 @@ func (q *Queries) CreateInstitutionPatent(ctx context.Context, arg CreateInstitu
 
 const deleteInstitutionPatent = `-- name: DeleteInstitutionPatent :exec
 DELETE FROM institution_patents
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_patents WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionPatent(ctx context.Context, id int64) error {
@@ -67,7 +72,7 @@ func (q *Queries) DeleteInstitutionPatent(ctx context.Context, id int64) error {
 }
 
 const getInstitutionPatentByID = `-- name: GetInstitutionPatentByID :one
-SELECT id, institution_id, language_code, patent_title, discipline, description, implemented_in, link_to_patent_file, created_at, updated_at
+SELECT id, institution_id, language_code, patent_title, discipline, description, implemented_in, link_to_patent_file, created_at, updated_at, translation_group_id
 FROM institution_patents
 WHERE id = $1
 `
@@ -86,23 +91,29 @@ func (q *Queries) GetInstitutionPatentByID(ctx context.Context, id int64) (Insti
 		&i.LinkToPatentFile,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getInstitutionPatentsByInstitutionIDAndLanguageCode = `-- name: GetInstitutionPatentsByInstitutionIDAndLanguageCode :many
-SELECT id, institution_id, language_code, patent_title, discipline, description, implemented_in, link_to_patent_file, created_at, updated_at
+const getInstitutionPatentsByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionPatentsByInstitutionIDAndLanguageCodes :many
+SELECT id, institution_id, language_code, patent_title, discipline, description, implemented_in, link_to_patent_file, created_at, updated_at, translation_group_id
 FROM institution_patents
-WHERE institution_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_patents
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetInstitutionPatentsByInstitutionIDAndLanguageCodeParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionPatentsByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionPatentsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionPatentsByInstitutionIDAndLanguageCodeParams) ([]InstitutionPatent, error) {
-	rows, err := q.db.Query(ctx, getInstitutionPatentsByInstitutionIDAndLanguageCode, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionPatentsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionPatentsByInstitutionIDAndLanguageCodesParams) ([]InstitutionPatent, error) {
+	rows, err := q.db.Query(ctx, getInstitutionPatentsByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +132,7 @@ func (q *Queries) GetInstitutionPatentsByInstitutionIDAndLanguageCode(ctx contex
 			&i.LinkToPatentFile,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
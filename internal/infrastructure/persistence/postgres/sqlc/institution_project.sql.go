@@ -21,22 +21,24 @@ INSERT INTO institution_projects(
   date_end,
   fund,
   institution_role,
-  coordinator
+  coordinator,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateInstitutionProjectParams struct {
-	InstitutionID   int64       `json:"institution_id"`
-	LanguageCode    string      `json:"language_code"`
-	ProjectType     string      `json:"project_type"`
-	ProjectTitle    string      `json:"project_title"`
-	DateStart       pgtype.Date `json:"date_start"`
-	DateEnd         pgtype.Date `json:"date_end"`
-	Fund            float64     `json:"fund"`
-	InstitutionRole string      `json:"institution_role"`
-	Coordinator     string      `json:"coordinator"`
+	InstitutionID      int64       `json:"institution_id"`
+	LanguageCode       string      `json:"language_code"`
+	ProjectType        string      `json:"project_type"`
+	ProjectTitle       string      `json:"project_title"`
+	DateStart          pgtype.Date `json:"date_start"`
+	DateEnd            pgtype.Date `json:"date_end"`
+	Fund               float64     `json:"fund"`
+	InstitutionRole    string      `json:"institution_role"`
+	Coordinator        string      `json:"coordinator"`
+	TranslationGroupID pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateInstitutionProjectRow struct {
@@ -56,6 +58,7 @@ func (q *Queries) CreateInstitutionProject(ctx context.Context, arg CreateInstit
 		arg.Fund,
 		arg.InstitutionRole,
 		arg.Coordinator,
+		arg.TranslationGroupID,
 	)
 	var i CreateInstitutionProjectRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -64,7 +67,9 @@ func (q *Queries) CreateInstitutionProject(ctx context.Context, arg CreateInstit
 
 const deleteInstitutionProject = `-- name: DeleteInstitutionProject :exec
 DELETE FROM institution_projects
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_projects WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionProject(ctx context.Context, id int64) error {
@@ -73,7 +78,7 @@ func (q *Queries) DeleteInstitutionProject(ctx context.Context, id int64) error
 }
 
 const getInstitutionProjectByID = `-- name: GetInstitutionProjectByID :one
-SELECT id, institution_id, language_code, project_type, project_title, date_start, date_end, fund, institution_role, coordinator, created_at, updated_at
+SELECT id, institution_id, language_code, project_type, project_title, date_start, date_end, fund, institution_role, coordinator, created_at, updated_at, translation_group_id
 FROM institution_projects
 WHERE id = $1
 `
@@ -94,23 +99,29 @@ func (q *Queries) GetInstitutionProjectByID(ctx context.Context, id int64) (Inst
 		&i.Coordinator,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getInstitutionProjectsByInstitutionIDAndLanguageCode = `-- name: GetInstitutionProjectsByInstitutionIDAndLanguageCode :many
-SELECT id, institution_id, language_code, project_type, project_title, date_start, date_end, fund, institution_role, coordinator, created_at, updated_at
+const getInstitutionProjectsByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionProjectsByInstitutionIDAndLanguageCodes :many
+SELECT id, institution_id, language_code, project_type, project_title, date_start, date_end, fund, institution_role, coordinator, created_at, updated_at, translation_group_id
 FROM institution_projects
-WHERE institution_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_projects
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetInstitutionProjectsByInstitutionIDAndLanguageCodeParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionProjectsByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionProjectsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionProjectsByInstitutionIDAndLanguageCodeParams) ([]InstitutionProject, error) {
-	rows, err := q.db.Query(ctx, getInstitutionProjectsByInstitutionIDAndLanguageCode, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionProjectsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionProjectsByInstitutionIDAndLanguageCodesParams) ([]InstitutionProject, error) {
+	rows, err := q.db.Query(ctx, getInstitutionProjectsByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -131,6 +142,7 @@ func (q *Queries) GetInstitutionProjectsByInstitutionIDAndLanguageCode(ctx conte
 			&i.Coordinator,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
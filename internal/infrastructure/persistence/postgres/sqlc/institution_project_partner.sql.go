@@ -17,18 +17,20 @@ INSERT INTO institution_project_partners(
   language_code,
   partner_name,
   partner_type,
-  link_to_partner
+  link_to_partner,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateInstitutionProjectPartnerParams struct {
-	InstitutionProjectID int64  `json:"institution_project_id"`
-	LanguageCode         string `json:"language_code"`
-	PartnerName          string `json:"partner_name"`
-	PartnerType          string `json:"partner_type"`
-	LinkToPartner        string `json:"link_to_partner"`
+	InstitutionProjectID int64       `json:"institution_project_id"`
+	LanguageCode         string      `json:"language_code"`
+	PartnerName          string      `json:"partner_name"`
+	PartnerType          string      `json:"partner_type"`
+	LinkToPartner        string      `json:"link_to_partner"`
+	TranslationGroupID   pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateInstitutionProjectPartnerRow struct {
@@ -44,6 +46,7 @@ func (q *Queries) CreateInstitutionProjectPartner(ctx context.Context, arg Creat
 		arg.PartnerName,
 		arg.PartnerType,
 		arg.LinkToPartner,
+		arg.TranslationGroupID,
 	)
 	var i CreateInstitutionProjectPartnerRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -52,7 +55,9 @@ func (q *Queries) CreateInstitutionProjectPartner(ctx context.Context, arg Creat
 
 const deleteInstitutionProjectPartner = `-- name: DeleteInstitutionProjectPartner :exec
 DELETE FROM institution_project_partners
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_project_partners WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionProjectPartner(ctx context.Context, id int64) error {
@@ -61,7 +66,7 @@ func (q *Queries) DeleteInstitutionProjectPartner(ctx context.Context, id int64)
 }
 
 const getInstitutionProjectPartnerByID = `-- name: GetInstitutionProjectPartnerByID :one
-SELECT id, institution_project_id, language_code, partner_type, partner_name, link_to_partner, created_at, updated_at
+SELECT id, institution_project_id, language_code, partner_type, partner_name, link_to_partner, created_at, updated_at, translation_group_id
 FROM institution_project_partners
 WHERE id = $1
 `
@@ -78,12 +83,13 @@ func (q *Queries) GetInstitutionProjectPartnerByID(ctx context.Context, id int64
 		&i.LinkToPartner,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
 const getInstitutionProjectPartnersByInstitutionProjectIDAndLanguageCode = `-- name: GetInstitutionProjectPartnersByInstitutionProjectIDAndLanguageCode :many
-SELECT id, institution_project_id, language_code, partner_type, partner_name, link_to_partner, created_at, updated_at
+SELECT id, institution_project_id, language_code, partner_type, partner_name, link_to_partner, created_at, updated_at, translation_group_id
 FROM institution_project_partners
 WHERE institution_project_id = $1 AND language_code = $2
 `
@@ -111,6 +117,7 @@ func (q *Queries) GetInstitutionProjectPartnersByInstitutionProjectIDAndLanguage
 			&i.LinkToPartner,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
@@ -21,9 +21,10 @@ INSERT INTO institution_rankings(
   ranking_agency,
   link_to_ranking_file,
   description,
-  link_to_ranking_agency
+  link_to_ranking_agency,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING id, created_at, updated_at
 `
 
@@ -37,6 +38,7 @@ type CreateInstitutionRankingParams struct {
 	LinkToRankingFile   string      `json:"link_to_ranking_file"`
 	Description         pgtype.Text `json:"description"`
 	LinkToRankingAgency string      `json:"link_to_ranking_agency"`
+	TranslationGroupID  pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateInstitutionRankingRow struct {
@@ -56,6 +58,7 @@ func (q *Queries) CreateInstitutionRanking(ctx context.Context, arg CreateInstit
 		arg.LinkToRankingFile,
 		arg.Description,
 		arg.LinkToRankingAgency,
+		arg.TranslationGroupID,
 	)
 	var i CreateInstitutionRankingRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -64,7 +67,9 @@ func (q *Queries) CreateInstitutionRanking(ctx context.Context, arg CreateInstit
 
 const deleteInstitutionRanking = `-- name: DeleteInstitutionRanking :exec
 DELETE FROM institution_rankings
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_rankings WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionRanking(ctx context.Context, id int64) error {
@@ -73,7 +78,7 @@ func (q *Queries) DeleteInstitutionRanking(ctx context.Context, id int64) error
 }
 
 const getInstitutionRankingByID = `-- name: GetInstitutionRankingByID :one
-SELECT id, institution_id, language_code, ranking_title, ranking_type, date_recieved, ranking_agency, link_to_ranking_file, description, link_to_ranking_agency, created_at, updated_at
+SELECT id, institution_id, language_code, ranking_title, ranking_type, date_recieved, ranking_agency, link_to_ranking_file, description, link_to_ranking_agency, created_at, updated_at, translation_group_id
 FROM institution_rankings
 WHERE id = $1
 `
@@ -94,23 +99,29 @@ func (q *Queries) GetInstitutionRankingByID(ctx context.Context, id int64) (Inst
 		&i.LinkToRankingAgency,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getInstitutionRankingsByInstitutionIDAndLanguageCode = `-- name: GetInstitutionRankingsByInstitutionIDAndLanguageCode :many
-SELECT id, institution_id, language_code, ranking_title, ranking_type, date_recieved, ranking_agency, link_to_ranking_file, description, link_to_ranking_agency, created_at, updated_at
+const getInstitutionRankingsByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionRankingsByInstitutionIDAndLanguageCodes :many
+SELECT id, institution_id, language_code, ranking_title, ranking_type, date_recieved, ranking_agency, link_to_ranking_file, description, link_to_ranking_agency, created_at, updated_at, translation_group_id
 FROM institution_rankings
-WHERE institution_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_rankings
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetInstitutionRankingsByInstitutionIDAndLanguageCodeParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionRankingsByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionRankingsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionRankingsByInstitutionIDAndLanguageCodeParams) ([]InstitutionRanking, error) {
-	rows, err := q.db.Query(ctx, getInstitutionRankingsByInstitutionIDAndLanguageCode, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionRankingsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionRankingsByInstitutionIDAndLanguageCodesParams) ([]InstitutionRanking, error) {
+	rows, err := q.db.Query(ctx, getInstitutionRankingsByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -131,6 +142,7 @@ func (q *Queries) GetInstitutionRankingsByInstitutionIDAndLanguageCode(ctx conte
 			&i.LinkToRankingAgency,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
@@ -17,18 +17,20 @@ INSERT INTO institution_research_support_infrastructures(
   language_code,
   research_support_infrastructure_title,
   research_support_infrastructure_type,
-  tin_of_legal_entity
+  tin_of_legal_entity,
+  translation_group_id
 ) VALUES (
-  $1, $2, $3, $4, $5
+  $1, $2, $3, $4, $5, $6
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateInstitutionResearchSupportInfrastructureParams struct {
-	InstitutionID                      int64  `json:"institution_id"`
-	LanguageCode                       string `json:"language_code"`
-	ResearchSupportInfrastructureTitle string `json:"research_support_infrastructure_title"`
-	ResearchSupportInfrastructureType  string `json:"research_support_infrastructure_type"`
-	TinOfLegalEntity                   string `json:"tin_of_legal_entity"`
+	InstitutionID                      int64       `json:"institution_id"`
+	LanguageCode                       string      `json:"language_code"`
+	ResearchSupportInfrastructureTitle string      `json:"research_support_infrastructure_title"`
+	ResearchSupportInfrastructureType  string      `json:"research_support_infrastructure_type"`
+	TinOfLegalEntity                   string      `json:"tin_of_legal_entity"`
+	TranslationGroupID                 pgtype.UUID `json:"translation_group_id"`
 }
 
 type CreateInstitutionResearchSupportInfrastructureRow struct {
@@ -44,6 +46,7 @@ func (q *Queries) CreateInstitutionResearchSupportInfrastructure(ctx context.Con
 		arg.ResearchSupportInfrastructureTitle,
 		arg.ResearchSupportInfrastructureType,
 		arg.TinOfLegalEntity,
+		arg.TranslationGroupID,
 	)
 	var i CreateInstitutionResearchSupportInfrastructureRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
@@ -52,7 +55,9 @@ func (q *Queries) CreateInstitutionResearchSupportInfrastructure(ctx context.Con
 
 const deleteInstitutionResearchSupportInfrastructure = `-- name: DeleteInstitutionResearchSupportInfrastructure :exec
 DELETE FROM institution_research_support_infrastructures
-WHERE id = $1
+WHERE translation_group_id = (
+  SELECT translation_group_id FROM institution_research_support_infrastructures WHERE id = $1
+)
 `
 
 func (q *Queries) DeleteInstitutionResearchSupportInfrastructure(ctx context.Context, id int64) error {
@@ -61,7 +66,7 @@ func (q *Queries) DeleteInstitutionResearchSupportInfrastructure(ctx context.Con
 }
 
 const getInstitutionResearchSupportInfrastructureByID = `-- name: GetInstitutionResearchSupportInfrastructureByID :one
-SELECT id, institution_id, language_code, research_support_infrastructure_title, research_support_infrastructure_type, tin_of_legal_entity, created_at, updated_at
+SELECT id, institution_id, language_code, research_support_infrastructure_title, research_support_infrastructure_type, tin_of_legal_entity, created_at, updated_at, translation_group_id
 FROM institution_research_support_infrastructures
 WHERE id = $1
 `
@@ -78,23 +83,29 @@ func (q *Queries) GetInstitutionResearchSupportInfrastructureByID(ctx context.Co
 		&i.TinOfLegalEntity,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TranslationGroupID,
 	)
 	return i, err
 }
 
-const getInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCode = `-- name: GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCode :many
-SELECT id, institution_id, language_code, research_support_infrastructure_title, research_support_infrastructure_type, tin_of_legal_entity, created_at, updated_at
+const getInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodes = `-- name: GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodes :many
+SELECT id, institution_id, language_code, research_support_infrastructure_title, research_support_infrastructure_type, tin_of_legal_entity, created_at, updated_at, translation_group_id
 FROM institution_research_support_infrastructures
-WHERE institution_id = $1 AND language_code = $2
+WHERE id IN (
+  SELECT DISTINCT ON (translation_group_id) id
+  FROM institution_research_support_infrastructures
+  WHERE institution_id = $1 AND language_code = ANY($2::text[])
+  ORDER BY translation_group_id, array_position($2::text[], language_code::text)
+)
 `
 
-type GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodeParams struct {
-	InstitutionID int64  `json:"institution_id"`
-	LanguageCode  string `json:"language_code"`
+type GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodesParams struct {
+	InstitutionID int64    `json:"institution_id"`
+	LanguageCodes []string `json:"language_codes"`
 }
 
-func (q *Queries) GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodeParams) ([]InstitutionResearchSupportInfrastructure, error) {
-	rows, err := q.db.Query(ctx, getInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCode, arg.InstitutionID, arg.LanguageCode)
+func (q *Queries) GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodesParams) ([]InstitutionResearchSupportInfrastructure, error) {
+	rows, err := q.db.Query(ctx, getInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodes, arg.InstitutionID, arg.LanguageCodes)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +122,7 @@ func (q *Queries) GetInstitutionResearchSupportInfrastructuresByInstitutionIDAnd
 			&i.TinOfLegalEntity,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TranslationGroupID,
 		); err != nil {
 			return nil, err
 		}
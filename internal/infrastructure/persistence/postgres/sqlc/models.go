@@ -8,6 +8,30 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ConsentDocument struct {
+	ID           int64              `json:"id"`
+	Version      int32              `json:"version"`
+	LanguageCode string             `json:"language_code"`
+	Title        string             `json:"title"`
+	Body         string             `json:"body"`
+	PublishedAt  pgtype.Timestamptz `json:"published_at"`
+}
+
+type DegreeLevel struct {
+	Code      string             `json:"code"`
+	Rank      int32              `json:"rank"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type DegreeLevelName struct {
+	DegreeLevelCode string             `json:"degree_level_code"`
+	LanguageCode    string             `json:"language_code"`
+	Name            string             `json:"name"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+}
+
 type Employee struct {
 	ID                    int64              `json:"id"`
 	UniqueID              string             `json:"unique_id"`
@@ -19,6 +43,18 @@ type Employee struct {
 	HighestAcademicDegree pgtype.Text        `json:"highest_academic_degree"`
 	Speciality            pgtype.Text        `json:"speciality"`
 	CurrentWorkplace      pgtype.Text        `json:"current_workplace"`
+	Orcid                 pgtype.Text        `json:"orcid"`
+	CurrentInstitutionID  pgtype.Int8        `json:"current_institution_id"`
+	CurrentOrgUnitID      pgtype.Int8        `json:"current_org_unit_id"`
+	SpecialityCode        pgtype.Text        `json:"speciality_code"`
+}
+
+type EmployeeCitationMetric struct {
+	EmployeeID     int64              `json:"employee_id"`
+	HIndex         int32              `json:"h_index"`
+	I10Index       int32              `json:"i10_index"`
+	TotalCitations int32              `json:"total_citations"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
 }
 
 type EmployeeDegree struct {
@@ -26,7 +62,7 @@ type EmployeeDegree struct {
 	EmployeeID         int64              `json:"employee_id"`
 	LanguageCode       string             `json:"language_code"`
 	UniversityName     string             `json:"university_name"`
-	DegreeLevel        string             `json:"degree_level"`
+	DegreeLevel        pgtype.Text        `json:"degree_level"`
 	Speciality         string             `json:"speciality"`
 	DateStart          pgtype.Date        `json:"date_start"`
 	DateEnd            pgtype.Date        `json:"date_end"`
@@ -34,6 +70,9 @@ type EmployeeDegree struct {
 	DateDegreeRecieved pgtype.Date        `json:"date_degree_recieved"`
 	CreatedAt          pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
+	DegreeLevelCode    pgtype.Text        `json:"degree_level_code"`
+	SpecialityCode     pgtype.Text        `json:"speciality_code"`
 }
 
 type EmployeeDetail struct {
@@ -46,16 +85,20 @@ type EmployeeDetail struct {
 	IsEmployeeDetailsNew bool               `json:"is_employee_details_new"`
 	CreatedAt            pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	EffectiveFrom        pgtype.Date        `json:"effective_from"`
+	EffectiveTo          pgtype.Date        `json:"effective_to"`
 }
 
 type EmployeeMainResearchArea struct {
-	ID           int64              `json:"id"`
-	EmployeeID   int64              `json:"employee_id"`
-	LanguageCode string             `json:"language_code"`
-	Area         string             `json:"area"`
-	Discipline   string             `json:"discipline"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	EmployeeID         int64              `json:"employee_id"`
+	LanguageCode       string             `json:"language_code"`
+	Area               string             `json:"area"`
+	Discipline         string             `json:"discipline"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
+	ResearchFieldCode  pgtype.Text        `json:"research_field_code"`
 }
 
 type EmployeeMainResearchAreaKeyTopic struct {
@@ -64,18 +107,34 @@ type EmployeeMainResearchAreaKeyTopic struct {
 	KeyTopicTitle              string             `json:"key_topic_title"`
 	CreatedAt                  pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt                  pgtype.Timestamptz `json:"updated_at"`
+	LanguageCode               string             `json:"language_code"`
+	KeywordID                  pgtype.Int8        `json:"keyword_id"`
 }
 
-type EmployeeParticipationInEvent struct {
+type EmployeeOrcidAccount struct {
 	ID           int64              `json:"id"`
 	EmployeeID   int64              `json:"employee_id"`
-	LanguageCode string             `json:"language_code"`
-	EventTitle   string             `json:"event_title"`
-	EventDate    pgtype.Date        `json:"event_date"`
+	Orcid        string             `json:"orcid"`
+	AccessToken  string             `json:"access_token"`
+	RefreshToken string             `json:"refresh_token"`
+	Scope        string             `json:"scope"`
+	ExpiresAt    pgtype.Timestamptz `json:"expires_at"`
+	LastSyncedAt pgtype.Timestamptz `json:"last_synced_at"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
 
+type EmployeeParticipationInEvent struct {
+	ID                 int64              `json:"id"`
+	EmployeeID         int64              `json:"employee_id"`
+	LanguageCode       string             `json:"language_code"`
+	EventTitle         string             `json:"event_title"`
+	EventDate          pgtype.Date        `json:"event_date"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
+}
+
 type EmployeeParticipationInProfessionalCommunity struct {
 	ID                          int64              `json:"id"`
 	EmployeeID                  int64              `json:"employee_id"`
@@ -84,37 +143,49 @@ type EmployeeParticipationInProfessionalCommunity struct {
 	RoleInProfessionalCommunity string             `json:"role_in_professional_community"`
 	CreatedAt                   pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt                   pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID          pgtype.UUID        `json:"translation_group_id"`
 }
 
 type EmployeePatent struct {
-	ID           int64              `json:"id"`
-	EmployeeID   int64              `json:"employee_id"`
-	LanguageCode string             `json:"language_code"`
-	PatentTitle  string             `json:"patent_title"`
-	Description  string             `json:"description"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	EmployeeID         int64              `json:"employee_id"`
+	LanguageCode       string             `json:"language_code"`
+	PatentTitle        string             `json:"patent_title"`
+	Description        string             `json:"description"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
 }
 
 type EmployeePublication struct {
-	ID                int64              `json:"id"`
-	EmployeeID        int64              `json:"employee_id"`
-	LanguageCode      string             `json:"language_code"`
-	PublicationTitle  string             `json:"publication_title"`
-	LinkToPublication string             `json:"link_to_publication"`
-	CreatedAt         pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	EmployeeID         int64              `json:"employee_id"`
+	LanguageCode       string             `json:"language_code"`
+	PublicationTitle   string             `json:"publication_title"`
+	LinkToPublication  string             `json:"link_to_publication"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	Authors            []string           `json:"authors"`
+	PublicationYear    pgtype.Int4        `json:"publication_year"`
+	Venue              pgtype.Text        `json:"venue"`
+	Doi                pgtype.Text        `json:"doi"`
+	PublicationType    pgtype.Text        `json:"publication_type"`
+	Source             string             `json:"source"`
+	ExternalID         pgtype.Text        `json:"external_id"`
+	PublicationID      pgtype.Int8        `json:"publication_id"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
 }
 
 type EmployeeRefresherCourse struct {
-	ID           int64              `json:"id"`
-	EmployeeID   int64              `json:"employee_id"`
-	LanguageCode string             `json:"language_code"`
-	CourseTitle  string             `json:"course_title"`
-	DateStart    pgtype.Date        `json:"date_start"`
-	DateEnd      pgtype.Date        `json:"date_end"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	EmployeeID         int64              `json:"employee_id"`
+	LanguageCode       string             `json:"language_code"`
+	CourseTitle        string             `json:"course_title"`
+	DateStart          pgtype.Date        `json:"date_start"`
+	DateEnd            pgtype.Date        `json:"date_end"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
 }
 
 type EmployeeResearchActivity struct {
@@ -125,6 +196,7 @@ type EmployeeResearchActivity struct {
 	EmployeeRole          string             `json:"employee_role"`
 	CreatedAt             pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID    pgtype.UUID        `json:"translation_group_id"`
 }
 
 type EmployeeScientificAward struct {
@@ -135,6 +207,18 @@ type EmployeeScientificAward struct {
 	GivenBy              string             `json:"given_by"`
 	CreatedAt            pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID   pgtype.UUID        `json:"translation_group_id"`
+}
+
+type EmployeeSimilarity struct {
+	EmployeeID          int64              `json:"employee_id"`
+	SimilarEmployeeID   int64              `json:"similar_employee_id"`
+	Score               float64            `json:"score"`
+	SharedResearchAreas int32              `json:"shared_research_areas"`
+	SharedKeywords      int32              `json:"shared_keywords"`
+	SharedEvents        int32              `json:"shared_events"`
+	SharedInstitutions  int32              `json:"shared_institutions"`
+	ComputedAt          pgtype.Timestamptz `json:"computed_at"`
 }
 
 type EmployeeSocial struct {
@@ -146,18 +230,30 @@ type EmployeeSocial struct {
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
 
+type EmployeeVisibilitySetting struct {
+	EmployeeID int64              `json:"employee_id"`
+	Target     string             `json:"target"`
+	Level      string             `json:"level"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
 type EmployeeWorkExperience struct {
-	ID           int64              `json:"id"`
-	EmployeeID   int64              `json:"employee_id"`
-	LanguageCode string             `json:"language_code"`
-	Workplace    string             `json:"workplace"`
-	JobTitle     string             `json:"job_title"`
-	Description  string             `json:"description"`
-	DateStart    pgtype.Date        `json:"date_start"`
-	DateEnd      pgtype.Date        `json:"date_end"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
-	OnGoing      bool               `json:"on_going"`
+	ID                 int64              `json:"id"`
+	EmployeeID         int64              `json:"employee_id"`
+	LanguageCode       string             `json:"language_code"`
+	Workplace          string             `json:"workplace"`
+	JobTitle           string             `json:"job_title"`
+	Description        string             `json:"description"`
+	DateStart          pgtype.Date        `json:"date_start"`
+	DateEnd            pgtype.Date        `json:"date_end"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	OnGoing            bool               `json:"on_going"`
+	Source             string             `json:"source"`
+	ExternalID         pgtype.Text        `json:"external_id"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
+	InstitutionID      pgtype.Int8        `json:"institution_id"`
+	OrgUnitID          pgtype.Int8        `json:"org_unit_id"`
 }
 
 type Institution struct {
@@ -173,39 +269,48 @@ type Institution struct {
 }
 
 type InstitutionAccreditation struct {
-	ID                int64              `json:"id"`
-	InstitutionID     int64              `json:"institution_id"`
-	LanguageCode      string             `json:"language_code"`
-	AccreditationType string             `json:"accreditation_type"`
-	GivenBy           string             `json:"given_by"`
-	CreatedAt         pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	InstitutionID      int64              `json:"institution_id"`
+	LanguageCode       string             `json:"language_code"`
+	AccreditationType  string             `json:"accreditation_type"`
+	GivenBy            string             `json:"given_by"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
 }
 
 type InstitutionAchievement struct {
-	ID               int64              `json:"id"`
-	InstitutionID    int64              `json:"institution_id"`
-	LanguageCode     string             `json:"language_code"`
-	AchievementTitle string             `json:"achievement_title"`
-	AchievementType  string             `json:"achievement_type"`
-	DateRecieved     pgtype.Date        `json:"date_recieved"`
-	GivenBy          string             `json:"given_by"`
-	LinkToFile       string             `json:"link_to_file"`
-	Description      pgtype.Text        `json:"description"`
-	CreatedAt        pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	InstitutionID      int64              `json:"institution_id"`
+	LanguageCode       string             `json:"language_code"`
+	AchievementTitle   string             `json:"achievement_title"`
+	AchievementType    string             `json:"achievement_type"`
+	DateRecieved       pgtype.Date        `json:"date_recieved"`
+	GivenBy            string             `json:"given_by"`
+	LinkToFile         string             `json:"link_to_file"`
+	Description        pgtype.Text        `json:"description"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
+}
+
+type InstitutionAdmin struct {
+	InstitutionID int64              `json:"institution_id"`
+	UserID        int64              `json:"user_id"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
 type InstitutionConference struct {
-	ID               int64              `json:"id"`
-	InstitutionID    int64              `json:"institution_id"`
-	LanguageCode     string             `json:"language_code"`
-	ConferenceTitle  string             `json:"conference_title"`
-	Link             string             `json:"link"`
-	LinkToRinc       pgtype.Text        `json:"link_to_rinc"`
-	DateOfConference pgtype.Date        `json:"date_of_conference"`
-	CreatedAt        pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	InstitutionID      int64              `json:"institution_id"`
+	LanguageCode       string             `json:"language_code"`
+	ConferenceTitle    string             `json:"conference_title"`
+	Link               string             `json:"link"`
+	LinkToRinc         pgtype.Text        `json:"link_to_rinc"`
+	DateOfConference   pgtype.Date        `json:"date_of_conference"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
 }
 
 type InstitutionDetail struct {
@@ -226,28 +331,30 @@ type InstitutionDetail struct {
 }
 
 type InstitutionLicence struct {
-	ID            int64              `json:"id"`
-	InstitutionID int64              `json:"institution_id"`
-	LanguageCode  string             `json:"language_code"`
-	LicenceTitle  string             `json:"licence_title"`
-	LicenceType   string             `json:"licence_type"`
-	LinkToFile    string             `json:"link_to_file"`
-	GivenBy       string             `json:"given_by"`
-	DateStart     pgtype.Date        `json:"date_start"`
-	DateEnd       pgtype.Date        `json:"date_end"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	InstitutionID      int64              `json:"institution_id"`
+	LanguageCode       string             `json:"language_code"`
+	LicenceTitle       string             `json:"licence_title"`
+	LicenceType        string             `json:"licence_type"`
+	LinkToFile         string             `json:"link_to_file"`
+	GivenBy            string             `json:"given_by"`
+	DateStart          pgtype.Date        `json:"date_start"`
+	DateEnd            pgtype.Date        `json:"date_end"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
 }
 
 type InstitutionMagazine struct {
-	ID            int64              `json:"id"`
-	InstitutionID int64              `json:"institution_id"`
-	LanguageCode  string             `json:"language_code"`
-	MagazineName  string             `json:"magazine_name"`
-	Link          string             `json:"link"`
-	LinkToRinc    pgtype.Text        `json:"link_to_rinc"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	InstitutionID      int64              `json:"institution_id"`
+	LanguageCode       string             `json:"language_code"`
+	MagazineName       string             `json:"magazine_name"`
+	Link               string             `json:"link"`
+	LinkToRinc         pgtype.Text        `json:"link_to_rinc"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
 }
 
 type InstitutionMainResearchDirection struct {
@@ -259,47 +366,52 @@ type InstitutionMainResearchDirection struct {
 	AreaOfResearch         pgtype.Text        `json:"area_of_research"`
 	CreatedAt              pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt              pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID     pgtype.UUID        `json:"translation_group_id"`
+	ResearchFieldCode      pgtype.Text        `json:"research_field_code"`
 }
 
 type InstitutionPartnership struct {
-	ID             int64              `json:"id"`
-	InstitutionID  int64              `json:"institution_id"`
-	LanguageCode   string             `json:"language_code"`
-	PartnerName    string             `json:"partner_name"`
-	PartnerType    string             `json:"partner_type"`
-	DateOfContract pgtype.Date        `json:"date_of_contract"`
-	LinkToPartner  string             `json:"link_to_partner"`
-	Goal           string             `json:"goal"`
-	CreatedAt      pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	InstitutionID      int64              `json:"institution_id"`
+	LanguageCode       string             `json:"language_code"`
+	PartnerName        string             `json:"partner_name"`
+	PartnerType        string             `json:"partner_type"`
+	DateOfContract     pgtype.Date        `json:"date_of_contract"`
+	LinkToPartner      string             `json:"link_to_partner"`
+	Goal               string             `json:"goal"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
 }
 
 type InstitutionPatent struct {
-	ID               int64              `json:"id"`
-	InstitutionID    int64              `json:"institution_id"`
-	LanguageCode     string             `json:"language_code"`
-	PatentTitle      string             `json:"patent_title"`
-	Discipline       string             `json:"discipline"`
-	Description      string             `json:"description"`
-	ImplementedIn    string             `json:"implemented_in"`
-	LinkToPatentFile string             `json:"link_to_patent_file"`
-	CreatedAt        pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	InstitutionID      int64              `json:"institution_id"`
+	LanguageCode       string             `json:"language_code"`
+	PatentTitle        string             `json:"patent_title"`
+	Discipline         string             `json:"discipline"`
+	Description        string             `json:"description"`
+	ImplementedIn      string             `json:"implemented_in"`
+	LinkToPatentFile   string             `json:"link_to_patent_file"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
 }
 
 type InstitutionProject struct {
-	ID              int64              `json:"id"`
-	InstitutionID   int64              `json:"institution_id"`
-	LanguageCode    string             `json:"language_code"`
-	ProjectType     string             `json:"project_type"`
-	ProjectTitle    string             `json:"project_title"`
-	DateStart       pgtype.Date        `json:"date_start"`
-	DateEnd         pgtype.Date        `json:"date_end"`
-	Fund            float64            `json:"fund"`
-	InstitutionRole string             `json:"institution_role"`
-	Coordinator     string             `json:"coordinator"`
-	CreatedAt       pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	ID                 int64              `json:"id"`
+	InstitutionID      int64              `json:"institution_id"`
+	LanguageCode       string             `json:"language_code"`
+	ProjectType        string             `json:"project_type"`
+	ProjectTitle       string             `json:"project_title"`
+	DateStart          pgtype.Date        `json:"date_start"`
+	DateEnd            pgtype.Date        `json:"date_end"`
+	Fund               float64            `json:"fund"`
+	InstitutionRole    string             `json:"institution_role"`
+	Coordinator        string             `json:"coordinator"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID pgtype.UUID        `json:"translation_group_id"`
 }
 
 type InstitutionProjectPartner struct {
@@ -311,6 +423,7 @@ type InstitutionProjectPartner struct {
 	LinkToPartner        string             `json:"link_to_partner"`
 	CreatedAt            pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID   pgtype.UUID        `json:"translation_group_id"`
 }
 
 type InstitutionRanking struct {
@@ -326,6 +439,7 @@ type InstitutionRanking struct {
 	LinkToRankingAgency string             `json:"link_to_ranking_agency"`
 	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID  pgtype.UUID        `json:"translation_group_id"`
 }
 
 type InstitutionResearchSupportInfrastructure struct {
@@ -337,6 +451,7 @@ type InstitutionResearchSupportInfrastructure struct {
 	TinOfLegalEntity                   string             `json:"tin_of_legal_entity"`
 	CreatedAt                          pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt                          pgtype.Timestamptz `json:"updated_at"`
+	TranslationGroupID                 pgtype.UUID        `json:"translation_group_id"`
 }
 
 type InstitutionSocial struct {
@@ -348,14 +463,140 @@ type InstitutionSocial struct {
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
 }
 
-type User struct {
-	ID           int64              `json:"id"`
-	Email        string             `json:"email"`
-	PasswordHash string             `json:"password_hash"`
+type Keyword struct {
+	ID        int64              `json:"id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type KeywordLabel struct {
+	ID              int64              `json:"id"`
+	KeywordID       int64              `json:"keyword_id"`
+	LanguageCode    string             `json:"language_code"`
+	Label           string             `json:"label"`
+	NormalizedLabel pgtype.Text        `json:"normalized_label"`
+	IsPreferred     bool               `json:"is_preferred"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Language struct {
+	Code       string             `json:"code"`
+	Name       string             `json:"name"`
+	NativeName string             `json:"native_name"`
+	Enabled    bool               `json:"enabled"`
+	IsDefault  bool               `json:"is_default"`
+	SortOrder  int32              `json:"sort_order"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type OrcidOauthState struct {
+	State      string             `json:"state"`
+	EmployeeID int64              `json:"employee_id"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type OrgUnit struct {
+	ID            int64              `json:"id"`
+	InstitutionID int64              `json:"institution_id"`
+	ParentID      pgtype.Int8        `json:"parent_id"`
+	UnitType      string             `json:"unit_type"`
+	SortOrder     int32              `json:"sort_order"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type OrgUnitName struct {
+	OrgUnitID    int64              `json:"org_unit_id"`
+	LanguageCode string             `json:"language_code"`
+	Name         string             `json:"name"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
 
+type Publication struct {
+	ID                int64              `json:"id"`
+	PublicationTitle  string             `json:"publication_title"`
+	NormalizedTitle   string             `json:"normalized_title"`
+	LinkToPublication string             `json:"link_to_publication"`
+	PublicationYear   pgtype.Int4        `json:"publication_year"`
+	Venue             string             `json:"venue"`
+	Doi               pgtype.Text        `json:"doi"`
+	PublicationType   string             `json:"publication_type"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+}
+
+type PublicationAuthor struct {
+	ID            int64              `json:"id"`
+	PublicationID int64              `json:"publication_id"`
+	Position      int32              `json:"position"`
+	AuthorName    string             `json:"author_name"`
+	EmployeeID    pgtype.Int8        `json:"employee_id"`
+	ClaimedAt     pgtype.Timestamptz `json:"claimed_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type PublicationCitation struct {
+	PublicationID int64              `json:"publication_id"`
+	CitationCount int32              `json:"citation_count"`
+	Source        string             `json:"source"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ResearchField struct {
+	Code       string             `json:"code"`
+	ParentCode pgtype.Text        `json:"parent_code"`
+	Level      string             `json:"level"`
+	SortOrder  int32              `json:"sort_order"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ResearchFieldName struct {
+	ResearchFieldCode string             `json:"research_field_code"`
+	LanguageCode      string             `json:"language_code"`
+	Name              string             `json:"name"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Speciality struct {
+	Code       string             `json:"code"`
+	ParentCode pgtype.Text        `json:"parent_code"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type SpecialityName struct {
+	SpecialityCode string             `json:"speciality_code"`
+	LanguageCode   string             `json:"language_code"`
+	Name           string             `json:"name"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type User struct {
+	ID                int64              `json:"id"`
+	Email             string             `json:"email"`
+	PasswordHash      string             `json:"password_hash"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	PreferredLanguage pgtype.Text        `json:"preferred_language"`
+	Role              string             `json:"role"`
+}
+
+type UserConsent struct {
+	ID              int64              `json:"id"`
+	UserID          int64              `json:"user_id"`
+	DocumentVersion int32              `json:"document_version"`
+	LanguageCode    string             `json:"language_code"`
+	IpAddress       string             `json:"ip_address"`
+	AcceptedAt      pgtype.Timestamptz `json:"accepted_at"`
+}
+
 type UserSession struct {
 	ID           int64              `json:"id"`
 	UserID       int64              `json:"user_id"`
@@ -364,3 +605,22 @@ type UserSession struct {
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
+
+type WorkplaceMappingBatch struct {
+	ID            int64              `json:"id"`
+	InstitutionID pgtype.Int8        `json:"institution_id"`
+	Workplaces    []string           `json:"workplaces"`
+	Overwrite     bool               `json:"overwrite"`
+	CreatedBy     pgtype.Int8        `json:"created_by"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UndoneBy      pgtype.Int8        `json:"undone_by"`
+	UndoneAt      pgtype.Timestamptz `json:"undone_at"`
+}
+
+type WorkplaceMappingChange struct {
+	ID               int64       `json:"id"`
+	BatchID          int64       `json:"batch_id"`
+	WorkExperienceID int64       `json:"work_experience_id"`
+	OldInstitutionID pgtype.Int8 `json:"old_institution_id"`
+	NewInstitutionID pgtype.Int8 `json:"new_institution_id"`
+}
@@ -11,7 +11,14 @@ import (
 )
 
 type Querier interface {
+	ApplyWorkplaceMappingBatch(ctx context.Context, batchID int64) (int64, error)
+	// the current name of the language becomes a previous one, its period is closed where the new name takes effect
+	ArchiveCurrentEmployeeDetails(ctx context.Context, arg ArchiveCurrentEmployeeDetailsParams) error
+	ClaimPublicationAuthor(ctx context.Context, arg ClaimPublicationAuthorParams) (int64, error)
+	ConsumeOrcidOAuthState(ctx context.Context, state string) (OrcidOauthState, error)
+	CountEmployeePublicationsByPublicationID(ctx context.Context, arg CountEmployeePublicationsByPublicationIDParams) (int64, error)
 	CountPersonnel(ctx context.Context, arg CountPersonnelParams) (int64, error)
+	CreateConsentDocument(ctx context.Context, arg CreateConsentDocumentParams) (ConsentDocument, error)
 	CreateEmployee(ctx context.Context, arg CreateEmployeeParams) (CreateEmployeeRow, error)
 	CreateEmployeeDegree(ctx context.Context, arg CreateEmployeeDegreeParams) (CreateEmployeeDegreeRow, error)
 	CreateEmployeeDetails(ctx context.Context, arg CreateEmployeeDetailsParams) (CreateEmployeeDetailsRow, error)
@@ -41,23 +48,37 @@ type Querier interface {
 	CreateInstitutionRanking(ctx context.Context, arg CreateInstitutionRankingParams) (CreateInstitutionRankingRow, error)
 	CreateInstitutionResearchSupportInfrastructure(ctx context.Context, arg CreateInstitutionResearchSupportInfrastructureParams) (CreateInstitutionResearchSupportInfrastructureRow, error)
 	CreateInstitutionSocial(ctx context.Context, arg CreateInstitutionSocialParams) (CreateInstitutionSocialRow, error)
+	CreateKeyword(ctx context.Context) (int64, error)
+	CreateKeywordLabel(ctx context.Context, arg CreateKeywordLabelParams) error
+	CreateOrcidOAuthState(ctx context.Context, arg CreateOrcidOAuthStateParams) error
+	CreateOrgUnit(ctx context.Context, arg CreateOrgUnitParams) (CreateOrgUnitRow, error)
+	CreatePublication(ctx context.Context, arg CreatePublicationParams) (Publication, error)
+	CreatePublicationAuthor(ctx context.Context, arg CreatePublicationAuthorParams) (PublicationAuthor, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error)
+	// accepting the same version again keeps the first acceptance
+	CreateUserConsent(ctx context.Context, arg CreateUserConsentParams) error
 	CreateUserSession(ctx context.Context, arg CreateUserSessionParams) (CreateUserSessionRow, error)
+	CreateWorkplaceMappingBatch(ctx context.Context, arg CreateWorkplaceMappingBatchParams) (CreateWorkplaceMappingBatchRow, error)
+	CreateWorkplaceMappingChanges(ctx context.Context, arg CreateWorkplaceMappingChangesParams) error
 	DeleteEmployee(ctx context.Context, id int64) error
 	DeleteEmployeeDegree(ctx context.Context, id int64) error
 	DeleteEmployeeDetails(ctx context.Context, id int64) error
 	DeleteEmployeeMainResearchArea(ctx context.Context, id int64) error
 	DeleteEmployeeMainResearchAreaKeyTopic(ctx context.Context, id int64) error
 	DeleteEmployeeMainResearchAreaKeyTopicsByEmployeeMainResearchAreaID(ctx context.Context, employeeMainResearchAreaID int64) error
+	DeleteEmployeeOrcidAccountByEmployeeID(ctx context.Context, employeeID int64) error
 	DeleteEmployeeParticipationInEvent(ctx context.Context, id int64) error
 	DeleteEmployeeParticipationInProfessionalCommunity(ctx context.Context, id int64) error
 	DeleteEmployeePatent(ctx context.Context, id int64) error
-	DeleteEmployeePublication(ctx context.Context, id int64) error
+	DeleteEmployeePublication(ctx context.Context, id int64) ([]pgtype.Int8, error)
+	DeleteEmployeePublicationsByPublicationID(ctx context.Context, arg DeleteEmployeePublicationsByPublicationIDParams) error
 	DeleteEmployeeRefresherCourse(ctx context.Context, id int64) error
 	DeleteEmployeeResearchActivity(ctx context.Context, id int64) error
 	DeleteEmployeeScientificAward(ctx context.Context, id int64) error
+	DeleteEmployeeSimilarities(ctx context.Context) error
 	DeleteEmployeeSocial(ctx context.Context, id int64) error
 	DeleteEmployeeWorkExperience(ctx context.Context, id int64) error
+	DeleteExpiredOrcidOAuthStates(ctx context.Context, createdAt pgtype.Timestamptz) error
 	DeleteInsitution(ctx context.Context, id int64) error
 	DeleteInstitutionAccreditation(ctx context.Context, id int64) error
 	DeleteInstitutionAchivement(ctx context.Context, id int64) error
@@ -73,84 +94,219 @@ type Querier interface {
 	DeleteInstitutionRanking(ctx context.Context, id int64) error
 	DeleteInstitutionResearchSupportInfrastructure(ctx context.Context, id int64) error
 	DeleteInstitutionSocial(ctx context.Context, id int64) error
+	DeleteKeywordsByIDs(ctx context.Context, ids []int64) error
+	DeleteOrgUnit(ctx context.Context, id int64) error
+	DeleteOrgUnitNamesExceptLanguages(ctx context.Context, arg DeleteOrgUnitNamesExceptLanguagesParams) error
+	DeleteStaleImportedEmployeePublications(ctx context.Context, arg DeleteStaleImportedEmployeePublicationsParams) ([]pgtype.Int8, error)
+	DeleteStaleImportedEmployeeWorkExperiences(ctx context.Context, arg DeleteStaleImportedEmployeeWorkExperiencesParams) error
 	DeleteUserSessionByID(ctx context.Context, id int64) error
 	DeleteUserSessionByUserID(ctx context.Context, userID int64) error
+	// first unclaimed author whose name contains the surname of the employee in any language
+	FindMatchingUnclaimedPublicationAuthor(ctx context.Context, arg FindMatchingUnclaimedPublicationAuthorParams) (PublicationAuthor, error)
+	GetAllDegreeLevelNames(ctx context.Context) ([]DegreeLevelName, error)
+	GetAllDegreeLevels(ctx context.Context) ([]DegreeLevel, error)
 	GetAllInstitutions(ctx context.Context) ([]Institution, error)
+	GetAllLanguages(ctx context.Context) ([]Language, error)
+	GetAllResearchFieldNames(ctx context.Context) ([]ResearchFieldName, error)
+	GetAllResearchFields(ctx context.Context) ([]ResearchField, error)
+	GetAllSpecialities(ctx context.Context) ([]Speciality, error)
+	GetAllUserConsents(ctx context.Context) ([]GetAllUserConsentsRow, error)
+	// pairs of employees sharing publications, projects, events or professional communities with the number
+	// of shared items of each kind. The employee is one of employee_ids, the collaborator too unless include_external is set.
+	// publications are shared records, projects (research activities), events and communities are free text matched by normalized title.
+	// an item is counted once however many languages it is entered in, titles shared by more than max_token_employees
+	// employees (a whole association, a congress) say nothing about collaboration and are left out.
+	// employees without consent to the latest terms are not linked
+	GetCollaborationEdges(ctx context.Context, arg GetCollaborationEdgesParams) ([]GetCollaborationEdgesRow, error)
+	// names are taken in the first of the language codes the employee has details in, they are empty when there is none.
+	// employees without consent to the latest terms are left out
+	GetCollaborationNodesByIDs(ctx context.Context, arg GetCollaborationNodesByIDsParams) ([]GetCollaborationNodesByIDsRow, error)
+	GetConsentDocumentsByVersion(ctx context.Context, version int32) ([]ConsentDocument, error)
 	GetCurrentEmployeeDetailsByEmployeeIDAndLanguageCode(ctx context.Context, arg GetCurrentEmployeeDetailsByEmployeeIDAndLanguageCodeParams) (EmployeeDetail, error)
 	GetEmployeeByID(ctx context.Context, id int64) (Employee, error)
 	GetEmployeeByUniqueIdentifier(ctx context.Context, uniqueID string) (Employee, error)
 	GetEmployeeByUserID(ctx context.Context, userID pgtype.Int8) (Employee, error)
-	GetEmployeeDegreeByID(ctx context.Context, id int64) (EmployeeDegree, error)
-	GetEmployeeDegreesByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeDegreesByEmployeeIDAndLanguageCodeParams) ([]EmployeeDegree, error)
+	GetEmployeeCitationMetrics(ctx context.Context, employeeID int64) (EmployeeCitationMetric, error)
+	// one row per employee and enabled language, sections written per language are counted in that language only,
+	// pass an empty unique_id, a zero institution_id or an empty language_code to ignore the filter
+	GetEmployeeCompletenessCounts(ctx context.Context, arg GetEmployeeCompletenessCountsParams) ([]GetEmployeeCompletenessCountsRow, error)
+	// degree_level_name is the name of the level in the language of the entry,
+	// the free text of an entry made before the vocabulary existed when it has no level
+	GetEmployeeDegreeByID(ctx context.Context, id int64) (GetEmployeeDegreeByIDRow, error)
+	GetEmployeeDegreesByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeDegreesByEmployeeIDAndLanguageCodesParams) ([]GetEmployeeDegreesByEmployeeIDAndLanguageCodesRow, error)
 	GetEmployeeDetailsByEmployeeID(ctx context.Context, employeeID int64) ([]EmployeeDetail, error)
 	GetEmployeeDetailsByID(ctx context.Context, id int64) (EmployeeDetail, error)
+	// employees without consent to the latest terms are left out
+	GetEmployeeIDsByCurrentInstitutionID(ctx context.Context, currentInstitutionID pgtype.Int8) ([]int64, error)
 	GetEmployeeMainResearchAreaByID(ctx context.Context, id int64) (EmployeeMainResearchArea, error)
 	GetEmployeeMainResearchAreaKeyTopicByID(ctx context.Context, id int64) (EmployeeMainResearchAreaKeyTopic, error)
 	GetEmployeeMainResearchAreaKeyTopicsByEmployeeMainResearchAreaIDAndLanguageCode(ctx context.Context, employeeMainResearchAreaID int64) ([]EmployeeMainResearchAreaKeyTopic, error)
-	GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodeParams) ([]EmployeeMainResearchArea, error)
+	GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeMainResearchAreasByEmployeeIDAndLanguageCodesParams) ([]EmployeeMainResearchArea, error)
+	// the encrypted access token is read on its own, only to call ORCID
+	GetEmployeeOrcidAccountAccessTokenByEmployeeID(ctx context.Context, employeeID int64) (string, error)
+	GetEmployeeOrcidAccountByEmployeeID(ctx context.Context, employeeID int64) (EmployeeOrcidAccount, error)
 	GetEmployeeParticipationInEventByID(ctx context.Context, id int64) (EmployeeParticipationInEvent, error)
-	GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodeParams) ([]EmployeeParticipationInEvent, error)
+	GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeParticipationInEventsByEmployeeIDAndLanguageCodesParams) ([]EmployeeParticipationInEvent, error)
 	GetEmployeeParticipationInProfessionalCommunityByID(ctx context.Context, id int64) (EmployeeParticipationInProfessionalCommunity, error)
-	GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodeParams) ([]EmployeeParticipationInProfessionalCommunity, error)
+	GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeParticipationInProfessionalCommunitysByEmployeeIDAndLanguageCodesParams) ([]EmployeeParticipationInProfessionalCommunity, error)
 	GetEmployeePatentByID(ctx context.Context, id int64) (EmployeePatent, error)
-	GetEmployeePatentsByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeePatentsByEmployeeIDAndLanguageCodeParams) ([]EmployeePatent, error)
+	GetEmployeePatentsByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeePatentsByEmployeeIDAndLanguageCodesParams) ([]EmployeePatent, error)
 	GetEmployeePublicationByID(ctx context.Context, id int64) (EmployeePublication, error)
-	GetEmployeePublicationsByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeePublicationsByEmployeeIDAndLanguageCodeParams) ([]EmployeePublication, error)
+	GetEmployeePublicationsByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeePublicationsByEmployeeIDAndLanguageCodesParams) ([]EmployeePublication, error)
 	GetEmployeeRefresherCourseByID(ctx context.Context, id int64) (EmployeeRefresherCourse, error)
-	GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodeParams) ([]EmployeeRefresherCourse, error)
-	GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodeParams) ([]EmployeeResearchActivity, error)
+	GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeRefresherCoursesByEmployeeIDAndLanguageCodesParams) ([]EmployeeRefresherCourse, error)
+	GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeResearchActivitiesByEmployeeIDAndLanguageCodesParams) ([]EmployeeResearchActivity, error)
 	GetEmployeeResearchActivityByID(ctx context.Context, id int64) (EmployeeResearchActivity, error)
 	GetEmployeeScientificAwardByID(ctx context.Context, id int64) (EmployeeScientificAward, error)
-	GetEmployeeScientificAwardsByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodeParams) ([]EmployeeScientificAward, error)
+	GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeScientificAwardsByEmployeeIDAndLanguageCodesParams) ([]EmployeeScientificAward, error)
 	GetEmployeeSocialByID(ctx context.Context, id int64) (EmployeeSocial, error)
 	GetEmployeeSocialsByEmployeeIDAndLanguageCode(ctx context.Context, employeeID int64) ([]EmployeeSocial, error)
+	GetEmployeeTranslationGroups(ctx context.Context, employeeID int64) ([]GetEmployeeTranslationGroupsRow, error)
+	GetEmployeeVisibilitySettingsByEmployeeID(ctx context.Context, employeeID int64) ([]EmployeeVisibilitySetting, error)
+	// one row per setting along with the current institution of the employee,
+	// an employee without settings has a single row with empty target and level
+	GetEmployeeVisibilitySettingsByEmployeeIDs(ctx context.Context, ids []int64) ([]GetEmployeeVisibilitySettingsByEmployeeIDsRow, error)
 	GetEmployeeWorkExperienceByID(ctx context.Context, id int64) (EmployeeWorkExperience, error)
-	GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCode(ctx context.Context, arg GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodeParams) ([]EmployeeWorkExperience, error)
+	GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodes(ctx context.Context, arg GetEmployeeWorkExperiencesByEmployeeIDAndLanguageCodesParams) ([]EmployeeWorkExperience, error)
+	// names are taken in the first of the language codes the employee has details in
+	GetExpertProfilesByIDs(ctx context.Context, arg GetExpertProfilesByIDsParams) ([]GetExpertProfilesByIDsRow, error)
 	GetInstitutionAccreditationByID(ctx context.Context, id int64) (InstitutionAccreditation, error)
-	GetInstitutionAccreditationsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionAccreditationsByInstitutionIDAndLanguageCodeParams) ([]InstitutionAccreditation, error)
+	GetInstitutionAccreditationsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionAccreditationsByInstitutionIDAndLanguageCodesParams) ([]InstitutionAccreditation, error)
 	GetInstitutionAchievementByID(ctx context.Context, id int64) (InstitutionAchievement, error)
-	GetInstitutionAchievementsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionAchievementsByInstitutionIDAndLanguageCodeParams) ([]InstitutionAchievement, error)
+	GetInstitutionAchievementsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionAchievementsByInstitutionIDAndLanguageCodesParams) ([]InstitutionAchievement, error)
 	GetInstitutionByID(ctx context.Context, id int64) (Institution, error)
 	GetInstitutionConferenceByID(ctx context.Context, id int64) (InstitutionConference, error)
-	GetInstitutionConferencesByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionConferencesByInstitutionIDAndLanguageCodeParams) ([]InstitutionConference, error)
+	GetInstitutionConferencesByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionConferencesByInstitutionIDAndLanguageCodesParams) ([]InstitutionConference, error)
 	GetInstitutionDetailsByID(ctx context.Context, id int64) (InstitutionDetail, error)
-	GetInstitutionDetailsByInstitutionIDAndLanguage(ctx context.Context, arg GetInstitutionDetailsByInstitutionIDAndLanguageParams) (InstitutionDetail, error)
+	GetInstitutionDetailsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionDetailsByInstitutionIDAndLanguageCodesParams) (InstitutionDetail, error)
 	GetInstitutionLicenceByID(ctx context.Context, id int64) (InstitutionLicence, error)
-	GetInstitutionLicencesByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionLicencesByInstitutionIDAndLanguageCodeParams) ([]InstitutionLicence, error)
+	GetInstitutionLicencesByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionLicencesByInstitutionIDAndLanguageCodesParams) ([]InstitutionLicence, error)
 	GetInstitutionMagazineByID(ctx context.Context, id int64) (InstitutionMagazine, error)
-	GetInstitutionMagazinesByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionMagazinesByInstitutionIDAndLanguageCodeParams) ([]InstitutionMagazine, error)
+	GetInstitutionMagazinesByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionMagazinesByInstitutionIDAndLanguageCodesParams) ([]InstitutionMagazine, error)
 	GetInstitutionMainResearchDirectionByID(ctx context.Context, id int64) (InstitutionMainResearchDirection, error)
-	GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguage(ctx context.Context, arg GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageParams) ([]InstitutionMainResearchDirection, error)
+	GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionMainResearchDirectionsByInstitutionIDAndLanguageCodesParams) ([]InstitutionMainResearchDirection, error)
 	GetInstitutionNamesByLanguageCode(ctx context.Context, languageCode string) ([]string, error)
 	GetInstitutionPartnershipByID(ctx context.Context, id int64) (InstitutionPartnership, error)
-	GetInstitutionPartnershipsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionPartnershipsByInstitutionIDAndLanguageCodeParams) ([]InstitutionPartnership, error)
+	GetInstitutionPartnershipsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionPartnershipsByInstitutionIDAndLanguageCodesParams) ([]InstitutionPartnership, error)
 	GetInstitutionPatentByID(ctx context.Context, id int64) (InstitutionPatent, error)
-	GetInstitutionPatentsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionPatentsByInstitutionIDAndLanguageCodeParams) ([]InstitutionPatent, error)
+	GetInstitutionPatentsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionPatentsByInstitutionIDAndLanguageCodesParams) ([]InstitutionPatent, error)
 	GetInstitutionProjectByID(ctx context.Context, id int64) (InstitutionProject, error)
 	GetInstitutionProjectPartnerByID(ctx context.Context, id int64) (InstitutionProjectPartner, error)
 	GetInstitutionProjectPartnersByInstitutionProjectIDAndLanguageCode(ctx context.Context, arg GetInstitutionProjectPartnersByInstitutionProjectIDAndLanguageCodeParams) ([]InstitutionProjectPartner, error)
-	GetInstitutionProjectsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionProjectsByInstitutionIDAndLanguageCodeParams) ([]InstitutionProject, error)
+	GetInstitutionProjectsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionProjectsByInstitutionIDAndLanguageCodesParams) ([]InstitutionProject, error)
 	GetInstitutionRankingByID(ctx context.Context, id int64) (InstitutionRanking, error)
-	GetInstitutionRankingsByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionRankingsByInstitutionIDAndLanguageCodeParams) ([]InstitutionRanking, error)
+	GetInstitutionRankingsByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionRankingsByInstitutionIDAndLanguageCodesParams) ([]InstitutionRanking, error)
 	GetInstitutionResearchSupportInfrastructureByID(ctx context.Context, id int64) (InstitutionResearchSupportInfrastructure, error)
-	GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCode(ctx context.Context, arg GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodeParams) ([]InstitutionResearchSupportInfrastructure, error)
+	GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodes(ctx context.Context, arg GetInstitutionResearchSupportInfrastructuresByInstitutionIDAndLanguageCodesParams) ([]InstitutionResearchSupportInfrastructure, error)
 	GetInstitutionSocialByID(ctx context.Context, id int64) (InstitutionSocial, error)
 	GetInstitutionSocialsByInstitutionID(ctx context.Context, institutionID int64) ([]InstitutionSocial, error)
+	GetInstitutionTranslationGroups(ctx context.Context, institutionID int64) ([]GetInstitutionTranslationGroupsRow, error)
+	// synonyms left by merges resolve to the keyword they were merged into
+	GetKeywordIDByLabel(ctx context.Context, arg GetKeywordIDByLabelParams) (int64, error)
+	GetKeywordLabelsByKeywordIDs(ctx context.Context, keywordIds []int64) ([]KeywordLabel, error)
+	GetKeywordsByIDs(ctx context.Context, ids []int64) ([]GetKeywordsByIDsRow, error)
+	// zero while no terms are published
+	GetLatestConsentDocumentVersion(ctx context.Context) (int32, error)
+	GetLatestUserConsentVersion(ctx context.Context, userID int64) (int32, error)
+	GetNextPublicationAuthorPosition(ctx context.Context, publicationID int64) (int32, error)
+	GetOrgUnitByID(ctx context.Context, id int64) (OrgUnit, error)
+	GetOrgUnitNamesByInstitutionID(ctx context.Context, institutionID int64) ([]OrgUnitName, error)
+	GetOrgUnitNamesByOrgUnitID(ctx context.Context, orgUnitID int64) ([]OrgUnitName, error)
+	GetOrgUnitsByInstitutionID(ctx context.Context, institutionID int64) ([]OrgUnit, error)
 	GetPersonnelPaginated(ctx context.Context, arg GetPersonnelPaginatedParams) ([]GetPersonnelPaginatedRow, error)
+	GetPublicationAuthorByEmployeeID(ctx context.Context, arg GetPublicationAuthorByEmployeeIDParams) (PublicationAuthor, error)
+	GetPublicationAuthorsByPublicationID(ctx context.Context, publicationID int64) ([]GetPublicationAuthorsByPublicationIDRow, error)
+	GetPublicationByDOI(ctx context.Context, doi pgtype.Text) (Publication, error)
+	GetPublicationByID(ctx context.Context, id int64) (Publication, error)
+	GetPublicationByNormalizedTitle(ctx context.Context, normalizedTitle string) (Publication, error)
+	// A paper shared by several employees of the same workplace or entered in several languages is counted once.
+	GetPublicationCitationsByEmployeeID(ctx context.Context, employeeID int64) ([]PublicationCitation, error)
+	GetPublicationCountsByWorkplace(ctx context.Context, languageCode string) ([]GetPublicationCountsByWorkplaceRow, error)
+	GetResearchFieldByCode(ctx context.Context, code string) (ResearchField, error)
+	// a node counts the records linked to it and to its descendants, each researcher and institution once
+	GetResearchFieldStats(ctx context.Context, arg GetResearchFieldStatsParams) ([]GetResearchFieldStatsRow, error)
+	// names are taken in the first of the language codes the similar employee has details in,
+	// employees without details in any of them or without consent to the latest terms are skipped
+	GetSimilarEmployeesByUniqueID(ctx context.Context, arg GetSimilarEmployeesByUniqueIDParams) ([]GetSimilarEmployeesByUniqueIDRow, error)
+	// top level specialities when parent_code is NULL
+	GetSpecialitiesByParentCode(ctx context.Context, parentCode pgtype.Text) ([]GetSpecialitiesByParentCodeRow, error)
+	GetSpecialityNamesBySpecialityCodes(ctx context.Context, specialityCodes []string) ([]SpecialityName, error)
 	GetSummaryData(ctx context.Context, languageCode string) ([]GetSummaryDataRow, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id int64) (User, error)
 	GetUserSessionByToken(ctx context.Context, refreshToken string) (UserSession, error)
-	ListUniqueHighestAcademicDegrees(ctx context.Context) ([]pgtype.Text, error)
+	GetWorkplaceMappingBatchByIDForUpdate(ctx context.Context, id int64) (GetWorkplaceMappingBatchByIDForUpdateRow, error)
+	// Work experiences spelled as one of the workplaces, together with their translations, that a mapping would change.
+	// Without overwrite translation groups already linked to another institution are left alone.
+	GetWorkplaceMappingCandidates(ctx context.Context, arg GetWorkplaceMappingCandidatesParams) ([]GetWorkplaceMappingCandidatesRow, error)
+	// the condition public listings and profiles apply, the user accepted the latest terms
+	HasLatestUserConsent(ctx context.Context, userID int64) (bool, error)
+	// pairs come from shared research areas, keywords and events, a shared institution only adds to the score
+	// so that large institutions do not pair everyone with everyone. Every kind scores the cosine of the two sets
+	// (shared / sqrt(size * size)), weighted and summed; the best max_per_employee pairs of each employee are kept.
+	// employees without consent to the latest terms are left out on both sides
+	InsertEmployeeSimilarities(ctx context.Context, arg InsertEmployeeSimilaritiesParams) (int64, error)
+	IsDegreeLevelExisting(ctx context.Context, code string) (bool, error)
+	IsInstitutionAdmin(ctx context.Context, arg IsInstitutionAdminParams) (bool, error)
+	// Whether the unit is the root of the subtree or lies anywhere below it.
+	IsOrgUnitInSubtree(ctx context.Context, arg IsOrgUnitInSubtreeParams) (bool, error)
+	IsResearchFieldExisting(ctx context.Context, code string) (bool, error)
+	IsSpecialityExisting(ctx context.Context, code string) (bool, error)
+	// shared publications of the employee that have a DOI to look the citation count up by
+	ListCitationImportCandidatesByEmployeeID(ctx context.Context, employeeID int64) ([]ListCitationImportCandidatesByEmployeeIDRow, error)
+	ListEmployeeOrcidAccountsDueForSync(ctx context.Context, arg ListEmployeeOrcidAccountsDueForSyncParams) ([]EmployeeOrcidAccount, error)
+	ListInstitutionTitles(ctx context.Context) ([]ListInstitutionTitlesRow, error)
+	// publications having an unclaimed author whose name contains the surname of the employee
+	ListPublicationClaimSuggestions(ctx context.Context, arg ListPublicationClaimSuggestionsParams) ([]Publication, error)
+	// degree level codes are named in language_code or in the first language of the registry having a name,
+	// free text entered before the vocabulary existed is returned as its own code and name
+	ListUniqueHighestAcademicDegrees(ctx context.Context, languageCode string) ([]ListUniqueHighestAcademicDegreesRow, error)
 	ListUniqueSpecialities(ctx context.Context) ([]pgtype.Text, error)
 	ListUniqueWorkplaces(ctx context.Context, languageCode string) ([]pgtype.Text, error)
+	ListWorkplaceMappingBatches(ctx context.Context, arg ListWorkplaceMappingBatchesParams) ([]ListWorkplaceMappingBatchesRow, error)
+	// Distinct spellings of workplaces per language with the institutions they are already linked to.
+	ListWorkplaceVariants(ctx context.Context) ([]ListWorkplaceVariantsRow, error)
+	MarkWorkplaceMappingBatchUndone(ctx context.Context, arg MarkWorkplaceMappingBatchUndoneParams) error
+	// moved labels become synonyms, the target keeps its preferred labels
+	MoveKeywordLabels(ctx context.Context, arg MoveKeywordLabelsParams) error
+	MoveKeywordTopics(ctx context.Context, arg MoveKeywordTopicsParams) error
+	// a language of the keyword without a preferred label gets its oldest label preferred
+	PreferKeywordLabels(ctx context.Context, keywordID int64) error
+	// recomputes the indicators of every employee linked to the publication, see RefreshEmployeeCitationMetrics
+	RefreshCitationMetricsByPublicationID(ctx context.Context, publicationID int64) error
+	// h-index is the largest h such that h publications have at least h citations each,
+	// i10-index is the number of publications with at least 10 citations.
+	// A publication entered in several languages is counted once.
+	RefreshEmployeeCitationMetrics(ctx context.Context, employeeID int64) error
+	// every profile item containing at least one of the terms, terms are lowercase words.
+	// item_key identifies the item across its translations so a translated item is counted once,
+	// key topics also match by the labels of their keyword in every language
+	SearchExpertMatches(ctx context.Context, arg SearchExpertMatchesParams) ([]SearchExpertMatchesRow, error)
+	// a keyword matches when any of its labels in any language starts with the prefix,
+	// the prefix is normalized like labels and has LIKE wildcards escaped
+	SearchKeywordsByPrefix(ctx context.Context, arg SearchKeywordsByPrefixParams) ([]SearchKeywordsByPrefixRow, error)
+	SetEmployeePublicationPublicationID(ctx context.Context, arg SetEmployeePublicationPublicationIDParams) error
+	SyncEmployeeDegreeTranslations(ctx context.Context, id int64) error
+	SyncEmployeeMainResearchAreaTranslations(ctx context.Context, id int64) error
+	SyncEmployeeParticipationInEventTranslations(ctx context.Context, id int64) error
+	SyncEmployeePublicationTranslations(ctx context.Context, id int64) error
+	SyncEmployeeRefresherCourseTranslations(ctx context.Context, id int64) error
+	SyncEmployeeWorkExperienceTranslations(ctx context.Context, id int64) error
+	SyncInstitutionMainResearchDirectionTranslations(ctx context.Context, id int64) error
+	UnclaimPublicationAuthor(ctx context.Context, arg UnclaimPublicationAuthorParams) error
+	// Work experiences relinked after the batch keep their current institution.
+	UndoWorkplaceMappingBatch(ctx context.Context, batchID int64) (int64, error)
+	// speciality_code is written as given, a NULL unlinks the degree from the classifier
 	UpdateEmployeeDegree(ctx context.Context, arg UpdateEmployeeDegreeParams) (UpdateEmployeeDegreeRow, error)
 	UpdateEmployeeDetails(ctx context.Context, arg UpdateEmployeeDetailsParams) (UpdateEmployeeDetailsRow, error)
 	UpdateEmployeeMainResearchArea(ctx context.Context, arg UpdateEmployeeMainResearchAreaParams) (UpdateEmployeeMainResearchAreaRow, error)
 	UpdateEmployeeMainResearchAreaKeyTopic(ctx context.Context, arg UpdateEmployeeMainResearchAreaKeyTopicParams) (UpdateEmployeeMainResearchAreaKeyTopicRow, error)
+	UpdateEmployeeOrcid(ctx context.Context, arg UpdateEmployeeOrcidParams) error
+	UpdateEmployeeOrcidAccountLastSyncedAt(ctx context.Context, employeeID int64) error
 	UpdateEmployeeParticipationInEvent(ctx context.Context, arg UpdateEmployeeParticipationInEventParams) (UpdateEmployeeParticipationInEventRow, error)
 	UpdateEmployeeParticipationInProfessionalCommunity(ctx context.Context, arg UpdateEmployeeParticipationInProfessionalCommunityParams) (UpdateEmployeeParticipationInProfessionalCommunityRow, error)
 	UpdateEmployeePatent(ctx context.Context, arg UpdateEmployeePatentParams) (UpdateEmployeePatentRow, error)
+	// the optional bibliographic fields are written as given, a NULL clears them
 	UpdateEmployeePublication(ctx context.Context, arg UpdateEmployeePublicationParams) (UpdateEmployeePublicationRow, error)
 	UpdateEmployeeRefresherCourse(ctx context.Context, arg UpdateEmployeeRefresherCourseParams) (UpdateEmployeeRefresherCourseRow, error)
 	UpdateEmployeeResearchActivity(ctx context.Context, arg UpdateEmployeeResearchActivityParams) (UpdateEmployeeResearchActivityRow, error)
@@ -172,7 +328,19 @@ type Querier interface {
 	UpdateInstitutionRanking(ctx context.Context, arg UpdateInstitutionRankingParams) (UpdateInstitutionRankingRow, error)
 	UpdateInstitutionResearchSupportInfrastructure(ctx context.Context, arg UpdateInstitutionResearchSupportInfrastructureParams) (UpdateInstitutionResearchSupportInfrastructureRow, error)
 	UpdateInstitutionSocial(ctx context.Context, arg UpdateInstitutionSocialParams) (UpdateInstitutionSocialRow, error)
+	UpdateOrgUnit(ctx context.Context, arg UpdateOrgUnitParams) (UpdateOrgUnitRow, error)
+	UpdateUserPreferredLanguage(ctx context.Context, arg UpdateUserPreferredLanguageParams) error
 	UpdateUserSession(ctx context.Context, arg UpdateUserSessionParams) (UpdateUserSessionRow, error)
+	UpsertEmployeeOrcidAccount(ctx context.Context, arg UpsertEmployeeOrcidAccountParams) (UpsertEmployeeOrcidAccountRow, error)
+	UpsertEmployeeVisibilitySetting(ctx context.Context, arg UpsertEmployeeVisibilitySettingParams) error
+	UpsertImportedEmployeePublication(ctx context.Context, arg UpsertImportedEmployeePublicationParams) (UpsertImportedEmployeePublicationRow, error)
+	UpsertImportedEmployeeWorkExperience(ctx context.Context, arg UpsertImportedEmployeeWorkExperienceParams) (UpsertImportedEmployeeWorkExperienceRow, error)
+	UpsertOrgUnitName(ctx context.Context, arg UpsertOrgUnitNameParams) error
+	UpsertPublicationCitation(ctx context.Context, arg UpsertPublicationCitationParams) (PublicationCitation, error)
+	UpsertResearchField(ctx context.Context, arg UpsertResearchFieldParams) (bool, error)
+	UpsertResearchFieldName(ctx context.Context, arg UpsertResearchFieldNameParams) error
+	UpsertSpeciality(ctx context.Context, arg UpsertSpecialityParams) (bool, error)
+	UpsertSpecialityName(ctx context.Context, arg UpsertSpecialityNameParams) error
 }
 
 var _ Querier = (*Queries)(nil)
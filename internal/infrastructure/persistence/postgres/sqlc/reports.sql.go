@@ -7,15 +7,67 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const getPublicationCountsByWorkplace = `-- name: GetPublicationCountsByWorkplace :many
+SELECT
+	latest_experience.institution_id,
+	coalesce(latest_institution.institution_title_long, latest_experience.workplace)::text AS workplace,
+	count(DISTINCT coalesce(ep.publication_id::text, ep.translation_group_id::text, ep.id::text))::bigint AS publication_count
+FROM employee_publications AS ep
+JOIN (
+	SELECT DISTINCT ON (employee_id)
+		employee_id,
+		institution_id,
+		workplace
+	FROM employee_work_experiences
+	WHERE employee_work_experiences.language_code = $1
+	ORDER BY employee_work_experiences.employee_id, employee_work_experiences.date_end DESC NULLS FIRST
+) AS latest_experience ON ep.employee_id = latest_experience.employee_id
+LEFT JOIN institution_details AS latest_institution
+	ON latest_institution.institution_id = latest_experience.institution_id
+	AND latest_institution.language_code = $1
+GROUP BY latest_experience.institution_id, coalesce(latest_institution.institution_title_long, latest_experience.workplace)
+`
+
+type GetPublicationCountsByWorkplaceRow struct {
+	InstitutionID    pgtype.Int8 `json:"institution_id"`
+	Workplace        string      `json:"workplace"`
+	PublicationCount int64       `json:"publication_count"`
+}
+
+// A paper shared by several employees of the same workplace or entered in several languages is counted once.
+// Workplaces linked to an institution are grouped by the institution and named by its title.
+func (q *Queries) GetPublicationCountsByWorkplace(ctx context.Context, languageCode string) ([]GetPublicationCountsByWorkplaceRow, error) {
+	rows, err := q.db.Query(ctx, getPublicationCountsByWorkplace, languageCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPublicationCountsByWorkplaceRow{}
+	for rows.Next() {
+		var i GetPublicationCountsByWorkplaceRow
+		if err := rows.Scan(&i.InstitutionID, &i.Workplace, &i.PublicationCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSummaryData = `-- name: GetSummaryData :many
 SELECT 
 	e.id,
 	ed.surname,
 	ed."name",
-	latest_experience.workplace,
-	latest_degree.degree_level
+	latest_experience.institution_id,
+	coalesce(latest_institution.institution_title_long, latest_experience.workplace)::text AS workplace,
+	highest_degree.degree_level_code
 FROM employees AS e
 JOIN employee_details AS ed ON e.id = ed.employee_id
 JOIN (
@@ -24,20 +76,26 @@ JOIN (
 JOIN (
 	SELECT DISTINCT ON (employee_id)
 		employee_id,
+		institution_id,
 		workplace
 	FROM employee_work_experiences
 	WHERE employee_work_experiences.language_code = $1
 	ORDER BY employee_work_experiences.employee_id, employee_work_experiences.date_end DESC NULLS FIRST
 ) AS latest_experience ON e.id = latest_experience.employee_id
+LEFT JOIN institution_details AS latest_institution
+	ON latest_institution.institution_id = latest_experience.institution_id
+	AND latest_institution.language_code = $1
+-- the highest degree by the rank of its level, the latest one among degrees of the same level
 JOIN (
-	SELECT DISTINCT ON (employee_id)
-		employee_id,
-		degree_level,
-		speciality
+	SELECT DISTINCT ON (employee_degrees.employee_id)
+		employee_degrees.employee_id,
+		employee_degrees.degree_level_code,
+		employee_degrees.speciality
 	FROM employee_degrees
+	LEFT JOIN degree_levels AS dl ON dl.code = employee_degrees.degree_level_code
 	WHERE employee_degrees.language_code = $1
-	ORDER BY employee_id, date_end DESC
-) AS latest_degree ON e.id = latest_degree.employee_id
+	ORDER BY employee_degrees.employee_id, dl.rank DESC NULLS LAST, employee_degrees.date_end DESC NULLS LAST, employee_degrees.id DESC
+) AS highest_degree ON e.id = highest_degree.employee_id
 WHERE
 	ed.language_code = $1
 	AND ed.is_employee_details_new = true
@@ -45,11 +103,12 @@ ORDER BY e.id
 `
 
 type GetSummaryDataRow struct {
-	ID          int64  `json:"id"`
-	Surname     string `json:"surname"`
-	Name        string `json:"name"`
-	Workplace   string `json:"workplace"`
-	DegreeLevel string `json:"degree_level"`
+	ID              int64       `json:"id"`
+	Surname         string      `json:"surname"`
+	Name            string      `json:"name"`
+	InstitutionID   pgtype.Int8 `json:"institution_id"`
+	Workplace       string      `json:"workplace"`
+	DegreeLevelCode pgtype.Text `json:"degree_level_code"`
 }
 
 func (q *Queries) GetSummaryData(ctx context.Context, languageCode string) ([]GetSummaryDataRow, error) {
@@ -65,8 +124,9 @@ func (q *Queries) GetSummaryData(ctx context.Context, languageCode string) ([]Ge
 			&i.ID,
 			&i.Surname,
 			&i.Name,
+			&i.InstitutionID,
 			&i.Workplace,
-			&i.DegreeLevel,
+			&i.DegreeLevelCode,
 		); err != nil {
 			return nil, err
 		}
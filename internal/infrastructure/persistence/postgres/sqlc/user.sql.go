@@ -14,15 +14,17 @@ import (
 const createUser = `-- name: CreateUser :one
 INSERT INTO users(
   email,
-  password_hash
+  password_hash,
+  preferred_language
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 ) RETURNING id, created_at, updated_at
 `
 
 type CreateUserParams struct {
-	Email        string `json:"email"`
-	PasswordHash string `json:"password_hash"`
+	Email             string      `json:"email"`
+	PasswordHash      string      `json:"password_hash"`
+	PreferredLanguage pgtype.Text `json:"preferred_language"`
 }
 
 type CreateUserRow struct {
@@ -32,14 +34,14 @@ type CreateUserRow struct {
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error) {
-	row := q.db.QueryRow(ctx, createUser, arg.Email, arg.PasswordHash)
+	row := q.db.QueryRow(ctx, createUser, arg.Email, arg.PasswordHash, arg.PreferredLanguage)
 	var i CreateUserRow
 	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password_hash, created_at, updated_at
+SELECT id, email, password_hash, created_at, updated_at, preferred_language, role
 FROM users
 WHERE email = $1
 `
@@ -53,12 +55,14 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PreferredLanguage,
+		&i.Role,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, password_hash, created_at, updated_at
+SELECT id, email, password_hash, created_at, updated_at, preferred_language, role
 FROM users
 WHERE id = $1
 `
@@ -72,6 +76,26 @@ func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PreferredLanguage,
+		&i.Role,
 	)
 	return i, err
 }
+
+const updateUserPreferredLanguage = `-- name: UpdateUserPreferredLanguage :exec
+UPDATE users
+SET
+  preferred_language = $2,
+  updated_at = now()
+WHERE id = $1
+`
+
+type UpdateUserPreferredLanguageParams struct {
+	ID                int64       `json:"id"`
+	PreferredLanguage pgtype.Text `json:"preferred_language"`
+}
+
+func (q *Queries) UpdateUserPreferredLanguage(ctx context.Context, arg UpdateUserPreferredLanguageParams) error {
+	_, err := q.db.Exec(ctx, updateUserPreferredLanguage, arg.ID, arg.PreferredLanguage)
+	return err
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"backend/internal/shared/custom_errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// translationGroupParam converts the translation group of an entry into query parameter,
+// an entry without group starts a new one
+func translationGroupParam(translationGroupID string) (pgtype.UUID, error) {
+	if translationGroupID == "" {
+		return pgtype.UUID{Bytes: uuid.New(), Valid: true}, nil
+	}
+
+	parsedTranslationGroupID, err := uuid.Parse(translationGroupID)
+	if err != nil {
+		return pgtype.UUID{}, custom_errors.BadRequest(fmt.Errorf("invalid translation group ID(%s): %w", translationGroupID, err))
+	}
+
+	return pgtype.UUID{Bytes: parsedTranslationGroupID, Valid: true}, nil
+}
+
+// translationGroupError reports an entry clashing with its translation group as client error,
+// that is a second entry in the same language or a group that belongs to another owner
+func translationGroupError(err error) error {
+	if custom_errors.IsUniqueConstraintError(err) || custom_errors.IsCheckConstraintError(err) {
+		return custom_errors.BadRequest(err)
+	}
+
+	return custom_errors.InternalServerError(err)
+}
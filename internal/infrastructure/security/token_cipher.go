@@ -0,0 +1,86 @@
+package security
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// TokenCipher encrypts third party tokens kept in DB with AES-256-GCM,
+// ciphertexts are stored as base64 of the nonce followed by the sealed token
+type TokenCipher struct {
+	aead cipher.AEAD
+}
+
+// NewTokenCipher expects base64 of a 32 byte key, without a key tokens can not be encrypted
+// but empty ones are still passed through
+func NewTokenCipher(encodedKey string) (*TokenCipher, error) {
+	if encodedKey == "" {
+		return &TokenCipher{}, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("token encryption key is not valid base64: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("token encryption key must be 32 bytes long, got %d", len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token cipher: %w", err)
+	}
+
+	return &TokenCipher{aead: aead}, nil
+}
+
+func (tc *TokenCipher) Encrypt(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	if tc.aead == nil {
+		return "", errors.New("token encryption key is not configured")
+	}
+
+	nonce := make([]byte, tc.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := tc.aead.Seal(nonce, nonce, []byte(token), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (tc *TokenCipher) Decrypt(encryptedToken string) (string, error) {
+	if encryptedToken == "" {
+		return "", nil
+	}
+	if tc.aead == nil {
+		return "", errors.New("token encryption key is not configured")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encryptedToken)
+	if err != nil {
+		return "", fmt.Errorf("encrypted token is not valid base64: %w", err)
+	}
+	if len(sealed) < tc.aead.NonceSize() {
+		return "", errors.New("encrypted token is too short")
+	}
+
+	nonce, ciphertext := sealed[:tc.aead.NonceSize()], sealed[tc.aead.NonceSize():]
+	token, err := tc.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt token: %w", err)
+	}
+
+	return string(token), nil
+}
@@ -10,7 +10,9 @@ import (
 )
 
 type AccessClaims struct {
-	UserID int64 `json:"userID"`
+	UserID            int64  `json:"userID"`
+	PreferredLanguage string `json:"preferredLanguage,omitempty"`
+	Role              string `json:"role,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -44,10 +46,12 @@ func (tm *TokenManager) GetRefreshTokenDuration() time.Duration {
 	return tm.refreshTokenDuration
 }
 
-func (tm *TokenManager) GenerateAccessToken(userID int64) (string, time.Time, error) {
+func (tm *TokenManager) GenerateAccessToken(userID int64, preferredLanguage string, role string) (string, time.Time, error) {
 	expirationTime := time.Now().Add(tm.accessTokenDuration)
 	claims := &AccessClaims{
-		UserID: userID,
+		UserID:            userID,
+		PreferredLanguage: preferredLanguage,
+		Role:              role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -0,0 +1,378 @@
+package bibliography
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// bibtexTypes maps BibTeX entry types onto the entry types used by Entry
+var bibtexTypes = map[string]string{
+	"article":       "article",
+	"inproceedings": "inproceedings",
+	"conference":    "inproceedings",
+	"book":          "book",
+	"inbook":        "incollection",
+	"incollection":  "incollection",
+	"phdthesis":     "thesis",
+	"mastersthesis": "thesis",
+	"techreport":    "report",
+	"misc":          "misc",
+}
+
+// latexReplacer converts the most common LaTeX escapes into plain text
+var latexReplacer = strings.NewReplacer(
+	`\&`, "&",
+	`\%`, "%",
+	`\$`, "$",
+	`\_`, "_",
+	`\#`, "#",
+	`--`, "–",
+	`~`, " ",
+)
+
+// latexEscaper escapes characters that have a special meaning in LaTeX
+var latexEscaper = strings.NewReplacer(
+	"&", `\&`,
+	"%", `\%`,
+	"$", `\$`,
+	"#", `\#`,
+	"_", `\_`,
+)
+
+// ParseBibTeX reads all entries from BibTeX formatted content.
+// @comment, @preamble and @string blocks are skipped.
+func ParseBibTeX(r io.Reader) ([]Entry, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bibtex content: %w", err)
+	}
+
+	parser := &bibtexParser{input: []rune(string(content))}
+	return parser.parse()
+}
+
+type bibtexParser struct {
+	input []rune
+	pos   int
+}
+
+func (p *bibtexParser) parse() ([]Entry, error) {
+	entries := []Entry{}
+	for {
+		// everything outside of an @ block is treated as a comment
+		for p.pos < len(p.input) && p.input[p.pos] != '@' {
+			p.pos++
+		}
+		if p.pos >= len(p.input) {
+			return entries, nil
+		}
+		p.pos++
+
+		entryType := strings.ToLower(p.readIdentifier())
+		p.skipSpaces()
+		if p.pos >= len(p.input) || (p.input[p.pos] != '{' && p.input[p.pos] != '(') {
+			return nil, fmt.Errorf("malformed bibtex entry @%s at position %d", entryType, p.pos)
+		}
+
+		if entryType == "comment" || entryType == "preamble" || entryType == "string" {
+			if _, err := p.readDelimited(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		entry, err := p.parseEntry(entryType)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+}
+
+func (p *bibtexParser) parseEntry(entryType string) (Entry, error) {
+	closing := '}'
+	if p.input[p.pos] == '(' {
+		closing = ')'
+	}
+	p.pos++
+
+	entry := Entry{Type: "misc"}
+	if mapped, ok := bibtexTypes[entryType]; ok {
+		entry.Type = mapped
+	}
+
+	p.skipSpaces()
+	keyStart := p.pos
+	for p.pos < len(p.input) && p.input[p.pos] != ',' && p.input[p.pos] != closing {
+		p.pos++
+	}
+	entry.Key = strings.TrimSpace(string(p.input[keyStart:p.pos]))
+
+	fields := map[string]string{}
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.input) {
+			return Entry{}, fmt.Errorf("unterminated bibtex entry %q", entry.Key)
+		}
+		if p.input[p.pos] == closing {
+			p.pos++
+			break
+		}
+		if p.input[p.pos] == ',' {
+			p.pos++
+			continue
+		}
+
+		name := strings.ToLower(p.readIdentifier())
+		if name == "" {
+			return Entry{}, fmt.Errorf("malformed field in bibtex entry %q at position %d", entry.Key, p.pos)
+		}
+
+		p.skipSpaces()
+		if p.pos >= len(p.input) || p.input[p.pos] != '=' {
+			return Entry{}, fmt.Errorf("missing '=' after field %q in bibtex entry %q", name, entry.Key)
+		}
+		p.pos++
+
+		value, err := p.readValue(closing)
+		if err != nil {
+			return Entry{}, fmt.Errorf("invalid value of field %q in bibtex entry %q: %w", name, entry.Key, err)
+		}
+		if name == "url" || name == "doi" {
+			fields[name] = strings.TrimSpace(value)
+		} else {
+			fields[name] = cleanLatex(value)
+		}
+	}
+
+	entry.Title = fields["title"]
+	entry.Authors = splitBibtexAuthors(fields["author"])
+	entry.DOI = NormalizeDOI(fields["doi"])
+	entry.URL = fields["url"]
+	if year, err := strconv.Atoi(strings.TrimSpace(fields["year"])); err == nil {
+		entry.Year = int32(year)
+	}
+	for _, venueField := range []string{"journal", "booktitle", "school", "institution", "publisher"} {
+		if fields[venueField] != "" {
+			entry.Venue = fields[venueField]
+			break
+		}
+	}
+
+	return entry, nil
+}
+
+// readValue reads a field value which may be a braced or quoted string,
+// a bare number/macro, or a concatenation of those joined by '#'
+func (p *bibtexParser) readValue(closing rune) (string, error) {
+	var builder strings.Builder
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.input) {
+			return "", fmt.Errorf("unexpected end of input")
+		}
+
+		switch p.input[p.pos] {
+		case '{':
+			part, err := p.readDelimited()
+			if err != nil {
+				return "", err
+			}
+			builder.WriteString(part)
+		case '"':
+			p.pos++
+			start := p.pos
+			depth := 0
+			for p.pos < len(p.input) && (p.input[p.pos] != '"' || depth > 0) {
+				switch p.input[p.pos] {
+				case '{':
+					depth++
+				case '}':
+					depth--
+				}
+				p.pos++
+			}
+			if p.pos >= len(p.input) {
+				return "", fmt.Errorf("unterminated quoted value")
+			}
+			builder.WriteString(string(p.input[start:p.pos]))
+			p.pos++
+		default:
+			start := p.pos
+			for p.pos < len(p.input) && p.input[p.pos] != ',' && p.input[p.pos] != '#' && p.input[p.pos] != closing && !unicode.IsSpace(p.input[p.pos]) {
+				p.pos++
+			}
+			builder.WriteString(string(p.input[start:p.pos]))
+		}
+
+		p.skipSpaces()
+		if p.pos < len(p.input) && p.input[p.pos] == '#' {
+			p.pos++
+			continue
+		}
+
+		return builder.String(), nil
+	}
+}
+
+// readDelimited reads a block enclosed in braces or parentheses
+// and returns its content without the outer delimiters
+func (p *bibtexParser) readDelimited() (string, error) {
+	opening := p.input[p.pos]
+	closing := '}'
+	if opening == '(' {
+		closing = ')'
+	}
+
+	p.pos++
+	start := p.pos
+	depth := 0
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case opening:
+			depth++
+		case closing:
+			if depth == 0 {
+				value := string(p.input[start:p.pos])
+				p.pos++
+				return value, nil
+			}
+			depth--
+		}
+		p.pos++
+	}
+
+	return "", fmt.Errorf("unbalanced %q starting at position %d", opening, start-1)
+}
+
+func (p *bibtexParser) readIdentifier() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.input) {
+		r := p.input[p.pos]
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' && r != ':' && r != '.' {
+			break
+		}
+		p.pos++
+	}
+
+	return string(p.input[start:p.pos])
+}
+
+func (p *bibtexParser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// cleanLatex removes grouping braces and common LaTeX escapes and collapses whitespace
+func cleanLatex(value string) string {
+	value = latexReplacer.Replace(value)
+	value = strings.NewReplacer("{", "", "}", "").Replace(value)
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// splitBibtexAuthors splits the "author" field on the " and " separator
+// and converts "Surname, Name" into "Name Surname"
+func splitBibtexAuthors(value string) []string {
+	authors := []string{}
+	if strings.TrimSpace(value) == "" {
+		return authors
+	}
+
+	for _, author := range strings.Split(value, " and ") {
+		author = strings.TrimSpace(author)
+		if author == "" {
+			continue
+		}
+
+		if surname, name, found := strings.Cut(author, ","); found {
+			author = strings.TrimSpace(strings.TrimSpace(name) + " " + strings.TrimSpace(surname))
+		}
+
+		authors = append(authors, author)
+	}
+
+	return authors
+}
+
+// WriteBibTeX serializes entries into BibTeX
+func WriteBibTeX(w io.Writer, entries []Entry) error {
+	for index, entry := range entries {
+		entryType := entry.Type
+		if entryType == "thesis" {
+			entryType = "phdthesis"
+		} else if entryType == "report" {
+			entryType = "techreport"
+		} else if _, ok := bibtexTypes[entryType]; !ok {
+			entryType = "misc"
+		}
+
+		key := entry.Key
+		if key == "" {
+			key = generateKey(entry, index)
+		}
+
+		var builder strings.Builder
+		fmt.Fprintf(&builder, "@%s{%s,\n", entryType, key)
+		writeBibtexField(&builder, "title", entry.Title)
+		writeBibtexField(&builder, "author", strings.Join(entry.Authors, " and "))
+		if entry.Year > 0 {
+			writeBibtexField(&builder, "year", strconv.Itoa(int(entry.Year)))
+		}
+		switch entryType {
+		case "article":
+			writeBibtexField(&builder, "journal", entry.Venue)
+		case "inproceedings", "incollection":
+			writeBibtexField(&builder, "booktitle", entry.Venue)
+		case "phdthesis":
+			writeBibtexField(&builder, "school", entry.Venue)
+		case "techreport":
+			writeBibtexField(&builder, "institution", entry.Venue)
+		default:
+			writeBibtexField(&builder, "publisher", entry.Venue)
+		}
+		writeBibtexField(&builder, "doi", entry.DOI)
+		writeBibtexField(&builder, "url", entry.URL)
+		builder.WriteString("}\n\n")
+
+		if _, err := io.WriteString(w, builder.String()); err != nil {
+			return fmt.Errorf("failed to write bibtex entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func writeBibtexField(builder *strings.Builder, name, value string) {
+	if value == "" {
+		return
+	}
+
+	if name != "url" && name != "doi" {
+		value = latexEscaper.Replace(value)
+	}
+
+	fmt.Fprintf(builder, "  %s = {%s},\n", name, value)
+}
+
+// generateKey builds a citation key from the first author's surname and the year
+func generateKey(entry Entry, index int) string {
+	base := "ref"
+	if len(entry.Authors) > 0 {
+		parts := strings.Fields(entry.Authors[0])
+		if len(parts) > 0 {
+			base = strings.ToLower(NormalizeTitle(parts[len(parts)-1]))
+		}
+	}
+
+	if entry.Year > 0 {
+		return fmt.Sprintf("%s%d_%d", base, entry.Year, index+1)
+	}
+
+	return fmt.Sprintf("%s_%d", base, index+1)
+}
@@ -0,0 +1,95 @@
+package bibliography
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// cslTypes maps entry types onto CSL item types
+var cslTypes = map[string]string{
+	"article":       "article-journal",
+	"inproceedings": "paper-conference",
+	"book":          "book",
+	"incollection":  "chapter",
+	"thesis":        "thesis",
+	"report":        "report",
+	"misc":          "document",
+}
+
+type cslName struct {
+	Family  string `json:"family,omitempty"`
+	Given   string `json:"given,omitempty"`
+	Literal string `json:"literal,omitempty"`
+}
+
+type cslDate struct {
+	DateParts [][]int32 `json:"date-parts"`
+}
+
+type cslItem struct {
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	Title          string    `json:"title,omitempty"`
+	Author         []cslName `json:"author,omitempty"`
+	Issued         *cslDate  `json:"issued,omitempty"`
+	ContainerTitle string    `json:"container-title,omitempty"`
+	DOI            string    `json:"DOI,omitempty"`
+	URL            string    `json:"URL,omitempty"`
+}
+
+// WriteCSLJSON serializes entries into a CSL-JSON array
+func WriteCSLJSON(w io.Writer, entries []Entry) error {
+	items := make([]cslItem, 0, len(entries))
+	for index, entry := range entries {
+		cslType, ok := cslTypes[entry.Type]
+		if !ok {
+			cslType = "document"
+		}
+
+		key := entry.Key
+		if key == "" {
+			key = generateKey(entry, index)
+		}
+
+		item := cslItem{
+			ID:             key,
+			Type:           cslType,
+			Title:          entry.Title,
+			ContainerTitle: entry.Venue,
+			DOI:            entry.DOI,
+			URL:            entry.URL,
+		}
+		for _, author := range entry.Authors {
+			item.Author = append(item.Author, splitCSLName(author))
+		}
+		if entry.Year > 0 {
+			item.Issued = &cslDate{DateParts: [][]int32{{entry.Year}}}
+		}
+
+		items = append(items, item)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(items); err != nil {
+		return fmt.Errorf("failed to write csl-json: %w", err)
+	}
+
+	return nil
+}
+
+// splitCSLName treats the last word of the name as the family name
+func splitCSLName(author string) cslName {
+	parts := strings.Fields(author)
+	if len(parts) < 2 {
+		return cslName{Literal: author}
+	}
+
+	return cslName{
+		Family: parts[len(parts)-1],
+		Given:  strings.Join(parts[:len(parts)-1], " "),
+	}
+}
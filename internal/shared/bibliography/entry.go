@@ -0,0 +1,76 @@
+package bibliography
+
+import (
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// Supported reference list formats
+const (
+	FormatBibTeX  = "bibtex"
+	FormatRIS     = "ris"
+	FormatCSLJSON = "csl-json"
+)
+
+// Entry is a format independent representation of a single bibliographic record
+type Entry struct {
+	Type    string
+	Key     string
+	Title   string
+	Authors []string
+	Year    int32
+	Venue   string
+	DOI     string
+	URL     string
+}
+
+// DetectFormat guesses the format of an uploaded reference list by its file extension,
+// falling back to sniffing the content when the extension is unknown.
+// Returns an empty string when the format could not be recognized.
+func DetectFormat(filename string, content []byte) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".bib", ".bibtex":
+		return FormatBibTeX
+	case ".ris":
+		return FormatRIS
+	}
+
+	trimmed := strings.TrimPrefix(string(content), "\ufeff")
+	trimmed = strings.TrimLeftFunc(trimmed, unicode.IsSpace)
+	switch {
+	case strings.HasPrefix(trimmed, "@"):
+		return FormatBibTeX
+	case strings.HasPrefix(trimmed, "TY  -"):
+		return FormatRIS
+	}
+
+	return ""
+}
+
+// NormalizeTitle lowercases the title and strips everything except letters and digits,
+// so that the same title typed with different punctuation or spacing compares equal.
+func NormalizeTitle(title string) string {
+	var builder strings.Builder
+	builder.Grow(len(title))
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+		}
+	}
+
+	return builder.String()
+}
+
+// NormalizeDOI strips resolver prefixes from the DOI and lowercases it
+func NormalizeDOI(doi string) string {
+	doi = strings.TrimSpace(doi)
+	for _, prefix := range []string{"https://doi.org/", "http://doi.org/", "https://dx.doi.org/", "http://dx.doi.org/", "doi:"} {
+		if len(doi) >= len(prefix) && strings.EqualFold(doi[:len(prefix)], prefix) {
+			doi = doi[len(prefix):]
+			break
+		}
+	}
+
+	return strings.ToLower(strings.TrimSpace(doi))
+}
@@ -0,0 +1,183 @@
+package bibliography
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// risTypes maps RIS reference types onto the entry types used by Entry
+var risTypes = map[string]string{
+	"JOUR":   "article",
+	"EJOUR":  "article",
+	"MGZN":   "article",
+	"CONF":   "inproceedings",
+	"CPAPER": "inproceedings",
+	"BOOK":   "book",
+	"EBOOK":  "book",
+	"CHAP":   "incollection",
+	"ECHAP":  "incollection",
+	"THES":   "thesis",
+	"RPRT":   "report",
+	"GEN":    "misc",
+}
+
+// risTypesReverse is used when exporting entries back into RIS
+var risTypesReverse = map[string]string{
+	"article":       "JOUR",
+	"inproceedings": "CONF",
+	"book":          "BOOK",
+	"incollection":  "CHAP",
+	"thesis":        "THES",
+	"report":        "RPRT",
+	"misc":          "GEN",
+}
+
+// ParseRIS reads all records from RIS formatted content.
+// Every record starts with a "TY" tag and ends with an "ER" tag.
+func ParseRIS(r io.Reader) ([]Entry, error) {
+	entries := []Entry{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var current *Entry
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		// RIS lines look like "XX  - value"
+		if len(line) < 5 || line[2:5] != "  -" {
+			return nil, fmt.Errorf("malformed ris line %d: %q", lineNumber, line)
+		}
+		tag := line[:2]
+		value := strings.TrimSpace(strings.TrimPrefix(line[5:], " "))
+
+		if tag == "TY" {
+			if current != nil {
+				return nil, fmt.Errorf("ris record started on line %d before previous record was closed", lineNumber)
+			}
+			current = &Entry{Type: "misc", Authors: []string{}}
+			if mapped, ok := risTypes[strings.ToUpper(value)]; ok {
+				current.Type = mapped
+			}
+			continue
+		}
+
+		if current == nil {
+			return nil, fmt.Errorf("ris tag %s on line %d outside of a record", tag, lineNumber)
+		}
+
+		switch tag {
+		case "ER":
+			entries = append(entries, *current)
+			current = nil
+		case "ID":
+			current.Key = value
+		case "TI", "T1":
+			current.Title = value
+		case "AU", "A1":
+			current.Authors = append(current.Authors, normalizeRisAuthor(value))
+		case "PY", "Y1", "DA":
+			if current.Year == 0 {
+				current.Year = parseRisYear(value)
+			}
+		case "JO", "JF", "T2", "BT", "PB":
+			if current.Venue == "" {
+				current.Venue = value
+			}
+		case "DO":
+			current.DOI = NormalizeDOI(value)
+		case "UR":
+			if current.URL == "" {
+				current.URL = value
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ris content: %w", err)
+	}
+
+	if current != nil {
+		return nil, fmt.Errorf("ris record is not terminated with ER tag")
+	}
+
+	return entries, nil
+}
+
+// parseRisYear extracts the year from values like "2020", "2020/05/01/" or "2020///"
+func parseRisYear(value string) int32 {
+	yearPart, _, _ := strings.Cut(value, "/")
+	year, err := strconv.Atoi(strings.TrimSpace(yearPart))
+	if err != nil {
+		return 0
+	}
+
+	return int32(year)
+}
+
+// normalizeRisAuthor converts "Surname, Name" into "Name Surname"
+func normalizeRisAuthor(value string) string {
+	if surname, name, found := strings.Cut(value, ","); found {
+		return strings.TrimSpace(strings.TrimSpace(name) + " " + strings.TrimSpace(surname))
+	}
+
+	return value
+}
+
+// WriteRIS serializes entries into RIS
+func WriteRIS(w io.Writer, entries []Entry) error {
+	for index, entry := range entries {
+		risType, ok := risTypesReverse[entry.Type]
+		if !ok {
+			risType = "GEN"
+		}
+
+		key := entry.Key
+		if key == "" {
+			key = generateKey(entry, index)
+		}
+
+		var builder strings.Builder
+		writeRisTag(&builder, "TY", risType)
+		writeRisTag(&builder, "ID", key)
+		writeRisTag(&builder, "TI", entry.Title)
+		for _, author := range entry.Authors {
+			writeRisTag(&builder, "AU", author)
+		}
+		if entry.Year > 0 {
+			writeRisTag(&builder, "PY", strconv.Itoa(int(entry.Year)))
+		}
+		if risType == "JOUR" {
+			writeRisTag(&builder, "JO", entry.Venue)
+		} else {
+			writeRisTag(&builder, "T2", entry.Venue)
+		}
+		writeRisTag(&builder, "DO", entry.DOI)
+		writeRisTag(&builder, "UR", entry.URL)
+		builder.WriteString("ER  - \n\n")
+
+		if _, err := io.WriteString(w, builder.String()); err != nil {
+			return fmt.Errorf("failed to write ris record: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func writeRisTag(builder *strings.Builder, tag, value string) {
+	if value == "" {
+		return
+	}
+
+	fmt.Fprintf(builder, "%s  - %s\n", tag, value)
+}
@@ -0,0 +1,151 @@
+package classifier
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Header columns of a classifier file, names are given in columns named NameColumnPrefix followed by a language code(e.g. "name_tg")
+const (
+	CodeColumn       = "code"
+	ParentCodeColumn = "parent_code"
+	NameColumnPrefix = "name_"
+)
+
+// Row is a node of a classifier as read from a file, ParentCode is empty for top level nodes
+type Row struct {
+	Line       int
+	Code       string
+	ParentCode string
+	// Names of the node by language code, empty cells are left out
+	Names map[string]string
+}
+
+// ReadFile reads the classifier from a CSV or XLSX file chosen by its extension,
+// the first sheet of a workbook is read
+func ReadFile(path string) ([]Row, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ReadCSV(file)
+	case ".xlsx":
+		return ReadXLSX(file)
+	default:
+		return nil, fmt.Errorf("unsupported classifier file %q, expected .csv or .xlsx", filepath.Base(path))
+	}
+}
+
+// ReadCSV reads the classifier from comma or semicolon separated content with a header line
+func ReadCSV(r io.Reader) ([]Row, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	content = bytes.TrimPrefix(content, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	// spreadsheets saved in locales using the decimal comma separate fields by semicolons
+	firstLine, _, _ := strings.Cut(string(content), "\n")
+	if strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		reader.Comma = ';'
+	}
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("malformed csv: %w", err)
+	}
+
+	return readRecords(records)
+}
+
+// ReadXLSX reads the classifier from the first sheet of a workbook with a header row
+func ReadXLSX(r io.Reader) ([]Row, error) {
+	workbook, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("malformed xlsx: %w", err)
+	}
+	defer workbook.Close()
+
+	sheets := workbook.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, fmt.Errorf("workbook has no sheets")
+	}
+
+	records, err := workbook.GetRows(sheets[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sheet %q: %w", sheets[0], err)
+	}
+
+	return readRecords(records)
+}
+
+// readRecords maps the records onto rows by the header in the first record, blank records are skipped
+func readRecords(records [][]string) ([]Row, error) {
+	if len(records) == 0 {
+		return nil, fmt.Errorf("classifier file is empty")
+	}
+
+	codeIndex, parentCodeIndex := -1, -1
+	nameIndexes := map[string]int{}
+	for index, column := range records[0] {
+		column = strings.ToLower(strings.TrimSpace(column))
+		switch {
+		case column == CodeColumn:
+			codeIndex = index
+		case column == ParentCodeColumn:
+			parentCodeIndex = index
+		case strings.HasPrefix(column, NameColumnPrefix) && len(column) > len(NameColumnPrefix):
+			nameIndexes[strings.TrimPrefix(column, NameColumnPrefix)] = index
+		}
+	}
+
+	if codeIndex < 0 {
+		return nil, fmt.Errorf("header has no %q column", CodeColumn)
+	}
+	if len(nameIndexes) == 0 {
+		return nil, fmt.Errorf("header has no %q columns", NameColumnPrefix+"<language>")
+	}
+
+	rows := []Row{}
+	for recordIndex, record := range records[1:] {
+		cell := func(index int) string {
+			if index < 0 || index >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[index])
+		}
+
+		row := Row{
+			Line:       recordIndex + 2,
+			Code:       cell(codeIndex),
+			ParentCode: cell(parentCodeIndex),
+			Names:      map[string]string{},
+		}
+		for languageCode, index := range nameIndexes {
+			if name := cell(index); name != "" {
+				row.Names[languageCode] = name
+			}
+		}
+
+		if row.Code == "" && row.ParentCode == "" && len(row.Names) == 0 {
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
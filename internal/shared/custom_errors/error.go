@@ -34,7 +34,25 @@ func IsNotFound(err error) bool {
 func IsUniqueConstraintError(err error) bool {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
-		return pgErr.Code == "23505" 
+		return pgErr.Code == "23505"
+	}
+
+	return false
+}
+
+func IsCheckConstraintError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23514"
+	}
+
+	return false
+}
+
+func IsForeignKeyViolationError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23503"
 	}
 
 	return false
@@ -79,3 +97,11 @@ func Unauthorized(err error) error {
 		Err:        err,
 	}
 }
+
+func Forbidden(err error) error {
+	return &AppError{
+		StatusCode: http.StatusForbidden,
+		ErrType:    "CLIENT_ERROR",
+		Err:        err,
+	}
+}
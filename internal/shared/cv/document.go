@@ -0,0 +1,146 @@
+package cv
+
+import "strings"
+
+const (
+	FormatPDF  = "pdf"
+	FormatDOCX = "docx"
+)
+
+// Section kinds, templates use them to decide in which order sections are rendered
+const (
+	SectionEducation      = "education"
+	SectionWorkExperience = "work_experience"
+	SectionPublications   = "publications"
+	SectionAwards         = "awards"
+	SectionPatents        = "patents"
+	SectionCourses        = "courses"
+)
+
+// Document is a CV in a single language, ready to be rendered by WritePDF or WriteDOCX
+type Document struct {
+	LanguageCode string
+	FullName     string
+	Headline     string
+	Contacts     []string
+	Sections     []Section
+}
+
+type Section struct {
+	Kind  string
+	Title string
+	Items []Item
+}
+
+// Item is a single entry of a section, every field except Title is optional
+type Item struct {
+	Title    string
+	Subtitle string
+	Period   string
+	Details  string
+}
+
+// sectionTitles holds section headings per language code
+var sectionTitles = map[string]map[string]string{
+	SectionEducation: {
+		"en": "Education",
+		"ru": "Образование",
+		"tg": "Маълумот",
+	},
+	SectionWorkExperience: {
+		"en": "Work experience",
+		"ru": "Опыт работы",
+		"tg": "Собиқаи корӣ",
+	},
+	SectionPublications: {
+		"en": "Publications",
+		"ru": "Публикации",
+		"tg": "Интишорот",
+	},
+	SectionAwards: {
+		"en": "Scientific awards",
+		"ru": "Научные награды",
+		"tg": "Мукофотҳои илмӣ",
+	},
+	SectionPatents: {
+		"en": "Patents",
+		"ru": "Патенты",
+		"tg": "Патентҳо",
+	},
+	SectionCourses: {
+		"en": "Refresher courses",
+		"ru": "Курсы повышения квалификации",
+		"tg": "Курсҳои такмили ихтисос",
+	},
+}
+
+var presentLabels = map[string]string{
+	"en": "present",
+	"ru": "по настоящее время",
+	"tg": "то ҳол",
+}
+
+// SectionTitle returns the heading of the section kind in the given language, falling back to english
+func SectionTitle(kind string, langCode string) string {
+	if title, ok := sectionTitles[kind][langCode]; ok {
+		return title
+	}
+
+	return sectionTitles[kind]["en"]
+}
+
+// PresentLabel returns the word used instead of an end date of ongoing entries
+func PresentLabel(langCode string) string {
+	if label, ok := presentLabels[langCode]; ok {
+		return label
+	}
+
+	return presentLabels["en"]
+}
+
+// Period formats "start – end" skipping empty parts
+func Period(start string, end string) string {
+	switch {
+	case start == "":
+		return end
+	case end == "" || end == start:
+		return start
+	default:
+		return start + " – " + end
+	}
+}
+
+// orderedSections returns non-empty sections of the document in the order of the template,
+// sections of kinds unknown to the template are appended at the end
+func orderedSections(doc *Document, template Template) []Section {
+	sections := make([]Section, 0, len(doc.Sections))
+	used := make([]bool, len(doc.Sections))
+	for _, kind := range template.SectionOrder {
+		for index, section := range doc.Sections {
+			if !used[index] && section.Kind == kind && len(section.Items) > 0 {
+				sections = append(sections, section)
+				used[index] = true
+			}
+		}
+	}
+
+	for index, section := range doc.Sections {
+		if !used[index] && len(section.Items) > 0 {
+			sections = append(sections, section)
+		}
+	}
+
+	return sections
+}
+
+// lines splits multi-line text and drops blank lines
+func lines(text string) []string {
+	result := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			result = append(result, line)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,207 @@
+package cv
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A4 page with 2cm margins, measured in twentieths of a point
+const (
+	docxPageWidth   = 11906
+	docxPageHeight  = 16838
+	docxPageMargin  = 1134
+	docxTextWidth   = docxPageWidth - 2*docxPageMargin
+	docxXMLHeader   = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+	docxMainNS      = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+	docxRelationsNS = "http://schemas.openxmlformats.org/package/2006/relationships"
+)
+
+// docxLanguages maps language codes onto the locales word processors use for spell checking
+var docxLanguages = map[string]string{
+	"en": "en-US",
+	"ru": "ru-RU",
+	"tg": "tg-Cyrl-TJ",
+}
+
+// WriteDOCX renders the document as an Office Open XML word processing package
+func WriteDOCX(w io.Writer, doc *Document, template Template) error {
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", docxContentTypes()},
+		{"_rels/.rels", docxPackageRelationships()},
+		{"docProps/core.xml", docxCoreProperties(doc)},
+		{"word/_rels/document.xml.rels", docxDocumentRelationships()},
+		{"word/styles.xml", docxStyles(doc, template)},
+		{"word/document.xml", docxDocument(doc, template)},
+	}
+
+	archive := zip.NewWriter(w)
+	for _, part := range parts {
+		partWriter, err := archive.Create(part.name)
+		if err != nil {
+			return fmt.Errorf("failed to add %s to docx: %w", part.name, err)
+		}
+
+		if _, err := io.WriteString(partWriter, part.content); err != nil {
+			return fmt.Errorf("failed to write %s of docx: %w", part.name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to finish docx: %w", err)
+	}
+
+	return nil
+}
+
+func docxContentTypes() string {
+	return docxXMLHeader +
+		`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>` +
+		`<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>` +
+		`<Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/>` +
+		`</Types>`
+}
+
+func docxPackageRelationships() string {
+	return docxXMLHeader +
+		`<Relationships xmlns="` + docxRelationsNS + `">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties" Target="docProps/core.xml"/>` +
+		`</Relationships>`
+}
+
+func docxDocumentRelationships() string {
+	return docxXMLHeader +
+		`<Relationships xmlns="` + docxRelationsNS + `">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`
+}
+
+func docxCoreProperties(doc *Document) string {
+	return docxXMLHeader +
+		`<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" xmlns:dc="http://purl.org/dc/elements/1.1/">` +
+		`<dc:title>` + docxEscape(doc.FullName) + `</dc:title>` +
+		`<dc:language>` + docxEscape(docxLanguage(doc.LanguageCode)) + `</dc:language>` +
+		`</cp:coreProperties>`
+}
+
+func docxStyles(doc *Document, template Template) string {
+	font := docxEscape(template.DOCXFont)
+	color := docxColor(template.AccentColor)
+	fontSize := int(template.FontSize * 2)
+
+	headingCaps := ""
+	if template.UppercaseHeadings {
+		headingCaps = `<w:caps/>`
+	}
+
+	return docxXMLHeader +
+		`<w:styles xmlns:w="` + docxMainNS + `">` +
+		`<w:docDefaults><w:rPrDefault><w:rPr>` +
+		fmt.Sprintf(`<w:rFonts w:ascii="%s" w:hAnsi="%s" w:cs="%s" w:eastAsia="%s"/>`, font, font, font, font) +
+		fmt.Sprintf(`<w:sz w:val="%d"/><w:szCs w:val="%d"/>`, fontSize, fontSize) +
+		`<w:lang w:val="` + docxEscape(docxLanguage(doc.LanguageCode)) + `"/>` +
+		`</w:rPr></w:rPrDefault>` +
+		`<w:pPrDefault><w:pPr><w:spacing w:after="40" w:line="264" w:lineRule="auto"/></w:pPr></w:pPrDefault>` +
+		`</w:docDefaults>` +
+		`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:qFormat/></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Title"><w:name w:val="Title"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/>` +
+		`<w:pPr><w:spacing w:after="80"/></w:pPr>` +
+		fmt.Sprintf(`<w:rPr><w:b/><w:color w:val="%s"/><w:sz w:val="%d"/><w:szCs w:val="%d"/></w:rPr>`, color, fontSize*5/2, fontSize*5/2) +
+		`</w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Heading1"><w:name w:val="heading 1"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/>` +
+		`<w:pPr><w:keepNext/>` +
+		fmt.Sprintf(`<w:pBdr><w:bottom w:val="single" w:sz="6" w:space="1" w:color="%s"/></w:pBdr>`, color) +
+		`<w:spacing w:before="240" w:after="120"/><w:outlineLvl w:val="0"/></w:pPr>` +
+		fmt.Sprintf(`<w:rPr><w:b/>%s<w:color w:val="%s"/><w:sz w:val="%d"/><w:szCs w:val="%d"/></w:rPr>`, headingCaps, color, fontSize*13/10, fontSize*13/10) +
+		`</w:style>` +
+		`</w:styles>`
+}
+
+func docxDocument(doc *Document, template Template) string {
+	var body strings.Builder
+
+	body.WriteString(docxParagraph(`<w:pStyle w:val="Title"/>`, docxRun("", doc.FullName)))
+	if doc.Headline != "" {
+		body.WriteString(docxParagraph("", docxRun(`<w:i/>`, doc.Headline)))
+	}
+	if len(doc.Contacts) > 0 {
+		body.WriteString(docxParagraph("", docxRun("", strings.Join(doc.Contacts, " · "))))
+	}
+
+	itemProperties := fmt.Sprintf(`<w:keepNext/><w:tabs><w:tab w:val="right" w:pos="%d"/></w:tabs><w:spacing w:before="80"/>`, docxTextWidth)
+	for _, section := range orderedSections(doc, template) {
+		body.WriteString(docxParagraph(`<w:pStyle w:val="Heading1"/>`, docxRun("", section.Title)))
+
+		for _, item := range section.Items {
+			titleRuns := docxRun(`<w:b/>`, item.Title)
+			if item.Period != "" {
+				titleRuns += `<w:r><w:tab/></w:r>` + docxRun("", item.Period)
+			}
+			body.WriteString(docxParagraph(itemProperties, titleRuns))
+
+			if item.Subtitle != "" {
+				body.WriteString(docxParagraph("", docxRun(`<w:i/>`, item.Subtitle)))
+			}
+
+			if template.Compact {
+				continue
+			}
+
+			for _, line := range lines(item.Details) {
+				body.WriteString(docxParagraph("", docxRun("", line)))
+			}
+		}
+	}
+
+	return docxXMLHeader +
+		`<w:document xmlns:w="` + docxMainNS + `"><w:body>` +
+		body.String() +
+		`<w:sectPr>` +
+		fmt.Sprintf(`<w:pgSz w:w="%d" w:h="%d"/>`, docxPageWidth, docxPageHeight) +
+		fmt.Sprintf(`<w:pgMar w:top="%d" w:right="%d" w:bottom="%d" w:left="%d" w:header="708" w:footer="708" w:gutter="0"/>`, docxPageMargin, docxPageMargin, docxPageMargin, docxPageMargin) +
+		`</w:sectPr>` +
+		`</w:body></w:document>`
+}
+
+func docxParagraph(properties string, runs string) string {
+	if properties != "" {
+		properties = `<w:pPr>` + properties + `</w:pPr>`
+	}
+
+	return `<w:p>` + properties + runs + `</w:p>`
+}
+
+func docxRun(properties string, text string) string {
+	if properties != "" {
+		properties = `<w:rPr>` + properties + `</w:rPr>`
+	}
+
+	return `<w:r>` + properties + `<w:t xml:space="preserve">` + docxEscape(text) + `</w:t></w:r>`
+}
+
+func docxEscape(text string) string {
+	var escaped strings.Builder
+	xml.EscapeText(&escaped, []byte(text))
+	return escaped.String()
+}
+
+func docxColor(color [3]int) string {
+	return fmt.Sprintf("%02X%02X%02X", color[0], color[1], color[2])
+}
+
+func docxLanguage(langCode string) string {
+	if language, ok := docxLanguages[langCode]; ok {
+		return language
+	}
+
+	return langCode
+}
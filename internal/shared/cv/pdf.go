@@ -0,0 +1,114 @@
+package cv
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	pdfMargin     = 20.0
+	pdfFontFamily = "cv"
+	// line height relative to the font size in points, result is in millimeters
+	pdfLineHeightRatio = 0.45
+)
+
+// WritePDF renders the document as A4 PDF.
+// fontDir must contain the TrueType fonts of the template, they are embedded so Cyrillic text is rendered as is.
+func WritePDF(w io.Writer, doc *Document, template Template, fontDir string) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+	pdf.AddUTF8Font(pdfFontFamily, "", filepath.Join(fontDir, template.PDFRegularFont))
+	pdf.AddUTF8Font(pdfFontFamily, "B", filepath.Join(fontDir, template.PDFBoldFont))
+	pdf.SetTitle(doc.FullName, true)
+	pdf.SetLang(doc.LanguageCode)
+	if pdf.Err() {
+		return fmt.Errorf("failed to prepare pdf fonts: %w", pdf.Error())
+	}
+
+	pageWidth, pageHeight := pdf.GetPageSize()
+	textWidth := pageWidth - 2*pdfMargin
+	lineHeight := template.FontSize * pdfLineHeightRatio
+	accentR, accentG, accentB := template.AccentColor[0], template.AccentColor[1], template.AccentColor[2]
+
+	pdf.AddPage()
+
+	pdf.SetFont(pdfFontFamily, "B", template.FontSize*2)
+	pdf.SetTextColor(accentR, accentG, accentB)
+	pdf.MultiCell(textWidth, template.FontSize*2*pdfLineHeightRatio, doc.FullName, "", "L", false)
+	pdf.SetTextColor(0, 0, 0)
+
+	pdf.SetFont(pdfFontFamily, "", template.FontSize)
+	if doc.Headline != "" {
+		pdf.MultiCell(textWidth, lineHeight, doc.Headline, "", "L", false)
+	}
+	if len(doc.Contacts) > 0 {
+		pdf.SetTextColor(90, 90, 90)
+		pdf.MultiCell(textWidth, lineHeight, strings.Join(doc.Contacts, " · "), "", "L", false)
+		pdf.SetTextColor(0, 0, 0)
+	}
+
+	for _, section := range orderedSections(doc, template) {
+		title := section.Title
+		if template.UppercaseHeadings {
+			title = strings.ToUpper(title)
+		}
+
+		pdf.Ln(lineHeight)
+		pdf.SetFont(pdfFontFamily, "B", template.FontSize*1.3)
+		pdf.SetTextColor(accentR, accentG, accentB)
+		pdf.SetDrawColor(accentR, accentG, accentB)
+		pdf.MultiCell(textWidth, template.FontSize*1.3*pdfLineHeightRatio, title, "B", "L", false)
+		pdf.SetTextColor(0, 0, 0)
+		pdf.Ln(lineHeight / 2)
+
+		for _, item := range section.Items {
+			pdf.SetFont(pdfFontFamily, "", template.FontSize)
+			periodWidth := 0.0
+			if item.Period != "" {
+				periodWidth = pdf.GetStringWidth(item.Period) + 2
+			}
+
+			// period is printed at the right edge of the first line of the title,
+			// so the page is broken beforehand to keep them together
+			x, y := pdf.GetXY()
+			if y+2*lineHeight > pageHeight-pdfMargin {
+				pdf.AddPage()
+				x, y = pdf.GetXY()
+			}
+			if item.Period != "" {
+				pdf.SetXY(x+textWidth-periodWidth, y)
+				pdf.CellFormat(periodWidth, lineHeight, item.Period, "", 0, "R", false, 0, "")
+				pdf.SetXY(x, y)
+			}
+
+			pdf.SetFont(pdfFontFamily, "B", template.FontSize)
+			pdf.MultiCell(textWidth-periodWidth, lineHeight, item.Title, "", "L", false)
+
+			pdf.SetFont(pdfFontFamily, "", template.FontSize)
+			if item.Subtitle != "" {
+				pdf.SetTextColor(90, 90, 90)
+				pdf.MultiCell(textWidth, lineHeight, item.Subtitle, "", "L", false)
+				pdf.SetTextColor(0, 0, 0)
+			}
+
+			if !template.Compact {
+				for _, line := range lines(item.Details) {
+					pdf.MultiCell(textWidth, lineHeight, line, "", "L", false)
+				}
+			}
+
+			pdf.Ln(lineHeight / 3)
+		}
+	}
+
+	if err := pdf.Output(w); err != nil {
+		return fmt.Errorf("failed to render pdf: %w", err)
+	}
+
+	return nil
+}
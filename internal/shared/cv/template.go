@@ -0,0 +1,94 @@
+package cv
+
+import "sort"
+
+const DefaultTemplate = "classic"
+
+// Template describes the look of a generated CV.
+// PDF is rendered with the bundled TrueType fonts, DOCX refers to fonts installed on the reader's machine.
+type Template struct {
+	Name              string
+	PDFRegularFont    string
+	PDFBoldFont       string
+	DOCXFont          string
+	FontSize          float64
+	AccentColor       [3]int
+	UppercaseHeadings bool
+	// Compact templates omit descriptions of the entries
+	Compact      bool
+	SectionOrder []string
+}
+
+var templates = map[string]Template{
+	"classic": {
+		Name:              "classic",
+		PDFRegularFont:    "DejaVuSerif.ttf",
+		PDFBoldFont:       "DejaVuSerif-Bold.ttf",
+		DOCXFont:          "Times New Roman",
+		FontSize:          11,
+		AccentColor:       [3]int{0, 0, 0},
+		UppercaseHeadings: true,
+		SectionOrder: []string{
+			SectionEducation,
+			SectionWorkExperience,
+			SectionPublications,
+			SectionAwards,
+			SectionPatents,
+			SectionCourses,
+		},
+	},
+	"modern": {
+		Name:           "modern",
+		PDFRegularFont: "DejaVuSans.ttf",
+		PDFBoldFont:    "DejaVuSans-Bold.ttf",
+		DOCXFont:       "Arial",
+		FontSize:       10.5,
+		AccentColor:    [3]int{31, 78, 121},
+		SectionOrder: []string{
+			SectionWorkExperience,
+			SectionEducation,
+			SectionPublications,
+			SectionAwards,
+			SectionPatents,
+			SectionCourses,
+		},
+	},
+	"compact": {
+		Name:           "compact",
+		PDFRegularFont: "DejaVuSans.ttf",
+		PDFBoldFont:    "DejaVuSans-Bold.ttf",
+		DOCXFont:       "Arial",
+		FontSize:       9,
+		AccentColor:    [3]int{64, 64, 64},
+		Compact:        true,
+		SectionOrder: []string{
+			SectionWorkExperience,
+			SectionEducation,
+			SectionCourses,
+			SectionAwards,
+			SectionPatents,
+			SectionPublications,
+		},
+	},
+}
+
+// LookupTemplate returns the template by name, empty name selects DefaultTemplate
+func LookupTemplate(name string) (Template, bool) {
+	if name == "" {
+		name = DefaultTemplate
+	}
+
+	template, ok := templates[name]
+	return template, ok
+}
+
+// TemplateNames lists names of all available templates
+func TemplateNames() []string {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,184 @@
+package graph
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Supported network formats
+const (
+	FormatJSON = "json"
+	FormatGEXF = "gexf"
+)
+
+// Attribute declares a value nodes or edges carry, Type is a GEXF type such as string, integer or boolean
+type Attribute struct {
+	ID    string
+	Title string
+	Type  string
+}
+
+type Node struct {
+	ID     string
+	Label  string
+	Values map[string]string
+}
+
+type Edge struct {
+	Source string
+	Target string
+	Weight float64
+	Values map[string]string
+}
+
+// Graph is a format independent undirected graph, values of nodes and edges are keyed by attribute ID
+type Graph struct {
+	Description    string
+	NodeAttributes []Attribute
+	EdgeAttributes []Attribute
+	Nodes          []Node
+	Edges          []Edge
+}
+
+type gexfDocument struct {
+	XMLName xml.Name  `xml:"gexf"`
+	Xmlns   string    `xml:"xmlns,attr"`
+	Version string    `xml:"version,attr"`
+	Meta    gexfMeta  `xml:"meta"`
+	Graph   gexfGraph `xml:"graph"`
+}
+
+type gexfMeta struct {
+	LastModified string `xml:"lastmodifieddate,attr"`
+	Creator      string `xml:"creator"`
+	Description  string `xml:"description,omitempty"`
+}
+
+type gexfGraph struct {
+	DefaultEdgeType string           `xml:"defaultedgetype,attr"`
+	Mode            string           `xml:"mode,attr"`
+	Attributes      []gexfAttributes `xml:"attributes"`
+	Nodes           []gexfNode       `xml:"nodes>node"`
+	Edges           []gexfEdge       `xml:"edges>edge"`
+}
+
+type gexfAttributes struct {
+	Class      string          `xml:"class,attr"`
+	Attributes []gexfAttribute `xml:"attribute"`
+}
+
+type gexfAttribute struct {
+	ID    string `xml:"id,attr"`
+	Title string `xml:"title,attr"`
+	Type  string `xml:"type,attr"`
+}
+
+type gexfNode struct {
+	ID        string         `xml:"id,attr"`
+	Label     string         `xml:"label,attr"`
+	AttValues *gexfAttValues `xml:"attvalues,omitempty"`
+}
+
+type gexfEdge struct {
+	ID        string         `xml:"id,attr"`
+	Source    string         `xml:"source,attr"`
+	Target    string         `xml:"target,attr"`
+	Weight    string         `xml:"weight,attr"`
+	AttValues *gexfAttValues `xml:"attvalues,omitempty"`
+}
+
+type gexfAttValues struct {
+	AttValues []gexfAttValue `xml:"attvalue"`
+}
+
+type gexfAttValue struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// WriteGEXF writes the graph as a GEXF 1.3 document readable by Gephi
+func WriteGEXF(w io.Writer, g Graph) error {
+	document := gexfDocument{
+		Xmlns:   "http://gexf.net/1.3",
+		Version: "1.3",
+		Meta: gexfMeta{
+			LastModified: time.Now().Format("2006-01-02"),
+			Creator:      "edugov",
+			Description:  g.Description,
+		},
+		Graph: gexfGraph{
+			DefaultEdgeType: "undirected",
+			Mode:            "static",
+			Nodes:           make([]gexfNode, len(g.Nodes)),
+			Edges:           make([]gexfEdge, len(g.Edges)),
+		},
+	}
+
+	if len(g.NodeAttributes) > 0 {
+		document.Graph.Attributes = append(document.Graph.Attributes, gexfAttributes{Class: "node", Attributes: gexfAttributeDeclarations(g.NodeAttributes)})
+	}
+	if len(g.EdgeAttributes) > 0 {
+		document.Graph.Attributes = append(document.Graph.Attributes, gexfAttributes{Class: "edge", Attributes: gexfAttributeDeclarations(g.EdgeAttributes)})
+	}
+
+	for index, node := range g.Nodes {
+		document.Graph.Nodes[index] = gexfNode{
+			ID:        node.ID,
+			Label:     node.Label,
+			AttValues: gexfValuesOf(g.NodeAttributes, node.Values),
+		}
+	}
+
+	for index, edge := range g.Edges {
+		document.Graph.Edges[index] = gexfEdge{
+			ID:        strconv.Itoa(index),
+			Source:    edge.Source,
+			Target:    edge.Target,
+			Weight:    strconv.FormatFloat(edge.Weight, 'f', -1, 64),
+			AttValues: gexfValuesOf(g.EdgeAttributes, edge.Values),
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+func gexfAttributeDeclarations(attributes []Attribute) []gexfAttribute {
+	declarations := make([]gexfAttribute, len(attributes))
+	for index, attribute := range attributes {
+		declarations[index] = gexfAttribute{
+			ID:    attribute.ID,
+			Title: attribute.Title,
+			Type:  attribute.Type,
+		}
+	}
+
+	return declarations
+}
+
+// gexfValuesOf lists the values in the order the attributes are declared, missing values are left out
+func gexfValuesOf(attributes []Attribute, values map[string]string) *gexfAttValues {
+	attValues := &gexfAttValues{}
+	for _, attribute := range attributes {
+		if value, ok := values[attribute.ID]; ok {
+			attValues.AttValues = append(attValues.AttValues, gexfAttValue{For: attribute.ID, Value: value})
+		}
+	}
+
+	if len(attValues.AttValues) == 0 {
+		return nil
+	}
+
+	return attValues
+}
@@ -0,0 +1,152 @@
+package languages
+
+import (
+	"backend/internal/domain"
+	"fmt"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ValidationTag is the struct tag validating that a field holds the code of an enabled language
+const ValidationTag = "language"
+
+// registry is the in-memory copy of the languages table.
+// It is consulted on every request, so it is loaded once at start up and reloaded periodically afterwards
+type registry struct {
+	mu          sync.RWMutex
+	enabled     []*domain.Language
+	defaultCode string
+}
+
+// builtinLanguages mirror the seed of the languages table and are used until the registry is loaded
+var builtinLanguages = []*domain.Language{
+	{Code: "tg", Name: "Tajik", NativeName: "Тоҷикӣ", Enabled: true, IsDefault: true, SortOrder: 1},
+	{Code: "ru", Name: "Russian", NativeName: "Русский", Enabled: true, SortOrder: 2},
+	{Code: "en", Name: "English", NativeName: "English", Enabled: true, SortOrder: 3},
+}
+
+var defaultRegistry = newRegistry()
+
+func newRegistry() *registry {
+	r := &registry{}
+	_ = r.load(builtinLanguages)
+	return r
+}
+
+func (r *registry) load(languages []*domain.Language) error {
+	enabled := []*domain.Language{}
+	defaultCode := ""
+	for _, language := range languages {
+		if !language.Enabled {
+			continue
+		}
+
+		enabled = append(enabled, language)
+		if language.IsDefault {
+			defaultCode = language.Code
+		}
+	}
+
+	if len(enabled) == 0 {
+		return fmt.Errorf("no enabled language in the registry")
+	}
+	if defaultCode == "" {
+		defaultCode = enabled[0].Code
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.enabled = enabled
+	r.defaultCode = defaultCode
+
+	return nil
+}
+
+// Load replaces the registry with the given languages, ordered as they should be listed.
+// Disabled languages are dropped, the registry is left untouched when none of the languages is enabled
+func Load(languages []*domain.Language) error {
+	return defaultRegistry.load(languages)
+}
+
+// IsEnabled reports whether content can be written and served in the language with the given code
+func IsEnabled(code string) bool {
+	defaultRegistry.mu.RLock()
+	defer defaultRegistry.mu.RUnlock()
+
+	for _, language := range defaultRegistry.enabled {
+		if language.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Codes returns codes of enabled languages in registry order
+func Codes() []string {
+	defaultRegistry.mu.RLock()
+	defer defaultRegistry.mu.RUnlock()
+
+	codes := make([]string, len(defaultRegistry.enabled))
+	for index, language := range defaultRegistry.enabled {
+		codes[index] = language.Code
+	}
+
+	return codes
+}
+
+// Enabled returns enabled languages in registry order
+func Enabled() []*domain.Language {
+	defaultRegistry.mu.RLock()
+	defer defaultRegistry.mu.RUnlock()
+
+	enabled := make([]*domain.Language, len(defaultRegistry.enabled))
+	copy(enabled, defaultRegistry.enabled)
+
+	return enabled
+}
+
+// Default returns the code of the language served when the client has no acceptable preference
+func Default() string {
+	defaultRegistry.mu.RLock()
+	defer defaultRegistry.mu.RUnlock()
+
+	return defaultRegistry.defaultCode
+}
+
+// Localize picks the entry of a message catalog in the given language.
+// Languages without an entry in the catalog get the default language, then the first language of the registry having one,
+// then the first built-in language having one, so a newly added language is served before its messages are translated
+// even when the built-in languages the catalogs are written in are disabled
+func Localize[T any](catalog map[string]T, code string) T {
+	if message, ok := catalog[code]; ok {
+		return message
+	}
+
+	if message, ok := catalog[Default()]; ok {
+		return message
+	}
+
+	for _, fallbackCode := range Codes() {
+		if message, ok := catalog[fallbackCode]; ok {
+			return message
+		}
+	}
+
+	for _, language := range builtinLanguages {
+		if message, ok := catalog[language.Code]; ok {
+			return message
+		}
+	}
+
+	var empty T
+	return empty
+}
+
+// RegisterValidation makes the ValidationTag available to the validator
+func RegisterValidation(v *validator.Validate) error {
+	return v.RegisterValidation(ValidationTag, func(fl validator.FieldLevel) bool {
+		return IsEnabled(fl.Field().String())
+	})
+}
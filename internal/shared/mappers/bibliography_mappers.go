@@ -0,0 +1,66 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+	"backend/internal/shared/bibliography"
+)
+
+func MapEmployeePublicationDomainToBibliographyEntry(employeePublication *domain.EmployeePublication) bibliography.Entry {
+	if employeePublication == nil {
+		return bibliography.Entry{}
+	}
+
+	entryType := employeePublication.PublicationType
+	if entryType == "" {
+		entryType = "misc"
+	}
+
+	return bibliography.Entry{
+		Type:    entryType,
+		Title:   employeePublication.PublicationTitle,
+		Authors: employeePublication.Authors,
+		Year:    employeePublication.PublicationYear,
+		Venue:   employeePublication.Venue,
+		DOI:     employeePublication.DOI,
+		URL:     employeePublication.LinkToPublication,
+	}
+}
+
+func MapBibliographyEntryToImportEntryRequest(entry bibliography.Entry) *dtos.ImportEmployeePublicationEntryRequest {
+	link := entry.URL
+	if link == "" && entry.DOI != "" {
+		link = "https://doi.org/" + entry.DOI
+	}
+
+	authors := entry.Authors
+	if authors == nil {
+		authors = []string{}
+	}
+
+	return &dtos.ImportEmployeePublicationEntryRequest{
+		PublicationTitle:  entry.Title,
+		LinkToPublication: link,
+		Authors:           authors,
+		PublicationYear:   entry.Year,
+		Venue:             entry.Venue,
+		DOI:               entry.DOI,
+		PublicationType:   entry.Type,
+	}
+}
+
+func MapPublicationMetadataDomainToResponseDTO(publicationMetadata *domain.PublicationMetadata) *dtos.PublicationMetadataResponse {
+	if publicationMetadata == nil {
+		return nil
+	}
+
+	return &dtos.PublicationMetadataResponse{
+		DOI:               publicationMetadata.DOI,
+		PublicationTitle:  publicationMetadata.PublicationTitle,
+		LinkToPublication: publicationMetadata.LinkToPublication,
+		Authors:           publicationMetadata.Authors,
+		PublicationYear:   publicationMetadata.PublicationYear,
+		Venue:             publicationMetadata.Venue,
+		PublicationType:   publicationMetadata.PublicationType,
+	}
+}
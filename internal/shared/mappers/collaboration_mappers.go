@@ -0,0 +1,71 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+	"backend/internal/shared/graph"
+	"strconv"
+	"strings"
+)
+
+var collaborationKinds = []string{
+	domain.CollaborationKindPublication,
+	domain.CollaborationKindProject,
+	domain.CollaborationKindEvent,
+	domain.CollaborationKindCommunity,
+}
+
+// MapCollaborationGraphResponseToGraph maps the network for export, counts of every kind become edge attributes
+func MapCollaborationGraphResponseToGraph(collaborationGraph *dtos.CollaborationGraphResponse, description string) graph.Graph {
+	result := graph.Graph{
+		Description: description,
+		NodeAttributes: []graph.Attribute{
+			{ID: "workplace", Title: "Current workplace", Type: "string"},
+			{ID: "institution_id", Title: "Current institution", Type: "long"},
+			{ID: "ego", Title: "Ego", Type: "boolean"},
+			{ID: "external", Title: "External", Type: "boolean"},
+		},
+		EdgeAttributes: []graph.Attribute{
+			{ID: "types", Title: "Types", Type: "string"},
+		},
+		Nodes: make([]graph.Node, len(collaborationGraph.Nodes)),
+		Edges: make([]graph.Edge, len(collaborationGraph.Edges)),
+	}
+	for _, kind := range collaborationKinds {
+		result.EdgeAttributes = append(result.EdgeAttributes, graph.Attribute{ID: kind, Title: kind, Type: "integer"})
+	}
+
+	for index, node := range collaborationGraph.Nodes {
+		result.Nodes[index] = graph.Node{
+			ID:    node.ID,
+			Label: node.Label,
+			Values: map[string]string{
+				"workplace":      node.CurrentWorkplace,
+				"institution_id": strconv.FormatInt(node.CurrentInstitutionID, 10),
+				"ego":            strconv.FormatBool(node.IsEgo),
+				"external":       strconv.FormatBool(node.IsExternal),
+			},
+		}
+	}
+
+	for index, edge := range collaborationGraph.Edges {
+		values := map[string]string{}
+		types := []string{}
+		for _, kind := range collaborationKinds {
+			if count, ok := edge.Types[kind]; ok {
+				values[kind] = strconv.FormatInt(int64(count), 10)
+				types = append(types, kind)
+			}
+		}
+		values["types"] = strings.Join(types, ",")
+
+		result.Edges[index] = graph.Edge{
+			Source: edge.Source,
+			Target: edge.Target,
+			Weight: float64(edge.Weight),
+			Values: values,
+		}
+	}
+
+	return result
+}
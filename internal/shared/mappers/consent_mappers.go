@@ -0,0 +1,37 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+)
+
+func MapConsentDocumentDomainToResponseDTO(document *domain.ConsentDocument) *dtos.ConsentDocumentResponse {
+	if document == nil {
+		return nil
+	}
+
+	return &dtos.ConsentDocumentResponse{
+		Version:      document.Version,
+		LanguageCode: document.LanguageCode,
+		Title:        document.Title,
+		Body:         document.Body,
+		PublishedAt:  document.PublishedAt,
+	}
+}
+
+func MapUserConsentDomainToResponseDTO(consent *domain.UserConsent) *dtos.UserConsentResponse {
+	if consent == nil {
+		return nil
+	}
+
+	return &dtos.UserConsentResponse{
+		ID:               consent.ID,
+		UserID:           consent.UserID,
+		Email:            consent.Email,
+		EmployeeUniqueID: consent.EmployeeUniqueID,
+		DocumentVersion:  consent.DocumentVersion,
+		LanguageCode:     consent.LanguageCode,
+		IPAddress:        consent.IPAddress,
+		AcceptedAt:       consent.AcceptedAt,
+	}
+}
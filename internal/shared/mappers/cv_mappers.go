@@ -0,0 +1,144 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/shared/cv"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MapEmployeeResponseToCVDocument assembles a CV from the employee profile retrived in the given language
+func MapEmployeeResponseToCVDocument(employee *dtos.EmployeeResponse, langCode string) *cv.Document {
+	doc := &cv.Document{
+		LanguageCode: langCode,
+		Contacts:     []string{},
+	}
+
+	var selectedDetails *dtos.EmployeeDetailsResponse
+	for _, details := range employee.Details {
+		if !details.IsEmployeeDetailsNew {
+			continue
+		}
+
+		if selectedDetails == nil || details.LanguageCode == langCode {
+			selectedDetails = details
+		}
+	}
+	if selectedDetails != nil {
+		doc.FullName = strings.Join(strings.Fields(selectedDetails.Surname+" "+selectedDetails.Name+" "+selectedDetails.Middlename), " ")
+	}
+
+	for _, workExperience := range employee.WorkExperiences {
+		if workExperience.Ongoing {
+			doc.Headline = joinNonEmpty(", ", workExperience.JobTitle, workExperience.Workplace)
+			break
+		}
+	}
+
+	if employee.ORCID != "" {
+		doc.Contacts = append(doc.Contacts, "ORCID: "+employee.ORCID)
+	}
+	for _, social := range employee.Socials {
+		doc.Contacts = append(doc.Contacts, social.LinkToSocial)
+	}
+
+	education := cv.Section{Kind: cv.SectionEducation, Title: cv.SectionTitle(cv.SectionEducation, langCode)}
+	for _, degree := range employee.Degrees {
+		education.Items = append(education.Items, cv.Item{
+			Title:    joinNonEmpty(", ", degree.DegreeLevel, degree.Speciality),
+			Subtitle: degree.UniversityName,
+			Period:   cv.Period(cvYear(degree.DateStart), cvYear(degree.DateEnd)),
+			Details:  degree.GivenBy,
+		})
+	}
+
+	workExperiences := cv.Section{Kind: cv.SectionWorkExperience, Title: cv.SectionTitle(cv.SectionWorkExperience, langCode)}
+	for _, workExperience := range employee.WorkExperiences {
+		end := cvMonth(workExperience.DateEnd)
+		if workExperience.Ongoing {
+			end = cv.PresentLabel(langCode)
+		}
+
+		workExperiences.Items = append(workExperiences.Items, cv.Item{
+			Title:    workExperience.JobTitle,
+			Subtitle: workExperience.Workplace,
+			Period:   cv.Period(cvMonth(workExperience.DateStart), end),
+			Details:  workExperience.Description,
+		})
+	}
+
+	publications := cv.Section{Kind: cv.SectionPublications, Title: cv.SectionTitle(cv.SectionPublications, langCode)}
+	for _, publication := range employee.Publications {
+		reference := publication.LinkToPublication
+		if publication.DOI != "" {
+			reference = "https://doi.org/" + publication.DOI
+		}
+
+		year := ""
+		if publication.PublicationYear != 0 {
+			year = strconv.Itoa(int(publication.PublicationYear))
+		}
+
+		publications.Items = append(publications.Items, cv.Item{
+			Title:    publication.PublicationTitle,
+			Subtitle: joinNonEmpty(". ", strings.Join(publication.Authors, ", "), publication.Venue),
+			Period:   year,
+			Details:  reference,
+		})
+	}
+
+	awards := cv.Section{Kind: cv.SectionAwards, Title: cv.SectionTitle(cv.SectionAwards, langCode)}
+	for _, award := range employee.ScientificAwards {
+		awards.Items = append(awards.Items, cv.Item{
+			Title:    award.ScientificAwardTitle,
+			Subtitle: award.GivenBy,
+		})
+	}
+
+	patents := cv.Section{Kind: cv.SectionPatents, Title: cv.SectionTitle(cv.SectionPatents, langCode)}
+	for _, patent := range employee.Patents {
+		patents.Items = append(patents.Items, cv.Item{
+			Title:   patent.PatentTitle,
+			Details: patent.Description,
+		})
+	}
+
+	courses := cv.Section{Kind: cv.SectionCourses, Title: cv.SectionTitle(cv.SectionCourses, langCode)}
+	for _, course := range employee.RefresherCourses {
+		courses.Items = append(courses.Items, cv.Item{
+			Title:  course.CourseTitle,
+			Period: cv.Period(cvMonth(course.DateStart), cvMonth(course.DateEnd)),
+		})
+	}
+
+	doc.Sections = []cv.Section{education, workExperiences, publications, awards, patents, courses}
+	return doc
+}
+
+func cvYear(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	return date.Format("2006")
+}
+
+func cvMonth(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	return date.Format("01.2006")
+}
+
+func joinNonEmpty(separator string, parts ...string) string {
+	nonEmpty := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+
+	return strings.Join(nonEmpty, separator)
+}
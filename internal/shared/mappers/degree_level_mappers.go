@@ -0,0 +1,32 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+	"backend/internal/shared/languages"
+)
+
+// MapDegreeLevelDomainToResponseDTO maps the level with its name picked in langCode or its fallback
+func MapDegreeLevelDomainToResponseDTO(degreeLevel *domain.DegreeLevel, langCode string) *dtos.DegreeLevelResponse {
+	if degreeLevel == nil {
+		return nil
+	}
+
+	return &dtos.DegreeLevelResponse{
+		Code:  degreeLevel.Code,
+		Rank:  degreeLevel.Rank,
+		Name:  languages.Localize(degreeLevel.Names, langCode),
+		Names: degreeLevel.Names,
+	}
+}
+
+func MapHighestAcademicDegreeDomainToResponseDTO(degree *domain.HighestAcademicDegree) *dtos.HighestAcademicDegreeResponse {
+	if degree == nil {
+		return nil
+	}
+
+	return &dtos.HighestAcademicDegreeResponse{
+		Code: degree.Code,
+		Name: degree.Name,
+	}
+}
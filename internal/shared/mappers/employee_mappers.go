@@ -3,20 +3,58 @@ package mappers
 import (
 	"backend/internal/application/dtos"
 	"backend/internal/domain"
+	"sort"
 )
 
-func MapEmployeeDomainToResponseDTO(employee *domain.Employee) *dtos.EmployeeResponse {
+// MapEmployeeDomainToResponseDTO leaves out the fields the viewer is not allowed to see by visibility
+func MapEmployeeDomainToResponseDTO(employee *domain.Employee, visibility *domain.EmployeeVisibility) *dtos.EmployeeResponse {
 	if employee == nil {
 		return nil
 	}
 
-	return &dtos.EmployeeResponse{
-		ID:        employee.ID,
-		UniqueID:  employee.UniqueID,
-		Gender:    employee.Gender,
-		CreatedAt: employee.CreatedAt,
-		UpdatedAt: employee.UpdatedAt,
+	resp := &dtos.EmployeeResponse{
+		ID:                   employee.ID,
+		UniqueID:             employee.UniqueID,
+		CurrentInstitutionID: employee.CurrentInstitutionID,
+		CurrentOrgUnitID:     employee.CurrentOrgUnitID,
+		CreatedAt:            employee.CreatedAt,
+		UpdatedAt:            employee.UpdatedAt,
 	}
+
+	if visibility.Allows(domain.VisibilityFieldGender) {
+		resp.Gender = employee.Gender
+	}
+	if visibility.Allows(domain.VisibilityFieldORCID) {
+		resp.ORCID = employee.ORCID
+	}
+	if visibility.Allows(domain.VisibilityFieldTIN) {
+		resp.TIN = employee.Tin
+	}
+
+	return resp
+}
+
+func MapEmployeeVisibilityDomainToResponseDTO(visibility *domain.EmployeeVisibility) []*dtos.EmployeeVisibilitySettingResponse {
+	if visibility == nil {
+		return nil
+	}
+
+	targets := make([]string, 0, len(domain.DefaultVisibility))
+	for target := range domain.DefaultVisibility {
+		targets = append(targets, target)
+	}
+	sort.Strings(targets)
+
+	resp := make([]*dtos.EmployeeVisibilitySettingResponse, len(targets))
+	for index, target := range targets {
+		resp[index] = &dtos.EmployeeVisibilitySettingResponse{
+			Target:       target,
+			Level:        visibility.LevelOf(target),
+			DefaultLevel: domain.DefaultVisibility[target],
+		}
+	}
+
+	return resp
 }
 
 func MapEmployeeDetailsDomainIntoResponseDTO(employeeDetails *domain.EmployeeDetails) *dtos.EmployeeDetailsResponse {
@@ -31,6 +69,8 @@ func MapEmployeeDetailsDomainIntoResponseDTO(employeeDetails *domain.EmployeeDet
 		Name:                 employeeDetails.Name,
 		Middlename:           employeeDetails.Middlename,
 		IsEmployeeDetailsNew: employeeDetails.IsEmployeeDetailsNew,
+		EffectiveFrom:        employeeDetails.EffectiveFrom,
+		EffectiveTo:          employeeDetails.EffectiveTo,
 		CreatedAt:            employeeDetails.CreatedAt,
 		UpdatedAt:            employeeDetails.UpdatedAt,
 	}
@@ -43,9 +83,13 @@ func MapEmployeeDegreeDomainToResponseDTO(employeeDegree *domain.EmployeeDegree)
 
 	return &dtos.EmployeeDegreeResponse{
 		ID:                 employeeDegree.ID,
+		TranslationGroupID: employeeDegree.TranslationGroupID,
+		LanguageCode:       employeeDegree.LanguageCode,
+		DegreeLevelCode:    employeeDegree.DegreeLevelCode,
 		DegreeLevel:        employeeDegree.DegreeLevel,
 		UniversityName:     employeeDegree.UniversityName,
 		Speciality:         employeeDegree.Speciality,
+		SpecialityCode:     employeeDegree.SpecialityCode,
 		DateStart:          employeeDegree.DateStart,
 		DateEnd:            employeeDegree.DateEnd,
 		GivenBy:            employeeDegree.GivenBy,
@@ -61,15 +105,20 @@ func MapEmployeeWorkExperienceDomainToResponseDTO(employeeWorkExperience *domain
 	}
 
 	return &dtos.EmployeeWorkExperienceResponse{
-		ID:          employeeWorkExperience.ID,
-		Workplace:   employeeWorkExperience.Workplace,
-		JobTitle:    employeeWorkExperience.JobTitle,
-		Description: employeeWorkExperience.Description,
-		DateStart:   employeeWorkExperience.DateStart,
-		DateEnd:     employeeWorkExperience.DateEnd,
-		Ongoing:     employeeWorkExperience.Ongoing,
-		CreatedAt:   employeeWorkExperience.CreatedAt,
-		UpdatedAt:   employeeWorkExperience.UpdatedAt,
+		ID:                 employeeWorkExperience.ID,
+		TranslationGroupID: employeeWorkExperience.TranslationGroupID,
+		LanguageCode:       employeeWorkExperience.LanguageCode,
+		InstitutionID:      employeeWorkExperience.InstitutionID,
+		OrgUnitID:          employeeWorkExperience.OrgUnitID,
+		Workplace:          employeeWorkExperience.Workplace,
+		JobTitle:           employeeWorkExperience.JobTitle,
+		Description:        employeeWorkExperience.Description,
+		DateStart:          employeeWorkExperience.DateStart,
+		DateEnd:            employeeWorkExperience.DateEnd,
+		Ongoing:            employeeWorkExperience.Ongoing,
+		Source:             employeeWorkExperience.Source,
+		CreatedAt:          employeeWorkExperience.CreatedAt,
+		UpdatedAt:          employeeWorkExperience.UpdatedAt,
 	}
 }
 
@@ -82,19 +131,24 @@ func MapEmployeeMainResearchAreaDomainToResponseDTO(employeeMRA *domain.Employee
 	for index, kt := range employeeMRA.KeyTopics {
 		keyTopics[index] = &dtos.ResearchAreaKeyTopicResponse{
 			ID:            kt.ID,
+			LanguageCode:  kt.LanguageCode,
 			KeyTopicTitle: kt.KeyTopicTitle,
+			KeywordID:     kt.KeywordID,
 			CreatedAt:     kt.CreatedAt,
 			UpdatedAt:     kt.UpdatedAt,
 		}
 	}
 
 	return &dtos.EmployeeMainResearchAreaResponse{
-		ID:         employeeMRA.ID,
-		Discipline: employeeMRA.Discipline,
-		Area:       employeeMRA.Area,
-		KeyTopics:  keyTopics,
-		CreatedAt:  employeeMRA.CreatedAt,
-		UpdatedAt:  employeeMRA.UpdatedAt,
+		ID:                 employeeMRA.ID,
+		TranslationGroupID: employeeMRA.TranslationGroupID,
+		LanguageCode:       employeeMRA.LanguageCode,
+		Discipline:         employeeMRA.Discipline,
+		Area:               employeeMRA.Area,
+		ResearchFieldCode:  employeeMRA.ResearchFieldCode,
+		KeyTopics:          keyTopics,
+		CreatedAt:          employeeMRA.CreatedAt,
+		UpdatedAt:          employeeMRA.UpdatedAt,
 	}
 }
 
@@ -104,11 +158,21 @@ func MapEmployeePublicationDomainToResponseDTO(employeePublication *domain.Emplo
 	}
 
 	return &dtos.EmployeePublicationResponse{
-		ID:                employeePublication.ID,
-		PublicationTitle:  employeePublication.PublicationTitle,
-		LinkToPublication: employeePublication.LinkToPublication,
-		CreatedAt:         employeePublication.CreatedAt,
-		UpdatedAt:         employeePublication.UpdatedAt,
+		ID:                 employeePublication.ID,
+		TranslationGroupID: employeePublication.TranslationGroupID,
+		LanguageCode:       employeePublication.LanguageCode,
+		PublicationTitle:   employeePublication.PublicationTitle,
+		LinkToPublication:  employeePublication.LinkToPublication,
+		Authors:            employeePublication.Authors,
+		PublicationYear:    employeePublication.PublicationYear,
+		Venue:              employeePublication.Venue,
+		DOI:                employeePublication.DOI,
+		PublicationType:    employeePublication.PublicationType,
+		Source:             employeePublication.Source,
+		PublicationID:      employeePublication.PublicationID,
+		Citations:          MapPublicationCitationDomainToResponseDTO(employeePublication.Citation),
+		CreatedAt:          employeePublication.CreatedAt,
+		UpdatedAt:          employeePublication.UpdatedAt,
 	}
 }
 
@@ -119,6 +183,8 @@ func MapEmployeeScientificAwardDomainToResponseDTO(employeeScientificAward *doma
 
 	return &dtos.EmployeeScientificAwardResponse{
 		ID:                   employeeScientificAward.ID,
+		TranslationGroupID:   employeeScientificAward.TranslationGroupID,
+		LanguageCode:         employeeScientificAward.LanguageCode,
 		ScientificAwardTitle: employeeScientificAward.ScientificAwardTitle,
 		GivenBy:              employeeScientificAward.GivenBy,
 		CreatedAt:            employeeScientificAward.CreatedAt,
@@ -132,11 +198,13 @@ func MapEmployeePatentDomainToResponseDTO(employeePatent *domain.EmployeePatent)
 	}
 
 	return &dtos.EmployeePatentResponse{
-		ID:          employeePatent.ID,
-		PatentTitle: employeePatent.PatentTitle,
-		Description: employeePatent.Description,
-		CreatedAt:   employeePatent.CreatedAt,
-		UpdatedAt:   employeePatent.UpdatedAt,
+		ID:                 employeePatent.ID,
+		TranslationGroupID: employeePatent.TranslationGroupID,
+		LanguageCode:       employeePatent.LanguageCode,
+		PatentTitle:        employeePatent.PatentTitle,
+		Description:        employeePatent.Description,
+		CreatedAt:          employeePatent.CreatedAt,
+		UpdatedAt:          employeePatent.UpdatedAt,
 	}
 }
 
@@ -147,6 +215,8 @@ func MapEmployeeParticipationInProfessionalCommunityDomainToResponseDTO(employee
 
 	return &dtos.EmployeeParticipationInProfessionalCommunityResponse{
 		ID:                          employeeParticipationInProfessionalCommunity.ID,
+		TranslationGroupID:          employeeParticipationInProfessionalCommunity.TranslationGroupID,
+		LanguageCode:                employeeParticipationInProfessionalCommunity.LanguageCode,
 		ProfessionalCommunityTitle:  employeeParticipationInProfessionalCommunity.ProfessionalCommunityTitle,
 		RoleInProfessionalCommunity: employeeParticipationInProfessionalCommunity.RoleInProfessionalCommunity,
 		CreatedAt:                   employeeParticipationInProfessionalCommunity.CreatedAt,
@@ -160,12 +230,14 @@ func MapEmployeeRefresherCourseDomainToResponseDTO(employeeRefresherCourse *doma
 	}
 
 	return &dtos.EmployeeRefresherCourseResponse{
-		ID:          employeeRefresherCourse.ID,
-		CourseTitle: employeeRefresherCourse.CourseTitle,
-		DateStart:   employeeRefresherCourse.DateStart,
-		DateEnd:     employeeRefresherCourse.DateEnd,
-		CreatedAt:   employeeRefresherCourse.CreatedAt,
-		UpdatedAt:   employeeRefresherCourse.UpdatedAt,
+		ID:                 employeeRefresherCourse.ID,
+		TranslationGroupID: employeeRefresherCourse.TranslationGroupID,
+		LanguageCode:       employeeRefresherCourse.LanguageCode,
+		CourseTitle:        employeeRefresherCourse.CourseTitle,
+		DateStart:          employeeRefresherCourse.DateStart,
+		DateEnd:            employeeRefresherCourse.DateEnd,
+		CreatedAt:          employeeRefresherCourse.CreatedAt,
+		UpdatedAt:          employeeRefresherCourse.UpdatedAt,
 	}
 }
 
@@ -175,11 +247,13 @@ func MapEmployeeParticipationInEventDomainToResponseDTO(employeeParticipationInE
 	}
 
 	return &dtos.EmployeeParticipationInEventResponse{
-		ID:         employeeParticipationInEvent.ID,
-		EventTitle: employeeParticipationInEvent.EventTitle,
-		EventDate:  employeeParticipationInEvent.EventDate,
-		CreatedAt:  employeeParticipationInEvent.CreatedAt,
-		UpdatedAt:  employeeParticipationInEvent.UpdatedAt,
+		ID:                 employeeParticipationInEvent.ID,
+		TranslationGroupID: employeeParticipationInEvent.TranslationGroupID,
+		LanguageCode:       employeeParticipationInEvent.LanguageCode,
+		EventTitle:         employeeParticipationInEvent.EventTitle,
+		EventDate:          employeeParticipationInEvent.EventDate,
+		CreatedAt:          employeeParticipationInEvent.CreatedAt,
+		UpdatedAt:          employeeParticipationInEvent.UpdatedAt,
 	}
 }
 
@@ -190,6 +264,8 @@ func MapEmployeeResearchActivityDomainToResponseDTO(employeeResearchActivity *do
 
 	return &dtos.EmployeeResearchActivityResponse{
 		ID:                    employeeResearchActivity.ID,
+		TranslationGroupID:    employeeResearchActivity.TranslationGroupID,
+		LanguageCode:          employeeResearchActivity.LanguageCode,
 		ResearchActivityTitle: employeeResearchActivity.ResearchActivityTitle,
 		EmployeeRole:          employeeResearchActivity.EmployeeRole,
 		CreatedAt:             employeeResearchActivity.CreatedAt,
@@ -0,0 +1,33 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+	"fmt"
+	"math"
+)
+
+func MapEmployeeSimilarityDomainToResponseDTO(similarity *domain.EmployeeSimilarity) *dtos.SimilarEmployeeResponse {
+	if similarity == nil {
+		return nil
+	}
+
+	fullname := fmt.Sprintf("%s %s", similarity.Surname, similarity.Name)
+	if similarity.Middlename != "" {
+		fullname += " " + similarity.Middlename
+	}
+
+	return &dtos.SimilarEmployeeResponse{
+		UID:                   similarity.UniqueID,
+		Fullname:              fullname,
+		HighestAcademicDegree: similarity.HighestAcademicDegree,
+		CurrentWorkplace:      similarity.CurrentWorkplace,
+		CurrentInstitutionID:  similarity.CurrentInstitutionID,
+		Score:                 math.Round(similarity.Score*1000) / 1000,
+		SharedResearchAreas:   similarity.SharedResearchAreas,
+		SharedKeywords:        similarity.SharedKeywords,
+		SharedEvents:          similarity.SharedEvents,
+		SharedInstitutions:    similarity.SharedInstitutions,
+		ComputedAt:            similarity.ComputedAt,
+	}
+}
@@ -0,0 +1,22 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+	"backend/internal/shared/languages"
+)
+
+// MapKeywordDomainToResponseDTO maps the keyword with its label picked in langCode or its fallback
+func MapKeywordDomainToResponseDTO(keyword *domain.Keyword, langCode string) *dtos.KeywordResponse {
+	if keyword == nil {
+		return nil
+	}
+
+	return &dtos.KeywordResponse{
+		ID:            keyword.ID,
+		Label:         languages.Localize(keyword.Labels, langCode),
+		Labels:        keyword.Labels,
+		Synonyms:      keyword.Synonyms,
+		EmployeeCount: keyword.EmployeeCount,
+	}
+}
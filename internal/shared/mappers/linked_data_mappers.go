@@ -0,0 +1,174 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+	"strconv"
+	"strings"
+)
+
+// MapEmployeeDomainToPersonJSONLD maps the employee aggregate onto schema.org/Person.
+// Entries of the aggregate are expected to be in langCode or its fallbacks, names in other languages and previous names become alternateName.
+// profileURL identifies the person and is omitted when empty.
+func MapEmployeeDomainToPersonJSONLD(employee *domain.Employee, langCode string, profileURL string) *dtos.PersonJSONLD {
+	if employee == nil {
+		return nil
+	}
+
+	person := &dtos.PersonJSONLD{
+		Context: dtos.SchemaOrgContext,
+		Type:    "Person",
+		ID:      profileURL,
+		URL:     profileURL,
+	}
+
+	var selectedDetails *domain.EmployeeDetails
+	for _, details := range employee.Details {
+		if !details.IsEmployeeDetailsNew {
+			continue
+		}
+
+		if selectedDetails == nil || details.LanguageCode == langCode {
+			selectedDetails = details
+		}
+	}
+	if selectedDetails != nil {
+		person.Name = joinNonEmpty(" ", selectedDetails.Name, selectedDetails.Middlename, selectedDetails.Surname)
+		person.GivenName = selectedDetails.Name
+		person.FamilyName = selectedDetails.Surname
+		person.AdditionalName = selectedDetails.Middlename
+
+		for _, details := range employee.Details {
+			if details != selectedDetails {
+				person.AlternateName = appendUnique(person.AlternateName, joinNonEmpty(" ", details.Name, details.Middlename, details.Surname))
+			}
+		}
+	}
+
+	if employee.ORCID != "" {
+		person.Identifier = append(person.Identifier, dtos.PropertyValueJSONLD{
+			Type:       "PropertyValue",
+			PropertyID: "ORCID",
+			Value:      employee.ORCID,
+		})
+		person.SameAs = append(person.SameAs, "https://orcid.org/"+employee.ORCID)
+	}
+	for _, social := range employee.Socials {
+		person.SameAs = appendUnique(person.SameAs, social.LinkToSocial)
+	}
+
+	for _, workExperience := range employee.WorkExperiences {
+		if !workExperience.Ongoing {
+			continue
+		}
+
+		person.JobTitle = appendUnique(person.JobTitle, workExperience.JobTitle)
+		if workplace := strings.TrimSpace(workExperience.Workplace); workplace != "" && !containsOrganization(person.Affiliation, workplace) {
+			person.Affiliation = append(person.Affiliation, dtos.OrganizationJSONLD{Type: "Organization", Name: workplace})
+		}
+	}
+
+	for _, degree := range employee.Degrees {
+		university := strings.TrimSpace(degree.UniversityName)
+		if university != "" && !containsOrganization(person.AlumniOf, university) {
+			person.AlumniOf = append(person.AlumniOf, dtos.OrganizationJSONLD{Type: "CollegeOrUniversity", Name: university})
+		}
+
+		credential := dtos.CredentialJSONLD{
+			Type:               "EducationalOccupationalCredential",
+			Name:               joinNonEmpty(", ", degree.DegreeLevel, degree.Speciality),
+			CredentialCategory: "degree",
+			EducationalLevel:   degree.DegreeLevel,
+		}
+		if recognizedBy := strings.TrimSpace(degree.GivenBy); recognizedBy != "" {
+			credential.RecognizedBy = &dtos.OrganizationJSONLD{Type: "Organization", Name: recognizedBy}
+		} else if university != "" {
+			credential.RecognizedBy = &dtos.OrganizationJSONLD{Type: "CollegeOrUniversity", Name: university}
+		}
+		if !degree.DateDegreeRecieved.IsZero() {
+			credential.DateCreated = degree.DateDegreeRecieved.Format("2006-01-02")
+		}
+		person.HasCredential = append(person.HasCredential, credential)
+	}
+
+	for _, award := range employee.ScientificAwards {
+		person.Award = appendUnique(person.Award, award.ScientificAwardTitle)
+	}
+
+	return person
+}
+
+// MapInstitutionDomainToCollegeOrUniversityJSONLD maps the institution aggregate with its details onto schema.org/CollegeOrUniversity.
+// profileURL identifies the institution and is omitted when empty, url points to the official website.
+func MapInstitutionDomainToCollegeOrUniversityJSONLD(institution *domain.Institution, profileURL string) *dtos.CollegeOrUniversityJSONLD {
+	if institution == nil {
+		return nil
+	}
+
+	university := &dtos.CollegeOrUniversityJSONLD{
+		Context:   dtos.SchemaOrgContext,
+		Type:      "CollegeOrUniversity",
+		ID:        profileURL,
+		URL:       institution.OfficialWebsite,
+		Email:     institution.Email,
+		Telephone: institution.PhoneNumber,
+		FaxNumber: institution.Fax,
+	}
+
+	if institution.YearOfEstablishment > 0 {
+		university.FoundingDate = strconv.Itoa(int(institution.YearOfEstablishment))
+	}
+
+	address := &dtos.PostalAddressJSONLD{
+		Type:       "PostalAddress",
+		PostalCode: institution.MailIndex,
+	}
+	if details := institution.Details; details != nil {
+		university.Name = details.InstitutionTitleLong
+		if details.InstitutionTitleShort != details.InstitutionTitleLong {
+			university.AlternateName = details.InstitutionTitleShort
+		}
+		university.Description = details.Mission
+
+		address.StreetAddress = details.LegalAddress
+		if details.FactualAddress != nil && strings.TrimSpace(*details.FactualAddress) != "" {
+			address.StreetAddress = *details.FactualAddress
+		}
+		address.AddressLocality = details.City
+	}
+	if address.StreetAddress != "" || address.AddressLocality != "" || address.PostalCode != "" {
+		university.Address = address
+	}
+
+	for _, social := range institution.Socials {
+		university.SameAs = appendUnique(university.SameAs, social.LinkToSocial)
+	}
+
+	return university
+}
+
+// appendUnique appends trimmed value unless it is empty or already present
+func appendUnique(values []string, value string) []string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return values
+	}
+
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+
+	return append(values, value)
+}
+
+func containsOrganization(organizations []dtos.OrganizationJSONLD, name string) bool {
+	for _, organization := range organizations {
+		if organization.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
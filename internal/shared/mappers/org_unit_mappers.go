@@ -0,0 +1,32 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+	"backend/internal/shared/languages"
+)
+
+// MapOrgUnitDomainToResponseDTO maps the unit together with its children, names are picked in langCode or its fallback
+func MapOrgUnitDomainToResponseDTO(orgUnit *domain.OrgUnit, langCode string) *dtos.OrgUnitResponse {
+	if orgUnit == nil {
+		return nil
+	}
+
+	resp := &dtos.OrgUnitResponse{
+		ID:            orgUnit.ID,
+		InstitutionID: orgUnit.InstitutionID,
+		ParentID:      orgUnit.ParentID,
+		UnitType:      orgUnit.UnitType,
+		Name:          languages.Localize(orgUnit.Names, langCode),
+		Names:         orgUnit.Names,
+		SortOrder:     orgUnit.SortOrder,
+		CreatedAt:     orgUnit.CreatedAt,
+		UpdatedAt:     orgUnit.UpdatedAt,
+	}
+
+	for _, child := range orgUnit.Children {
+		resp.Children = append(resp.Children, MapOrgUnitDomainToResponseDTO(child, langCode))
+	}
+
+	return resp
+}
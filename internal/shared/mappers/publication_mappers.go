@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+)
+
+func MapPublicationDomainToResponseDTO(publication *domain.Publication) *dtos.PublicationResponse {
+	if publication == nil {
+		return nil
+	}
+
+	authors := make([]*dtos.PublicationAuthorResponse, len(publication.Authors))
+	for index, author := range publication.Authors {
+		authors[index] = &dtos.PublicationAuthorResponse{
+			Position:    author.Position,
+			AuthorName:  author.AuthorName,
+			IsClaimed:   author.EmployeeID != 0,
+			EmployeeUID: author.EmployeeUniqueID,
+		}
+	}
+
+	return &dtos.PublicationResponse{
+		ID:                publication.ID,
+		PublicationTitle:  publication.PublicationTitle,
+		LinkToPublication: publication.LinkToPublication,
+		PublicationYear:   publication.PublicationYear,
+		Venue:             publication.Venue,
+		DOI:               publication.DOI,
+		PublicationType:   publication.PublicationType,
+		Authors:           authors,
+		CreatedAt:         publication.CreatedAt,
+		UpdatedAt:         publication.UpdatedAt,
+	}
+}
+
+func MapPublicationCitationDomainToResponseDTO(citation *domain.PublicationCitation) *dtos.PublicationCitationResponse {
+	if citation == nil {
+		return nil
+	}
+
+	return &dtos.PublicationCitationResponse{
+		CitationCount: citation.CitationCount,
+		Source:        citation.Source,
+		UpdatedAt:     citation.UpdatedAt,
+	}
+}
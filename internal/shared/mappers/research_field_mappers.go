@@ -0,0 +1,39 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+	"backend/internal/shared/languages"
+)
+
+// MapResearchFieldDomainToResponseDTO maps the taxonomy node with its name picked in langCode or its fallback
+func MapResearchFieldDomainToResponseDTO(researchField *domain.ResearchField, langCode string) *dtos.ResearchFieldResponse {
+	if researchField == nil {
+		return nil
+	}
+
+	return &dtos.ResearchFieldResponse{
+		Code:       researchField.Code,
+		ParentCode: researchField.ParentCode,
+		Level:      researchField.Level,
+		SortOrder:  researchField.SortOrder,
+		Name:       languages.Localize(researchField.Names, langCode),
+		Names:      researchField.Names,
+	}
+}
+
+// MapResearchFieldStatsDomainToResponseDTO maps the counts of a node, names holds the names of the node by language code
+func MapResearchFieldStatsDomainToResponseDTO(stats *domain.ResearchFieldStats, names map[string]string, langCode string) *dtos.ResearchFieldStatsResponse {
+	if stats == nil {
+		return nil
+	}
+
+	return &dtos.ResearchFieldStatsResponse{
+		Code:             stats.Code,
+		ParentCode:       stats.ParentCode,
+		Level:            stats.Level,
+		Name:             languages.Localize(names, langCode),
+		ResearcherCount:  stats.ResearcherCount,
+		InstitutionCount: stats.InstitutionCount,
+	}
+}
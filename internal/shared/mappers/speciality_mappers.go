@@ -0,0 +1,22 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+	"backend/internal/shared/languages"
+)
+
+// MapSpecialityDomainToResponseDTO maps the classifier node with its name picked in langCode or its fallback
+func MapSpecialityDomainToResponseDTO(speciality *domain.Speciality, langCode string) *dtos.SpecialityResponse {
+	if speciality == nil {
+		return nil
+	}
+
+	return &dtos.SpecialityResponse{
+		Code:        speciality.Code,
+		ParentCode:  speciality.ParentCode,
+		Name:        languages.Localize(speciality.Names, langCode),
+		Names:       speciality.Names,
+		HasChildren: speciality.HasChildren,
+	}
+}
@@ -0,0 +1,69 @@
+package mappers
+
+import (
+	"backend/internal/application/dtos"
+	"backend/internal/domain"
+)
+
+func MapWorkplaceClusterDomainToResponseDTO(cluster *domain.WorkplaceCluster) *dtos.WorkplaceClusterResponse {
+	if cluster == nil {
+		return nil
+	}
+
+	variants := make([]*dtos.WorkplaceVariantResponse, len(cluster.Variants))
+	for index, variant := range cluster.Variants {
+		variants[index] = &dtos.WorkplaceVariantResponse{
+			Workplace:       variant.Workplace,
+			LanguageCode:    variant.LanguageCode,
+			ExperienceCount: variant.ExperienceCount,
+			LinkedCount:     variant.LinkedCount,
+			InstitutionIDs:  variant.InstitutionIDs,
+		}
+	}
+
+	return &dtos.WorkplaceClusterResponse{
+		Key:                    cluster.Key,
+		Variants:               variants,
+		ExperienceCount:        cluster.ExperienceCount,
+		LinkedCount:            cluster.LinkedCount,
+		SuggestedInstitutionID: cluster.SuggestedInstitutionID,
+		SuggestionScore:        cluster.SuggestionScore,
+	}
+}
+
+func MapWorkplaceMappingChangeDomainToResponseDTO(change *domain.WorkplaceMappingChange) *dtos.WorkplaceMappingChangeResponse {
+	if change == nil {
+		return nil
+	}
+
+	return &dtos.WorkplaceMappingChangeResponse{
+		WorkExperienceID: change.WorkExperienceID,
+		EmployeeID:       change.EmployeeID,
+		LanguageCode:     change.LanguageCode,
+		Workplace:        change.Workplace,
+		OldInstitutionID: change.OldInstitutionID,
+		NewInstitutionID: change.NewInstitutionID,
+	}
+}
+
+func MapWorkplaceMappingBatchDomainToResponseDTO(batch *domain.WorkplaceMappingBatch) *dtos.WorkplaceMappingBatchResponse {
+	if batch == nil {
+		return nil
+	}
+
+	resp := &dtos.WorkplaceMappingBatchResponse{
+		ID:            batch.ID,
+		InstitutionID: batch.InstitutionID,
+		Workplaces:    batch.Workplaces,
+		Overwrite:     batch.Overwrite,
+		CreatedBy:     batch.CreatedBy,
+		CreatedAt:     batch.CreatedAt,
+		UndoneBy:      batch.UndoneBy,
+		ChangeCount:   batch.ChangeCount,
+	}
+	if !batch.UndoneAt.IsZero() {
+		resp.UndoneAt = &batch.UndoneAt
+	}
+
+	return resp
+}
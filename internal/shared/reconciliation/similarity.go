@@ -0,0 +1,165 @@
+package reconciliation
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize reduces a free-text name to the form variants are compared in:
+// lower case letters and digits separated by single spaces, so quotes, punctuation and spacing do not matter
+func Normalize(name string) string {
+	var builder strings.Builder
+	builder.Grow(len(name))
+
+	pendingSpace := false
+	for _, char := range strings.ToLower(name) {
+		if !unicode.IsLetter(char) && !unicode.IsDigit(char) {
+			pendingSpace = builder.Len() > 0
+			continue
+		}
+
+		if pendingSpace {
+			builder.WriteByte(' ')
+			pendingSpace = false
+		}
+		builder.WriteRune(char)
+	}
+
+	return builder.String()
+}
+
+// trigrams splits every word of a normalized name into character trigrams the way pg_trgm does:
+// words are padded with two spaces in front and one behind
+func trigrams(normalized string) map[string]struct{} {
+	result := map[string]struct{}{}
+	for _, word := range strings.Fields(normalized) {
+		chars := []rune("  " + word + " ")
+		for index := 0; index+3 <= len(chars); index++ {
+			result[string(chars[index:index+3])] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// dice is the Sørensen–Dice coefficient of two trigram sets
+func dice(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for trigram := range a {
+		if _, ok := b[trigram]; ok {
+			shared++
+		}
+	}
+
+	return 2 * float64(shared) / float64(len(a)+len(b))
+}
+
+// Similarity scores how alike two names are from 0 to 1, names equal after normalization score 1
+func Similarity(a, b string) float64 {
+	normalizedA, normalizedB := Normalize(a), Normalize(b)
+	if normalizedA == normalizedB {
+		return 1
+	}
+
+	return dice(trigrams(normalizedA), trigrams(normalizedB))
+}
+
+// Cluster groups names so that every name is in the group of each name it is at least threshold similar to.
+// Groups are returned as indexes into names, in order of their first name
+func Cluster(names []string, threshold float64) [][]int {
+	normalized := make([]string, len(names))
+	nameTrigrams := make([]map[string]struct{}, len(names))
+	for index, name := range names {
+		normalized[index] = Normalize(name)
+		nameTrigrams[index] = trigrams(normalized[index])
+	}
+
+	parents := make([]int, len(names))
+	for index := range parents {
+		parents[index] = index
+	}
+
+	var find func(index int) int
+	find = func(index int) int {
+		if parents[index] != index {
+			parents[index] = find(parents[index])
+		}
+		return parents[index]
+	}
+
+	for i := range names {
+		for j := i + 1; j < len(names); j++ {
+			if find(i) == find(j) {
+				continue
+			}
+
+			if normalized[i] == normalized[j] || dice(nameTrigrams[i], nameTrigrams[j]) >= threshold {
+				rootI, rootJ := find(i), find(j)
+				if rootI < rootJ {
+					parents[rootJ] = rootI
+				} else {
+					parents[rootI] = rootJ
+				}
+			}
+		}
+	}
+
+	clusters := [][]int{}
+	clusterIndexes := map[int]int{}
+	for index := range names {
+		root := find(index)
+		clusterIndex, ok := clusterIndexes[root]
+		if !ok {
+			clusterIndex = len(clusters)
+			clusterIndexes[root] = clusterIndex
+			clusters = append(clusters, []int{})
+		}
+
+		clusters[clusterIndex] = append(clusters[clusterIndex], index)
+	}
+
+	return clusters
+}
+
+// Matcher finds the most similar of a fixed list of names, the list is prepared once for repeated lookups
+type Matcher struct {
+	normalized []string
+	trigrams   []map[string]struct{}
+}
+
+func NewMatcher(names []string) *Matcher {
+	matcher := &Matcher{
+		normalized: make([]string, len(names)),
+		trigrams:   make([]map[string]struct{}, len(names)),
+	}
+	for index, name := range names {
+		matcher.normalized[index] = Normalize(name)
+		matcher.trigrams[index] = trigrams(matcher.normalized[index])
+	}
+
+	return matcher
+}
+
+// Match returns the index of the name most similar to the given one and its similarity, -1 when the list is empty
+func (m *Matcher) Match(name string) (int, float64) {
+	normalized := Normalize(name)
+	nameTrigrams := trigrams(normalized)
+
+	bestIndex, bestScore := -1, 0.0
+	for index := range m.normalized {
+		score := 1.0
+		if m.normalized[index] != normalized {
+			score = dice(nameTrigrams, m.trigrams[index])
+		}
+
+		if bestIndex < 0 || score > bestScore {
+			bestIndex, bestScore = index, score
+		}
+	}
+
+	return bestIndex, bestScore
+}
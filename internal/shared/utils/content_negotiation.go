@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	MediaTypeJSON   = "application/json"
+	MediaTypeJSONLD = "application/ld+json"
+)
+
+type mediaRange struct {
+	mediaType string
+	subtype   string
+	quality   float64
+}
+
+// NegotiateMediaType picks the offered media type the client prefers according to the Accept header (RFC 9110, section 12.5.1).
+// The first offer is returned when the header is missing, malformed or none of the offers is acceptable,
+// so clients that do not negotiate keep receiving the default representation.
+func NegotiateMediaType(r *http.Request, offers ...string) string {
+	if len(offers) == 0 {
+		return ""
+	}
+
+	ranges := parseAccept(r.Header.Values("Accept"))
+	if len(ranges) == 0 {
+		return offers[0]
+	}
+
+	best := offers[0]
+	bestQuality := 0.0
+	for _, offer := range offers {
+		offerType, offerSubtype, ok := strings.Cut(offer, "/")
+		if !ok {
+			continue
+		}
+
+		// the most specific matching range decides the quality of the offer
+		quality, specificity := 0.0, -1
+		for _, accepted := range ranges {
+			rangeSpecificity := -1
+			switch {
+			case accepted.mediaType == offerType && accepted.subtype == offerSubtype:
+				rangeSpecificity = 2
+			case accepted.mediaType == offerType && accepted.subtype == "*":
+				rangeSpecificity = 1
+			case accepted.mediaType == "*" && accepted.subtype == "*":
+				rangeSpecificity = 0
+			}
+
+			if rangeSpecificity > specificity {
+				quality, specificity = accepted.quality, rangeSpecificity
+			}
+		}
+
+		if quality > bestQuality {
+			best, bestQuality = offer, quality
+		}
+	}
+
+	return best
+}
+
+// parseAccept splits Accept header values into media ranges, invalid ranges are skipped
+func parseAccept(values []string) []mediaRange {
+	ranges := []mediaRange{}
+	for _, value := range values {
+		for _, element := range strings.Split(value, ",") {
+			parameters := strings.Split(element, ";")
+			mediaType, subtype, ok := strings.Cut(strings.ToLower(strings.TrimSpace(parameters[0])), "/")
+			if !ok || mediaType == "" || subtype == "" || (mediaType == "*" && subtype != "*") {
+				continue
+			}
+
+			accepted := mediaRange{mediaType: mediaType, subtype: subtype, quality: 1}
+			for _, parameter := range parameters[1:] {
+				name, rawValue, _ := strings.Cut(strings.TrimSpace(parameter), "=")
+				if !strings.EqualFold(strings.TrimSpace(name), "q") {
+					continue
+				}
+
+				quality, err := strconv.ParseFloat(strings.TrimSpace(rawValue), 64)
+				if err != nil || quality < 0 || quality > 1 {
+					quality = 0
+				}
+				accepted.quality = quality
+			}
+
+			ranges = append(ranges, accepted)
+		}
+	}
+
+	return ranges
+}
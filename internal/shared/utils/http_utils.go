@@ -3,14 +3,28 @@ package utils
 import (
 	"backend/internal/infrastructure/http/middleware"
 	"backend/internal/shared/custom_errors"
+	"backend/internal/shared/languages"
 	"encoding/json"
+	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"strings"
 )
 
 // Sends JSON response to the client.
 // Code is HTTP STATUS code
 func RespondWithJSON(w http.ResponseWriter, r *http.Request, code int, payload any) {
+	respondWithMediaType(w, r, code, MediaTypeJSON, payload)
+}
+
+// Sends JSON-LD response to the client, payload is expected to carry its own "@context".
+// Code is HTTP STATUS code
+func RespondWithJSONLD(w http.ResponseWriter, r *http.Request, code int, payload any) {
+	respondWithMediaType(w, r, code, MediaTypeJSONLD, payload)
+}
+
+func respondWithMediaType(w http.ResponseWriter, r *http.Request, code int, mediaType string, payload any) {
 	requestID := middleware.GetRequestIDFromContext(r.Context())
 
 	response, err := json.Marshal(payload)
@@ -19,7 +33,7 @@ func RespondWithJSON(w http.ResponseWriter, r *http.Request, code int, payload a
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Type", mediaType)
 	w.WriteHeader(code)
 	_, err = w.Write(response)
 	if err != nil {
@@ -27,6 +41,7 @@ func RespondWithJSON(w http.ResponseWriter, r *http.Request, code int, payload a
 	}
 }
 
+// errorMessages are keyed by language code, languages missing here get messages of the default language
 var errorMessages = map[string]map[int]string{
 	"en": {
 		http.StatusInternalServerError: "An unexpected error occurred. Please try again later.",
@@ -64,10 +79,107 @@ func RespondWithError(w http.ResponseWriter, r *http.Request, err error) {
 			RespondWithJSON(w, r, appErr.StatusCode, map[string]string{"message": appErr.Err.Error()})
 			return
 		}
-		RespondWithJSON(w, r, appErr.StatusCode, map[string]string{"message": errorMessages[lang][appErr.StatusCode]})
+		RespondWithJSON(w, r, appErr.StatusCode, map[string]string{"message": languages.Localize(errorMessages, lang)[appErr.StatusCode]})
 		return
 	}
 
 	log.Printf("[%s] Unidenfied error: %v", requestID, err)
-	RespondWithJSON(w, r, 520, map[string]string{"message": errorMessages[lang][520]})
+	RespondWithJSON(w, r, 520, map[string]string{"message": languages.Localize(errorMessages, lang)[520]})
+}
+
+// TrustedProxies are the reverse proxies in front of the application, only their forwarding headers are believed
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies accepts single addresses and CIDR ranges
+func ParseTrustedProxies(entries []string) (TrustedProxies, error) {
+	trustedProxies := make(TrustedProxies, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy address(%s)", entry)
+			}
+
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			trustedProxies = append(trustedProxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy range(%s): %w", entry, err)
+		}
+		trustedProxies = append(trustedProxies, ipNet)
+	}
+
+	return trustedProxies, nil
+}
+
+func (tp TrustedProxies) contains(address string) bool {
+	ip := net.ParseIP(strings.TrimSpace(address))
+	if ip == nil {
+		return false
+	}
+
+	for _, ipNet := range tp {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClientIP returns the address of the client. Forwarding headers are believed only when the connection comes from a trusted proxy:
+// the client is then the rightmost address of X-Forwarded-For not belonging to a trusted proxy, or X-Real-IP.
+// Entries that are not IP addresses are skipped, an empty string is returned when no address is known
+func ClientIP(r *http.Request, trustedProxies TrustedProxies) string {
+	remoteIP, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remoteIP = r.RemoteAddr
+	}
+	remoteIP = normalizeIP(remoteIP)
+
+	if !trustedProxies.contains(remoteIP) {
+		return remoteIP
+	}
+
+	// a request may carry several X-Forwarded-For headers, their values form a single list in the order received
+	var addresses []string
+	for _, forwardedFor := range r.Header.Values("X-Forwarded-For") {
+		for _, address := range strings.Split(forwardedFor, ",") {
+			if address = normalizeIP(address); address != "" {
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	for index := len(addresses) - 1; index >= 0; index-- {
+		if !trustedProxies.contains(addresses[index]) {
+			return addresses[index]
+		}
+	}
+
+	if realIP := normalizeIP(r.Header.Get("X-Real-IP")); realIP != "" {
+		return realIP
+	}
+
+	return remoteIP
+}
+
+// normalizeIP returns the canonical form of an IP address, or an empty string when address is not one
+func normalizeIP(address string) string {
+	ip := net.ParseIP(strings.TrimSpace(address))
+	if ip == nil {
+		return ""
+	}
+
+	return ip.String()
 }
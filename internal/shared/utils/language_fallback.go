@@ -0,0 +1,22 @@
+package utils
+
+import "backend/internal/shared/languages"
+
+// LanguageFallback returns the languages to read an entry in, ordered by preference:
+// the requested langCode first, then the rest of fallbackChain in its own order.
+// Languages disabled in the registry are skipped, an empty fallbackChain means every enabled language in registry order.
+func LanguageFallback(langCode string, fallbackChain []string) []string {
+	if len(fallbackChain) == 0 {
+		fallbackChain = languages.Codes()
+	}
+
+	langCodes := make([]string, 0, len(fallbackChain)+1)
+	langCodes = append(langCodes, langCode)
+	for _, fallbackLangCode := range fallbackChain {
+		if fallbackLangCode != langCode && languages.IsEnabled(fallbackLangCode) {
+			langCodes = append(langCodes, fallbackLangCode)
+		}
+	}
+
+	return langCodes
+}
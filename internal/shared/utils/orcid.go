@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"strings"
+)
+
+// NormalizeORCID strips the orcid.org resolver prefix and uppercases the checksum character,
+// so "https://orcid.org/0000-0002-1825-009x" becomes "0000-0002-1825-009X"
+func NormalizeORCID(orcid string) string {
+	orcid = strings.TrimSpace(orcid)
+	for _, prefix := range []string{"https://orcid.org/", "http://orcid.org/", "orcid.org/"} {
+		if len(orcid) >= len(prefix) && strings.EqualFold(orcid[:len(prefix)], prefix) {
+			orcid = orcid[len(prefix):]
+			break
+		}
+	}
+
+	return strings.ToUpper(orcid)
+}
+
+// IsValidORCID checks the "0000-0000-0000-000X" format of the ORCID iD
+// and its ISO 7064 MOD 11-2 check digit
+func IsValidORCID(orcid string) bool {
+	if len(orcid) != 19 {
+		return false
+	}
+
+	total := 0
+	digits := 0
+	for index, r := range orcid {
+		if index == 4 || index == 9 || index == 14 {
+			if r != '-' {
+				return false
+			}
+			continue
+		}
+
+		if index == 18 {
+			break
+		}
+
+		if r < '0' || r > '9' {
+			return false
+		}
+
+		total = (total + int(r-'0')) * 2
+		digits++
+	}
+
+	if digits != 15 {
+		return false
+	}
+
+	result := (12 - total%11) % 11
+	expected := byte('0' + result)
+	if result == 10 {
+		expected = 'X'
+	}
+
+	return orcid[18] == expected
+}